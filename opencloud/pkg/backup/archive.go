@@ -0,0 +1,516 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/xattr"
+
+	"github.com/opencloud-eu/opencloud/pkg/version"
+)
+
+// ArchiveVersion is the version of the archive format written by CreateArchive
+const ArchiveVersion = 1
+
+const (
+	_manifestName = "manifest.json"
+	_xattrPrefix  = "SCHILY.xattr."
+)
+
+// Source is a directory or file that is part of a backup
+type Source struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// File is true if the source is a single file instead of a directory
+	File bool `json:"file,omitempty"`
+	// Blobs marks sources containing a blobstore. Blobs are immutable, incremental
+	// backups only contain the blobs that are not part of the base backup.
+	Blobs bool `json:"blobs,omitempty"`
+}
+
+// Manifest describes the content of a backup archive
+type Manifest struct {
+	Version          int       `json:"version"`
+	OpenCloudVersion string    `json:"opencloudVersion"`
+	Created          time.Time `json:"created"`
+	// Base is the file name of the archive an incremental backup is based on
+	Base    string   `json:"base,omitempty"`
+	Sources []Source `json:"sources"`
+	// Blobs are the IDs of all blobs per source at the time of the backup, including the
+	// blobs that are stored in the base archives
+	Blobs map[string][]string `json:"blobs,omitempty"`
+}
+
+// blobSet returns the blob IDs of a source as a set
+func (m *Manifest) blobSet(source string) map[string]bool {
+	set := map[string]bool{}
+	if m == nil {
+		return set
+	}
+	for _, id := range m.Blobs[source] {
+		set[id] = true
+	}
+	return set
+}
+
+// BlobID returns the ID of the blob stored at the given path relative to a blobstore source.
+// Blobs are stored as `.../blobs/<pathified id>`, other files are no blobs.
+func BlobID(rel string) (string, bool) {
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i, p := range parts {
+		if p == "blobs" && i+1 < len(parts) {
+			return strings.Join(parts[i+1:], ""), true
+		}
+	}
+	return "", false
+}
+
+type archiveEntry struct {
+	source Source
+	rel    string
+	path   string
+}
+
+// CreateArchive writes a gzip compressed tar archive of the sources to w, starting with the manifest.
+// If base is given, blobs that are part of the base backup are skipped. Sources that don't exist are
+// left out of the archive. The sources must not be written to while the archive is created.
+func CreateArchive(w io.Writer, sources []Source, base *Manifest, baseName string) (*Manifest, error) {
+	m := &Manifest{
+		Version:          ArchiveVersion,
+		OpenCloudVersion: version.GetString(),
+		Created:          time.Now().UTC(),
+		Blobs:            map[string][]string{},
+	}
+	if base != nil {
+		m.Base = baseName
+	}
+
+	var entries []archiveEntry
+	for _, s := range sources {
+		fi, err := os.Stat(s.Path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			continue
+		case err != nil:
+			return nil, err
+		}
+		s.File = !fi.IsDir()
+
+		var known map[string]bool
+		if base != nil {
+			known = base.blobSet(s.Name)
+		}
+
+		if s.File {
+			entries = append(entries, archiveEntry{source: s, rel: filepath.Base(s.Path), path: s.Path})
+			m.Sources = append(m.Sources, s)
+			continue
+		}
+
+		err = filepath.WalkDir(s.Path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(s.Path, p)
+			if err != nil || rel == "." {
+				return err
+			}
+
+			e := archiveEntry{source: s, rel: rel, path: p}
+			if id, ok := BlobID(rel); ok && s.Blobs && d.Type().IsRegular() {
+				m.Blobs[s.Name] = append(m.Blobs[s.Name], id)
+				if known[id] {
+					return nil
+				}
+			}
+			entries = append(entries, e)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not read '%s': %w", s.Path, err)
+		}
+		m.Sources = append(m.Sources, s)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     _manifestName,
+		Mode:     0600,
+		Size:     int64(len(b)),
+		ModTime:  m.Created,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(b); err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if err := writeEntry(tw, e); err != nil {
+			return nil, fmt.Errorf("could not archive '%s': %w", e.path, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return m, gw.Close()
+}
+
+func writeEntry(tw *tar.Writer, e archiveEntry) error {
+	fi, err := os.Lstat(e.path)
+	if err != nil {
+		return err
+	}
+
+	var link string
+	if fi.Mode()&fs.ModeSymlink != 0 {
+		if link, err = os.Readlink(e.path); err != nil {
+			return err
+		}
+	}
+
+	hdr, err := tar.FileInfoHeader(fi, link)
+	if err != nil {
+		return err
+	}
+	hdr.Name = path.Join(e.source.Name, filepath.ToSlash(e.rel))
+	hdr.Format = tar.FormatPAX
+
+	// decomposedfs and posixfs keep metadata in extended attributes
+	if names, err := xattr.LList(e.path); err == nil {
+		for _, name := range names {
+			v, err := xattr.LGet(e.path, name)
+			if err != nil {
+				return err
+			}
+			if hdr.PAXRecords == nil {
+				hdr.PAXRecords = map[string]string{}
+			}
+			hdr.PAXRecords[_xattrPrefix+name] = string(v)
+		}
+	}
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return nil
+	}
+
+	f, err := os.Open(e.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.CopyN(tw, f, hdr.Size)
+	return err
+}
+
+// ReadManifest reads the manifest of a backup archive
+func ReadManifest(archive string) (*Manifest, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tr, closeFn, err := openArchive(f)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	return readManifest(tr)
+}
+
+func openArchive(r io.Reader) (*tar.Reader, func(), error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tar.NewReader(gr), func() { _ = gr.Close() }, nil
+}
+
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest: %w", err)
+	}
+	if hdr.Name != _manifestName {
+		return nil, errors.New("not a backup archive, the manifest is missing")
+	}
+
+	m := &Manifest{}
+	if err := json.NewDecoder(tr).Decode(m); err != nil {
+		return nil, fmt.Errorf("could not read manifest: %w", err)
+	}
+	if m.Version > ArchiveVersion {
+		return nil, fmt.Errorf("archive version %d is not supported, the newest supported version is %d", m.Version, ArchiveVersion)
+	}
+	return m, nil
+}
+
+// Chain returns the archives needed to restore the given archive, starting with the full backup.
+// Base archives are expected in the same directory as the archive.
+func Chain(archive string) ([]string, *Manifest, error) {
+	m, err := ReadManifest(archive)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chain := []string{archive}
+	seen := map[string]bool{filepath.Base(archive): true}
+	for base := m.Base; base != ""; {
+		if seen[base] {
+			return nil, nil, fmt.Errorf("backup chain of '%s' contains a cycle", archive)
+		}
+		seen[base] = true
+
+		p := filepath.Join(filepath.Dir(archive), base)
+		bm, err := ReadManifest(p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read base archive '%s': %w", p, err)
+		}
+		chain = append([]string{p}, chain...)
+		base = bm.Base
+	}
+	return chain, m, nil
+}
+
+// RestoreArchive restores a backup into the target paths, which map source names to the paths to restore them to.
+// For incremental backups the base archives are read first, only the blobs that are still part of the restored
+// backup are taken from them. Sources without a target path are skipped.
+func RestoreArchive(archive string, targets map[string]string) (*Manifest, error) {
+	chain, m, err := Chain(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, a := range chain {
+		final := i == len(chain)-1
+		if err := restoreArchive(a, m, targets, final); err != nil {
+			return nil, fmt.Errorf("could not restore '%s': %w", a, err)
+		}
+	}
+	return m, nil
+}
+
+func restoreArchive(archive string, final *Manifest, targets map[string]string, all bool) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tr, closeFn, err := openArchive(f)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	m, err := readManifest(tr)
+	if err != nil {
+		return err
+	}
+
+	sources := map[string]Source{}
+	blobs := map[string]map[string]bool{}
+	for _, s := range m.Sources {
+		sources[s.Name] = s
+		blobs[s.Name] = final.blobSet(s.Name)
+	}
+
+	// the entries are extracted below the targets, they can't escape them via symlinks
+	roots := map[string]*os.Root{}
+	defer func() {
+		for _, r := range roots {
+			_ = r.Close()
+		}
+	}()
+
+	var dirs []*tar.Header
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name, rel, _ := strings.Cut(hdr.Name, "/")
+		s, ok := sources[name]
+		target, restore := targets[name]
+		if !ok || !restore || rel == "" {
+			continue
+		}
+
+		if !all {
+			// only the blobs of the restored backup are taken from base archives
+			id, isBlob := BlobID(rel)
+			if !s.Blobs || !isBlob || !blobs[name][id] {
+				continue
+			}
+		}
+
+		rel = filepath.FromSlash(rel)
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("invalid path '%s' in archive", hdr.Name)
+		}
+		dir := target
+		if s.File {
+			if hdr.Typeflag != tar.TypeReg {
+				return fmt.Errorf("invalid entry '%s' in archive", hdr.Name)
+			}
+			dir, rel = filepath.Dir(target), filepath.Base(target)
+		}
+
+		root, ok := roots[name]
+		if !ok {
+			if err := os.MkdirAll(dir, 0700); err != nil {
+				return err
+			}
+			if root, err = os.OpenRoot(dir); err != nil {
+				return err
+			}
+			roots[name] = root
+		}
+
+		if err := extractEntry(tr, hdr, root, rel); err != nil {
+			return fmt.Errorf("could not extract '%s': %w", hdr.Name, err)
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			hdr.Name = filepath.Join(dir, rel)
+			dirs = append(dirs, hdr)
+		}
+	}
+
+	// directories are created writable and their modification times change while their
+	// content is extracted, both are restored at the end
+	for _, hdr := range dirs {
+		if err := os.Chmod(hdr.Name, hdr.FileInfo().Mode().Perm()); err != nil {
+			return err
+		}
+		_ = os.Chtimes(hdr.Name, hdr.AccessTime, hdr.ModTime)
+	}
+	return nil
+}
+
+// extractEntry extracts an entry to the path rel below the root. Symlinks must point to a path
+// below the root, the root refuses to follow symlinks that leave it.
+func extractEntry(tr *tar.Reader, hdr *tar.Header, root *os.Root, rel string) error {
+	if err := mkdirAll(root, filepath.Dir(rel)); err != nil {
+		return err
+	}
+	p := filepath.Join(root.Name(), rel)
+
+	var f *os.File
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := mkdirAll(root, rel); err != nil {
+			return err
+		}
+		var err error
+		if f, err = root.Open(rel); err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		link := filepath.FromSlash(hdr.Linkname)
+		if filepath.IsAbs(link) || !filepath.IsLocal(filepath.Join(filepath.Dir(rel), link)) {
+			return fmt.Errorf("symlink target '%s' is outside of the restored data", hdr.Linkname)
+		}
+		// the parent was resolved by the root, it is not a symlink leaving the root
+		_ = root.Remove(rel)
+		if err := os.Symlink(link, p); err != nil {
+			return err
+		}
+	case tar.TypeReg:
+		_ = removeSymlink(root, rel)
+		var err error
+		if f, err = root.OpenFile(rel, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, hdr.FileInfo().Mode().Perm()); err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, tr); err != nil {
+			_ = f.Close()
+			return err
+		}
+	default:
+		return nil
+	}
+
+	for k, v := range hdr.PAXRecords {
+		name, ok := strings.CutPrefix(k, _xattrPrefix)
+		if !ok {
+			continue
+		}
+		var err error
+		if f != nil {
+			err = xattr.FSet(f, name, []byte(v))
+		} else {
+			err = xattr.LSet(p, name, []byte(v))
+		}
+		if err != nil {
+			if f != nil {
+				_ = f.Close()
+			}
+			return err
+		}
+	}
+
+	if f == nil {
+		return nil
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if hdr.Typeflag == tar.TypeReg {
+		return os.Chtimes(p, hdr.AccessTime, hdr.ModTime)
+	}
+	return nil
+}
+
+// mkdirAll creates a directory and its parents below the root
+func mkdirAll(root *os.Root, rel string) error {
+	if rel == "." {
+		return nil
+	}
+	if err := mkdirAll(root, filepath.Dir(rel)); err != nil {
+		return err
+	}
+	err := root.Mkdir(rel, 0700)
+	if errors.Is(err, fs.ErrExist) {
+		var fi fs.FileInfo
+		if fi, err = root.Stat(rel); err == nil && !fi.IsDir() {
+			return fmt.Errorf("'%s' is not a directory", rel)
+		}
+	}
+	return err
+}
+
+// removeSymlink removes a symlink that was extracted before, files are written to the path
+// of the symlink instead of its target
+func removeSymlink(root *os.Root, rel string) error {
+	fi, err := root.Lstat(rel)
+	if err != nil || fi.Mode()&fs.ModeSymlink == 0 {
+		return err
+	}
+	return root.Remove(rel)
+}
@@ -0,0 +1,252 @@
+package backup_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencloud-eu/opencloud/opencloud/pkg/backup"
+	"github.com/test-go/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for p, content := range files {
+		p = filepath.Join(root, p)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+	}
+}
+
+func readFiles(t *testing.T, root string) map[string]string {
+	files := map[string]string{}
+	require.NoError(t, filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		require.NoError(t, err)
+		if d.Type().IsRegular() {
+			b, err := os.ReadFile(p)
+			require.NoError(t, err)
+			rel, _ := filepath.Rel(root, p)
+			files[filepath.ToSlash(rel)] = string(b)
+		}
+		return nil
+	}))
+	return files
+}
+
+func createArchive(t *testing.T, path string, sources []backup.Source, base string) *backup.Manifest {
+	var bm *backup.Manifest
+	if base != "" {
+		var err error
+		bm, err = backup.ReadManifest(base)
+		require.NoError(t, err)
+	}
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	m, err := backup.CreateArchive(f, sources, bm, filepath.Base(base))
+	require.NoError(t, err)
+	return m
+}
+
+func TestBlobID(t *testing.T) {
+	id, ok := backup.BlobID("spaces/1a/2b3c/blobs/ab/cd/ef/gh/ijkl")
+	require.True(t, ok)
+	require.Equal(t, "abcdefghijkl", id)
+
+	_, ok = backup.BlobID("spaces/1a/2b3c/nodes/ab/cd/ef/gh/ijkl.mpk")
+	require.False(t, ok)
+}
+
+func TestArchive(t *testing.T) {
+	src := t.TempDir()
+	out := t.TempDir()
+
+	storage := filepath.Join(src, "storage")
+	db := filepath.Join(src, "idm", "idm.boltdb")
+	sources := []backup.Source{
+		{Name: "storage", Path: storage, Blobs: true},
+		{Name: "idm", Path: db},
+		{Name: "search", Path: filepath.Join(src, "missing")},
+	}
+
+	writeFiles(t, storage, map[string]string{
+		"spaces/1a/2b/nodes/n1.mpk":      "node 1",
+		"spaces/1a/2b/nodes/n2.mpk":      "node 2",
+		"spaces/1a/2b/blobs/aa/bb/cc/dd": "blob 1",
+		"spaces/1a/2b/blobs/ee/ff/gg/hh": "blob 2",
+	})
+	writeFiles(t, filepath.Dir(db), map[string]string{"idm.boltdb": "ldap v1"})
+
+	full := filepath.Join(out, "full.tar.gz")
+	m := createArchive(t, full, sources, "")
+	require.Equal(t, backup.ArchiveVersion, m.Version)
+	require.Len(t, m.Sources, 2, "missing sources are skipped")
+	require.True(t, m.Sources[1].File)
+	require.Equal(t, []string{"aabbccdd", "eeffgghh"}, m.Blobs["storage"])
+
+	// delete a node and its blob, add a new one
+	require.NoError(t, os.Remove(filepath.Join(storage, "spaces/1a/2b/nodes/n2.mpk")))
+	require.NoError(t, os.Remove(filepath.Join(storage, "spaces/1a/2b/blobs/ee/ff/gg/hh")))
+	writeFiles(t, storage, map[string]string{
+		"spaces/1a/2b/nodes/n3.mpk":      "node 3",
+		"spaces/1a/2b/blobs/ii/jj/kk/ll": "blob 3",
+	})
+	writeFiles(t, filepath.Dir(db), map[string]string{"idm.boltdb": "ldap v2"})
+
+	incremental := filepath.Join(out, "incremental.tar.gz")
+	m = createArchive(t, incremental, sources, full)
+	require.Equal(t, "full.tar.gz", m.Base)
+	require.Equal(t, []string{"aabbccdd", "iijjkkll"}, m.Blobs["storage"])
+
+	chain, _, err := backup.Chain(incremental)
+	require.NoError(t, err)
+	require.Equal(t, []string{full, incremental}, chain)
+
+	t.Run("restores an incremental backup", func(t *testing.T) {
+		dst := t.TempDir()
+		_, err := backup.RestoreArchive(incremental, map[string]string{
+			"storage": filepath.Join(dst, "storage"),
+			"idm":     filepath.Join(dst, "idm.boltdb"),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, readFiles(t, storage), readFiles(t, filepath.Join(dst, "storage")))
+		b, err := os.ReadFile(filepath.Join(dst, "idm.boltdb"))
+		require.NoError(t, err)
+		require.Equal(t, "ldap v2", string(b))
+	})
+
+	t.Run("restores a full backup", func(t *testing.T) {
+		dst := t.TempDir()
+		_, err := backup.RestoreArchive(full, map[string]string{"storage": dst})
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]string{
+			"spaces/1a/2b/nodes/n1.mpk":      "node 1",
+			"spaces/1a/2b/nodes/n2.mpk":      "node 2",
+			"spaces/1a/2b/blobs/aa/bb/cc/dd": "blob 1",
+			"spaces/1a/2b/blobs/ee/ff/gg/hh": "blob 2",
+		}, readFiles(t, dst))
+	})
+
+	t.Run("fails without the base archive", func(t *testing.T) {
+		dir := t.TempDir()
+		p := filepath.Join(dir, "incremental.tar.gz")
+		b, err := os.ReadFile(incremental)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(p, b, 0600))
+
+		_, err = backup.RestoreArchive(p, map[string]string{"storage": t.TempDir()})
+		require.Error(t, err)
+	})
+}
+
+// writeArchive writes an archive with the given entries of the source "storage"
+func writeArchive(t *testing.T, path string, file bool, entries []*tar.Header) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	m, err := json.Marshal(backup.Manifest{
+		Version: backup.ArchiveVersion,
+		Sources: []backup.Source{{Name: "storage", Path: "/storage", File: file}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "manifest.json", Mode: 0600, Size: int64(len(m)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(m)
+	require.NoError(t, err)
+
+	for _, hdr := range entries {
+		hdr.Name = "storage/" + hdr.Name
+		if hdr.Mode == 0 {
+			hdr.Mode = 0700
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err = tw.Write(make([]byte, hdr.Size))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+}
+
+func TestRestoreArchiveSymlinks(t *testing.T) {
+	file := func(name string) *tar.Header {
+		return &tar.Header{Name: name, Typeflag: tar.TypeReg, Size: 4}
+	}
+	symlink := func(name, target string) *tar.Header {
+		return &tar.Header{Name: name, Typeflag: tar.TypeSymlink, Linkname: target}
+	}
+	dir := func(name string) *tar.Header {
+		return &tar.Header{Name: name, Typeflag: tar.TypeDir}
+	}
+
+	tests := []struct {
+		name    string
+		file    bool
+		entries []*tar.Header
+		wantErr bool
+	}{
+		{
+			name:    "relative symlink inside of the target",
+			entries: []*tar.Header{dir("nodes/"), file("nodes/n1"), symlink("spaces/s1/root", "../../nodes/n1")},
+		},
+		{
+			name:    "absolute symlink",
+			entries: []*tar.Header{symlink("link", "/etc"), file("link/passwd")},
+			wantErr: true,
+		},
+		{
+			name:    "symlink leaving the target",
+			entries: []*tar.Header{symlink("spaces/link", "../../outside"), file("spaces/link/file")},
+			wantErr: true,
+		},
+		{
+			name:    "chained symlinks leaving the target",
+			entries: []*tar.Header{symlink("self", "."), symlink("parent", "self/.."), file("parent/outside/file")},
+			wantErr: true,
+		},
+		{
+			name:    "symlink replacing a directory",
+			entries: []*tar.Header{symlink("self", "."), symlink("parent", "self/.."), dir("parent/outside/")},
+			wantErr: true,
+		},
+		{
+			name:    "symlink as a file source",
+			file:    true,
+			entries: []*tar.Header{symlink("storage", "/etc/passwd")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			archive := filepath.Join(dir, "backup.tar.gz")
+			writeArchive(t, archive, tt.file, tt.entries)
+
+			target := filepath.Join(dir, "restore", "storage")
+			_, err := backup.RestoreArchive(archive, map[string]string{"storage": target})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// nothing is written outside of the target
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			for _, e := range entries {
+				require.Contains(t, []string{"backup.tar.gz", "restore"}, e.Name())
+			}
+			_, err = os.Lstat(filepath.Join(dir, "outside"))
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
@@ -3,6 +3,12 @@ package command
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,11 +16,34 @@ import (
 	"github.com/opencloud-eu/opencloud/opencloud/pkg/register"
 	"github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/pkg/config/parser"
+	idmparser "github.com/opencloud-eu/opencloud/services/idm/pkg/config/parser"
+	natsparser "github.com/opencloud-eu/opencloud/services/nats/pkg/config/parser"
+	searchparser "github.com/opencloud-eu/opencloud/services/search/pkg/config/parser"
+	storagesystemparser "github.com/opencloud-eu/opencloud/services/storage-system/pkg/config/parser"
+	storageusersparser "github.com/opencloud-eu/opencloud/services/storage-users/pkg/config/parser"
 	decomposedbs "github.com/opencloud-eu/reva/v2/pkg/storage/fs/decomposed/blobstore"
 	decomposeds3bs "github.com/opencloud-eu/reva/v2/pkg/storage/fs/decomposeds3/blobstore"
 )
 
+// _quiescedServices are stopped while a backup is created, in this order. They either accept
+// writes from users or keep state on disk or in the nats key value store. nats is stopped last,
+// after all services publishing to it, so its store is not changed while it is copied.
+var _quiescedServices = []string{
+	"proxy",
+	"storage-users",
+	"storage-system",
+	"search",
+	"idm",
+	"settings",
+	"userlog",
+	"eventhistory",
+	"activitylog",
+	"postprocessing",
+	"nats",
+}
+
 // BackupCommand is the entrypoint for the backup command
 func BackupCommand(cfg *config.Config) *cobra.Command {
 	bckCmd := &cobra.Command{
@@ -24,7 +53,7 @@ func BackupCommand(cfg *config.Config) *cobra.Command {
 			return configlog.ReturnError(parser.ParseConfig(cfg, true))
 		},
 	}
-	bckCmd.AddCommand(ConsistencyCommand(cfg))
+	bckCmd.AddCommand(ConsistencyCommand(cfg), CreateCommand(cfg), RestoreCommand(cfg))
 	return bckCmd
 }
 
@@ -77,6 +106,231 @@ func ConsistencyCommand(cfg *config.Config) *cobra.Command {
 	return consCmd
 }
 
+// CreateCommand is the entrypoint for the backup create command
+func CreateCommand(cfg *config.Config) *cobra.Command {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a backup archive of the data of this node",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnError(parseBackupConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, _ := cmd.Flags().GetString("output")
+			basePath, _ := cmd.Flags().GetString("base")
+			offline, _ := cmd.Flags().GetBool("offline")
+
+			var base *backup.Manifest
+			if basePath != "" {
+				baseDir, _ := filepath.Abs(filepath.Dir(basePath))
+				outDir, _ := filepath.Abs(output)
+				if baseDir != outDir {
+					return errors.New("the base archive must be in the output directory")
+				}
+				var err error
+				if base, err = backup.ReadManifest(basePath); err != nil {
+					return fmt.Errorf("could not read base archive: %w", err)
+				}
+			}
+
+			if err := os.MkdirAll(output, 0700); err != nil {
+				return err
+			}
+			name := filepath.Join(output, fmt.Sprintf("opencloud-backup-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z")))
+
+			if !offline {
+				client, err := rpc.DialHTTP("tcp", net.JoinHostPort(cfg.Runtime.Host, cfg.Runtime.Port))
+				if err != nil {
+					return errors.New("could not connect to the runtime to stop the services while the backup is created. Use --offline if OpenCloud is not running")
+				}
+				defer client.Close()
+
+				var stopped []string
+				if err := client.Call("Service.Stop", _quiescedServices, &stopped); err != nil {
+					return fmt.Errorf("could not stop the services: %w", err)
+				}
+				defer func() {
+					slices.Reverse(stopped)
+					var started []string
+					if err := client.Call("Service.Start", stopped, &started); err != nil {
+						fmt.Printf("could not start the services %v again: %v\n", stopped, err)
+					}
+				}()
+				fmt.Printf("stopped services %v while the backup is created\n", stopped)
+			}
+
+			f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+			if err != nil {
+				return err
+			}
+			m, err := backup.CreateArchive(f, backupSources(cfg), base, filepath.Base(basePath))
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				_ = os.Remove(name)
+				return err
+			}
+
+			for _, s := range m.Sources {
+				fmt.Printf("backed up %s from %s\n", s.Name, s.Path)
+			}
+			fmt.Printf("backup written to %s\n", name)
+			return nil
+		},
+	}
+	createCmd.Flags().StringP("output", "o", "", "the directory to write the backup archive to")
+	_ = createCmd.MarkFlagRequired("output")
+	createCmd.Flags().String("base", "", "create an incremental backup containing only the blobs added since the given backup archive")
+	createCmd.Flags().Bool("offline", false, "OpenCloud is not running, don't try to stop the services while the backup is created")
+	return createCmd
+}
+
+// RestoreCommand is the entrypoint for the backup restore command
+func RestoreCommand(cfg *config.Config) *cobra.Command {
+	restoreCmd := &cobra.Command{
+		Use:   "restore <archive>",
+		Short: "restore a backup archive, OpenCloud must not be running",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnError(parseBackupConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			force, _ := cmd.Flags().GetBool("force")
+
+			if client, err := rpc.DialHTTP("tcp", net.JoinHostPort(cfg.Runtime.Host, cfg.Runtime.Port)); err == nil {
+				_ = client.Close()
+				return errors.New("OpenCloud is running, stop it before restoring a backup")
+			}
+
+			m, err := backup.ReadManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			targets := map[string]string{}
+			suffix := ".before-restore-" + time.Now().UTC().Format("20060102T150405Z")
+			for _, s := range backupSources(cfg) {
+				if !slices.ContainsFunc(m.Sources, func(ms backup.Source) bool { return ms.Name == s.Name }) {
+					continue
+				}
+				if _, err := os.Stat(s.Path); err == nil {
+					if !force {
+						return fmt.Errorf("'%s' already exists, use --force to move existing data aside", s.Path)
+					}
+					if err := os.Rename(s.Path, s.Path+suffix); err != nil {
+						return err
+					}
+					fmt.Printf("moved existing %s to %s\n", s.Path, s.Path+suffix)
+				}
+				targets[s.Name] = s.Path
+			}
+
+			if _, err := backup.RestoreArchive(args[0], targets); err != nil {
+				return err
+			}
+
+			for name, path := range targets {
+				fmt.Printf("restored %s to %s\n", name, path)
+			}
+			return checkRestoredStorage(cfg, targets)
+		},
+	}
+	restoreCmd.Flags().Bool("force", false, "move existing data aside instead of refusing to restore")
+	return restoreCmd
+}
+
+// parseBackupConfig parses the configs of the services whose data is backed up
+func parseBackupConfig(cfg *config.Config) error {
+	cfg.StorageUsers.Commons = cfg.Commons
+	if err := storageusersparser.ParseConfig(cfg.StorageUsers); err != nil {
+		return err
+	}
+	cfg.StorageSystem.Commons = cfg.Commons
+	if err := storagesystemparser.ParseConfig(cfg.StorageSystem); err != nil {
+		return err
+	}
+	cfg.IDM.Commons = cfg.Commons
+	if err := idmparser.ParseConfig(cfg.IDM); err != nil {
+		return err
+	}
+	cfg.Search.Commons = cfg.Commons
+	if err := searchparser.ParseConfig(cfg.Search); err != nil {
+		return err
+	}
+	cfg.Nats.Commons = cfg.Commons
+	return natsparser.ParseConfig(cfg.Nats)
+}
+
+// backupSources returns the data of this node that is part of a backup
+func backupSources(cfg *config.Config) []backup.Source {
+	sources := []backup.Source{
+		{Name: "config", Path: defaults.BaseConfigPath()},
+		{Name: "storage-system", Path: cfg.StorageSystem.Drivers.Decomposed.Root, Blobs: true},
+	}
+
+	switch cfg.StorageUsers.Driver {
+	case "decomposed", "ocis":
+		sources = append(sources, backup.Source{Name: "storage-users", Path: cfg.StorageUsers.Drivers.Decomposed.Root, Blobs: true})
+	case "decomposeds3", "s3ng":
+		// the blobs are stored in the s3 bucket and need to be backed up separately
+		sources = append(sources, backup.Source{Name: "storage-users", Path: cfg.StorageUsers.Drivers.DecomposedS3.Root})
+	case "posix":
+		sources = append(sources, backup.Source{Name: "storage-users", Path: cfg.StorageUsers.Drivers.Posix.Root})
+	}
+
+	return append(sources,
+		backup.Source{Name: "idm", Path: cfg.IDM.IDM.DatabasePath},
+		backup.Source{Name: "search", Path: cfg.Search.Engine.Bleve.Datapath},
+		backup.Source{Name: "nats", Path: cfg.Nats.Nats.StoreDir},
+	)
+}
+
+// checkRestoredStorage runs the consistency check on the restored decomposedfs storages
+func checkRestoredStorage(cfg *config.Config, targets map[string]string) error {
+	if p, ok := targets["storage-system"]; ok {
+		bs, err := decomposedbs.New(p)
+		if err != nil {
+			return err
+		}
+		if err := backup.CheckProviderConsistency(p, bs, true); err != nil {
+			return fmt.Errorf("the restored system storage is not consistent, don't start OpenCloud: %w", err)
+		}
+	}
+
+	p, ok := targets["storage-users"]
+	if !ok {
+		return nil
+	}
+
+	var (
+		bs  backup.ListBlobstore
+		err error
+	)
+	switch cfg.StorageUsers.Driver {
+	case "decomposed", "ocis":
+		bs, err = decomposedbs.New(p)
+	case "decomposeds3", "s3ng":
+		bs, err = decomposeds3bs.New(
+			cfg.StorageUsers.Drivers.DecomposedS3.Endpoint,
+			cfg.StorageUsers.Drivers.DecomposedS3.Region,
+			cfg.StorageUsers.Drivers.DecomposedS3.Bucket,
+			cfg.StorageUsers.Drivers.DecomposedS3.AccessKey,
+			cfg.StorageUsers.Drivers.DecomposedS3.SecretKey,
+			decomposeds3bs.Options{},
+		)
+	default:
+		fmt.Printf("the consistency check is not available for the '%s' storage driver\n", cfg.StorageUsers.Driver)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := backup.CheckProviderConsistency(p, bs, true); err != nil {
+		return fmt.Errorf("the restored user storage is not consistent, don't start OpenCloud: %w", err)
+	}
+	return nil
+}
+
 func init() {
 	register.AddCommand(BackupCommand)
 }
@@ -48,6 +48,7 @@ import (
 	web "github.com/opencloud-eu/opencloud/services/web/pkg/command"
 	webdav "github.com/opencloud-eu/opencloud/services/webdav/pkg/command"
 	webfinger "github.com/opencloud-eu/opencloud/services/webfinger/pkg/command"
+	webhooks "github.com/opencloud-eu/opencloud/services/webhooks/pkg/command"
 
 	"github.com/spf13/cobra"
 )
@@ -258,6 +259,11 @@ var serviceCommands = []register.Command{
 			cfg.Webfinger.Commons = cfg.Commons
 		})
 	},
+	func(cfg *config.Config) *cobra.Command {
+		return ServiceCommand(cfg, cfg.Webhooks.Service.Name, webhooks.GetCommands(cfg.Webhooks), func(c *config.Config) {
+			cfg.Webhooks.Commons = cfg.Commons
+		})
+	},
 }
 
 // ServiceCommand composes a cobra command from the given inputs.
@@ -46,6 +46,7 @@ type OpenCloudConfig struct {
 	AuthService       AuthService           `yaml:"auth_service"`
 	Clientlog         Clientlog             `yaml:"clientlog"`
 	Activitylog       Activitylog           `yaml:"activitylog"`
+	Postprocessing    Postprocessing        `yaml:"postprocessing"`
 }
 
 // Activitylog is the configuration for the activitylog service
@@ -53,6 +54,11 @@ type Activitylog struct {
 	ServiceAccount ServiceAccount `yaml:"service_account"`
 }
 
+// Postprocessing is the configuration for the postprocessing service
+type Postprocessing struct {
+	ServiceAccount ServiceAccount `yaml:"service_account"`
+}
+
 // App is the configuration for the collaboration service
 type App struct {
 	Insecure bool `yaml:"insecure"`
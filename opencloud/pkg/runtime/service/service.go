@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/rpc"
@@ -59,6 +60,7 @@ import (
 	web "github.com/opencloud-eu/opencloud/services/web/pkg/command"
 	webdav "github.com/opencloud-eu/opencloud/services/webdav/pkg/command"
 	webfinger "github.com/opencloud-eu/opencloud/services/webfinger/pkg/command"
+	webhooks "github.com/opencloud-eu/opencloud/services/webhooks/pkg/command"
 	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
 	"github.com/opencloud-eu/reva/v2/pkg/logger"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
@@ -87,6 +89,8 @@ type Service struct {
 	Additional serviceFuncMap
 	Log        log.Logger
 
+	// mu guards serviceToken, which is changed by the Stop and Start RPC calls
+	mu           sync.Mutex
 	serviceToken map[string][]suture.ServiceToken
 	cfg          *occfg.Config
 }
@@ -342,6 +346,11 @@ func NewService(ctx context.Context, options ...Option) (*Service, error) {
 		cfg.Notifications.Commons = cfg.Commons
 		return notifications.Execute(cfg.Notifications)
 	})
+	areg(opts.Config.Webhooks.Service.Name, func(ctx context.Context, cfg *occfg.Config) error {
+		cfg.Webhooks.Context = ctx
+		cfg.Webhooks.Commons = cfg.Commons
+		return webhooks.Execute(cfg.Webhooks)
+	})
 
 	return s, nil
 }
@@ -459,6 +468,8 @@ func Start(ctx context.Context, o ...Option) error {
 
 // scheduleServiceTokens adds service tokens to the service supervisor.
 func scheduleServiceTokens(s *Service, funcSet serviceFuncMap) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for name := range runset {
 		if _, ok := funcSet[name]; !ok {
 			continue
@@ -504,11 +515,13 @@ func (s *Service) List(_ struct{}, reply *string) error {
 	table.Header([]string{"Service"})
 
 	names := []string{}
+	s.mu.Lock()
 	for t := range s.serviceToken {
 		if len(s.serviceToken[t]) > 0 {
 			names = append(names, t)
 		}
 	}
+	s.mu.Unlock()
 
 	sort.Strings(names)
 
@@ -521,6 +534,65 @@ func (s *Service) List(_ struct{}, reply *string) error {
 	return nil
 }
 
+// Stop stops the given services until they are started again with Start. It is used to quiesce
+// services while their data is backed up. The names of the stopped services are returned,
+// services that are not running are skipped. If a service can't be stopped, the services
+// stopped before are started again.
+func (s *Service) Stop(names []string, reply *[]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range names {
+		if len(s.serviceToken[name]) == 0 {
+			continue
+		}
+		for _, token := range s.serviceToken[name] {
+			if err := s.Supervisor.RemoveAndWait(token, _defaultShutdownTimeoutDuration); err != nil && !errors.Is(err, suture.ErrSupervisorNotRunning) {
+				var started []string
+				if err := s.start(*reply, &started); err != nil {
+					s.Log.Error().Err(err).Msg("could not start stopped services again")
+				}
+				return fmt.Errorf("could not stop service '%s': %w", name, err)
+			}
+		}
+		delete(s.serviceToken, name)
+		*reply = append(*reply, name)
+		s.Log.Info().Str("service", name).Msg("service stopped")
+	}
+	return nil
+}
+
+// Start starts services that have been stopped with Stop.
+func (s *Service) Start(names []string, reply *[]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.start(names, reply)
+}
+
+// start starts the given services, the caller must hold the lock.
+func (s *Service) start(names []string, reply *[]string) error {
+	for _, name := range names {
+		if len(s.serviceToken[name]) > 0 {
+			continue
+		}
+		for _, funcSet := range append(s.Services, s.Additional) {
+			f, ok := funcSet[name]
+			if !ok {
+				continue
+			}
+			swap := deepcopy.Copy(s.cfg)
+			s.serviceToken[name] = append(s.serviceToken[name], s.Supervisor.Add(f(swap.(*occfg.Config))))
+		}
+		if len(s.serviceToken[name]) == 0 {
+			return fmt.Errorf("unknown service '%s'", name)
+		}
+		*reply = append(*reply, name)
+		s.Log.Info().Str("service", name).Msg("service started")
+	}
+	return nil
+}
+
 func trapShutdownCtx(s *Service, srv *http.Server, ctx context.Context) error {
 	<-ctx.Done()
 	s.Log.Info().Msg("starting graceful shutdown")
@@ -539,6 +611,10 @@ func trapShutdownCtx(s *Service, srv *http.Server, ctx context.Context) error {
 		s.Log.Debug().Msg("runtime listener shutdown done")
 	}()
 
+	// keep services from being started by the Stop and Start RPC calls during the shutdown
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	// shutdown services in the order defined in the config
 	// any services not listed will be shutdown in parallel afterwards
 	for _, sName := range s.cfg.Runtime.ShutdownOrder {
@@ -558,12 +634,12 @@ func trapShutdownCtx(s *Service, srv *http.Server, ctx context.Context) error {
 	}
 
 	for sName := range s.serviceToken {
-		for i := range s.serviceToken[sName] {
+		for _, token := range s.serviceToken[sName] {
 			wg.Add(1)
 			go func() {
 				s.Log.Debug().Str("service", sName).Msg("starting graceful shutdown for service")
 				defer wg.Done()
-				if err := s.Supervisor.RemoveAndWait(s.serviceToken[sName][i], _defaultShutdownTimeoutDuration); err != nil && !errors.Is(err, suture.ErrSupervisorNotRunning) {
+				if err := s.Supervisor.RemoveAndWait(token, _defaultShutdownTimeoutDuration); err != nil && !errors.Is(err, suture.ErrSupervisorNotRunning) {
 					s.Log.Error().Err(err).Str("service", sName).Msg("could not shutdown service")
 					return
 				}
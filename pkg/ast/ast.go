@@ -60,6 +60,40 @@ type DateTimeNode struct {
 	Value    time.Time
 }
 
+// NumericNode represents a float64 value
+type NumericNode struct {
+	*Base
+	Key      string
+	Operator *OperatorNode
+	Value    float64
+}
+
+// ProximityNode represents terms that have to be near to each other,
+// at most Distance terms apart and in the given order if Ordered is set
+type ProximityNode struct {
+	*Base
+	Key      string
+	Terms    []string
+	Distance int
+	Ordered  bool
+}
+
+// RankNode represents a XRANK expression, the matches of the Match nodes
+// are boosted by Boost if they also match the Rank nodes
+type RankNode struct {
+	*Base
+	Boost float64
+	Match []Node
+	Rank  []Node
+}
+
+// SimilarNode represents a similar clause, the matches are ranked
+// by their similarity to the Value text
+type SimilarNode struct {
+	*Base
+	Value string
+}
+
 // OperatorNode represents an operator value like
 // AND, OR, NOT, =, <= ... and so on
 type OperatorNode struct {
@@ -83,6 +117,10 @@ func NodeKey(n Node) string {
 		return node.Key
 	case *BooleanNode:
 		return node.Key
+	case *NumericNode:
+		return node.Key
+	case *ProximityNode:
+		return node.Key
 	case *GroupNode:
 		return node.Key
 	default:
@@ -99,6 +137,12 @@ func NodeValue(n Node) interface{} {
 		return node.Value
 	case *BooleanNode:
 		return node.Value
+	case *NumericNode:
+		return node.Value
+	case *ProximityNode:
+		return node.Terms
+	case *SimilarNode:
+		return node.Value
 	case *GroupNode:
 		return node.Nodes
 	default:
@@ -43,6 +43,7 @@ import (
 	web "github.com/opencloud-eu/opencloud/services/web/pkg/config"
 	webdav "github.com/opencloud-eu/opencloud/services/webdav/pkg/config"
 	webfinger "github.com/opencloud-eu/opencloud/services/webfinger/pkg/config"
+	webhooks "github.com/opencloud-eu/opencloud/services/webhooks/pkg/config"
 )
 
 type Mode int
@@ -122,5 +123,6 @@ type Config struct {
 	Web               *web.Config            `yaml:"web"`
 	WebDAV            *webdav.Config         `yaml:"webdav"`
 	Webfinger         *webfinger.Config      `yaml:"webfinger"`
+	Webhooks          *webhooks.Config       `yaml:"webhooks"`
 	Search            *search.Config         `yaml:"search"`
 }
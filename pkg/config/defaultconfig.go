@@ -43,6 +43,7 @@ import (
 	web "github.com/opencloud-eu/opencloud/services/web/pkg/config/defaults"
 	webdav "github.com/opencloud-eu/opencloud/services/webdav/pkg/config/defaults"
 	webfinger "github.com/opencloud-eu/opencloud/services/webfinger/pkg/config/defaults"
+	webhooks "github.com/opencloud-eu/opencloud/services/webhooks/pkg/config/defaults"
 )
 
 func DefaultConfig() *Config {
@@ -98,5 +99,6 @@ func DefaultConfig() *Config {
 		Web:               web.DefaultConfig(),
 		WebDAV:            webdav.DefaultConfig(),
 		Webfinger:         webfinger.DefaultConfig(),
+		Webhooks:          webhooks.DefaultConfig(),
 	}
 }
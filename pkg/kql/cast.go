@@ -2,7 +2,10 @@ package kql
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/jinzhu/now"
 	"github.com/opencloud-eu/opencloud/pkg/ast"
@@ -79,6 +82,64 @@ func toTime(in interface{}) (time.Time, error) {
 	return now.Parse(ts)
 }
 
+// toTimeBounds returns the first and the last moment of a date value,
+// a full date lasts a day and a natural language date lasts its range
+func toTimeBounds(in interface{}) (time.Time, time.Time, error) {
+	value, err := toString(in)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if from, to, err := toTimeRange(value); err == nil {
+		return *from, *to, nil
+	}
+
+	t, err := toTime(value)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if strings.Contains(value, "T") {
+		return t, t, nil
+	}
+
+	day := now.With(t)
+	return day.BeginningOfDay(), day.EndOfDay(), nil
+}
+
+// _sizeUnits are the factors of the units a number can have
+var _sizeUnits = map[string]float64{
+	"b":  1,
+	"kb": 1 << 10,
+	"mb": 1 << 20,
+	"gb": 1 << 30,
+	"tb": 1 << 40,
+}
+
+func toNumber(in interface{}) (float64, error) {
+	value, err := toString(in)
+	if err != nil {
+		return 0, err
+	}
+
+	value = strings.ToLower(value)
+	factor := 1.0
+	if i := strings.IndexFunc(value, unicode.IsLetter); i >= 0 {
+		f, ok := _sizeUnits[value[i:]]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit '%s'", value[i:])
+		}
+		value, factor = value[:i], f
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return number * factor, nil
+}
+
 func toTimeRange(in interface{}) (*time.Time, *time.Time, error) {
 	var from, to time.Time
 
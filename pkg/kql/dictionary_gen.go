@@ -43,12 +43,12 @@ var g = &grammar{
 					pos: position{line: 19, col: 6, offset: 351},
 					exprs: []any{
 						&actionExpr{
-							pos: position{line: 233, col: 5, offset: 4804},
+							pos: position{line: 320, col: 5, offset: 7129},
 							run: (*parser).callonNodes3,
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 233, col: 5, offset: 4804},
+								pos: position{line: 320, col: 5, offset: 7129},
 								expr: &charClassMatcher{
-									pos:        position{line: 233, col: 5, offset: 4804},
+									pos:        position{line: 320, col: 5, offset: 7129},
 									val:        "[ \\t]",
 									chars:      []rune{' ', '\t'},
 									ignoreCase: false,
@@ -70,26 +70,321 @@ var g = &grammar{
 			expr: &choiceExpr{
 				pos: position{line: 22, col: 5, offset: 373},
 				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 180, col: 5, offset: 4670},
+						run: (*parser).callonNode2,
+						expr: &seqExpr{
+							pos: position{line: 180, col: 5, offset: 4670},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 180, col: 5, offset: 4670},
+									val:        "XRANK",
+									ignoreCase: false,
+									want:       "\"XRANK\"",
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode5,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 180, col: 15, offset: 4680},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode9,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 180, col: 21, offset: 4686},
+									val:        "cb",
+									ignoreCase: false,
+									want:       "\"cb\"",
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode13,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 180, col: 28, offset: 4693},
+									val:        "=",
+									ignoreCase: false,
+									want:       "\"=\"",
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode17,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 180, col: 34, offset: 4699},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 293, col: 5, offset: 6697},
+										run: (*parser).callonNode21,
+										expr: &seqExpr{
+											pos: position{line: 293, col: 5, offset: 6697},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 293, col: 5, offset: 6697},
+													expr: &actionExpr{
+														pos: position{line: 315, col: 5, offset: 7078},
+														run: (*parser).callonNode24,
+														expr: &charClassMatcher{
+															pos:        position{line: 315, col: 5, offset: 7078},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 12, offset: 6704},
+													expr: &seqExpr{
+														pos: position{line: 293, col: 13, offset: 6705},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 13, offset: 6705},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 293, col: 17, offset: 6709},
+																expr: &actionExpr{
+																	pos: position{line: 315, col: 5, offset: 7078},
+																	run: (*parser).callonNode30,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 315, col: 5, offset: 7078},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 26, offset: 6718},
+													expr: &choiceExpr{
+														pos: position{line: 293, col: 27, offset: 6719},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 27, offset: 6719},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 35, offset: 6727},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 43, offset: 6735},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 51, offset: 6743},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 59, offset: 6751},
+																val:        "b",
+																ignoreCase: true,
+																want:       "\"B\"i",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode39,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 180, col: 51, offset: 4716},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 22, col: 5, offset: 373},
+						pos:  position{line: 23, col: 5, offset: 396},
 						name: "GroupNode",
 					},
 					&actionExpr{
-						pos: position{line: 46, col: 5, offset: 1042},
-						run: (*parser).callonNode3,
+						pos: position{line: 43, col: 5, offset: 929},
+						run: (*parser).callonNode44,
+						expr: &seqExpr{
+							pos: position{line: 43, col: 5, offset: 929},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 43, col: 5, offset: 929},
+									val:        "similar",
+									ignoreCase: false,
+									want:       "\"similar\"",
+								},
+								&actionExpr{
+									pos: position{line: 185, col: 5, offset: 4801},
+									run: (*parser).callonNode47,
+									expr: &litMatcher{
+										pos:        position{line: 185, col: 5, offset: 4801},
+										val:        ":",
+										ignoreCase: false,
+										want:       "\":\"",
+									},
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode49,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 43, col: 35, offset: 959},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 43, col: 38, offset: 962},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 307, col: 5, offset: 6988},
+												run: (*parser).callonNode54,
+												expr: &seqExpr{
+													pos: position{line: 307, col: 5, offset: 6988},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 307, col: 5, offset: 6988},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 307, col: 9, offset: 6992},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 307, col: 11, offset: 6994},
+																expr: &charClassMatcher{
+																	pos:        position{line: 307, col: 11, offset: 6994},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 307, col: 17, offset: 7000},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 43, col: 47, offset: 971},
+												expr: &charClassMatcher{
+													pos:        position{line: 43, col: 47, offset: 971},
+													val:        "[^ ()]",
+													chars:      []rune{' ', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 60, col: 5, offset: 1427},
+						run: (*parser).callonNode63,
 						expr: &seqExpr{
-							pos: position{line: 46, col: 5, offset: 1042},
+							pos: position{line: 60, col: 5, offset: 1427},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 46, col: 5, offset: 1042},
+									pos:   position{line: 60, col: 5, offset: 1427},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 46, col: 7, offset: 1044},
+										pos: position{line: 60, col: 7, offset: 1429},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode7,
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode67,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 302, col: 5, offset: 6929},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -99,23 +394,23 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 46, col: 14, offset: 1051},
+									pos: position{line: 60, col: 14, offset: 1436},
 									alternatives: []any{
 										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode10,
+											pos: position{line: 185, col: 5, offset: 4801},
+											run: (*parser).callonNode70,
 											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
+												pos:        position{line: 185, col: 5, offset: 4801},
 												val:        ":",
 												ignoreCase: false,
 												want:       "\":\"",
 											},
 										},
 										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode12,
+											pos: position{line: 190, col: 5, offset: 4887},
+											run: (*parser).callonNode72,
 											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
+												pos:        position{line: 190, col: 5, offset: 4887},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
@@ -124,19 +419,19 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 46, col: 53, offset: 1090},
+									pos:   position{line: 60, col: 53, offset: 1475},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 46, col: 56, offset: 1093},
+										pos: position{line: 60, col: 56, offset: 1478},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 46, col: 56, offset: 1093},
+												pos:        position{line: 60, col: 56, offset: 1478},
 												val:        "true",
 												ignoreCase: false,
 												want:       "\"true\"",
 											},
 											&litMatcher{
-												pos:        position{line: 46, col: 65, offset: 1102},
+												pos:        position{line: 60, col: 65, offset: 1487},
 												val:        "false",
 												ignoreCase: false,
 												want:       "\"false\"",
@@ -148,21 +443,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 51, col: 5, offset: 1203},
-						run: (*parser).callonNode18,
+						pos: position{line: 95, col: 5, offset: 2413},
+						run: (*parser).callonNode78,
 						expr: &seqExpr{
-							pos: position{line: 51, col: 5, offset: 1203},
+							pos: position{line: 95, col: 5, offset: 2413},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 51, col: 5, offset: 1203},
+									pos:   position{line: 95, col: 5, offset: 2413},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 51, col: 7, offset: 1205},
+										pos: position{line: 95, col: 7, offset: 2415},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode22,
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode82,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 302, col: 5, offset: 6929},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -171,113 +466,69 @@ var g = &grammar{
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 51, col: 13, offset: 1211},
-									label: "o",
-									expr: &choiceExpr{
-										pos: position{line: 52, col: 9, offset: 1223},
-										alternatives: []any{
-											&actionExpr{
-												pos: position{line: 145, col: 5, offset: 3358},
-												run: (*parser).callonNode26,
-												expr: &litMatcher{
-													pos:        position{line: 145, col: 5, offset: 3358},
-													val:        ">=",
-													ignoreCase: false,
-													want:       "\">=\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 135, col: 5, offset: 3174},
-												run: (*parser).callonNode28,
-												expr: &litMatcher{
-													pos:        position{line: 135, col: 5, offset: 3174},
-													val:        "<=",
-													ignoreCase: false,
-													want:       "\"<=\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 140, col: 5, offset: 3263},
-												run: (*parser).callonNode30,
-												expr: &litMatcher{
-													pos:        position{line: 140, col: 5, offset: 3263},
-													val:        ">",
-													ignoreCase: false,
-													want:       "\">\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 130, col: 5, offset: 3082},
-												run: (*parser).callonNode32,
-												expr: &litMatcher{
-													pos:        position{line: 130, col: 5, offset: 3082},
-													val:        "<",
-													ignoreCase: false,
-													want:       "\"<\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 125, col: 5, offset: 2997},
-												run: (*parser).callonNode34,
-												expr: &litMatcher{
-													pos:        position{line: 125, col: 5, offset: 2997},
-													val:        "=",
-													ignoreCase: false,
-													want:       "\"=\"",
-												},
+								&choiceExpr{
+									pos: position{line: 95, col: 14, offset: 2422},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 185, col: 5, offset: 4801},
+											run: (*parser).callonNode85,
+											expr: &litMatcher{
+												pos:        position{line: 185, col: 5, offset: 4801},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
 											},
-											&actionExpr{
-												pos: position{line: 120, col: 5, offset: 2911},
-												run: (*parser).callonNode36,
-												expr: &litMatcher{
-													pos:        position{line: 120, col: 5, offset: 2911},
-													val:        ":",
-													ignoreCase: false,
-													want:       "\":\"",
-												},
+										},
+										&actionExpr{
+											pos: position{line: 190, col: 5, offset: 4887},
+											run: (*parser).callonNode87,
+											expr: &litMatcher{
+												pos:        position{line: 190, col: 5, offset: 4887},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
 											},
 										},
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 58, col: 7, offset: 1403},
+									pos: position{line: 95, col: 53, offset: 2461},
 									expr: &litMatcher{
-										pos:        position{line: 58, col: 7, offset: 1403},
+										pos:        position{line: 95, col: 53, offset: 2461},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 58, col: 12, offset: 1408},
-									label: "v",
+									pos:   position{line: 95, col: 58, offset: 2466},
+									label: "f",
 									expr: &choiceExpr{
-										pos: position{line: 59, col: 9, offset: 1420},
+										pos: position{line: 284, col: 5, offset: 6496},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 195, col: 5, offset: 4197},
-												run: (*parser).callonNode42,
+												pos: position{line: 265, col: 5, offset: 6177},
+												run: (*parser).callonNode93,
 												expr: &seqExpr{
-													pos: position{line: 195, col: 5, offset: 4197},
+													pos: position{line: 265, col: 5, offset: 6177},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 185, col: 5, offset: 3960},
-															run: (*parser).callonNode44,
+															pos: position{line: 255, col: 5, offset: 5940},
+															run: (*parser).callonNode95,
 															expr: &seqExpr{
-																pos: position{line: 185, col: 5, offset: 3960},
+																pos: position{line: 255, col: 5, offset: 5940},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 155, col: 5, offset: 3560},
-																		run: (*parser).callonNode46,
+																		pos: position{line: 225, col: 5, offset: 5540},
+																		run: (*parser).callonNode97,
 																		expr: &seqExpr{
-																			pos: position{line: 155, col: 5, offset: 3560},
+																			pos: position{line: 225, col: 5, offset: 5540},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode48,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode99,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -285,10 +536,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode50,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode101,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -296,10 +547,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode52,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode103,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -307,10 +558,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode54,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode105,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -321,22 +572,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 14, offset: 3969},
+																		pos:        position{line: 255, col: 14, offset: 5949},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 160, col: 5, offset: 3637},
-																		run: (*parser).callonNode57,
+																		pos: position{line: 230, col: 5, offset: 5617},
+																		run: (*parser).callonNode108,
 																		expr: &seqExpr{
-																			pos: position{line: 160, col: 5, offset: 3637},
+																			pos: position{line: 230, col: 5, offset: 5617},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode59,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode110,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -344,10 +595,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode61,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode112,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -358,22 +609,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 28, offset: 3983},
+																		pos:        position{line: 255, col: 28, offset: 5963},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 165, col: 5, offset: 3700},
-																		run: (*parser).callonNode64,
+																		pos: position{line: 235, col: 5, offset: 5680},
+																		run: (*parser).callonNode115,
 																		expr: &seqExpr{
-																			pos: position{line: 165, col: 5, offset: 3700},
+																			pos: position{line: 235, col: 5, offset: 5680},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode66,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode117,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -381,10 +632,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode68,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode119,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -398,28 +649,28 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 195, col: 14, offset: 4206},
+															pos:        position{line: 265, col: 14, offset: 6186},
 															val:        "T",
 															ignoreCase: false,
 															want:       "\"T\"",
 														},
 														&actionExpr{
-															pos: position{line: 190, col: 5, offset: 4047},
-															run: (*parser).callonNode71,
+															pos: position{line: 260, col: 5, offset: 6027},
+															run: (*parser).callonNode122,
 															expr: &seqExpr{
-																pos: position{line: 190, col: 5, offset: 4047},
+																pos: position{line: 260, col: 5, offset: 6027},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 170, col: 5, offset: 3764},
-																		run: (*parser).callonNode73,
+																		pos: position{line: 240, col: 5, offset: 5744},
+																		run: (*parser).callonNode124,
 																		expr: &seqExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
+																			pos: position{line: 240, col: 5, offset: 5744},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode75,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode126,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -427,10 +678,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode77,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode128,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -441,22 +692,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 14, offset: 4056},
+																		pos:        position{line: 260, col: 14, offset: 6036},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 175, col: 5, offset: 3830},
-																		run: (*parser).callonNode80,
+																		pos: position{line: 245, col: 5, offset: 5810},
+																		run: (*parser).callonNode131,
 																		expr: &seqExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
+																			pos: position{line: 245, col: 5, offset: 5810},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode82,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode133,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -464,10 +715,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode84,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode135,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -478,22 +729,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 29, offset: 4071},
+																		pos:        position{line: 260, col: 29, offset: 6051},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 180, col: 5, offset: 3896},
-																		run: (*parser).callonNode87,
+																		pos: position{line: 250, col: 5, offset: 5876},
+																		run: (*parser).callonNode138,
 																		expr: &seqExpr{
-																			pos: position{line: 180, col: 5, offset: 3896},
+																			pos: position{line: 250, col: 5, offset: 5876},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode89,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode140,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -501,10 +752,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode91,
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode142,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 315, col: 5, offset: 7078},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -515,23 +766,23 @@ var g = &grammar{
 																		},
 																	},
 																	&zeroOrOneExpr{
-																		pos: position{line: 190, col: 44, offset: 4086},
+																		pos: position{line: 260, col: 44, offset: 6066},
 																		expr: &seqExpr{
-																			pos: position{line: 190, col: 45, offset: 4087},
+																			pos: position{line: 260, col: 45, offset: 6067},
 																			exprs: []any{
 																				&litMatcher{
-																					pos:        position{line: 190, col: 45, offset: 4087},
+																					pos:        position{line: 260, col: 45, offset: 6067},
 																					val:        ".",
 																					ignoreCase: false,
 																					want:       "\".\"",
 																				},
 																				&oneOrMoreExpr{
-																					pos: position{line: 190, col: 49, offset: 4091},
+																					pos: position{line: 260, col: 49, offset: 6071},
 																					expr: &actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode97,
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode148,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 315, col: 5, offset: 7078},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -543,35 +794,35 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 190, col: 59, offset: 4101},
+																		pos: position{line: 260, col: 59, offset: 6081},
 																		alternatives: []any{
 																			&litMatcher{
-																				pos:        position{line: 190, col: 59, offset: 4101},
+																				pos:        position{line: 260, col: 59, offset: 6081},
 																				val:        "Z",
 																				ignoreCase: false,
 																				want:       "\"Z\"",
 																			},
 																			&seqExpr{
-																				pos: position{line: 190, col: 65, offset: 4107},
+																				pos: position{line: 260, col: 65, offset: 6087},
 																				exprs: []any{
 																					&charClassMatcher{
-																						pos:        position{line: 190, col: 66, offset: 4108},
+																						pos:        position{line: 260, col: 66, offset: 6088},
 																						val:        "[+-]",
 																						chars:      []rune{'+', '-'},
 																						ignoreCase: false,
 																						inverted:   false,
 																					},
 																					&actionExpr{
-																						pos: position{line: 170, col: 5, offset: 3764},
-																						run: (*parser).callonNode103,
+																						pos: position{line: 240, col: 5, offset: 5744},
+																						run: (*parser).callonNode154,
 																						expr: &seqExpr{
-																							pos: position{line: 170, col: 5, offset: 3764},
+																							pos: position{line: 240, col: 5, offset: 5744},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode105,
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode156,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 315, col: 5, offset: 7078},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -579,10 +830,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode107,
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode158,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 315, col: 5, offset: 7078},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -593,22 +844,22 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 190, col: 86, offset: 4128},
+																						pos:        position{line: 260, col: 86, offset: 6108},
 																						val:        ":",
 																						ignoreCase: false,
 																						want:       "\":\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 175, col: 5, offset: 3830},
-																						run: (*parser).callonNode110,
+																						pos: position{line: 245, col: 5, offset: 5810},
+																						run: (*parser).callonNode161,
 																						expr: &seqExpr{
-																							pos: position{line: 175, col: 5, offset: 3830},
+																							pos: position{line: 245, col: 5, offset: 5810},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode112,
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode163,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 315, col: 5, offset: 7078},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -616,10 +867,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode114,
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode165,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 315, col: 5, offset: 7078},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -640,22 +891,22 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 185, col: 5, offset: 3960},
-												run: (*parser).callonNode116,
+												pos: position{line: 255, col: 5, offset: 5940},
+												run: (*parser).callonNode167,
 												expr: &seqExpr{
-													pos: position{line: 185, col: 5, offset: 3960},
+													pos: position{line: 255, col: 5, offset: 5940},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 155, col: 5, offset: 3560},
-															run: (*parser).callonNode118,
+															pos: position{line: 225, col: 5, offset: 5540},
+															run: (*parser).callonNode169,
 															expr: &seqExpr{
-																pos: position{line: 155, col: 5, offset: 3560},
+																pos: position{line: 225, col: 5, offset: 5540},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode120,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode171,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -663,10 +914,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode122,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode173,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -674,10 +925,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode124,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode175,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -685,10 +936,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode126,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode177,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -699,22 +950,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 14, offset: 3969},
+															pos:        position{line: 255, col: 14, offset: 5949},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 160, col: 5, offset: 3637},
-															run: (*parser).callonNode129,
+															pos: position{line: 230, col: 5, offset: 5617},
+															run: (*parser).callonNode180,
 															expr: &seqExpr{
-																pos: position{line: 160, col: 5, offset: 3637},
+																pos: position{line: 230, col: 5, offset: 5617},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode131,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode182,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -722,10 +973,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode133,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode184,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -736,22 +987,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 28, offset: 3983},
+															pos:        position{line: 255, col: 28, offset: 5963},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 165, col: 5, offset: 3700},
-															run: (*parser).callonNode136,
+															pos: position{line: 235, col: 5, offset: 5680},
+															run: (*parser).callonNode187,
 															expr: &seqExpr{
-																pos: position{line: 165, col: 5, offset: 3700},
+																pos: position{line: 235, col: 5, offset: 5680},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode138,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode189,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -759,10 +1010,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode140,
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode191,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 315, col: 5, offset: 7078},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -775,377 +1026,65 @@ var g = &grammar{
 													},
 												},
 											},
-											&actionExpr{
-												pos: position{line: 190, col: 5, offset: 4047},
-												run: (*parser).callonNode142,
-												expr: &seqExpr{
-													pos: position{line: 190, col: 5, offset: 4047},
-													exprs: []any{
-														&actionExpr{
-															pos: position{line: 170, col: 5, offset: 3764},
-															run: (*parser).callonNode144,
-															expr: &seqExpr{
-																pos: position{line: 170, col: 5, offset: 3764},
-																exprs: []any{
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode146,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode148,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 190, col: 14, offset: 4056},
-															val:        ":",
-															ignoreCase: false,
-															want:       "\":\"",
-														},
-														&actionExpr{
-															pos: position{line: 175, col: 5, offset: 3830},
-															run: (*parser).callonNode151,
-															expr: &seqExpr{
-																pos: position{line: 175, col: 5, offset: 3830},
-																exprs: []any{
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode153,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode155,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 190, col: 29, offset: 4071},
-															val:        ":",
-															ignoreCase: false,
-															want:       "\":\"",
-														},
-														&actionExpr{
-															pos: position{line: 180, col: 5, offset: 3896},
-															run: (*parser).callonNode158,
-															expr: &seqExpr{
-																pos: position{line: 180, col: 5, offset: 3896},
-																exprs: []any{
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode160,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode162,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
-														},
-														&zeroOrOneExpr{
-															pos: position{line: 190, col: 44, offset: 4086},
-															expr: &seqExpr{
-																pos: position{line: 190, col: 45, offset: 4087},
-																exprs: []any{
-																	&litMatcher{
-																		pos:        position{line: 190, col: 45, offset: 4087},
-																		val:        ".",
-																		ignoreCase: false,
-																		want:       "\".\"",
-																	},
-																	&oneOrMoreExpr{
-																		pos: position{line: 190, col: 49, offset: 4091},
-																		expr: &actionExpr{
-																			pos: position{line: 228, col: 5, offset: 4753},
-																			run: (*parser).callonNode168,
-																			expr: &charClassMatcher{
-																				pos:        position{line: 228, col: 5, offset: 4753},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&choiceExpr{
-															pos: position{line: 190, col: 59, offset: 4101},
-															alternatives: []any{
-																&litMatcher{
-																	pos:        position{line: 190, col: 59, offset: 4101},
-																	val:        "Z",
-																	ignoreCase: false,
-																	want:       "\"Z\"",
-																},
-																&seqExpr{
-																	pos: position{line: 190, col: 65, offset: 4107},
-																	exprs: []any{
-																		&charClassMatcher{
-																			pos:        position{line: 190, col: 66, offset: 4108},
-																			val:        "[+-]",
-																			chars:      []rune{'+', '-'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																		&actionExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
-																			run: (*parser).callonNode174,
-																			expr: &seqExpr{
-																				pos: position{line: 170, col: 5, offset: 3764},
-																				exprs: []any{
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode176,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode178,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 190, col: 86, offset: 4128},
-																			val:        ":",
-																			ignoreCase: false,
-																			want:       "\":\"",
-																		},
-																		&actionExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
-																			run: (*parser).callonNode181,
-																			expr: &seqExpr{
-																				pos: position{line: 175, col: 5, offset: 3830},
-																				exprs: []any{
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode183,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode185,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 62, col: 7, offset: 1473},
-									expr: &litMatcher{
-										pos:        position{line: 62, col: 7, offset: 1473},
-										val:        "\"",
-										ignoreCase: false,
-										want:       "\"\\\"\"",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 65, col: 5, offset: 1549},
-						run: (*parser).callonNode189,
-						expr: &seqExpr{
-							pos: position{line: 65, col: 5, offset: 1549},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 65, col: 5, offset: 1549},
-									label: "k",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 65, col: 7, offset: 1551},
-										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode193,
-											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
-												val:        "[A-Za-z]",
-												ranges:     []rune{'A', 'Z', 'a', 'z'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 66, col: 9, offset: 1567},
-									alternatives: []any{
-										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode196,
-											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
-												val:        "=",
-												ignoreCase: false,
-												want:       "\"=\"",
-											},
-										},
-										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode198,
-											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
-												val:        ":",
-												ignoreCase: false,
-												want:       "\":\"",
-											},
-										},
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 68, col: 7, offset: 1619},
-									expr: &litMatcher{
-										pos:        position{line: 68, col: 7, offset: 1619},
-										val:        "\"",
-										ignoreCase: false,
-										want:       "\"\\\"\"",
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 68, col: 12, offset: 1624},
-									label: "v",
-									expr: &choiceExpr{
-										pos: position{line: 200, col: 5, offset: 4285},
-										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 200, col: 5, offset: 4285},
+												pos:        position{line: 270, col: 5, offset: 6265},
 												val:        "today",
 												ignoreCase: false,
 												want:       "\"today\"",
 											},
 											&litMatcher{
-												pos:        position{line: 201, col: 5, offset: 4299},
+												pos:        position{line: 271, col: 5, offset: 6279},
 												val:        "yesterday",
 												ignoreCase: false,
 												want:       "\"yesterday\"",
 											},
 											&litMatcher{
-												pos:        position{line: 202, col: 5, offset: 4317},
+												pos:        position{line: 272, col: 5, offset: 6297},
 												val:        "this week",
 												ignoreCase: false,
 												want:       "\"this week\"",
 											},
 											&litMatcher{
-												pos:        position{line: 203, col: 5, offset: 4335},
+												pos:        position{line: 273, col: 5, offset: 6315},
 												val:        "last week",
 												ignoreCase: false,
 												want:       "\"last week\"",
 											},
 											&litMatcher{
-												pos:        position{line: 204, col: 5, offset: 4353},
+												pos:        position{line: 274, col: 5, offset: 6333},
 												val:        "last 7 days",
 												ignoreCase: false,
 												want:       "\"last 7 days\"",
 											},
 											&litMatcher{
-												pos:        position{line: 205, col: 5, offset: 4373},
+												pos:        position{line: 275, col: 5, offset: 6353},
 												val:        "this month",
 												ignoreCase: false,
 												want:       "\"this month\"",
 											},
 											&litMatcher{
-												pos:        position{line: 206, col: 5, offset: 4392},
+												pos:        position{line: 276, col: 5, offset: 6372},
 												val:        "last month",
 												ignoreCase: false,
 												want:       "\"last month\"",
 											},
 											&litMatcher{
-												pos:        position{line: 207, col: 5, offset: 4411},
+												pos:        position{line: 277, col: 5, offset: 6391},
 												val:        "last 30 days",
 												ignoreCase: false,
 												want:       "\"last 30 days\"",
 											},
 											&litMatcher{
-												pos:        position{line: 208, col: 5, offset: 4432},
+												pos:        position{line: 278, col: 5, offset: 6412},
 												val:        "this year",
 												ignoreCase: false,
 												want:       "\"this year\"",
 											},
 											&actionExpr{
-												pos: position{line: 209, col: 5, offset: 4450},
-												run: (*parser).callonNode213,
+												pos: position{line: 279, col: 5, offset: 6430},
+												run: (*parser).callonNode202,
 												expr: &litMatcher{
-													pos:        position{line: 209, col: 5, offset: 4450},
+													pos:        position{line: 279, col: 5, offset: 6430},
 													val:        "last year",
 													ignoreCase: false,
 													want:       "\"last year\"",
@@ -1154,318 +1093,2950 @@ var g = &grammar{
 										},
 									},
 								},
-								&zeroOrOneExpr{
-									pos: position{line: 68, col: 38, offset: 1650},
-									expr: &litMatcher{
-										pos:        position{line: 68, col: 38, offset: 1650},
-										val:        "\"",
-										ignoreCase: false,
-										want:       "\"\\\"\"",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 1769},
-						run: (*parser).callonNode217,
-						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 1769},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 73, col: 5, offset: 1769},
-									label: "k",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 73, col: 7, offset: 1771},
-										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode221,
-											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
-												val:        "[A-Za-z]",
-												ranges:     []rune{'A', 'Z', 'a', 'z'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 73, col: 14, offset: 1778},
-									alternatives: []any{
-										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode224,
-											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
-												val:        ":",
-												ignoreCase: false,
-												want:       "\":\"",
-											},
-										},
-										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode226,
-											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
-												val:        "=",
-												ignoreCase: false,
-												want:       "\"=\"",
-											},
-										},
-									},
+								&litMatcher{
+									pos:        position{line: 95, col: 70, offset: 2478},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 53, offset: 1817},
-									label: "v",
+									pos:   position{line: 95, col: 75, offset: 2483},
+									label: "t",
 									expr: &choiceExpr{
-										pos: position{line: 73, col: 56, offset: 1820},
+										pos: position{line: 284, col: 5, offset: 6496},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 223, col: 5, offset: 4693},
-												run: (*parser).callonNode230,
+												pos: position{line: 265, col: 5, offset: 6177},
+												run: (*parser).callonNode207,
 												expr: &seqExpr{
-													pos: position{line: 223, col: 5, offset: 4693},
+													pos: position{line: 265, col: 5, offset: 6177},
 													exprs: []any{
-														&litMatcher{
-															pos:        position{line: 223, col: 5, offset: 4693},
-															val:        "\"",
-															ignoreCase: false,
-															want:       "\"\\\"\"",
-														},
-														&labeledExpr{
-															pos:   position{line: 223, col: 9, offset: 4697},
-															label: "v",
-															expr: &zeroOrMoreExpr{
-																pos: position{line: 223, col: 11, offset: 4699},
-																expr: &charClassMatcher{
-																	pos:        position{line: 223, col: 11, offset: 4699},
-																	val:        "[^\"]",
-																	chars:      []rune{'"'},
-																	ignoreCase: false,
-																	inverted:   true,
+														&actionExpr{
+															pos: position{line: 255, col: 5, offset: 5940},
+															run: (*parser).callonNode209,
+															expr: &seqExpr{
+																pos: position{line: 255, col: 5, offset: 5940},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 225, col: 5, offset: 5540},
+																		run: (*parser).callonNode211,
+																		expr: &seqExpr{
+																			pos: position{line: 225, col: 5, offset: 5540},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode213,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode215,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode217,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode219,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 255, col: 14, offset: 5949},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 230, col: 5, offset: 5617},
+																		run: (*parser).callonNode222,
+																		expr: &seqExpr{
+																			pos: position{line: 230, col: 5, offset: 5617},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode224,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode226,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 255, col: 28, offset: 5963},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 235, col: 5, offset: 5680},
+																		run: (*parser).callonNode229,
+																		expr: &seqExpr{
+																			pos: position{line: 235, col: 5, offset: 5680},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode231,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode233,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
 																},
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 223, col: 17, offset: 4705},
-															val:        "\"",
-															ignoreCase: false,
-															want:       "\"\\\"\"",
-														},
-													},
-												},
-											},
-											&oneOrMoreExpr{
-												pos: position{line: 73, col: 65, offset: 1829},
-												expr: &charClassMatcher{
-													pos:        position{line: 73, col: 65, offset: 1829},
-													val:        "[^ ()]",
-													chars:      []rune{' ', '(', ')'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 105, col: 5, offset: 2621},
-						run: (*parser).callonNode239,
-						expr: &choiceExpr{
-							pos: position{line: 105, col: 6, offset: 2622},
-							alternatives: []any{
-								&litMatcher{
-									pos:        position{line: 105, col: 6, offset: 2622},
-									val:        "AND",
-									ignoreCase: false,
-									want:       "\"AND\"",
-								},
-								&litMatcher{
-									pos:        position{line: 105, col: 14, offset: 2630},
-									val:        "+",
-									ignoreCase: false,
-									want:       "\"+\"",
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 2722},
-						run: (*parser).callonNode243,
-						expr: &choiceExpr{
-							pos: position{line: 110, col: 6, offset: 2723},
-							alternatives: []any{
-								&litMatcher{
-									pos:        position{line: 110, col: 6, offset: 2723},
-									val:        "NOT",
-									ignoreCase: false,
-									want:       "\"NOT\"",
-								},
-								&litMatcher{
-									pos:        position{line: 110, col: 14, offset: 2731},
-									val:        "-",
-									ignoreCase: false,
-									want:       "\"-\"",
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 115, col: 5, offset: 2822},
-						run: (*parser).callonNode247,
-						expr: &litMatcher{
-							pos:        position{line: 115, col: 6, offset: 2823},
-							val:        "OR",
-							ignoreCase: false,
-							want:       "\"OR\"",
-						},
-					},
-					&actionExpr{
-						pos: position{line: 86, col: 6, offset: 2109},
-						run: (*parser).callonNode249,
-						expr: &seqExpr{
-							pos: position{line: 86, col: 6, offset: 2109},
-							exprs: []any{
-								&zeroOrOneExpr{
-									pos: position{line: 86, col: 6, offset: 2109},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode252,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode254,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 86, col: 27, offset: 2130},
-									label: "v",
-									expr: &actionExpr{
-										pos: position{line: 223, col: 5, offset: 4693},
-										run: (*parser).callonNode258,
-										expr: &seqExpr{
-											pos: position{line: 223, col: 5, offset: 4693},
-											exprs: []any{
-												&litMatcher{
-													pos:        position{line: 223, col: 5, offset: 4693},
-													val:        "\"",
-													ignoreCase: false,
-													want:       "\"\\\"\"",
-												},
-												&labeledExpr{
-													pos:   position{line: 223, col: 9, offset: 4697},
-													label: "v",
-													expr: &zeroOrMoreExpr{
-														pos: position{line: 223, col: 11, offset: 4699},
-														expr: &charClassMatcher{
-															pos:        position{line: 223, col: 11, offset: 4699},
-															val:        "[^\"]",
-															chars:      []rune{'"'},
+															pos:        position{line: 265, col: 14, offset: 6186},
+															val:        "T",
 															ignoreCase: false,
-															inverted:   true,
+															want:       "\"T\"",
 														},
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 223, col: 17, offset: 4705},
-													val:        "\"",
-													ignoreCase: false,
-													want:       "\"\\\"\"",
-												},
-											},
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode265,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 86, col: 38, offset: 2141},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode269,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 91, col: 6, offset: 2239},
-						run: (*parser).callonNode271,
-						expr: &seqExpr{
-							pos: position{line: 91, col: 6, offset: 2239},
-							exprs: []any{
-								&zeroOrOneExpr{
-									pos: position{line: 91, col: 6, offset: 2239},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode274,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode276,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
+														&actionExpr{
+															pos: position{line: 260, col: 5, offset: 6027},
+															run: (*parser).callonNode236,
+															expr: &seqExpr{
+																pos: position{line: 260, col: 5, offset: 6027},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 240, col: 5, offset: 5744},
+																		run: (*parser).callonNode238,
+																		expr: &seqExpr{
+																			pos: position{line: 240, col: 5, offset: 5744},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode240,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode242,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 260, col: 14, offset: 6036},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 245, col: 5, offset: 5810},
+																		run: (*parser).callonNode245,
+																		expr: &seqExpr{
+																			pos: position{line: 245, col: 5, offset: 5810},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode247,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode249,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 260, col: 29, offset: 6051},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 250, col: 5, offset: 5876},
+																		run: (*parser).callonNode252,
+																		expr: &seqExpr{
+																			pos: position{line: 250, col: 5, offset: 5876},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode254,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode256,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 260, col: 44, offset: 6066},
+																		expr: &seqExpr{
+																			pos: position{line: 260, col: 45, offset: 6067},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 260, col: 45, offset: 6067},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 260, col: 49, offset: 6071},
+																					expr: &actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode262,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 260, col: 59, offset: 6081},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 260, col: 59, offset: 6081},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 260, col: 65, offset: 6087},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 260, col: 66, offset: 6088},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 240, col: 5, offset: 5744},
+																						run: (*parser).callonNode268,
+																						expr: &seqExpr{
+																							pos: position{line: 240, col: 5, offset: 5744},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode270,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode272,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 260, col: 86, offset: 6108},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 245, col: 5, offset: 5810},
+																						run: (*parser).callonNode275,
+																						expr: &seqExpr{
+																							pos: position{line: 245, col: 5, offset: 5810},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode277,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode279,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 255, col: 5, offset: 5940},
+												run: (*parser).callonNode281,
+												expr: &seqExpr{
+													pos: position{line: 255, col: 5, offset: 5940},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 225, col: 5, offset: 5540},
+															run: (*parser).callonNode283,
+															expr: &seqExpr{
+																pos: position{line: 225, col: 5, offset: 5540},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode285,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode287,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode289,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode291,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 255, col: 14, offset: 5949},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 230, col: 5, offset: 5617},
+															run: (*parser).callonNode294,
+															expr: &seqExpr{
+																pos: position{line: 230, col: 5, offset: 5617},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode296,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode298,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 255, col: 28, offset: 5963},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 235, col: 5, offset: 5680},
+															run: (*parser).callonNode301,
+															expr: &seqExpr{
+																pos: position{line: 235, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode303,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode305,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 270, col: 5, offset: 6265},
+												val:        "today",
+												ignoreCase: false,
+												want:       "\"today\"",
+											},
+											&litMatcher{
+												pos:        position{line: 271, col: 5, offset: 6279},
+												val:        "yesterday",
+												ignoreCase: false,
+												want:       "\"yesterday\"",
+											},
+											&litMatcher{
+												pos:        position{line: 272, col: 5, offset: 6297},
+												val:        "this week",
+												ignoreCase: false,
+												want:       "\"this week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 273, col: 5, offset: 6315},
+												val:        "last week",
+												ignoreCase: false,
+												want:       "\"last week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 274, col: 5, offset: 6333},
+												val:        "last 7 days",
+												ignoreCase: false,
+												want:       "\"last 7 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 275, col: 5, offset: 6353},
+												val:        "this month",
+												ignoreCase: false,
+												want:       "\"this month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 276, col: 5, offset: 6372},
+												val:        "last month",
+												ignoreCase: false,
+												want:       "\"last month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 277, col: 5, offset: 6391},
+												val:        "last 30 days",
+												ignoreCase: false,
+												want:       "\"last 30 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 278, col: 5, offset: 6412},
+												val:        "this year",
+												ignoreCase: false,
+												want:       "\"this year\"",
+											},
+											&actionExpr{
+												pos: position{line: 279, col: 5, offset: 6430},
+												run: (*parser).callonNode316,
+												expr: &litMatcher{
+													pos:        position{line: 279, col: 5, offset: 6430},
+													val:        "last year",
+													ignoreCase: false,
+													want:       "\"last year\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 95, col: 87, offset: 2495},
+									expr: &litMatcher{
+										pos:        position{line: 95, col: 87, offset: 2495},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&andExpr{
+									pos: position{line: 95, col: 92, offset: 2500},
+									expr: &choiceExpr{
+										pos: position{line: 312, col: 5, offset: 7051},
+										alternatives: []any{
+											&charClassMatcher{
+												pos:        position{line: 312, col: 5, offset: 7051},
+												val:        "[ \\t()]",
+												chars:      []rune{' ', '\t', '(', ')'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&notExpr{
+												pos: position{line: 312, col: 15, offset: 7061},
+												expr: &anyMatcher{
+													line: 312, col: 16, offset: 7062,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 98, col: 5, offset: 2587},
+						run: (*parser).callonNode325,
+						expr: &seqExpr{
+							pos: position{line: 98, col: 5, offset: 2587},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 98, col: 5, offset: 2587},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 98, col: 7, offset: 2589},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode329,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 98, col: 14, offset: 2596},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 185, col: 5, offset: 4801},
+											run: (*parser).callonNode332,
+											expr: &litMatcher{
+												pos:        position{line: 185, col: 5, offset: 4801},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 190, col: 5, offset: 4887},
+											run: (*parser).callonNode334,
+											expr: &litMatcher{
+												pos:        position{line: 190, col: 5, offset: 4887},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 98, col: 53, offset: 2635},
+									label: "f",
+									expr: &actionExpr{
+										pos: position{line: 293, col: 5, offset: 6697},
+										run: (*parser).callonNode337,
+										expr: &seqExpr{
+											pos: position{line: 293, col: 5, offset: 6697},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 293, col: 5, offset: 6697},
+													expr: &actionExpr{
+														pos: position{line: 315, col: 5, offset: 7078},
+														run: (*parser).callonNode340,
+														expr: &charClassMatcher{
+															pos:        position{line: 315, col: 5, offset: 7078},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 12, offset: 6704},
+													expr: &seqExpr{
+														pos: position{line: 293, col: 13, offset: 6705},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 13, offset: 6705},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 293, col: 17, offset: 6709},
+																expr: &actionExpr{
+																	pos: position{line: 315, col: 5, offset: 7078},
+																	run: (*parser).callonNode346,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 315, col: 5, offset: 7078},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 26, offset: 6718},
+													expr: &choiceExpr{
+														pos: position{line: 293, col: 27, offset: 6719},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 27, offset: 6719},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 35, offset: 6727},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 43, offset: 6735},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 51, offset: 6743},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 59, offset: 6751},
+																val:        "b",
+																ignoreCase: true,
+																want:       "\"B\"i",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 98, col: 68, offset: 2650},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 98, col: 73, offset: 2655},
+									label: "t",
+									expr: &actionExpr{
+										pos: position{line: 293, col: 5, offset: 6697},
+										run: (*parser).callonNode357,
+										expr: &seqExpr{
+											pos: position{line: 293, col: 5, offset: 6697},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 293, col: 5, offset: 6697},
+													expr: &actionExpr{
+														pos: position{line: 315, col: 5, offset: 7078},
+														run: (*parser).callonNode360,
+														expr: &charClassMatcher{
+															pos:        position{line: 315, col: 5, offset: 7078},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 12, offset: 6704},
+													expr: &seqExpr{
+														pos: position{line: 293, col: 13, offset: 6705},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 13, offset: 6705},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 293, col: 17, offset: 6709},
+																expr: &actionExpr{
+																	pos: position{line: 315, col: 5, offset: 7078},
+																	run: (*parser).callonNode366,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 315, col: 5, offset: 7078},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 26, offset: 6718},
+													expr: &choiceExpr{
+														pos: position{line: 293, col: 27, offset: 6719},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 27, offset: 6719},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 35, offset: 6727},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 43, offset: 6735},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 51, offset: 6743},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 59, offset: 6751},
+																val:        "b",
+																ignoreCase: true,
+																want:       "\"B\"i",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&andExpr{
+									pos: position{line: 98, col: 88, offset: 2670},
+									expr: &choiceExpr{
+										pos: position{line: 312, col: 5, offset: 7051},
+										alternatives: []any{
+											&charClassMatcher{
+												pos:        position{line: 312, col: 5, offset: 7051},
+												val:        "[ \\t()]",
+												chars:      []rune{' ', '\t', '(', ')'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&notExpr{
+												pos: position{line: 312, col: 15, offset: 7061},
+												expr: &anyMatcher{
+													line: 312, col: 16, offset: 7062,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 65, col: 5, offset: 1588},
+						run: (*parser).callonNode380,
+						expr: &seqExpr{
+							pos: position{line: 65, col: 5, offset: 1588},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 65, col: 5, offset: 1588},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 65, col: 7, offset: 1590},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode384,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 65, col: 13, offset: 1596},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 66, col: 9, offset: 1608},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 215, col: 5, offset: 5338},
+												run: (*parser).callonNode388,
+												expr: &litMatcher{
+													pos:        position{line: 215, col: 5, offset: 5338},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 205, col: 5, offset: 5154},
+												run: (*parser).callonNode390,
+												expr: &litMatcher{
+													pos:        position{line: 205, col: 5, offset: 5154},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 210, col: 5, offset: 5243},
+												run: (*parser).callonNode392,
+												expr: &litMatcher{
+													pos:        position{line: 210, col: 5, offset: 5243},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 200, col: 5, offset: 5062},
+												run: (*parser).callonNode394,
+												expr: &litMatcher{
+													pos:        position{line: 200, col: 5, offset: 5062},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 190, col: 5, offset: 4887},
+												run: (*parser).callonNode396,
+												expr: &litMatcher{
+													pos:        position{line: 190, col: 5, offset: 4887},
+													val:        "=",
+													ignoreCase: false,
+													want:       "\"=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 185, col: 5, offset: 4801},
+												run: (*parser).callonNode398,
+												expr: &litMatcher{
+													pos:        position{line: 185, col: 5, offset: 4801},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 72, col: 7, offset: 1788},
+									expr: &litMatcher{
+										pos:        position{line: 72, col: 7, offset: 1788},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 72, col: 12, offset: 1793},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 73, col: 9, offset: 1805},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 265, col: 5, offset: 6177},
+												run: (*parser).callonNode404,
+												expr: &seqExpr{
+													pos: position{line: 265, col: 5, offset: 6177},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 255, col: 5, offset: 5940},
+															run: (*parser).callonNode406,
+															expr: &seqExpr{
+																pos: position{line: 255, col: 5, offset: 5940},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 225, col: 5, offset: 5540},
+																		run: (*parser).callonNode408,
+																		expr: &seqExpr{
+																			pos: position{line: 225, col: 5, offset: 5540},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode410,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode412,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode414,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode416,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 255, col: 14, offset: 5949},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 230, col: 5, offset: 5617},
+																		run: (*parser).callonNode419,
+																		expr: &seqExpr{
+																			pos: position{line: 230, col: 5, offset: 5617},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode421,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode423,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 255, col: 28, offset: 5963},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 235, col: 5, offset: 5680},
+																		run: (*parser).callonNode426,
+																		expr: &seqExpr{
+																			pos: position{line: 235, col: 5, offset: 5680},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode428,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode430,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 265, col: 14, offset: 6186},
+															val:        "T",
+															ignoreCase: false,
+															want:       "\"T\"",
+														},
+														&actionExpr{
+															pos: position{line: 260, col: 5, offset: 6027},
+															run: (*parser).callonNode433,
+															expr: &seqExpr{
+																pos: position{line: 260, col: 5, offset: 6027},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 240, col: 5, offset: 5744},
+																		run: (*parser).callonNode435,
+																		expr: &seqExpr{
+																			pos: position{line: 240, col: 5, offset: 5744},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode437,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode439,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 260, col: 14, offset: 6036},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 245, col: 5, offset: 5810},
+																		run: (*parser).callonNode442,
+																		expr: &seqExpr{
+																			pos: position{line: 245, col: 5, offset: 5810},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode444,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode446,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 260, col: 29, offset: 6051},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 250, col: 5, offset: 5876},
+																		run: (*parser).callonNode449,
+																		expr: &seqExpr{
+																			pos: position{line: 250, col: 5, offset: 5876},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode451,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 315, col: 5, offset: 7078},
+																					run: (*parser).callonNode453,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 315, col: 5, offset: 7078},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 260, col: 44, offset: 6066},
+																		expr: &seqExpr{
+																			pos: position{line: 260, col: 45, offset: 6067},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 260, col: 45, offset: 6067},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 260, col: 49, offset: 6071},
+																					expr: &actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode459,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 260, col: 59, offset: 6081},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 260, col: 59, offset: 6081},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 260, col: 65, offset: 6087},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 260, col: 66, offset: 6088},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 240, col: 5, offset: 5744},
+																						run: (*parser).callonNode465,
+																						expr: &seqExpr{
+																							pos: position{line: 240, col: 5, offset: 5744},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode467,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode469,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 260, col: 86, offset: 6108},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 245, col: 5, offset: 5810},
+																						run: (*parser).callonNode472,
+																						expr: &seqExpr{
+																							pos: position{line: 245, col: 5, offset: 5810},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode474,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 315, col: 5, offset: 7078},
+																									run: (*parser).callonNode476,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 315, col: 5, offset: 7078},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 255, col: 5, offset: 5940},
+												run: (*parser).callonNode478,
+												expr: &seqExpr{
+													pos: position{line: 255, col: 5, offset: 5940},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 225, col: 5, offset: 5540},
+															run: (*parser).callonNode480,
+															expr: &seqExpr{
+																pos: position{line: 225, col: 5, offset: 5540},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode482,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode484,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode486,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode488,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 255, col: 14, offset: 5949},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 230, col: 5, offset: 5617},
+															run: (*parser).callonNode491,
+															expr: &seqExpr{
+																pos: position{line: 230, col: 5, offset: 5617},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode493,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode495,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 255, col: 28, offset: 5963},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 235, col: 5, offset: 5680},
+															run: (*parser).callonNode498,
+															expr: &seqExpr{
+																pos: position{line: 235, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode500,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode502,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 260, col: 5, offset: 6027},
+												run: (*parser).callonNode504,
+												expr: &seqExpr{
+													pos: position{line: 260, col: 5, offset: 6027},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 240, col: 5, offset: 5744},
+															run: (*parser).callonNode506,
+															expr: &seqExpr{
+																pos: position{line: 240, col: 5, offset: 5744},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode508,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode510,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 260, col: 14, offset: 6036},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 245, col: 5, offset: 5810},
+															run: (*parser).callonNode513,
+															expr: &seqExpr{
+																pos: position{line: 245, col: 5, offset: 5810},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode515,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode517,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 260, col: 29, offset: 6051},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 250, col: 5, offset: 5876},
+															run: (*parser).callonNode520,
+															expr: &seqExpr{
+																pos: position{line: 250, col: 5, offset: 5876},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode522,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 315, col: 5, offset: 7078},
+																		run: (*parser).callonNode524,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 315, col: 5, offset: 7078},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 260, col: 44, offset: 6066},
+															expr: &seqExpr{
+																pos: position{line: 260, col: 45, offset: 6067},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 260, col: 45, offset: 6067},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 260, col: 49, offset: 6071},
+																		expr: &actionExpr{
+																			pos: position{line: 315, col: 5, offset: 7078},
+																			run: (*parser).callonNode530,
+																			expr: &charClassMatcher{
+																				pos:        position{line: 315, col: 5, offset: 7078},
+																				val:        "[0-9]",
+																				ranges:     []rune{'0', '9'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&choiceExpr{
+															pos: position{line: 260, col: 59, offset: 6081},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 260, col: 59, offset: 6081},
+																	val:        "Z",
+																	ignoreCase: false,
+																	want:       "\"Z\"",
+																},
+																&seqExpr{
+																	pos: position{line: 260, col: 65, offset: 6087},
+																	exprs: []any{
+																		&charClassMatcher{
+																			pos:        position{line: 260, col: 66, offset: 6088},
+																			val:        "[+-]",
+																			chars:      []rune{'+', '-'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&actionExpr{
+																			pos: position{line: 240, col: 5, offset: 5744},
+																			run: (*parser).callonNode536,
+																			expr: &seqExpr{
+																				pos: position{line: 240, col: 5, offset: 5744},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode538,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode540,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																		&litMatcher{
+																			pos:        position{line: 260, col: 86, offset: 6108},
+																			val:        ":",
+																			ignoreCase: false,
+																			want:       "\":\"",
+																		},
+																		&actionExpr{
+																			pos: position{line: 245, col: 5, offset: 5810},
+																			run: (*parser).callonNode543,
+																			expr: &seqExpr{
+																				pos: position{line: 245, col: 5, offset: 5810},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode545,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 315, col: 5, offset: 7078},
+																						run: (*parser).callonNode547,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 315, col: 5, offset: 7078},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 76, col: 7, offset: 1858},
+									expr: &litMatcher{
+										pos:        position{line: 76, col: 7, offset: 1858},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 79, col: 5, offset: 1934},
+						run: (*parser).callonNode551,
+						expr: &seqExpr{
+							pos: position{line: 79, col: 5, offset: 1934},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 79, col: 5, offset: 1934},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 79, col: 7, offset: 1936},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode555,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 80, col: 9, offset: 1952},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 190, col: 5, offset: 4887},
+											run: (*parser).callonNode558,
+											expr: &litMatcher{
+												pos:        position{line: 190, col: 5, offset: 4887},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 185, col: 5, offset: 4801},
+											run: (*parser).callonNode560,
+											expr: &litMatcher{
+												pos:        position{line: 185, col: 5, offset: 4801},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 82, col: 7, offset: 2004},
+									expr: &litMatcher{
+										pos:        position{line: 82, col: 7, offset: 2004},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 82, col: 12, offset: 2009},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 270, col: 5, offset: 6265},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 270, col: 5, offset: 6265},
+												val:        "today",
+												ignoreCase: false,
+												want:       "\"today\"",
+											},
+											&litMatcher{
+												pos:        position{line: 271, col: 5, offset: 6279},
+												val:        "yesterday",
+												ignoreCase: false,
+												want:       "\"yesterday\"",
+											},
+											&litMatcher{
+												pos:        position{line: 272, col: 5, offset: 6297},
+												val:        "this week",
+												ignoreCase: false,
+												want:       "\"this week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 273, col: 5, offset: 6315},
+												val:        "last week",
+												ignoreCase: false,
+												want:       "\"last week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 274, col: 5, offset: 6333},
+												val:        "last 7 days",
+												ignoreCase: false,
+												want:       "\"last 7 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 275, col: 5, offset: 6353},
+												val:        "this month",
+												ignoreCase: false,
+												want:       "\"this month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 276, col: 5, offset: 6372},
+												val:        "last month",
+												ignoreCase: false,
+												want:       "\"last month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 277, col: 5, offset: 6391},
+												val:        "last 30 days",
+												ignoreCase: false,
+												want:       "\"last 30 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 278, col: 5, offset: 6412},
+												val:        "this year",
+												ignoreCase: false,
+												want:       "\"this year\"",
+											},
+											&actionExpr{
+												pos: position{line: 279, col: 5, offset: 6430},
+												run: (*parser).callonNode575,
+												expr: &litMatcher{
+													pos:        position{line: 279, col: 5, offset: 6430},
+													val:        "last year",
+													ignoreCase: false,
+													want:       "\"last year\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 82, col: 38, offset: 2035},
+									expr: &litMatcher{
+										pos:        position{line: 82, col: 38, offset: 2035},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 85, col: 5, offset: 2124},
+						run: (*parser).callonNode579,
+						expr: &seqExpr{
+							pos: position{line: 85, col: 5, offset: 2124},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 85, col: 5, offset: 2124},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 85, col: 7, offset: 2126},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode583,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 85, col: 13, offset: 2132},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 86, col: 9, offset: 2144},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 215, col: 5, offset: 5338},
+												run: (*parser).callonNode587,
+												expr: &litMatcher{
+													pos:        position{line: 215, col: 5, offset: 5338},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 205, col: 5, offset: 5154},
+												run: (*parser).callonNode589,
+												expr: &litMatcher{
+													pos:        position{line: 205, col: 5, offset: 5154},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 210, col: 5, offset: 5243},
+												run: (*parser).callonNode591,
+												expr: &litMatcher{
+													pos:        position{line: 210, col: 5, offset: 5243},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 200, col: 5, offset: 5062},
+												run: (*parser).callonNode593,
+												expr: &litMatcher{
+													pos:        position{line: 200, col: 5, offset: 5062},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 90, col: 7, offset: 2268},
+									expr: &litMatcher{
+										pos:        position{line: 90, col: 7, offset: 2268},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 90, col: 12, offset: 2273},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 270, col: 5, offset: 6265},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 270, col: 5, offset: 6265},
+												val:        "today",
+												ignoreCase: false,
+												want:       "\"today\"",
+											},
+											&litMatcher{
+												pos:        position{line: 271, col: 5, offset: 6279},
+												val:        "yesterday",
+												ignoreCase: false,
+												want:       "\"yesterday\"",
+											},
+											&litMatcher{
+												pos:        position{line: 272, col: 5, offset: 6297},
+												val:        "this week",
+												ignoreCase: false,
+												want:       "\"this week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 273, col: 5, offset: 6315},
+												val:        "last week",
+												ignoreCase: false,
+												want:       "\"last week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 274, col: 5, offset: 6333},
+												val:        "last 7 days",
+												ignoreCase: false,
+												want:       "\"last 7 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 275, col: 5, offset: 6353},
+												val:        "this month",
+												ignoreCase: false,
+												want:       "\"this month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 276, col: 5, offset: 6372},
+												val:        "last month",
+												ignoreCase: false,
+												want:       "\"last month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 277, col: 5, offset: 6391},
+												val:        "last 30 days",
+												ignoreCase: false,
+												want:       "\"last 30 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 278, col: 5, offset: 6412},
+												val:        "this year",
+												ignoreCase: false,
+												want:       "\"this year\"",
+											},
+											&actionExpr{
+												pos: position{line: 279, col: 5, offset: 6430},
+												run: (*parser).callonNode608,
+												expr: &litMatcher{
+													pos:        position{line: 279, col: 5, offset: 6430},
+													val:        "last year",
+													ignoreCase: false,
+													want:       "\"last year\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 90, col: 38, offset: 2299},
+									expr: &litMatcher{
+										pos:        position{line: 90, col: 38, offset: 2299},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 103, col: 5, offset: 2781},
+						run: (*parser).callonNode612,
+						expr: &seqExpr{
+							pos: position{line: 103, col: 5, offset: 2781},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 103, col: 5, offset: 2781},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 103, col: 7, offset: 2783},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode616,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 103, col: 13, offset: 2789},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 104, col: 9, offset: 2801},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 215, col: 5, offset: 5338},
+												run: (*parser).callonNode620,
+												expr: &litMatcher{
+													pos:        position{line: 215, col: 5, offset: 5338},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 205, col: 5, offset: 5154},
+												run: (*parser).callonNode622,
+												expr: &litMatcher{
+													pos:        position{line: 205, col: 5, offset: 5154},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 210, col: 5, offset: 5243},
+												run: (*parser).callonNode624,
+												expr: &litMatcher{
+													pos:        position{line: 210, col: 5, offset: 5243},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 200, col: 5, offset: 5062},
+												run: (*parser).callonNode626,
+												expr: &litMatcher{
+													pos:        position{line: 200, col: 5, offset: 5062},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 108, col: 7, offset: 2925},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 293, col: 5, offset: 6697},
+										run: (*parser).callonNode629,
+										expr: &seqExpr{
+											pos: position{line: 293, col: 5, offset: 6697},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 293, col: 5, offset: 6697},
+													expr: &actionExpr{
+														pos: position{line: 315, col: 5, offset: 7078},
+														run: (*parser).callonNode632,
+														expr: &charClassMatcher{
+															pos:        position{line: 315, col: 5, offset: 7078},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 12, offset: 6704},
+													expr: &seqExpr{
+														pos: position{line: 293, col: 13, offset: 6705},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 13, offset: 6705},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 293, col: 17, offset: 6709},
+																expr: &actionExpr{
+																	pos: position{line: 315, col: 5, offset: 7078},
+																	run: (*parser).callonNode638,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 315, col: 5, offset: 7078},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 293, col: 26, offset: 6718},
+													expr: &choiceExpr{
+														pos: position{line: 293, col: 27, offset: 6719},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 293, col: 27, offset: 6719},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 35, offset: 6727},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 43, offset: 6735},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 51, offset: 6743},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 293, col: 59, offset: 6751},
+																val:        "b",
+																ignoreCase: true,
+																want:       "\"B\"i",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&andExpr{
+									pos: position{line: 108, col: 22, offset: 2940},
+									expr: &choiceExpr{
+										pos: position{line: 312, col: 5, offset: 7051},
+										alternatives: []any{
+											&charClassMatcher{
+												pos:        position{line: 312, col: 5, offset: 7051},
+												val:        "[ \\t()]",
+												chars:      []rune{' ', '\t', '(', ')'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&notExpr{
+												pos: position{line: 312, col: 15, offset: 7061},
+												expr: &anyMatcher{
+													line: 312, col: 16, offset: 7062,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 113, col: 5, offset: 3046},
+						run: (*parser).callonNode652,
+						expr: &seqExpr{
+							pos: position{line: 113, col: 5, offset: 3046},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 113, col: 5, offset: 3046},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 113, col: 7, offset: 3048},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode656,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 195, col: 5, offset: 4976},
+									run: (*parser).callonNode658,
+									expr: &litMatcher{
+										pos:        position{line: 195, col: 5, offset: 4976},
+										val:        "<>",
+										ignoreCase: false,
+										want:       "\"<>\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 113, col: 34, offset: 3075},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 113, col: 37, offset: 3078},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 307, col: 5, offset: 6988},
+												run: (*parser).callonNode662,
+												expr: &seqExpr{
+													pos: position{line: 307, col: 5, offset: 6988},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 307, col: 5, offset: 6988},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 307, col: 9, offset: 6992},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 307, col: 11, offset: 6994},
+																expr: &charClassMatcher{
+																	pos:        position{line: 307, col: 11, offset: 6994},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 307, col: 17, offset: 7000},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 113, col: 46, offset: 3087},
+												expr: &charClassMatcher{
+													pos:        position{line: 113, col: 46, offset: 3087},
+													val:        "[^ ()]",
+													chars:      []rune{' ', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 118, col: 5, offset: 3194},
+						run: (*parser).callonNode671,
+						expr: &seqExpr{
+							pos: position{line: 118, col: 5, offset: 3194},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 118, col: 5, offset: 3194},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 118, col: 7, offset: 3196},
+										expr: &actionExpr{
+											pos: position{line: 302, col: 5, offset: 6929},
+											run: (*parser).callonNode675,
+											expr: &charClassMatcher{
+												pos:        position{line: 302, col: 5, offset: 6929},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 118, col: 14, offset: 3203},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 185, col: 5, offset: 4801},
+											run: (*parser).callonNode678,
+											expr: &litMatcher{
+												pos:        position{line: 185, col: 5, offset: 4801},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 190, col: 5, offset: 4887},
+											run: (*parser).callonNode680,
+											expr: &litMatcher{
+												pos:        position{line: 190, col: 5, offset: 4887},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 118, col: 53, offset: 3242},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 118, col: 56, offset: 3245},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 307, col: 5, offset: 6988},
+												run: (*parser).callonNode684,
+												expr: &seqExpr{
+													pos: position{line: 307, col: 5, offset: 6988},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 307, col: 5, offset: 6988},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 307, col: 9, offset: 6992},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 307, col: 11, offset: 6994},
+																expr: &charClassMatcher{
+																	pos:        position{line: 307, col: 11, offset: 6994},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 307, col: 17, offset: 7000},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 118, col: 65, offset: 3254},
+												expr: &charClassMatcher{
+													pos:        position{line: 118, col: 65, offset: 3254},
+													val:        "[^ ()]",
+													chars:      []rune{' ', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 165, col: 5, offset: 4381},
+						run: (*parser).callonNode693,
+						expr: &choiceExpr{
+							pos: position{line: 165, col: 6, offset: 4382},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 165, col: 6, offset: 4382},
+									val:        "AND",
+									ignoreCase: false,
+									want:       "\"AND\"",
+								},
+								&litMatcher{
+									pos:        position{line: 165, col: 14, offset: 4390},
+									val:        "+",
+									ignoreCase: false,
+									want:       "\"+\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 170, col: 5, offset: 4482},
+						run: (*parser).callonNode697,
+						expr: &choiceExpr{
+							pos: position{line: 170, col: 6, offset: 4483},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 170, col: 6, offset: 4483},
+									val:        "NOT",
+									ignoreCase: false,
+									want:       "\"NOT\"",
+								},
+								&litMatcher{
+									pos:        position{line: 170, col: 14, offset: 4491},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 175, col: 5, offset: 4582},
+						run: (*parser).callonNode701,
+						expr: &litMatcher{
+							pos:        position{line: 175, col: 6, offset: 4583},
+							val:        "OR",
+							ignoreCase: false,
+							want:       "\"OR\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 132, col: 5, offset: 3556},
+						run: (*parser).callonNode703,
+						expr: &seqExpr{
+							pos: position{line: 132, col: 5, offset: 3556},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 132, col: 5, offset: 3556},
+									label: "l",
+									expr: &choiceExpr{
+										pos: position{line: 137, col: 5, offset: 3735},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 307, col: 5, offset: 6988},
+												run: (*parser).callonNode707,
+												expr: &seqExpr{
+													pos: position{line: 307, col: 5, offset: 6988},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 307, col: 5, offset: 6988},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 307, col: 9, offset: 6992},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 307, col: 11, offset: 6994},
+																expr: &charClassMatcher{
+																	pos:        position{line: 307, col: 11, offset: 6994},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 307, col: 17, offset: 7000},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 138, col: 5, offset: 3748},
+												expr: &charClassMatcher{
+													pos:        position{line: 138, col: 5, offset: 3748},
+													val:        "[^ :()]",
+													chars:      []rune{' ', ':', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 132, col: 21, offset: 3572},
+									expr: &charClassMatcher{
+										pos:        position{line: 132, col: 21, offset: 3572},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 132, col: 28, offset: 3579},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 132, col: 31, offset: 3582},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 132, col: 31, offset: 3582},
+												val:        "NEAR",
+												ignoreCase: false,
+												want:       "\"NEAR\"",
+											},
+											&litMatcher{
+												pos:        position{line: 132, col: 40, offset: 3591},
+												val:        "ONEAR",
+												ignoreCase: false,
+												want:       "\"ONEAR\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 132, col: 49, offset: 3600},
+									label: "d",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 132, col: 51, offset: 3602},
+										expr: &actionExpr{
+											pos: position{line: 141, col: 5, offset: 3783},
+											run: (*parser).callonNode724,
+											expr: &seqExpr{
+												pos: position{line: 141, col: 5, offset: 3783},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 141, col: 5, offset: 3783},
+														val:        "(",
+														ignoreCase: false,
+														want:       "\"(\"",
+													},
+													&actionExpr{
+														pos: position{line: 320, col: 5, offset: 7129},
+														run: (*parser).callonNode727,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 320, col: 5, offset: 7129},
+															expr: &charClassMatcher{
+																pos:        position{line: 320, col: 5, offset: 7129},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&zeroOrOneExpr{
+														pos: position{line: 141, col: 11, offset: 3789},
+														expr: &seqExpr{
+															pos: position{line: 141, col: 12, offset: 3790},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 141, col: 12, offset: 3790},
+																	val:        "n",
+																	ignoreCase: false,
+																	want:       "\"n\"",
+																},
+																&actionExpr{
+																	pos: position{line: 320, col: 5, offset: 7129},
+																	run: (*parser).callonNode733,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 320, col: 5, offset: 7129},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 320, col: 5, offset: 7129},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 141, col: 18, offset: 3796},
+																	val:        "=",
+																	ignoreCase: false,
+																	want:       "\"=\"",
+																},
+																&actionExpr{
+																	pos: position{line: 320, col: 5, offset: 7129},
+																	run: (*parser).callonNode737,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 320, col: 5, offset: 7129},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 320, col: 5, offset: 7129},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 141, col: 26, offset: 3804},
+														label: "v",
+														expr: &oneOrMoreExpr{
+															pos: position{line: 141, col: 28, offset: 3806},
+															expr: &actionExpr{
+																pos: position{line: 315, col: 5, offset: 7078},
+																run: (*parser).callonNode742,
+																expr: &charClassMatcher{
+																	pos:        position{line: 315, col: 5, offset: 7078},
+																	val:        "[0-9]",
+																	ranges:     []rune{'0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&actionExpr{
+														pos: position{line: 320, col: 5, offset: 7129},
+														run: (*parser).callonNode744,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 320, col: 5, offset: 7129},
+															expr: &charClassMatcher{
+																pos:        position{line: 320, col: 5, offset: 7129},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 141, col: 37, offset: 3815},
+														val:        ")",
+														ignoreCase: false,
+														want:       "\")\"",
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 132, col: 70, offset: 3621},
+									expr: &charClassMatcher{
+										pos:        position{line: 132, col: 70, offset: 3621},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 132, col: 77, offset: 3628},
+									label: "r",
+									expr: &choiceExpr{
+										pos: position{line: 137, col: 5, offset: 3735},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 307, col: 5, offset: 6988},
+												run: (*parser).callonNode752,
+												expr: &seqExpr{
+													pos: position{line: 307, col: 5, offset: 6988},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 307, col: 5, offset: 6988},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 307, col: 9, offset: 6992},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 307, col: 11, offset: 6994},
+																expr: &charClassMatcher{
+																	pos:        position{line: 307, col: 11, offset: 6994},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 307, col: 17, offset: 7000},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 138, col: 5, offset: 3748},
+												expr: &charClassMatcher{
+													pos:        position{line: 138, col: 5, offset: 3748},
+													val:        "[^ :()]",
+													chars:      []rune{' ', ':', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 146, col: 6, offset: 3869},
+						run: (*parser).callonNode761,
+						expr: &seqExpr{
+							pos: position{line: 146, col: 6, offset: 3869},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 146, col: 6, offset: 3869},
+									expr: &actionExpr{
+										pos: position{line: 185, col: 5, offset: 4801},
+										run: (*parser).callonNode764,
+										expr: &litMatcher{
+											pos:        position{line: 185, col: 5, offset: 4801},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode766,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 146, col: 27, offset: 3890},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 307, col: 5, offset: 6988},
+										run: (*parser).callonNode770,
+										expr: &seqExpr{
+											pos: position{line: 307, col: 5, offset: 6988},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 307, col: 5, offset: 6988},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+												&labeledExpr{
+													pos:   position{line: 307, col: 9, offset: 6992},
+													label: "v",
+													expr: &zeroOrMoreExpr{
+														pos: position{line: 307, col: 11, offset: 6994},
+														expr: &charClassMatcher{
+															pos:        position{line: 307, col: 11, offset: 6994},
+															val:        "[^\"]",
+															chars:      []rune{'"'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 307, col: 17, offset: 7000},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+											},
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode777,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 146, col: 38, offset: 3901},
+									expr: &actionExpr{
+										pos: position{line: 185, col: 5, offset: 4801},
+										run: (*parser).callonNode781,
+										expr: &litMatcher{
+											pos:        position{line: 185, col: 5, offset: 4801},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 151, col: 6, offset: 3999},
+						run: (*parser).callonNode783,
+						expr: &seqExpr{
+							pos: position{line: 151, col: 6, offset: 3999},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 151, col: 6, offset: 3999},
+									expr: &actionExpr{
+										pos: position{line: 185, col: 5, offset: 4801},
+										run: (*parser).callonNode786,
+										expr: &litMatcher{
+											pos:        position{line: 185, col: 5, offset: 4801},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode788,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 320, col: 5, offset: 7129},
+										expr: &charClassMatcher{
+											pos:        position{line: 320, col: 5, offset: 7129},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 91, col: 27, offset: 2260},
+									pos:   position{line: 151, col: 27, offset: 4020},
 									label: "v",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 91, col: 29, offset: 2262},
+										pos: position{line: 151, col: 29, offset: 4022},
 										expr: &charClassMatcher{
-											pos:        position{line: 91, col: 29, offset: 2262},
+											pos:        position{line: 151, col: 29, offset: 4022},
 											val:        "[^ :()]",
 											chars:      []rune{' ', ':', '(', ')'},
 											ignoreCase: false,
@@ -1474,12 +4045,12 @@ var g = &grammar{
 									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode282,
+									pos: position{line: 320, col: 5, offset: 7129},
+									run: (*parser).callonNode794,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 320, col: 5, offset: 7129},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 320, col: 5, offset: 7129},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1488,12 +4059,12 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 91, col: 40, offset: 2273},
+									pos: position{line: 151, col: 40, offset: 4033},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode286,
+										pos: position{line: 185, col: 5, offset: 4801},
+										run: (*parser).callonNode798,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 185, col: 5, offset: 4801},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1508,25 +4079,25 @@ var g = &grammar{
 		},
 		{
 			name: "GroupNode",
-			pos:  position{line: 31, col: 1, offset: 595},
+			pos:  position{line: 33, col: 1, offset: 636},
 			expr: &actionExpr{
-				pos: position{line: 32, col: 5, offset: 612},
+				pos: position{line: 34, col: 5, offset: 653},
 				run: (*parser).callonGroupNode1,
 				expr: &seqExpr{
-					pos: position{line: 32, col: 5, offset: 612},
+					pos: position{line: 34, col: 5, offset: 653},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 32, col: 5, offset: 612},
+							pos:   position{line: 34, col: 5, offset: 653},
 							label: "k",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 32, col: 7, offset: 614},
+								pos: position{line: 34, col: 7, offset: 655},
 								expr: &oneOrMoreExpr{
-									pos: position{line: 32, col: 8, offset: 615},
+									pos: position{line: 34, col: 8, offset: 656},
 									expr: &actionExpr{
-										pos: position{line: 218, col: 5, offset: 4634},
+										pos: position{line: 302, col: 5, offset: 6929},
 										run: (*parser).callonGroupNode6,
 										expr: &charClassMatcher{
-											pos:        position{line: 218, col: 5, offset: 4634},
+											pos:        position{line: 302, col: 5, offset: 6929},
 											val:        "[A-Za-z]",
 											ranges:     []rune{'A', 'Z', 'a', 'z'},
 											ignoreCase: false,
@@ -1537,25 +4108,25 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 32, col: 16, offset: 623},
+							pos: position{line: 34, col: 16, offset: 664},
 							expr: &choiceExpr{
-								pos: position{line: 32, col: 17, offset: 624},
+								pos: position{line: 34, col: 17, offset: 665},
 								alternatives: []any{
 									&actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
+										pos: position{line: 185, col: 5, offset: 4801},
 										run: (*parser).callonGroupNode10,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 185, col: 5, offset: 4801},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 125, col: 5, offset: 2997},
+										pos: position{line: 190, col: 5, offset: 4887},
 										run: (*parser).callonGroupNode12,
 										expr: &litMatcher{
-											pos:        position{line: 125, col: 5, offset: 2997},
+											pos:        position{line: 190, col: 5, offset: 4887},
 											val:        "=",
 											ignoreCase: false,
 											want:       "\"=\"",
@@ -1565,21 +4136,21 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 32, col: 57, offset: 664},
+							pos:        position{line: 34, col: 57, offset: 705},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 32, col: 61, offset: 668},
+							pos:   position{line: 34, col: 61, offset: 709},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 63, offset: 670},
+								pos:  position{line: 34, col: 63, offset: 711},
 								name: "Nodes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 32, col: 69, offset: 676},
+							pos:        position{line: 34, col: 69, offset: 717},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -1591,1071 +4162,2633 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onAST1(n any) (any, error) {
-	return buildAST(n, c.text, c.pos)
+func (c *current) onAST1(n any) (any, error) {
+	return buildAST(n, c.text, c.pos)
+
+}
+
+func (p *parser) callonAST1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAST1(stack["n"])
+}
+
+func (c *current) onNodes3() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNodes3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNodes3()
+}
+
+func (c *current) onNode5() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode5()
+}
+
+func (c *current) onNode9() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode9()
+}
+
+func (c *current) onNode13() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode13()
+}
+
+func (c *current) onNode17() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode17()
+}
+
+func (c *current) onNode24() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode24() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode24()
+}
+
+func (c *current) onNode30() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode30() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode30()
+}
+
+func (c *current) onNode21() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode21() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode21()
+}
+
+func (c *current) onNode39(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode39() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode39(stack["v"])
+}
+
+func (c *current) onNode2(v any) (any, error) {
+	return buildRankNode(v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode2(stack["v"])
+}
+
+func (c *current) onNode47() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode47() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode47()
+}
+
+func (c *current) onNode49() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode49() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode49()
+}
+
+func (c *current) onNode54(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode54() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode54(stack["v"])
+}
+
+func (c *current) onNode44(v any) (any, error) {
+	return buildSimilarNode(v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode44() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode44(stack["v"])
+}
+
+func (c *current) onNode67() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode67() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode67()
+}
+
+func (c *current) onNode70() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode70() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode70()
+}
+
+func (c *current) onNode72() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode72() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode72()
+}
+
+func (c *current) onNode63(k, v any) (any, error) {
+	return buildBooleanNode(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode63() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode63(stack["k"], stack["v"])
+}
+
+func (c *current) onNode82() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode82() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode82()
+}
+
+func (c *current) onNode85() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode85() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode85()
+}
+
+func (c *current) onNode87() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode87() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode87()
+}
+
+func (c *current) onNode99() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode99() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode99()
+}
+
+func (c *current) onNode101() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode101() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode101()
+}
+
+func (c *current) onNode103() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode103() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode103()
+}
+
+func (c *current) onNode105() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode105() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode105()
+}
+
+func (c *current) onNode97() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode97() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode97()
+}
+
+func (c *current) onNode110() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode110() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode110()
+}
+
+func (c *current) onNode112() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode112() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode112()
+}
+
+func (c *current) onNode108() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode108() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode108()
+}
+
+func (c *current) onNode117() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode117() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode117()
+}
+
+func (c *current) onNode119() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode119() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode119()
+}
+
+func (c *current) onNode115() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode115() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode115()
+}
+
+func (c *current) onNode95() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode95() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode95()
+}
+
+func (c *current) onNode126() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode126() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode126()
+}
+
+func (c *current) onNode128() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode128() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode128()
+}
+
+func (c *current) onNode124() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode124() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode124()
+}
+
+func (c *current) onNode133() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode133() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode133()
+}
+
+func (c *current) onNode135() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode135() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode135()
+}
+
+func (c *current) onNode131() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode131() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode131()
+}
+
+func (c *current) onNode140() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode140() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode140()
+}
+
+func (c *current) onNode142() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode142() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode142()
+}
+
+func (c *current) onNode138() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode138() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode138()
+}
+
+func (c *current) onNode148() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode148() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode148()
+}
+
+func (c *current) onNode156() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode156() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode156()
+}
+
+func (c *current) onNode158() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode158() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode158()
+}
+
+func (c *current) onNode154() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode154() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode154()
+}
+
+func (c *current) onNode163() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode163() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode163()
+}
+
+func (c *current) onNode165() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode165() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode165()
+}
+
+func (c *current) onNode161() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode161() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode161()
+}
+
+func (c *current) onNode122() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode122() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode122()
+}
+
+func (c *current) onNode93() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode93() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode93()
+}
+
+func (c *current) onNode171() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode171() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode171()
+}
+
+func (c *current) onNode173() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode173() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode173()
+}
+
+func (c *current) onNode175() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode175() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode175()
+}
+
+func (c *current) onNode177() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode177() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode177()
+}
+
+func (c *current) onNode169() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode169() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode169()
+}
+
+func (c *current) onNode182() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode182() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode182()
+}
+
+func (c *current) onNode184() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode184() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode184()
+}
+
+func (c *current) onNode180() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode180() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode180()
+}
+
+func (c *current) onNode189() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode189() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode189()
+}
+
+func (c *current) onNode191() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode191() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode191()
+}
+
+func (c *current) onNode187() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode187() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode187()
+}
+
+func (c *current) onNode167() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode167() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode167()
+}
+
+func (c *current) onNode202() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode202() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode202()
+}
+
+func (c *current) onNode213() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode213() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode213()
+}
+
+func (c *current) onNode215() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode215() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode215()
+}
+
+func (c *current) onNode217() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode217() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode217()
+}
+
+func (c *current) onNode219() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode219() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode219()
+}
+
+func (c *current) onNode211() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode211() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode211()
+}
+
+func (c *current) onNode224() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode224() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode224()
+}
+
+func (c *current) onNode226() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode226() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode226()
+}
+
+func (c *current) onNode222() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode222() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode222()
+}
+
+func (c *current) onNode231() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode231() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode231()
+}
+
+func (c *current) onNode233() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode233() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode233()
+}
+
+func (c *current) onNode229() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode229() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode229()
+}
+
+func (c *current) onNode209() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode209() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode209()
+}
+
+func (c *current) onNode240() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode240() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode240()
+}
+
+func (c *current) onNode242() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode242() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode242()
+}
+
+func (c *current) onNode238() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode238() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode238()
+}
+
+func (c *current) onNode247() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode247() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode247()
+}
+
+func (c *current) onNode249() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode249() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode249()
+}
+
+func (c *current) onNode245() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode245() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode245()
+}
+
+func (c *current) onNode254() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode254() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode254()
+}
+
+func (c *current) onNode256() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode256() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode256()
+}
+
+func (c *current) onNode252() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode252() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode252()
+}
+
+func (c *current) onNode262() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode262() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode262()
+}
+
+func (c *current) onNode270() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode270() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode270()
+}
+
+func (c *current) onNode272() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode272() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode272()
+}
+
+func (c *current) onNode268() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode268() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode268()
+}
+
+func (c *current) onNode277() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode277() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode277()
+}
+
+func (c *current) onNode279() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode279() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode279()
+}
+
+func (c *current) onNode275() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode275() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode275()
+}
+
+func (c *current) onNode236() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode236() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode236()
+}
+
+func (c *current) onNode207() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode207() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode207()
+}
+
+func (c *current) onNode285() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode285() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode285()
+}
+
+func (c *current) onNode287() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode287() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode287()
+}
+
+func (c *current) onNode289() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode289() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode289()
+}
+
+func (c *current) onNode291() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode291() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode291()
+}
+
+func (c *current) onNode283() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode283() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode283()
+}
+
+func (c *current) onNode296() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode296() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode296()
+}
+
+func (c *current) onNode298() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode298() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode298()
+}
+
+func (c *current) onNode294() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode294() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode294()
+}
+
+func (c *current) onNode303() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode303() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode303()
+}
+
+func (c *current) onNode305() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode305() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode305()
+}
+
+func (c *current) onNode301() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode301() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode301()
+}
+
+func (c *current) onNode281() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode281() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode281()
+}
+
+func (c *current) onNode316() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode316() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode316()
+}
+
+func (c *current) onNode78(k, f, t any) (any, error) {
+	return buildDateTimeRangeNodes(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode78() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode78(stack["k"], stack["f"], stack["t"])
+}
+
+func (c *current) onNode329() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode329() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode329()
+}
+
+func (c *current) onNode332() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode332() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode332()
+}
+
+func (c *current) onNode334() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode334() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode334()
+}
+
+func (c *current) onNode340() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode340() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode340()
+}
+
+func (c *current) onNode346() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode346() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode346()
+}
+
+func (c *current) onNode337() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode337() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode337()
+}
+
+func (c *current) onNode360() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode360() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode360()
+}
+
+func (c *current) onNode366() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode366() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode366()
+}
+
+func (c *current) onNode357() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode357() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode357()
+}
+
+func (c *current) onNode325(k, f, t any) (any, error) {
+	return buildNumericRangeNodes(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode325() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode325(stack["k"], stack["f"], stack["t"])
+}
+
+func (c *current) onNode384() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode384() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode384()
+}
+
+func (c *current) onNode388() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode388() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode388()
+}
+
+func (c *current) onNode390() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode390() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode390()
+}
+
+func (c *current) onNode392() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode392() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode392()
+}
+
+func (c *current) onNode394() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode394() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode394()
+}
+
+func (c *current) onNode396() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode396() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode396()
+}
+
+func (c *current) onNode398() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode398() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode398()
+}
+
+func (c *current) onNode410() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonAST1() (any, error) {
+func (p *parser) callonNode410() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAST1(stack["n"])
+	return p.cur.onNode410()
 }
 
-func (c *current) onNodes3() (any, error) {
-	return nil, nil
+func (c *current) onNode412() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNodes3() (any, error) {
+func (p *parser) callonNode412() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNodes3()
+	return p.cur.onNode412()
 }
 
-func (c *current) onNode7() (any, error) {
+func (c *current) onNode414() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode7() (any, error) {
+func (p *parser) callonNode414() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode7()
+	return p.cur.onNode414()
 }
 
-func (c *current) onNode10() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode416() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode10() (any, error) {
+func (p *parser) callonNode416() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode10()
+	return p.cur.onNode416()
 }
 
-func (c *current) onNode12() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode408() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode12() (any, error) {
+func (p *parser) callonNode408() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode12()
+	return p.cur.onNode408()
 }
 
-func (c *current) onNode3(k, v any) (any, error) {
-	return buildBooleanNode(k, v, c.text, c.pos)
+func (c *current) onNode421() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode3() (any, error) {
+func (p *parser) callonNode421() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode3(stack["k"], stack["v"])
+	return p.cur.onNode421()
 }
 
-func (c *current) onNode22() (any, error) {
+func (c *current) onNode423() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode22() (any, error) {
+func (p *parser) callonNode423() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode22()
+	return p.cur.onNode423()
 }
 
-func (c *current) onNode26() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode419() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode26() (any, error) {
+func (p *parser) callonNode419() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode26()
+	return p.cur.onNode419()
 }
 
-func (c *current) onNode28() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode428() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode28() (any, error) {
+func (p *parser) callonNode428() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode28()
+	return p.cur.onNode428()
 }
 
-func (c *current) onNode30() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode430() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode30() (any, error) {
+func (p *parser) callonNode430() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode30()
+	return p.cur.onNode430()
 }
 
-func (c *current) onNode32() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode426() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode32() (any, error) {
+func (p *parser) callonNode426() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode32()
+	return p.cur.onNode426()
 }
 
-func (c *current) onNode34() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode406() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode34() (any, error) {
+func (p *parser) callonNode406() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode34()
+	return p.cur.onNode406()
 }
 
-func (c *current) onNode36() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onNode437() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode36() (any, error) {
+func (p *parser) callonNode437() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode36()
+	return p.cur.onNode437()
 }
 
-func (c *current) onNode48() (any, error) {
+func (c *current) onNode439() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode48() (any, error) {
+func (p *parser) callonNode439() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode48()
+	return p.cur.onNode439()
 }
 
-func (c *current) onNode50() (any, error) {
+func (c *current) onNode435() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode50() (any, error) {
+func (p *parser) callonNode435() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode50()
+	return p.cur.onNode435()
 }
 
-func (c *current) onNode52() (any, error) {
+func (c *current) onNode444() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode52() (any, error) {
+func (p *parser) callonNode444() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode52()
+	return p.cur.onNode444()
 }
 
-func (c *current) onNode54() (any, error) {
+func (c *current) onNode446() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode54() (any, error) {
+func (p *parser) callonNode446() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode54()
+	return p.cur.onNode446()
 }
 
-func (c *current) onNode46() (any, error) {
+func (c *current) onNode442() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode46() (any, error) {
+func (p *parser) callonNode442() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode46()
+	return p.cur.onNode442()
 }
 
-func (c *current) onNode59() (any, error) {
+func (c *current) onNode451() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode59() (any, error) {
+func (p *parser) callonNode451() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode59()
+	return p.cur.onNode451()
 }
 
-func (c *current) onNode61() (any, error) {
+func (c *current) onNode453() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode61() (any, error) {
+func (p *parser) callonNode453() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode61()
+	return p.cur.onNode453()
 }
 
-func (c *current) onNode57() (any, error) {
+func (c *current) onNode449() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode57() (any, error) {
+func (p *parser) callonNode449() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode57()
+	return p.cur.onNode449()
 }
 
-func (c *current) onNode66() (any, error) {
+func (c *current) onNode459() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode66() (any, error) {
+func (p *parser) callonNode459() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode66()
+	return p.cur.onNode459()
 }
 
-func (c *current) onNode68() (any, error) {
+func (c *current) onNode467() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode68() (any, error) {
+func (p *parser) callonNode467() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode68()
+	return p.cur.onNode467()
 }
 
-func (c *current) onNode64() (any, error) {
+func (c *current) onNode469() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode64() (any, error) {
+func (p *parser) callonNode469() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode64()
+	return p.cur.onNode469()
 }
 
-func (c *current) onNode44() (any, error) {
+func (c *current) onNode465() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode44() (any, error) {
+func (p *parser) callonNode465() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode44()
+	return p.cur.onNode465()
 }
 
-func (c *current) onNode75() (any, error) {
+func (c *current) onNode474() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode75() (any, error) {
+func (p *parser) callonNode474() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode75()
+	return p.cur.onNode474()
 }
 
-func (c *current) onNode77() (any, error) {
+func (c *current) onNode476() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode77() (any, error) {
+func (p *parser) callonNode476() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode77()
+	return p.cur.onNode476()
 }
 
-func (c *current) onNode73() (any, error) {
+func (c *current) onNode472() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode73() (any, error) {
+func (p *parser) callonNode472() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode73()
+	return p.cur.onNode472()
 }
 
-func (c *current) onNode82() (any, error) {
+func (c *current) onNode433() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode82() (any, error) {
+func (p *parser) callonNode433() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode82()
+	return p.cur.onNode433()
 }
 
-func (c *current) onNode84() (any, error) {
+func (c *current) onNode404() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode84() (any, error) {
+func (p *parser) callonNode404() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode84()
+	return p.cur.onNode404()
 }
 
-func (c *current) onNode80() (any, error) {
+func (c *current) onNode482() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode80() (any, error) {
+func (p *parser) callonNode482() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode80()
+	return p.cur.onNode482()
 }
 
-func (c *current) onNode89() (any, error) {
+func (c *current) onNode484() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode89() (any, error) {
+func (p *parser) callonNode484() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode89()
+	return p.cur.onNode484()
 }
 
-func (c *current) onNode91() (any, error) {
+func (c *current) onNode486() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode91() (any, error) {
+func (p *parser) callonNode486() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode91()
+	return p.cur.onNode486()
 }
 
-func (c *current) onNode87() (any, error) {
+func (c *current) onNode488() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode87() (any, error) {
+func (p *parser) callonNode488() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode87()
+	return p.cur.onNode488()
 }
 
-func (c *current) onNode97() (any, error) {
+func (c *current) onNode480() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode97() (any, error) {
+func (p *parser) callonNode480() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode97()
+	return p.cur.onNode480()
 }
 
-func (c *current) onNode105() (any, error) {
+func (c *current) onNode493() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode105() (any, error) {
+func (p *parser) callonNode493() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode105()
+	return p.cur.onNode493()
 }
 
-func (c *current) onNode107() (any, error) {
+func (c *current) onNode495() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode107() (any, error) {
+func (p *parser) callonNode495() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode107()
+	return p.cur.onNode495()
 }
 
-func (c *current) onNode103() (any, error) {
+func (c *current) onNode491() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode103() (any, error) {
+func (p *parser) callonNode491() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode103()
+	return p.cur.onNode491()
 }
 
-func (c *current) onNode112() (any, error) {
+func (c *current) onNode500() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode112() (any, error) {
+func (p *parser) callonNode500() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode112()
+	return p.cur.onNode500()
 }
 
-func (c *current) onNode114() (any, error) {
+func (c *current) onNode502() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode114() (any, error) {
+func (p *parser) callonNode502() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode114()
+	return p.cur.onNode502()
 }
 
-func (c *current) onNode110() (any, error) {
+func (c *current) onNode498() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode110() (any, error) {
+func (p *parser) callonNode498() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode110()
+	return p.cur.onNode498()
 }
 
-func (c *current) onNode71() (any, error) {
+func (c *current) onNode478() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode71() (any, error) {
+func (p *parser) callonNode478() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode71()
+	return p.cur.onNode478()
 }
 
-func (c *current) onNode42() (any, error) {
+func (c *current) onNode508() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode42() (any, error) {
+func (p *parser) callonNode508() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode42()
+	return p.cur.onNode508()
 }
 
-func (c *current) onNode120() (any, error) {
+func (c *current) onNode510() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode120() (any, error) {
+func (p *parser) callonNode510() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode120()
+	return p.cur.onNode510()
 }
 
-func (c *current) onNode122() (any, error) {
+func (c *current) onNode506() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode122() (any, error) {
+func (p *parser) callonNode506() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode122()
+	return p.cur.onNode506()
 }
 
-func (c *current) onNode124() (any, error) {
+func (c *current) onNode515() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode124() (any, error) {
+func (p *parser) callonNode515() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode124()
+	return p.cur.onNode515()
 }
 
-func (c *current) onNode126() (any, error) {
+func (c *current) onNode517() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode126() (any, error) {
+func (p *parser) callonNode517() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode126()
+	return p.cur.onNode517()
 }
 
-func (c *current) onNode118() (any, error) {
+func (c *current) onNode513() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode118() (any, error) {
+func (p *parser) callonNode513() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode118()
+	return p.cur.onNode513()
 }
 
-func (c *current) onNode131() (any, error) {
+func (c *current) onNode522() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode131() (any, error) {
+func (p *parser) callonNode522() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode131()
+	return p.cur.onNode522()
 }
 
-func (c *current) onNode133() (any, error) {
+func (c *current) onNode524() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode133() (any, error) {
+func (p *parser) callonNode524() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode133()
+	return p.cur.onNode524()
 }
 
-func (c *current) onNode129() (any, error) {
+func (c *current) onNode520() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode129() (any, error) {
+func (p *parser) callonNode520() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode129()
+	return p.cur.onNode520()
 }
 
-func (c *current) onNode138() (any, error) {
+func (c *current) onNode530() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode138() (any, error) {
+func (p *parser) callonNode530() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode138()
+	return p.cur.onNode530()
 }
 
-func (c *current) onNode140() (any, error) {
+func (c *current) onNode538() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode140() (any, error) {
+func (p *parser) callonNode538() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode140()
+	return p.cur.onNode538()
 }
 
-func (c *current) onNode136() (any, error) {
+func (c *current) onNode540() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode136() (any, error) {
+func (p *parser) callonNode540() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode136()
+	return p.cur.onNode540()
 }
 
-func (c *current) onNode116() (any, error) {
+func (c *current) onNode536() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode116() (any, error) {
+func (p *parser) callonNode536() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode116()
+	return p.cur.onNode536()
 }
 
-func (c *current) onNode146() (any, error) {
+func (c *current) onNode545() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode146() (any, error) {
+func (p *parser) callonNode545() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode146()
+	return p.cur.onNode545()
 }
 
-func (c *current) onNode148() (any, error) {
+func (c *current) onNode547() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode148() (any, error) {
+func (p *parser) callonNode547() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode148()
+	return p.cur.onNode547()
 }
 
-func (c *current) onNode144() (any, error) {
+func (c *current) onNode543() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode144() (any, error) {
+func (p *parser) callonNode543() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode144()
+	return p.cur.onNode543()
 }
 
-func (c *current) onNode153() (any, error) {
+func (c *current) onNode504() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode153() (any, error) {
+func (p *parser) callonNode504() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode153()
+	return p.cur.onNode504()
 }
 
-func (c *current) onNode155() (any, error) {
-	return c.text, nil
+func (c *current) onNode380(k, o, v any) (any, error) {
+	return buildDateTimeNode(k, o, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode155() (any, error) {
+func (p *parser) callonNode380() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode155()
+	return p.cur.onNode380(stack["k"], stack["o"], stack["v"])
 }
 
-func (c *current) onNode151() (any, error) {
+func (c *current) onNode555() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode151() (any, error) {
+func (p *parser) callonNode555() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode151()
+	return p.cur.onNode555()
 }
 
-func (c *current) onNode160() (any, error) {
-	return c.text, nil
+func (c *current) onNode558() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode160() (any, error) {
+func (p *parser) callonNode558() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode160()
+	return p.cur.onNode558()
 }
 
-func (c *current) onNode162() (any, error) {
-	return c.text, nil
+func (c *current) onNode560() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode162() (any, error) {
+func (p *parser) callonNode560() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode162()
+	return p.cur.onNode560()
 }
 
-func (c *current) onNode158() (any, error) {
+func (c *current) onNode575() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode158() (any, error) {
+func (p *parser) callonNode575() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode158()
+	return p.cur.onNode575()
 }
 
-func (c *current) onNode168() (any, error) {
-	return c.text, nil
+func (c *current) onNode551(k, v any) (any, error) {
+	return buildNaturalLanguageDateTimeNodes(k, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode168() (any, error) {
+func (p *parser) callonNode551() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode168()
+	return p.cur.onNode551(stack["k"], stack["v"])
 }
 
-func (c *current) onNode176() (any, error) {
+func (c *current) onNode583() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode176() (any, error) {
+func (p *parser) callonNode583() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode176()
+	return p.cur.onNode583()
 }
 
-func (c *current) onNode178() (any, error) {
-	return c.text, nil
+func (c *current) onNode587() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode178() (any, error) {
+func (p *parser) callonNode587() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode178()
+	return p.cur.onNode587()
 }
 
-func (c *current) onNode174() (any, error) {
-	return c.text, nil
+func (c *current) onNode589() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode174() (any, error) {
+func (p *parser) callonNode589() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode174()
+	return p.cur.onNode589()
 }
 
-func (c *current) onNode183() (any, error) {
-	return c.text, nil
+func (c *current) onNode591() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode183() (any, error) {
+func (p *parser) callonNode591() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode183()
+	return p.cur.onNode591()
 }
 
-func (c *current) onNode185() (any, error) {
-	return c.text, nil
+func (c *current) onNode593() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode185() (any, error) {
+func (p *parser) callonNode593() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode185()
+	return p.cur.onNode593()
 }
 
-func (c *current) onNode181() (any, error) {
+func (c *current) onNode608() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode181() (any, error) {
+func (p *parser) callonNode608() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode181()
+	return p.cur.onNode608()
 }
 
-func (c *current) onNode142() (any, error) {
+func (c *current) onNode579(k, o, v any) (any, error) {
+	return buildNaturalLanguageDateTimeNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode579() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode579(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onNode616() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode142() (any, error) {
+func (p *parser) callonNode616() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode142()
+	return p.cur.onNode616()
 }
 
-func (c *current) onNode18(k, o, v any) (any, error) {
-	return buildDateTimeNode(k, o, v, c.text, c.pos)
+func (c *current) onNode620() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode18() (any, error) {
+func (p *parser) callonNode620() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode18(stack["k"], stack["o"], stack["v"])
+	return p.cur.onNode620()
 }
 
-func (c *current) onNode193() (any, error) {
-	return c.text, nil
+func (c *current) onNode622() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode193() (any, error) {
+func (p *parser) callonNode622() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode193()
+	return p.cur.onNode622()
 }
 
-func (c *current) onNode196() (any, error) {
+func (c *current) onNode624() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode196() (any, error) {
+func (p *parser) callonNode624() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode196()
+	return p.cur.onNode624()
 }
 
-func (c *current) onNode198() (any, error) {
+func (c *current) onNode626() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode198() (any, error) {
+func (p *parser) callonNode626() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode198()
+	return p.cur.onNode626()
 }
 
-func (c *current) onNode213() (any, error) {
+func (c *current) onNode632() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode213() (any, error) {
+func (p *parser) callonNode632() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode213()
+	return p.cur.onNode632()
 }
 
-func (c *current) onNode189(k, v any) (any, error) {
-	return buildNaturalLanguageDateTimeNodes(k, v, c.text, c.pos)
+func (c *current) onNode638() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode189() (any, error) {
+func (p *parser) callonNode638() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode189(stack["k"], stack["v"])
+	return p.cur.onNode638()
 }
 
-func (c *current) onNode221() (any, error) {
+func (c *current) onNode629() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode221() (any, error) {
+func (p *parser) callonNode629() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode221()
+	return p.cur.onNode629()
 }
 
-func (c *current) onNode224() (any, error) {
+func (c *current) onNode612(k, o, v any) (any, error) {
+	return buildNumericNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode612() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode612(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onNode656() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode656() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode656()
+}
+
+func (c *current) onNode658(k any) (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode224() (any, error) {
+func (p *parser) callonNode658() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode224()
+	return p.cur.onNode658(stack["k"])
 }
 
-func (c *current) onNode226() (any, error) {
+func (c *current) onNode662(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode662() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode662(stack["v"])
+}
+
+func (c *current) onNode652(k, v any) (any, error) {
+	return buildNotEqualNodes(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode652() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode652(stack["k"], stack["v"])
+}
+
+func (c *current) onNode675() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode675() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode675()
+}
+
+func (c *current) onNode678() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode226() (any, error) {
+func (p *parser) callonNode678() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode226()
+	return p.cur.onNode678()
+}
+
+func (c *current) onNode680() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode680() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode680()
 }
 
-func (c *current) onNode230(v any) (any, error) {
+func (c *current) onNode684(v any) (any, error) {
 	return v, nil
 
 }
 
-func (p *parser) callonNode230() (any, error) {
+func (p *parser) callonNode684() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode230(stack["v"])
+	return p.cur.onNode684(stack["v"])
 }
 
-func (c *current) onNode217(k, v any) (any, error) {
+func (c *current) onNode671(k, v any) (any, error) {
 	return buildStringNode(k, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode217() (any, error) {
+func (p *parser) callonNode671() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode217(stack["k"], stack["v"])
+	return p.cur.onNode671(stack["k"], stack["v"])
 }
 
-func (c *current) onNode239() (any, error) {
+func (c *current) onNode693() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode239() (any, error) {
+func (p *parser) callonNode693() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode239()
+	return p.cur.onNode693()
 }
 
-func (c *current) onNode243() (any, error) {
+func (c *current) onNode697() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode243() (any, error) {
+func (p *parser) callonNode697() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode243()
+	return p.cur.onNode697()
 }
 
-func (c *current) onNode247() (any, error) {
+func (c *current) onNode701() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode247() (any, error) {
+func (p *parser) callonNode701() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode247()
+	return p.cur.onNode701()
 }
 
-func (c *current) onNode252() (any, error) {
+func (c *current) onNode707(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode707() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode707(stack["v"])
+}
+
+func (c *current) onNode727() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode727() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode727()
+}
+
+func (c *current) onNode733() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode733() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode733()
+}
+
+func (c *current) onNode737() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode737() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode737()
+}
+
+func (c *current) onNode742() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode742() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode742()
+}
+
+func (c *current) onNode744(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode744() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode744(stack["v"])
+}
+
+func (c *current) onNode724(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode724() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode724(stack["v"])
+}
+
+func (c *current) onNode752(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode752() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode752(stack["v"])
+}
+
+func (c *current) onNode703(l, o, d, r any) (any, error) {
+	return buildProximityNode(l, o, d, r, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode703() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode703(stack["l"], stack["o"], stack["d"], stack["r"])
+}
+
+func (c *current) onNode764() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode252() (any, error) {
+func (p *parser) callonNode764() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode252()
+	return p.cur.onNode764()
 }
 
-func (c *current) onNode254() (any, error) {
+func (c *current) onNode766() (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode254() (any, error) {
+func (p *parser) callonNode766() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode254()
+	return p.cur.onNode766()
 }
 
-func (c *current) onNode258(v any) (any, error) {
+func (c *current) onNode770(v any) (any, error) {
 	return v, nil
 
 }
 
-func (p *parser) callonNode258() (any, error) {
+func (p *parser) callonNode770() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode258(stack["v"])
+	return p.cur.onNode770(stack["v"])
 }
 
-func (c *current) onNode265(v any) (any, error) {
+func (c *current) onNode777(v any) (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode265() (any, error) {
+func (p *parser) callonNode777() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode265(stack["v"])
+	return p.cur.onNode777(stack["v"])
 }
 
-func (c *current) onNode269() (any, error) {
+func (c *current) onNode781() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode269() (any, error) {
+func (p *parser) callonNode781() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode269()
+	return p.cur.onNode781()
 }
 
-func (c *current) onNode249(v any) (any, error) {
+func (c *current) onNode761(v any) (any, error) {
 	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode249() (any, error) {
+func (p *parser) callonNode761() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode249(stack["v"])
+	return p.cur.onNode761(stack["v"])
 }
 
-func (c *current) onNode274() (any, error) {
+func (c *current) onNode786() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode274() (any, error) {
+func (p *parser) callonNode786() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode274()
+	return p.cur.onNode786()
 }
 
-func (c *current) onNode276() (any, error) {
+func (c *current) onNode788() (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode276() (any, error) {
+func (p *parser) callonNode788() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode276()
+	return p.cur.onNode788()
 }
 
-func (c *current) onNode282(v any) (any, error) {
+func (c *current) onNode794(v any) (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode282() (any, error) {
+func (p *parser) callonNode794() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode282(stack["v"])
+	return p.cur.onNode794(stack["v"])
 }
 
-func (c *current) onNode286() (any, error) {
+func (c *current) onNode798() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode286() (any, error) {
+func (p *parser) callonNode798() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode286()
+	return p.cur.onNode798()
 }
 
-func (c *current) onNode271(v any) (any, error) {
+func (c *current) onNode783(v any) (any, error) {
 	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode271() (any, error) {
+func (p *parser) callonNode783() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode271(stack["v"])
+	return p.cur.onNode783(stack["v"])
 }
 
 func (c *current) onGroupNode6() (any, error) {
@@ -858,6 +858,358 @@ func TestParse_DateTimeRestrictionNode(t *testing.T) {
 	}
 }
 
+func TestParse_NumericRestrictionNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `size>10MB size<=1.5GB size>=1024 size<2kb`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">"}, Value: 10 << 20},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<="}, Value: 1.5 * (1 << 30)},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1024},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<"}, Value: 2 << 10},
+				},
+			},
+		},
+		{
+			name: `size:1MB..5MB`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1 << 20},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<="}, Value: 5 << 20},
+				},
+			},
+		},
+		{
+			name: `size>10MB cat`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">"}, Value: 10 << 20},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "cat"},
+				},
+			},
+		},
+		{
+			name: `size:10`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "size", Value: "10"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_RangeRestrictionNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `Mtime:2023-08-01..2023-08-31`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: ">="},
+						Value:    mustParseTime(t, "2023-08-01"),
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    mustParseTime(t, "2023-08-31 23:59:59.999999999"),
+					},
+				},
+			},
+		},
+		{
+			name: `Mtime:"2023-08-01T10:00:00Z..2023-08-31T12:00:00Z"`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: ">="},
+						Value:    mustParseTime(t, "2023-08-01T10:00:00Z"),
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    mustParseTime(t, "2023-08-31T12:00:00Z"),
+					},
+				},
+			},
+		},
+		{
+			name:  `Mtime:last month..yesterday`,
+			patch: setWorldClock(t, "2023-09-10"),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: ">="},
+						Value:    mustParseTime(t, "2023-08-01"),
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    mustParseTime(t, "2023-09-09 23:59:59.999999999"),
+					},
+				},
+			},
+		},
+		{
+			name:  `Mtime>yesterday Mtime<="last week" Mtime<"this week"`,
+			patch: setWorldClock(t, "2023-09-10"),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: ">"},
+						Value:    mustParseTime(t, "2023-09-09 23:59:59.999999999"),
+					},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    mustParseTime(t, "2023-09-03 23:59:59.999999999"),
+					},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.DateTimeNode{
+						Key:      "Mtime",
+						Operator: &ast.OperatorNode{Value: "<"},
+						Value:    mustParseTime(t, "2023-09-04"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_NotEqualOperator(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `cat author<>"John Smith"`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "cat"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.OperatorNode{Value: kql.BoolNOT},
+					&ast.StringNode{Key: "author", Value: "John Smith"},
+				},
+			},
+		},
+		{
+			name: `filetype<>docx`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.OperatorNode{Value: kql.BoolNOT},
+					&ast.StringNode{Key: "filetype", Value: "docx"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_ProximityNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `cat NEAR dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"cat", "dog"}, Distance: kql.DefaultProximityDistance},
+				},
+			},
+		},
+		{
+			name: `cat NEAR(3) dog fox`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"cat", "dog"}, Distance: 3},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "fox"},
+				},
+			},
+		},
+		{
+			name: `"tax return" ONEAR(n=2) 2023`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"tax return", "2023"}, Distance: 2, Ordered: true},
+				},
+			},
+		},
+		{
+			name: `content:(cat NEAR dog)`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Key: "content", Nodes: []ast.Node{
+						&ast.ProximityNode{Terms: []string{"cat", "dog"}, Distance: kql.DefaultProximityDistance},
+					}},
+				},
+			},
+		},
+		{
+			name: `cat NEARBY dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "cat"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "NEARBY"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "dog"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_RankNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `cat XRANK(cb=100) dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Boost: 100,
+						Match: []ast.Node{&ast.StringNode{Value: "cat"}},
+						Rank:  []ast.Node{&ast.StringNode{Value: "dog"}},
+					},
+				},
+			},
+		},
+		{
+			name: `cat tag:pet XRANK(cb=1.5) dog OR fox XRANK(cb=2) tag:bestseller`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Boost: 2,
+						Match: []ast.Node{
+							&ast.RankNode{
+								Boost: 1.5,
+								Match: []ast.Node{
+									&ast.StringNode{Value: "cat"},
+									&ast.OperatorNode{Value: kql.BoolAND},
+									&ast.StringNode{Key: "tag", Value: "pet"},
+								},
+								Rank: []ast.Node{
+									&ast.StringNode{Value: "dog"},
+									&ast.OperatorNode{Value: kql.BoolOR},
+									&ast.StringNode{Value: "fox"},
+								},
+							},
+						},
+						Rank: []ast.Node{&ast.StringNode{Key: "tag", Value: "bestseller"}},
+					},
+				},
+			},
+		},
+		{
+			name: `cat (dog XRANK(cb=10) fox)`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "cat"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.RankNode{
+							Boost: 10,
+							Match: []ast.Node{&ast.StringNode{Value: "dog"}},
+							Rank:  []ast.Node{&ast.StringNode{Value: "fox"}},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_SimilarNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: `similar:"tax refund"`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.SimilarNode{Value: "tax refund"},
+				},
+			},
+		},
+		{
+			name: `similar:invoices mediatype:document`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.SimilarNode{Value: "invoices"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "mediatype", Value: "document"},
+				},
+			},
+		},
+		{
+			name: `similar:tax similar:refund`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.SimilarNode{Value: "tax"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.SimilarNode{Value: "refund"},
+				},
+			},
+		},
+		{
+			name: `similarity:high`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "similarity", Value: "high"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
 func TestParse_Errors(t *testing.T) {
 	tests := []testCase{
 		{
@@ -896,6 +1248,28 @@ func TestParse_Errors(t *testing.T) {
 				Node: &ast.OperatorNode{Value: kql.BoolOR},
 			},
 		},
+		{
+			query: "cat NEAR(101) dog",
+			error: query.InvalidProximityDistanceError{Distance: 101, Max: kql.MaxProximityDistance},
+		},
+		{
+			query: "cat ONEAR(99999999999999999999) dog",
+			error: query.InvalidProximityDistanceError{Distance: 99999999999999999999, Max: kql.MaxProximityDistance},
+		},
+		{
+			query: "XRANK(cb=100) cat",
+			error: query.IncompleteRankError{},
+		},
+		{
+			query: "cat XRANK(cb=100)",
+			error: query.IncompleteRankError{},
+		},
+		{
+			query: "cat XRANK(cb=100) OR dog",
+			error: query.StartsWithBinaryOperatorError{
+				Node: &ast.OperatorNode{Value: kql.BoolOR},
+			},
+		},
 		{
 			query: `cat dog`,
 			ast: &ast.Ast{
@@ -19,6 +19,15 @@ The following spec parts are supported and tested:
   - 3.3.1.1.1 Implicit AND Operator
   - 3.3.5 Date Tokens
 
+Additionally, the following parts are supported:
+  - prefix and suffix wildcards, e.g. `report*` or `*.pdf`
+  - the NEAR and ONEAR operators with an optional distance, e.g. `cat NEAR(3) dog`
+  - the XRANK operator with a constant boost, e.g. `cat XRANK(cb=100) dog`
+  - numeric restrictions with size units, e.g. `size>10MB`
+  - ranges of numbers and dates, e.g. `size:1MB..5MB` or `mtime:2023-01-01..2023-03-31`
+  - the not equal operator, e.g. `author<>"John Smith"`
+  - natural language dates with comparison operators, e.g. `mtime>=last week`
+
 References:
   - https://learn.microsoft.com/en-us/sharepoint/dev/general-development/keyword-query-language-kql-syntax-reference
   - https://learn.microsoft.com/en-us/openspecs/sharepoint_protocols/ms-kql/3bbf06cd-8fc1-4277-bd92-8661ccd3c9b0
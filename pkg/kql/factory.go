@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/opencloud-eu/opencloud/pkg/ast"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/query"
 )
 
 func base(text []byte, pos position) (*ast.Base, error) {
@@ -38,6 +39,11 @@ func buildAST(n interface{}, text []byte, pos position) (*ast.Ast, error) {
 		return nil, err
 	}
 
+	nodes, err = rankNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
 	a := &ast.Ast{
 		Base:  b,
 		Nodes: connectNodes(DefaultConnector{sameKeyOPValue: BoolOR}, nodes...),
@@ -135,6 +141,277 @@ func buildNaturalLanguageDateTimeNodes(k, v interface{}, text []byte, pos positi
 
 }
 
+func buildNaturalLanguageDateTimeNode(k, o, v interface{}, text []byte, pos position) (*ast.DateTimeNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toNode[*ast.OperatorNode](o)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := toTimeRange(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// spec: same
+	// 		modified>=yesterday
+	// 		modified>=<the beginning of yesterday>
+	// 		modified>yesterday
+	// 		modified><the end of yesterday>
+	value := *from
+	switch operator.Value {
+	case ">", "<=":
+		value = *to
+	}
+
+	return &ast.DateTimeNode{
+		Base:     b,
+		Key:      key,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func buildDateTimeRangeNodes(k, f, t interface{}, text []byte, pos position) ([]ast.Node, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, _, err := toTimeBounds(f)
+	if err != nil {
+		return nil, err
+	}
+
+	_, to, err := toTimeBounds(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return []ast.Node{
+		&ast.DateTimeNode{
+			Base:     b,
+			Value:    from,
+			Key:      key,
+			Operator: &ast.OperatorNode{Value: ">="},
+		},
+		&ast.OperatorNode{Value: BoolAND},
+		&ast.DateTimeNode{
+			Base:     b,
+			Value:    to,
+			Key:      key,
+			Operator: &ast.OperatorNode{Value: "<="},
+		},
+	}, nil
+}
+
+func buildNumericNode(k, o, v interface{}, text []byte, pos position) (*ast.NumericNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toNode[*ast.OperatorNode](o)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.NumericNode{
+		Base:     b,
+		Key:      key,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func buildNumericRangeNodes(k, f, t interface{}, text []byte, pos position) ([]ast.Node, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := toNumber(f)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := toNumber(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return []ast.Node{
+		&ast.NumericNode{
+			Base:     b,
+			Value:    from,
+			Key:      key,
+			Operator: &ast.OperatorNode{Value: ">="},
+		},
+		&ast.OperatorNode{Value: BoolAND},
+		&ast.NumericNode{
+			Base:     b,
+			Value:    to,
+			Key:      key,
+			Operator: &ast.OperatorNode{Value: "<="},
+		},
+	}, nil
+}
+
+func buildNotEqualNodes(k, v interface{}, text []byte, pos position) ([]ast.Node, error) {
+	node, err := buildStringNode(k, v, text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	// spec: same
+	// 		author<>"John Smith"
+	// 		NOT author:"John Smith"
+	return []ast.Node{
+		&ast.OperatorNode{Base: node.Base, Value: BoolNOT},
+		node,
+	}, nil
+}
+
+func buildProximityNode(l, o, d, r interface{}, text []byte, pos position) (*ast.ProximityNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := toString(l)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toString(o)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := toString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := DefaultProximityDistance
+	if d != nil {
+		n, err := toNumber(d)
+		if err != nil {
+			return nil, err
+		}
+		if n > MaxProximityDistance {
+			return nil, &query.InvalidProximityDistanceError{Distance: n, Max: MaxProximityDistance}
+		}
+		distance = int(n)
+	}
+
+	return &ast.ProximityNode{
+		Base:     b,
+		Terms:    []string{left, right},
+		Distance: distance,
+		Ordered:  operator == ProximityONEAR,
+	}, nil
+}
+
+func buildRankNode(v interface{}, text []byte, pos position) (*ast.RankNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	boost, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.RankNode{
+		Base:  b,
+		Boost: boost,
+	}, nil
+}
+
+// rankNodes splits the nodes at the last XRANK operator into the match and the rank expression,
+// XRANK has the lowest precedence of all operators.
+//
+// spec: same
+//
+//	cat dog XRANK(cb=100) fox
+//	(cat AND dog) XRANK(cb=100) fox
+func rankNodes(nodes []ast.Node) ([]ast.Node, error) {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node, ok := nodes[i].(*ast.RankNode)
+		if !ok || node.Match != nil || node.Rank != nil {
+			continue
+		}
+
+		match, err := rankNodes(nodes[:i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(match) == 0 || len(nodes[i+1:]) == 0 {
+			return nil, &query.IncompleteRankError{Node: node}
+		}
+
+		node.Match = connectNodes(DefaultConnector{sameKeyOPValue: BoolOR}, match...)
+		node.Rank = connectNodes(DefaultConnector{sameKeyOPValue: BoolOR}, nodes[i+1:]...)
+
+		if err := validateRankNode(node); err != nil {
+			return nil, err
+		}
+
+		return []ast.Node{node}, nil
+	}
+
+	return nodes, nil
+}
+
+func buildSimilarNode(v interface{}, text []byte, pos position) (*ast.SimilarNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := toString(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.SimilarNode{
+		Base:  b,
+		Value: value,
+	}, nil
+}
+
 func buildBooleanNode(k, v interface{}, text []byte, pos position) (*ast.BooleanNode, error) {
 	b, err := base(text, pos)
 	if err != nil {
@@ -195,6 +472,11 @@ func buildGroupNode(k, n interface{}, text []byte, pos position) (*ast.GroupNode
 		return nil, err
 	}
 
+	nodes, err = rankNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
 	gn := &ast.GroupNode{
 		Base:  b,
 		Key:   key,
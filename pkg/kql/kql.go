@@ -18,6 +18,18 @@ const (
 	BoolNOT = "NOT"
 )
 
+// The proximity node operator definition
+const (
+	// ProximityNEAR matches terms near to each other in any order
+	ProximityNEAR = "NEAR"
+	// ProximityONEAR matches terms near to each other in the given order
+	ProximityONEAR = "ONEAR"
+	// DefaultProximityDistance is the number of terms between proximity terms if no distance is given
+	DefaultProximityDistance = 8
+	// MaxProximityDistance is the largest number of terms allowed between proximity terms
+	MaxProximityDistance = 100
+)
+
 // Builder implements kql Builder interface
 type Builder struct{}
 
@@ -47,3 +59,13 @@ func (b Builder) Build(q string) (*ast.Ast, error) {
 // timeNow mirrors time.Now by default, the only reason why this exists
 // is to monkey patch it from the tests. See PatchTimeNow
 var timeNow = time.Now
+
+// TimeRange returns the range of a natural language date like `today` or `last week`, relative to the current time.
+func TimeRange(value string) (time.Time, time.Time, error) {
+	from, to, err := toTimeRange(value)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *from, *to, nil
+}
@@ -35,3 +35,17 @@ func validateGroupNode(n *ast.GroupNode) error {
 
 	return nil
 }
+
+func validateRankNode(n *ast.RankNode) error {
+	for _, nodes := range [][]ast.Node{n.Match, n.Rank} {
+		switch node := nodes[0].(type) {
+		case *ast.OperatorNode:
+			switch node.Value {
+			case BoolAND, BoolOR:
+				return &query.StartsWithBinaryOperatorError{Node: node}
+			}
+		}
+	}
+
+	return nil
+}
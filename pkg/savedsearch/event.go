@@ -0,0 +1,28 @@
+// Package savedsearch contains the events of the saved searches of the search service.
+package savedsearch
+
+import (
+	"encoding/json"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+)
+
+// Matched is emitted when an indexed resource newly matches a saved search with an enabled alert
+type Matched struct {
+	UserID          *user.UserId
+	SavedSearchID   string
+	SavedSearchName string
+	ResourceID      *provider.ResourceId
+	ResourceName    string
+	Email           bool
+	Timestamp       time.Time
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (Matched) Unmarshal(v []byte) (interface{}, error) {
+	e := Matched{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
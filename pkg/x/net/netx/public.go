@@ -0,0 +1,81 @@
+// Package netx contains helpers to restrict outgoing connections to public addresses.
+package netx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+// ErrNotPublic is returned when an address is not a public address.
+var ErrNotPublic = errors.New("address is not public")
+
+// nonPublicPrefixes are the special purpose ranges which are not covered by the checks of the net package
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // this network
+	netip.MustParsePrefix("100.64.0.0/10"),   // shared address space
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, can map to private IPv4 addresses
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("2002::/16"),       // 6to4, can map to private IPv4 addresses
+}
+
+// IsPublic returns true if the address is a public unicast address. Private, loopback,
+// link-local, multicast and other special purpose addresses are not public.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckPublicHost resolves the host and returns ErrNotPublic if one of its addresses
+// is not public. Hosts that are ip addresses are checked without a lookup.
+func CheckPublicHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublic(addr) {
+			return fmt.Errorf("%w: %s", ErrNotPublic, host)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("could not resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublic(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrNotPublic, host, addr)
+		}
+	}
+	return nil
+}
+
+// PublicOnly can be used as the Control function of a net.Dialer to refuse connections
+// to addresses which are not public. As it checks the address that is actually dialed,
+// it also prevents hosts from resolving to a different address after they were checked.
+func PublicOnly(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublic(ap.Addr()) {
+		return fmt.Errorf("%w: %s", ErrNotPublic, ap.Addr())
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package netx_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "8.8.8.8", want: true},
+		{addr: "2606:4700:4700::1111", want: true},
+		{addr: "::ffff:8.8.8.8", want: true},
+		{addr: "127.0.0.1", want: false},
+		{addr: "::1", want: false},
+		{addr: "10.1.2.3", want: false},
+		{addr: "172.16.0.1", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "fd00::1", want: false},
+		{addr: "0.0.0.0", want: false},
+		{addr: "::", want: false},
+		{addr: "100.64.0.1", want: false},
+		{addr: "224.0.0.1", want: false},
+		{addr: "255.255.255.255", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+		{addr: "64:ff9b::a00:1", want: false},
+		{addr: "2002:a00:1::", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := netx.IsPublic(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("IsPublic(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPublicHost(t *testing.T) {
+	if err := netx.CheckPublicHost(context.Background(), "8.8.8.8"); err != nil {
+		t.Errorf("CheckPublicHost() unexpected error: %v", err)
+	}
+	for _, host := range []string{"127.0.0.1", "::1", "localhost"} {
+		if err := netx.CheckPublicHost(context.Background(), host); !errors.Is(err, netx.ErrNotPublic) {
+			t.Errorf("CheckPublicHost(%s) = %v, want ErrNotPublic", host, err)
+		}
+	}
+}
+
+func TestPublicOnly(t *testing.T) {
+	if err := netx.PublicOnly("tcp", "8.8.8.8:443", nil); err != nil {
+		t.Errorf("PublicOnly() unexpected error: %v", err)
+	}
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "10.0.0.1:80", "[fe80::1%eth0]:443"} {
+		if err := netx.PublicOnly("tcp", address, nil); err == nil {
+			t.Errorf("PublicOnly(%s) expected an error", address)
+		}
+	}
+}
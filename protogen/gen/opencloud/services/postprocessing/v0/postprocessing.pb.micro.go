@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-micro. DO NOT EDIT.
+// source: opencloud/services/postprocessing/v0/postprocessing.proto
+
+package v0
+
+import (
+	fmt "fmt"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/postprocessing/v0"
+	proto "google.golang.org/protobuf/proto"
+	math "math"
+)
+
+import (
+	context "context"
+	api "go-micro.dev/v4/api"
+	client "go-micro.dev/v4/client"
+	server "go-micro.dev/v4/server"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ api.Endpoint
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Api Endpoints for PostprocessingService service
+
+func NewPostprocessingServiceEndpoints() []*api.Endpoint {
+	return []*api.Endpoint{}
+}
+
+// Client API for PostprocessingService service
+
+type PostprocessingService interface {
+	// returns the uploads in postprocessing, the oldest first
+	ListUploads(ctx context.Context, in *ListUploadsRequest, opts ...client.CallOption) (*ListUploadsResponse, error)
+	// returns the postprocessing state of an upload
+	GetUpload(ctx context.Context, in *GetUploadRequest, opts ...client.CallOption) (*GetUploadResponse, error)
+	// resumes the postprocessing of an upload at its current step
+	RetryUpload(ctx context.Context, in *RetryUploadRequest, opts ...client.CallOption) (*RetryUploadResponse, error)
+	// finishes the postprocessing of an upload with the outcome abort
+	AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...client.CallOption) (*AbortUploadResponse, error)
+}
+
+type postprocessingService struct {
+	c    client.Client
+	name string
+}
+
+func NewPostprocessingService(name string, c client.Client) PostprocessingService {
+	return &postprocessingService{
+		c:    c,
+		name: name,
+	}
+}
+
+func (c *postprocessingService) ListUploads(ctx context.Context, in *ListUploadsRequest, opts ...client.CallOption) (*ListUploadsResponse, error) {
+	req := c.c.NewRequest(c.name, "PostprocessingService.ListUploads", in)
+	out := new(ListUploadsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postprocessingService) GetUpload(ctx context.Context, in *GetUploadRequest, opts ...client.CallOption) (*GetUploadResponse, error) {
+	req := c.c.NewRequest(c.name, "PostprocessingService.GetUpload", in)
+	out := new(GetUploadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postprocessingService) RetryUpload(ctx context.Context, in *RetryUploadRequest, opts ...client.CallOption) (*RetryUploadResponse, error) {
+	req := c.c.NewRequest(c.name, "PostprocessingService.RetryUpload", in)
+	out := new(RetryUploadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postprocessingService) AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...client.CallOption) (*AbortUploadResponse, error) {
+	req := c.c.NewRequest(c.name, "PostprocessingService.AbortUpload", in)
+	out := new(AbortUploadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for PostprocessingService service
+
+type PostprocessingServiceHandler interface {
+	// returns the uploads in postprocessing, the oldest first
+	ListUploads(context.Context, *ListUploadsRequest, *ListUploadsResponse) error
+	// returns the postprocessing state of an upload
+	GetUpload(context.Context, *GetUploadRequest, *GetUploadResponse) error
+	// resumes the postprocessing of an upload at its current step
+	RetryUpload(context.Context, *RetryUploadRequest, *RetryUploadResponse) error
+	// finishes the postprocessing of an upload with the outcome abort
+	AbortUpload(context.Context, *AbortUploadRequest, *AbortUploadResponse) error
+}
+
+func RegisterPostprocessingServiceHandler(s server.Server, hdlr PostprocessingServiceHandler, opts ...server.HandlerOption) error {
+	type postprocessingService interface {
+		ListUploads(ctx context.Context, in *ListUploadsRequest, out *ListUploadsResponse) error
+		GetUpload(ctx context.Context, in *GetUploadRequest, out *GetUploadResponse) error
+		RetryUpload(ctx context.Context, in *RetryUploadRequest, out *RetryUploadResponse) error
+		AbortUpload(ctx context.Context, in *AbortUploadRequest, out *AbortUploadResponse) error
+	}
+	type PostprocessingService struct {
+		postprocessingService
+	}
+	h := &postprocessingServiceHandler{hdlr}
+	return s.Handle(s.NewHandler(&PostprocessingService{h}, opts...))
+}
+
+type postprocessingServiceHandler struct {
+	PostprocessingServiceHandler
+}
+
+func (h *postprocessingServiceHandler) ListUploads(ctx context.Context, in *ListUploadsRequest, out *ListUploadsResponse) error {
+	return h.PostprocessingServiceHandler.ListUploads(ctx, in, out)
+}
+
+func (h *postprocessingServiceHandler) GetUpload(ctx context.Context, in *GetUploadRequest, out *GetUploadResponse) error {
+	return h.PostprocessingServiceHandler.GetUpload(ctx, in, out)
+}
+
+func (h *postprocessingServiceHandler) RetryUpload(ctx context.Context, in *RetryUploadRequest, out *RetryUploadResponse) error {
+	return h.PostprocessingServiceHandler.RetryUpload(ctx, in, out)
+}
+
+func (h *postprocessingServiceHandler) AbortUpload(ctx context.Context, in *AbortUploadRequest, out *AbortUploadResponse) error {
+	return h.PostprocessingServiceHandler.AbortUpload(ctx, in, out)
+}
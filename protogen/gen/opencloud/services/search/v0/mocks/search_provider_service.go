@@ -39,6 +39,255 @@ func (_m *SearchProviderService) EXPECT() *SearchProviderService_Expecter {
 	return &SearchProviderService_Expecter{mock: &_m.Mock}
 }
 
+// CreateSavedSearch provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) CreateSavedSearch(ctx context.Context, in *v0.CreateSavedSearchRequest, opts ...client.CallOption) (*v0.CreateSavedSearchResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavedSearch")
+	}
+
+	var r0 *v0.CreateSavedSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.CreateSavedSearchRequest, ...client.CallOption) (*v0.CreateSavedSearchResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.CreateSavedSearchRequest, ...client.CallOption) *v0.CreateSavedSearchResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.CreateSavedSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.CreateSavedSearchRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_CreateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavedSearch'
+type SearchProviderService_CreateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// CreateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.CreateSavedSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) CreateSavedSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_CreateSavedSearch_Call {
+	return &SearchProviderService_CreateSavedSearch_Call{Call: _e.mock.On("CreateSavedSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_CreateSavedSearch_Call) Run(run func(ctx context.Context, in *v0.CreateSavedSearchRequest, opts ...client.CallOption)) *SearchProviderService_CreateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.CreateSavedSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.CreateSavedSearchRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_CreateSavedSearch_Call) Return(createSavedSearchResponse *v0.CreateSavedSearchResponse, err error) *SearchProviderService_CreateSavedSearch_Call {
+	_c.Call.Return(createSavedSearchResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_CreateSavedSearch_Call) RunAndReturn(run func(ctx context.Context, in *v0.CreateSavedSearchRequest, opts ...client.CallOption) (*v0.CreateSavedSearchResponse, error)) *SearchProviderService_CreateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSavedSearch provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) DeleteSavedSearch(ctx context.Context, in *v0.DeleteSavedSearchRequest, opts ...client.CallOption) (*v0.DeleteSavedSearchResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavedSearch")
+	}
+
+	var r0 *v0.DeleteSavedSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) (*v0.DeleteSavedSearchResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) *v0.DeleteSavedSearchResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.DeleteSavedSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_DeleteSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavedSearch'
+type SearchProviderService_DeleteSavedSearch_Call struct {
+	*mock.Call
+}
+
+// DeleteSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.DeleteSavedSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) DeleteSavedSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_DeleteSavedSearch_Call {
+	return &SearchProviderService_DeleteSavedSearch_Call{Call: _e.mock.On("DeleteSavedSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) Run(run func(ctx context.Context, in *v0.DeleteSavedSearchRequest, opts ...client.CallOption)) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.DeleteSavedSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.DeleteSavedSearchRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) Return(deleteSavedSearchResponse *v0.DeleteSavedSearchResponse, err error) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Return(deleteSavedSearchResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) RunAndReturn(run func(ctx context.Context, in *v0.DeleteSavedSearchRequest, opts ...client.CallOption) (*v0.DeleteSavedSearchResponse, error)) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavedSearch provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) GetSavedSearch(ctx context.Context, in *v0.GetSavedSearchRequest, opts ...client.CallOption) (*v0.GetSavedSearchResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavedSearch")
+	}
+
+	var r0 *v0.GetSavedSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.GetSavedSearchRequest, ...client.CallOption) (*v0.GetSavedSearchResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.GetSavedSearchRequest, ...client.CallOption) *v0.GetSavedSearchResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.GetSavedSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.GetSavedSearchRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_GetSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavedSearch'
+type SearchProviderService_GetSavedSearch_Call struct {
+	*mock.Call
+}
+
+// GetSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.GetSavedSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) GetSavedSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_GetSavedSearch_Call {
+	return &SearchProviderService_GetSavedSearch_Call{Call: _e.mock.On("GetSavedSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_GetSavedSearch_Call) Run(run func(ctx context.Context, in *v0.GetSavedSearchRequest, opts ...client.CallOption)) *SearchProviderService_GetSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.GetSavedSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.GetSavedSearchRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_GetSavedSearch_Call) Return(getSavedSearchResponse *v0.GetSavedSearchResponse, err error) *SearchProviderService_GetSavedSearch_Call {
+	_c.Call.Return(getSavedSearchResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_GetSavedSearch_Call) RunAndReturn(run func(ctx context.Context, in *v0.GetSavedSearchRequest, opts ...client.CallOption) (*v0.GetSavedSearchResponse, error)) *SearchProviderService_GetSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexSpace provides a mock function for the type SearchProviderService
 func (_mock *SearchProviderService) IndexSpace(ctx context.Context, in *v0.IndexSpaceRequest, opts ...client.CallOption) (*v0.IndexSpaceResponse, error) {
 	var tmpRet mock.Arguments
@@ -122,6 +371,89 @@ func (_c *SearchProviderService_IndexSpace_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// ListSavedSearches provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) ListSavedSearches(ctx context.Context, in *v0.ListSavedSearchesRequest, opts ...client.CallOption) (*v0.ListSavedSearchesResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSavedSearches")
+	}
+
+	var r0 *v0.ListSavedSearchesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) (*v0.ListSavedSearchesResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) *v0.ListSavedSearchesResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.ListSavedSearchesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_ListSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSavedSearches'
+type SearchProviderService_ListSavedSearches_Call struct {
+	*mock.Call
+}
+
+// ListSavedSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.ListSavedSearchesRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) ListSavedSearches(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_ListSavedSearches_Call {
+	return &SearchProviderService_ListSavedSearches_Call{Call: _e.mock.On("ListSavedSearches",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) Run(run func(ctx context.Context, in *v0.ListSavedSearchesRequest, opts ...client.CallOption)) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.ListSavedSearchesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.ListSavedSearchesRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) Return(listSavedSearchesResponse *v0.ListSavedSearchesResponse, err error) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Return(listSavedSearchesResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) RunAndReturn(run func(ctx context.Context, in *v0.ListSavedSearchesRequest, opts ...client.CallOption) (*v0.ListSavedSearchesResponse, error)) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function for the type SearchProviderService
 func (_mock *SearchProviderService) Search(ctx context.Context, in *v0.SearchRequest, opts ...client.CallOption) (*v0.SearchResponse, error) {
 	var tmpRet mock.Arguments
@@ -204,3 +536,86 @@ func (_c *SearchProviderService_Search_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateSavedSearch provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) UpdateSavedSearch(ctx context.Context, in *v0.UpdateSavedSearchRequest, opts ...client.CallOption) (*v0.UpdateSavedSearchResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSavedSearch")
+	}
+
+	var r0 *v0.UpdateSavedSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.UpdateSavedSearchRequest, ...client.CallOption) (*v0.UpdateSavedSearchResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.UpdateSavedSearchRequest, ...client.CallOption) *v0.UpdateSavedSearchResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.UpdateSavedSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.UpdateSavedSearchRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_UpdateSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSavedSearch'
+type SearchProviderService_UpdateSavedSearch_Call struct {
+	*mock.Call
+}
+
+// UpdateSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.UpdateSavedSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) UpdateSavedSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_UpdateSavedSearch_Call {
+	return &SearchProviderService_UpdateSavedSearch_Call{Call: _e.mock.On("UpdateSavedSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_UpdateSavedSearch_Call) Run(run func(ctx context.Context, in *v0.UpdateSavedSearchRequest, opts ...client.CallOption)) *SearchProviderService_UpdateSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.UpdateSavedSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.UpdateSavedSearchRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_UpdateSavedSearch_Call) Return(updateSavedSearchResponse *v0.UpdateSavedSearchResponse, err error) *SearchProviderService_UpdateSavedSearch_Call {
+	_c.Call.Return(updateSavedSearchResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_UpdateSavedSearch_Call) RunAndReturn(run func(ctx context.Context, in *v0.UpdateSavedSearchRequest, opts ...client.CallOption) (*v0.UpdateSavedSearchResponse, error)) *SearchProviderService_UpdateSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -47,6 +47,36 @@ func NewSearchProviderEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "SearchProvider.ListSavedSearches",
+			Path:    []string{"/api/v0/search/saved-searches/list"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.GetSavedSearch",
+			Path:    []string{"/api/v0/search/saved-searches/get"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.CreateSavedSearch",
+			Path:    []string{"/api/v0/search/saved-searches/create"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.UpdateSavedSearch",
+			Path:    []string{"/api/v0/search/saved-searches/update"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.DeleteSavedSearch",
+			Path:    []string{"/api/v0/search/saved-searches/delete"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -55,6 +85,11 @@ func NewSearchProviderEndpoints() []*api.Endpoint {
 type SearchProviderService interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...client.CallOption) (*SearchResponse, error)
 	IndexSpace(ctx context.Context, in *IndexSpaceRequest, opts ...client.CallOption) (*IndexSpaceResponse, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...client.CallOption) (*ListSavedSearchesResponse, error)
+	GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...client.CallOption) (*GetSavedSearchResponse, error)
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...client.CallOption) (*CreateSavedSearchResponse, error)
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...client.CallOption) (*UpdateSavedSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...client.CallOption) (*DeleteSavedSearchResponse, error)
 }
 
 type searchProviderService struct {
@@ -89,17 +124,77 @@ func (c *searchProviderService) IndexSpace(ctx context.Context, in *IndexSpaceRe
 	return out, nil
 }
 
+func (c *searchProviderService) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...client.CallOption) (*ListSavedSearchesResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.ListSavedSearches", in)
+	out := new(ListSavedSearchesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...client.CallOption) (*GetSavedSearchResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.GetSavedSearch", in)
+	out := new(GetSavedSearchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...client.CallOption) (*CreateSavedSearchResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.CreateSavedSearch", in)
+	out := new(CreateSavedSearchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...client.CallOption) (*UpdateSavedSearchResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.UpdateSavedSearch", in)
+	out := new(UpdateSavedSearchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...client.CallOption) (*DeleteSavedSearchResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.DeleteSavedSearch", in)
+	out := new(DeleteSavedSearchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SearchProvider service
 
 type SearchProviderHandler interface {
 	Search(context.Context, *SearchRequest, *SearchResponse) error
 	IndexSpace(context.Context, *IndexSpaceRequest, *IndexSpaceResponse) error
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest, *ListSavedSearchesResponse) error
+	GetSavedSearch(context.Context, *GetSavedSearchRequest, *GetSavedSearchResponse) error
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest, *CreateSavedSearchResponse) error
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest, *UpdateSavedSearchResponse) error
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest, *DeleteSavedSearchResponse) error
 }
 
 func RegisterSearchProviderHandler(s server.Server, hdlr SearchProviderHandler, opts ...server.HandlerOption) error {
 	type searchProvider interface {
 		Search(ctx context.Context, in *SearchRequest, out *SearchResponse) error
 		IndexSpace(ctx context.Context, in *IndexSpaceRequest, out *IndexSpaceResponse) error
+		ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, out *ListSavedSearchesResponse) error
+		GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, out *GetSavedSearchResponse) error
+		CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, out *CreateSavedSearchResponse) error
+		UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, out *UpdateSavedSearchResponse) error
+		DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, out *DeleteSavedSearchResponse) error
 	}
 	type SearchProvider struct {
 		searchProvider
@@ -117,6 +212,36 @@ func RegisterSearchProviderHandler(s server.Server, hdlr SearchProviderHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.ListSavedSearches",
+		Path:    []string{"/api/v0/search/saved-searches/list"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.GetSavedSearch",
+		Path:    []string{"/api/v0/search/saved-searches/get"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.CreateSavedSearch",
+		Path:    []string{"/api/v0/search/saved-searches/create"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.UpdateSavedSearch",
+		Path:    []string{"/api/v0/search/saved-searches/update"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.DeleteSavedSearch",
+		Path:    []string{"/api/v0/search/saved-searches/delete"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&SearchProvider{h}, opts...))
 }
 
@@ -132,6 +257,26 @@ func (h *searchProviderHandler) IndexSpace(ctx context.Context, in *IndexSpaceRe
 	return h.SearchProviderHandler.IndexSpace(ctx, in, out)
 }
 
+func (h *searchProviderHandler) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, out *ListSavedSearchesResponse) error {
+	return h.SearchProviderHandler.ListSavedSearches(ctx, in, out)
+}
+
+func (h *searchProviderHandler) GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, out *GetSavedSearchResponse) error {
+	return h.SearchProviderHandler.GetSavedSearch(ctx, in, out)
+}
+
+func (h *searchProviderHandler) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, out *CreateSavedSearchResponse) error {
+	return h.SearchProviderHandler.CreateSavedSearch(ctx, in, out)
+}
+
+func (h *searchProviderHandler) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, out *UpdateSavedSearchResponse) error {
+	return h.SearchProviderHandler.UpdateSavedSearch(ctx, in, out)
+}
+
+func (h *searchProviderHandler) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, out *DeleteSavedSearchResponse) error {
+	return h.SearchProviderHandler.DeleteSavedSearch(ctx, in, out)
+}
+
 // Api Endpoints for IndexProvider service
 
 func NewIndexProviderEndpoints() []*api.Endpoint {
@@ -75,6 +75,136 @@ func (h *webSearchProviderHandler) IndexSpace(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
+func (h *webSearchProviderHandler) ListSavedSearches(w http.ResponseWriter, r *http.Request) {
+	req := &ListSavedSearchesRequest{}
+	resp := &ListSavedSearchesResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListSavedSearches(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) GetSavedSearch(w http.ResponseWriter, r *http.Request) {
+	req := &GetSavedSearchRequest{}
+	resp := &GetSavedSearchResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.GetSavedSearch(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	req := &CreateSavedSearchRequest{}
+	resp := &CreateSavedSearchResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CreateSavedSearch(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) UpdateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	req := &UpdateSavedSearchRequest{}
+	resp := &UpdateSavedSearchResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.UpdateSavedSearch(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	req := &DeleteSavedSearchRequest{}
+	resp := &DeleteSavedSearchResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DeleteSavedSearch(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
 func RegisterSearchProviderWeb(r chi.Router, i SearchProviderHandler, middlewares ...func(http.Handler) http.Handler) {
 	handler := &webSearchProviderHandler{
 		r: r,
@@ -83,6 +213,11 @@ func RegisterSearchProviderWeb(r chi.Router, i SearchProviderHandler, middleware
 
 	r.MethodFunc("POST", "/api/v0/search/search", handler.Search)
 	r.MethodFunc("POST", "/api/v0/search/index-space", handler.IndexSpace)
+	r.MethodFunc("POST", "/api/v0/search/saved-searches/list", handler.ListSavedSearches)
+	r.MethodFunc("POST", "/api/v0/search/saved-searches/get", handler.GetSavedSearch)
+	r.MethodFunc("POST", "/api/v0/search/saved-searches/create", handler.CreateSavedSearch)
+	r.MethodFunc("POST", "/api/v0/search/saved-searches/update", handler.UpdateSavedSearch)
+	r.MethodFunc("POST", "/api/v0/search/saved-searches/delete", handler.DeleteSavedSearch)
 }
 
 type webIndexProviderHandler struct {
@@ -344,3 +479,363 @@ func (m *IndexSpaceResponse) UnmarshalJSON(b []byte) error {
 }
 
 var _ json.Unmarshaler = (*IndexSpaceResponse)(nil)
+
+// ListSavedSearchesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListSavedSearchesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListSavedSearchesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListSavedSearchesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListSavedSearchesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListSavedSearchesRequest)(nil)
+
+// ListSavedSearchesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListSavedSearchesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListSavedSearchesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListSavedSearchesRequest) UnmarshalJSON(b []byte) error {
+	return ListSavedSearchesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListSavedSearchesRequest)(nil)
+
+// ListSavedSearchesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListSavedSearchesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListSavedSearchesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListSavedSearchesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListSavedSearchesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListSavedSearchesResponse)(nil)
+
+// ListSavedSearchesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListSavedSearchesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListSavedSearchesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListSavedSearchesResponse) UnmarshalJSON(b []byte) error {
+	return ListSavedSearchesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListSavedSearchesResponse)(nil)
+
+// GetSavedSearchRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSavedSearchRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetSavedSearchRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetSavedSearchRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetSavedSearchRequest)(nil)
+
+// GetSavedSearchRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSavedSearchRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetSavedSearchRequest) UnmarshalJSON(b []byte) error {
+	return GetSavedSearchRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetSavedSearchRequest)(nil)
+
+// GetSavedSearchResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSavedSearchResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetSavedSearchResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetSavedSearchResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetSavedSearchResponse)(nil)
+
+// GetSavedSearchResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSavedSearchResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetSavedSearchResponse) UnmarshalJSON(b []byte) error {
+	return GetSavedSearchResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetSavedSearchResponse)(nil)
+
+// CreateSavedSearchRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateSavedSearchRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateSavedSearchRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateSavedSearchRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateSavedSearchRequest)(nil)
+
+// CreateSavedSearchRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateSavedSearchRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateSavedSearchRequest) UnmarshalJSON(b []byte) error {
+	return CreateSavedSearchRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateSavedSearchRequest)(nil)
+
+// CreateSavedSearchResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateSavedSearchResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateSavedSearchResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateSavedSearchResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateSavedSearchResponse)(nil)
+
+// CreateSavedSearchResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateSavedSearchResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateSavedSearchResponse) UnmarshalJSON(b []byte) error {
+	return CreateSavedSearchResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateSavedSearchResponse)(nil)
+
+// UpdateSavedSearchRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateSavedSearchRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateSavedSearchRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateSavedSearchRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateSavedSearchRequest)(nil)
+
+// UpdateSavedSearchRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateSavedSearchRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateSavedSearchRequest) UnmarshalJSON(b []byte) error {
+	return UpdateSavedSearchRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateSavedSearchRequest)(nil)
+
+// UpdateSavedSearchResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateSavedSearchResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateSavedSearchResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateSavedSearchResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateSavedSearchResponse)(nil)
+
+// UpdateSavedSearchResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateSavedSearchResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateSavedSearchResponse) UnmarshalJSON(b []byte) error {
+	return UpdateSavedSearchResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateSavedSearchResponse)(nil)
+
+// DeleteSavedSearchRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteSavedSearchRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteSavedSearchRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteSavedSearchRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteSavedSearchRequest)(nil)
+
+// DeleteSavedSearchRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteSavedSearchRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteSavedSearchRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteSavedSearchRequest) UnmarshalJSON(b []byte) error {
+	return DeleteSavedSearchRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteSavedSearchRequest)(nil)
+
+// DeleteSavedSearchResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteSavedSearchResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteSavedSearchResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteSavedSearchResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteSavedSearchResponse)(nil)
+
+// DeleteSavedSearchResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteSavedSearchResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteSavedSearchResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteSavedSearchResponse) UnmarshalJSON(b []byte) error {
+	return DeleteSavedSearchResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteSavedSearchResponse)(nil)
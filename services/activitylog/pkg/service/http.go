@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
@@ -118,113 +121,21 @@ func (s *ActivitylogService) HandleGetItemActivities(w http.ResponseWriter, r *h
 			continue
 		}
 
-		var (
-			message string
-			ts      time.Time
-			vars    map[string]interface{}
-		)
-
 		loc := l10n.MustGetUserLocale(r.Context(), activeUser.GetId().GetOpaqueId(), r.Header.Get(l10n.HeaderAcceptLanguage), s.valService)
 		t := l10n.NewTranslatorFromCommonConfig(s.cfg.DefaultLanguage, _domain, s.cfg.TranslationPath, _localeFS, _localeSubPath)
 
-		switch ev := s.unwrapEvent(e).(type) {
-		case nil:
-			// error already logged in unwrapEvent
+		a, ok := s.toActivity(e, &t, loc)
+		if !ok {
 			continue
-		case events.UploadReady:
-			message = MessageResourceCreated
-			if ev.IsVersion {
-				message = MessageResourceUpdated
-			}
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithResource(ev.FileRef, false, ""), WithUser(nil, ev.ExecutingUser, ev.ImpersonatingUser))
-		case events.FileTouched:
-			message = MessageResourceCreated
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser))
-		case events.FileDownloaded:
-			message = MessageResourceDownloaded
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser), WithVar("token", "", ev.ImpersonatingUser.GetId().GetOpaqueId()))
-		case events.ContainerCreated:
-			message = MessageResourceCreated
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser))
-		case events.ItemTrashed:
-			message = MessageResourceTrashed
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithTrashedResource(ev.Ref, ev.ID), WithUser(ev.Executant, nil, ev.ImpersonatingUser))
-		case events.ItemMoved:
-			switch isRename(ev.OldReference, ev.Ref) {
-			case true:
-				message = MessageResourceRenamed
-				vars, err = s.GetVars(ctx, WithResource(ev.Ref, false, ""), WithOldResource(ev.OldReference), WithUser(ev.Executant, nil, ev.ImpersonatingUser))
-			case false:
-				message = MessageResourceMoved
-				vars, err = s.GetVars(ctx, WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser))
-			}
-			ts = utils.TSToTime(ev.Timestamp)
-		case events.ShareCreated:
-			message = MessageShareCreated
-			ts = utils.TSToTime(ev.CTime)
-			vars, err = s.GetVars(ctx,
-				WithResource(toRef(ev.ItemID), false, ev.ResourceName),
-				WithUser(ev.Executant, nil, nil),
-				WithSharee(ev.GranteeUserID, ev.GranteeGroupID))
-		case events.ShareUpdated:
-			if ev.Sharer != nil && ev.ItemID != nil && ev.Sharer.GetOpaqueId() == ev.ItemID.GetSpaceId() {
-				continue
-			}
-			message = MessageShareUpdated
-			ts = utils.TSToTime(ev.MTime)
-			vars, err = s.GetVars(ctx,
-				WithResource(toRef(ev.ItemID), false, ev.ResourceName),
-				WithUser(ev.Executant, nil, nil),
-				WithTranslation(&t, loc, "field", ev.UpdateMask))
-		case events.ShareRemoved:
-			message = MessageShareDeleted
-			ts = ev.Timestamp
-			vars, err = s.GetVars(ctx,
-				WithResource(toRef(ev.ItemID), false, ev.ResourceName),
-				WithUser(ev.Executant, nil, nil),
-				WithSharee(ev.GranteeUserID, ev.GranteeGroupID))
-		case events.LinkCreated:
-			message = MessageLinkCreated
-			ts = utils.TSToTime(ev.CTime)
-			vars, err = s.GetVars(ctx,
-				WithResource(toRef(ev.ItemID), false, ev.ResourceName),
-				WithUser(ev.Executant, nil, nil))
-		case events.LinkUpdated:
-			if ev.Sharer != nil && ev.ItemID != nil && ev.Sharer.GetOpaqueId() == ev.ItemID.GetSpaceId() {
-				continue
-			}
-			message = MessageLinkUpdated
-			ts = utils.TSToTime(ev.MTime)
-			vars, err = s.GetVars(ctx,
-				WithVar("resource", storagespace.FormatResourceID(ev.ItemID), ev.ResourceName),
-				WithUser(ev.Executant, nil, nil),
-				WithTranslation(&t, loc, "field", []string{ev.FieldUpdated}),
-				WithVar("token", ev.ItemID.GetOpaqueId(), ev.DisplayName))
-		case events.LinkRemoved:
-			message = MessageLinkDeleted
-			ts = utils.TSToTime(ev.Timestamp)
-			vars, err = s.GetVars(ctx, WithResource(toRef(ev.ItemID), false, ""), WithUser(ev.Executant, nil, nil))
-		case events.SpaceShared:
-			message = MessageSpaceShared
-			ts = ev.Timestamp
-			vars, err = s.GetVars(ctx, WithSpace(ev.ID), WithUser(ev.Executant, nil, nil), WithSharee(ev.GranteeUserID, ev.GranteeGroupID))
-		case events.SpaceUnshared:
-			message = MessageSpaceUnshared
-			ts = ev.Timestamp
-			vars, err = s.GetVars(ctx, WithSpace(ev.ID), WithUser(ev.Executant, nil, nil), WithSharee(ev.GranteeUserID, ev.GranteeGroupID))
 		}
 
+		vars, err := s.GetVars(ctx, a.opts...)
 		if err != nil {
 			s.log.Error().Err(err).Msg("error getting response data")
 			continue
 		}
 
-		resp.Activities = append(resp.Activities, NewActivity(t.Translate(message, loc), ts, e.GetId(), vars))
+		resp.Activities = append(resp.Activities, NewActivity(t.Translate(a.message, loc), a.ts, e.GetId(), vars))
 	}
 
 	// delete activities in separate go routine
@@ -252,6 +163,173 @@ func (s *ActivitylogService) HandleGetItemActivities(w http.ResponseWriter, r *h
 	w.WriteHeader(http.StatusOK)
 }
 
+// HandleGetSpaceActivities handles the request to query all activities of a space.
+// Only managers of the space are allowed to query them.
+func (s *ActivitylogService) HandleGetSpaceActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ctx = metadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, r.Header.Get(revactx.TokenHeader))
+
+	activeUser, ok := revactx.ContextGetUser(ctx)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	gwc, err := s.gws.Next()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	rid, err := storagespace.ParseID(chi.URLParam(r, "spaceID"))
+	if err != nil || rid.GetSpaceId() == "" {
+		http.Error(w, "invalid space id", http.StatusBadRequest)
+		return
+	}
+	// activities of the whole space are stored on the space root
+	rid.OpaqueId = rid.GetSpaceId()
+
+	sq, err := parseSpaceQuery(r.URL.Query())
+	if err != nil {
+		s.log.Info().Str("query", r.URL.RawQuery).Err(err).Msg("error parsing space activities query")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	info, err := utils.GetResourceByID(ctx, &rid, gwc)
+	if err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if !utils.ManagerRole(info.GetPermissionSet()) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	raw, err := s.Activities(&rid)
+	if err != nil {
+		s.log.Error().Err(err).Msg("error getting activities")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	selected := sq.selectRaw(raw)
+
+	loc := l10n.MustGetUserLocale(r.Context(), activeUser.GetId().GetOpaqueId(), r.Header.Get(l10n.HeaderAcceptLanguage), s.valService)
+	t := l10n.NewTranslatorFromCommonConfig(s.cfg.DefaultLanguage, _domain, s.cfg.TranslationPath, _localeFS, _localeSubPath)
+
+	var (
+		resp     = GetSpaceActivitiesResponse{Activities: make([]libregraph.Activity, 0)}
+		records  []ActivityRecord
+		next     url.Values
+		count    int
+		toDelete = make(map[string]struct{})
+	)
+
+	// events are requested in batches to stop early once the limit is reached
+	for start := 0; start < len(selected) && next == nil; start += eventBatchSize {
+		batch := selected[start:min(start+eventBatchSize, len(selected))]
+
+		ids := make([]string, 0, len(batch))
+		for _, a := range batch {
+			ids = append(ids, a.EventID)
+		}
+
+		evRes, err := s.evHistory.GetEvents(r.Context(), &ehsvc.GetEventsRequest{Ids: ids})
+		if err != nil {
+			s.log.Error().Err(err).Msg("error getting events")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		evs := make(map[string]*ehmsg.Event, len(evRes.GetEvents()))
+		for _, e := range evRes.GetEvents() {
+			evs[e.GetId()] = e
+		}
+
+		for i, ra := range batch {
+			e, ok := evs[ra.EventID]
+			if !ok {
+				toDelete[ra.EventID] = struct{}{}
+				continue
+			}
+
+			a, ok := s.toActivity(e, &t, loc)
+			if !ok || !sq.accepts(a) {
+				continue
+			}
+
+			vars, err := s.GetVars(ctx, a.opts...)
+			if err != nil {
+				s.log.Error().Err(err).Msg("error getting response data")
+				continue
+			}
+
+			message := t.Translate(a.message, loc)
+			switch sq.format {
+			case FormatJSON:
+				resp.Activities = append(resp.Activities, NewActivity(message, a.ts, e.GetId(), vars))
+			default:
+				records = append(records, NewActivityRecord(a.typ, message, a.ts, e.GetId(), vars))
+			}
+
+			count++
+			if sq.limit > 0 && count >= sq.limit {
+				if start+i+1 < len(selected) {
+					next = nextQuery(r.URL.Query(), ra)
+				}
+				break
+			}
+		}
+	}
+
+	// delete activities in separate go routine
+	if len(toDelete) > 0 {
+		go func() {
+			err := s.RemoveActivities(&rid, toDelete)
+			if err != nil {
+				s.log.Error().Err(err).Msg("error removing activities")
+			}
+		}()
+	}
+
+	var nextLink string
+	if next != nil {
+		nextLink = (&url.URL{Path: r.URL.Path, RawQuery: next.Encode()}).String()
+	}
+
+	if sq.format != FormatJSON {
+		contentType := "text/csv"
+		if sq.format == FormatJSONL {
+			contentType = "application/jsonl"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="activities.`+sq.format+`"`)
+		if nextLink != "" {
+			w.Header().Set("Link", "<"+nextLink+`>; rel="next"`)
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := writeRecords(w, sq.format, records); err != nil {
+			s.log.Error().Err(err).Msg("error writing activities")
+		}
+		return
+	}
+
+	resp.NextLink = nextLink
+	b, err := json.Marshal(resp)
+	if err != nil {
+		s.log.Error().Err(err).Msg("error marshalling activities")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(b); err != nil {
+		s.log.Error().Err(err).Msg("error writing response")
+	}
+}
+
 func (s *ActivitylogService) unwrapEvent(e *ehmsg.Event) interface{} {
 	etype, ok := s.registeredEvents[e.GetType()]
 	if !ok {
@@ -268,6 +346,143 @@ func (s *ActivitylogService) unwrapEvent(e *ehmsg.Event) interface{} {
 	return einterface
 }
 
+// activity holds the data needed to render an event as activity
+type activity struct {
+	// typ is the activity type, see the ActivityType constants
+	typ     string
+	actorID string
+	message string
+	ts      time.Time
+	opts    []ActivityOption
+}
+
+// toActivity converts an event into an activity. It returns false if the event should not be shown.
+func (s *ActivitylogService) toActivity(e *ehmsg.Event, t *l10n.Translator, loc string) (activity, bool) {
+	var a activity
+
+	switch ev := s.unwrapEvent(e).(type) {
+	case nil:
+		// error already logged in unwrapEvent
+		return a, false
+	case events.UploadReady:
+		a.typ, a.message = ActivityTypeResourceCreated, MessageResourceCreated
+		if ev.IsVersion {
+			a.typ, a.message = ActivityTypeResourceUpdated, MessageResourceUpdated
+		}
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(nil, ev.ExecutingUser, ev.ImpersonatingUser)
+		a.opts = []ActivityOption{WithResource(ev.FileRef, false, ""), WithUser(nil, ev.ExecutingUser, ev.ImpersonatingUser)}
+	case events.FileTouched:
+		a.typ, a.message = ActivityTypeResourceCreated, MessageResourceCreated
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, ev.ImpersonatingUser)
+		a.opts = []ActivityOption{WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser)}
+	case events.FileDownloaded:
+		a.typ, a.message = ActivityTypeResourceDownloaded, MessageResourceDownloaded
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, ev.ImpersonatingUser)
+		a.opts = []ActivityOption{WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser), WithVar("token", "", ev.ImpersonatingUser.GetId().GetOpaqueId())}
+	case events.ContainerCreated:
+		a.typ, a.message = ActivityTypeResourceCreated, MessageResourceCreated
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, ev.ImpersonatingUser)
+		a.opts = []ActivityOption{WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser)}
+	case events.ItemTrashed:
+		a.typ, a.message = ActivityTypeResourceTrashed, MessageResourceTrashed
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, ev.ImpersonatingUser)
+		a.opts = []ActivityOption{WithTrashedResource(ev.Ref, ev.ID), WithUser(ev.Executant, nil, ev.ImpersonatingUser)}
+	case events.ItemMoved:
+		switch isRename(ev.OldReference, ev.Ref) {
+		case true:
+			a.typ, a.message = ActivityTypeResourceRenamed, MessageResourceRenamed
+			a.opts = []ActivityOption{WithResource(ev.Ref, false, ""), WithOldResource(ev.OldReference), WithUser(ev.Executant, nil, ev.ImpersonatingUser)}
+		case false:
+			a.typ, a.message = ActivityTypeResourceMoved, MessageResourceMoved
+			a.opts = []ActivityOption{WithResource(ev.Ref, false, ""), WithUser(ev.Executant, nil, ev.ImpersonatingUser)}
+		}
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, ev.ImpersonatingUser)
+	case events.ShareCreated:
+		a.typ, a.message = ActivityTypeShareCreated, MessageShareCreated
+		a.ts = utils.TSToTime(ev.CTime)
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{
+			WithResource(toRef(ev.ItemID), false, ev.ResourceName),
+			WithUser(ev.Executant, nil, nil),
+			WithSharee(ev.GranteeUserID, ev.GranteeGroupID)}
+	case events.ShareUpdated:
+		if ev.Sharer != nil && ev.ItemID != nil && ev.Sharer.GetOpaqueId() == ev.ItemID.GetSpaceId() {
+			return a, false
+		}
+		a.typ, a.message = ActivityTypeShareUpdated, MessageShareUpdated
+		a.ts = utils.TSToTime(ev.MTime)
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{
+			WithResource(toRef(ev.ItemID), false, ev.ResourceName),
+			WithUser(ev.Executant, nil, nil),
+			WithTranslation(t, loc, "field", ev.UpdateMask)}
+	case events.ShareRemoved:
+		a.typ, a.message = ActivityTypeShareDeleted, MessageShareDeleted
+		a.ts = ev.Timestamp
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{
+			WithResource(toRef(ev.ItemID), false, ev.ResourceName),
+			WithUser(ev.Executant, nil, nil),
+			WithSharee(ev.GranteeUserID, ev.GranteeGroupID)}
+	case events.LinkCreated:
+		a.typ, a.message = ActivityTypeLinkCreated, MessageLinkCreated
+		a.ts = utils.TSToTime(ev.CTime)
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{
+			WithResource(toRef(ev.ItemID), false, ev.ResourceName),
+			WithUser(ev.Executant, nil, nil)}
+	case events.LinkUpdated:
+		if ev.Sharer != nil && ev.ItemID != nil && ev.Sharer.GetOpaqueId() == ev.ItemID.GetSpaceId() {
+			return a, false
+		}
+		a.typ, a.message = ActivityTypeLinkUpdated, MessageLinkUpdated
+		a.ts = utils.TSToTime(ev.MTime)
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{
+			WithVar("resource", storagespace.FormatResourceID(ev.ItemID), ev.ResourceName),
+			WithUser(ev.Executant, nil, nil),
+			WithTranslation(t, loc, "field", []string{ev.FieldUpdated}),
+			WithVar("token", ev.ItemID.GetOpaqueId(), ev.DisplayName)}
+	case events.LinkRemoved:
+		a.typ, a.message = ActivityTypeLinkDeleted, MessageLinkDeleted
+		a.ts = utils.TSToTime(ev.Timestamp)
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{WithResource(toRef(ev.ItemID), false, ""), WithUser(ev.Executant, nil, nil)}
+	case events.SpaceShared:
+		a.typ, a.message = ActivityTypeSpaceShared, MessageSpaceShared
+		a.ts = ev.Timestamp
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{WithSpace(ev.ID), WithUser(ev.Executant, nil, nil), WithSharee(ev.GranteeUserID, ev.GranteeGroupID)}
+	case events.SpaceUnshared:
+		a.typ, a.message = ActivityTypeSpaceUnshared, MessageSpaceUnshared
+		a.ts = ev.Timestamp
+		a.actorID = actorID(ev.Executant, nil, nil)
+		a.opts = []ActivityOption{WithSpace(ev.ID), WithUser(ev.Executant, nil, nil), WithSharee(ev.GranteeUserID, ev.GranteeGroupID)}
+	default:
+		return a, false
+	}
+
+	return a, true
+}
+
+// actorID returns the id of the user WithUser shows for an activity
+func actorID(uid *user.UserId, u *user.User, impersonator *user.User) string {
+	switch {
+	case impersonator != nil:
+		return impersonator.GetId().GetOpaqueId()
+	case u != nil:
+		return u.GetId().GetOpaqueId()
+	default:
+		return uid.GetOpaqueId()
+	}
+}
+
 func (s *ActivitylogService) getFilters(query string) (*provider.ResourceId, int, func(RawActivity) bool, func(*ehmsg.Event) bool, func([]*ehmsg.Event), error) {
 	qast, err := kql.Builder{}.Build(query)
 	if err != nil {
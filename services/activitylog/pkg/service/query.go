@@ -0,0 +1,305 @@
+package service
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export formats of the space activities
+const (
+	FormatJSON  = "json"
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+const (
+	// defaultSpaceActivitiesLimit is the page size of json responses if no limit is requested
+	defaultSpaceActivitiesLimit = 100
+	// eventBatchSize is the number of events requested from the eventhistory service at once
+	eventBatchSize = 200
+)
+
+var (
+	errInvalidCursor = errors.New("invalid cursor")
+
+	_placeholder = regexp.MustCompile(`{(\w+)}`)
+
+	_csvHeader = []string{"id", "time", "type", "actorId", "actorName", "resourceId", "resourceName", "message"}
+)
+
+// spaceQuery holds the parameters of a space activities request
+type spaceQuery struct {
+	from   time.Time
+	to     time.Time
+	actors []string
+	types  []string
+	limit  int
+	desc   bool
+	cursor *cursor
+	format string
+}
+
+// cursor points at the last activity of a page
+type cursor struct {
+	ts      time.Time
+	eventID string
+}
+
+// ActivityRecord is the flat representation of an activity used for exports
+type ActivityRecord struct {
+	ID       string    `json:"id"`
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Actor    Actor     `json:"actor"`
+	Resource Resource  `json:"resource"`
+	Message  string    `json:"message"`
+}
+
+func parseSpaceQuery(q url.Values) (spaceQuery, error) {
+	sq := spaceQuery{
+		actors: splitValues(q["actor"]),
+		types:  splitValues(q["type"]),
+		format: FormatJSON,
+	}
+
+	var err error
+	if v := q.Get("from"); v != "" {
+		if sq.from, err = time.Parse(time.RFC3339, v); err != nil {
+			return sq, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+	if v := q.Get("to"); v != "" {
+		if sq.to, err = time.Parse(time.RFC3339, v); err != nil {
+			return sq, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	switch v := q.Get("format"); v {
+	case "", FormatJSON:
+		sq.limit = defaultSpaceActivitiesLimit
+	case FormatCSV, FormatJSONL:
+		sq.format = v
+	default:
+		return sq, fmt.Errorf("unsupported format: %s", v)
+	}
+
+	if v := q.Get("limit"); v != "" {
+		if sq.limit, err = strconv.Atoi(v); err != nil || sq.limit < 0 {
+			return sq, fmt.Errorf("invalid limit: %s", v)
+		}
+	}
+
+	switch v := q.Get("sort"); v {
+	case "", "asc":
+	case "desc":
+		sq.desc = true
+	default:
+		return sq, fmt.Errorf("unsupported sort order: %s", v)
+	}
+
+	if v := q.Get("cursor"); v != "" {
+		c, err := decodeCursor(v)
+		if err != nil {
+			return sq, err
+		}
+		sq.cursor = &c
+	}
+
+	return sq, nil
+}
+
+// splitValues supports repeated as well as comma separated query parameters
+func splitValues(values []string) []string {
+	var res []string
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+		}
+	}
+	return res
+}
+
+// selectRaw returns the raw activities in the requested time range and order which follow the cursor
+func (sq spaceQuery) selectRaw(raw []RawActivity) []RawActivity {
+	selected := make([]RawActivity, 0, len(raw))
+	for _, a := range raw {
+		if !sq.from.IsZero() && a.Timestamp.Before(sq.from) {
+			continue
+		}
+		if !sq.to.IsZero() && !a.Timestamp.Before(sq.to) {
+			continue
+		}
+		selected = append(selected, a)
+	}
+
+	slices.SortFunc(selected, func(a, b RawActivity) int {
+		if sq.desc {
+			a, b = b, a
+		}
+		return compareRaw(a, b)
+	})
+
+	if sq.cursor == nil {
+		return selected
+	}
+
+	cur := RawActivity{EventID: sq.cursor.eventID, Timestamp: sq.cursor.ts}
+	i, _ := slices.BinarySearchFunc(selected, cur, func(a, b RawActivity) int {
+		if sq.desc {
+			a, b = b, a
+		}
+		// sort activities equal to the cursor before it, they were part of the previous page
+		if c := compareRaw(a, b); c != 0 {
+			return c
+		}
+		return -1
+	})
+	return selected[i:]
+}
+
+// accepts returns true if the activity matches the actor and type filters
+func (sq spaceQuery) accepts(a activity) bool {
+	if len(sq.actors) > 0 && !slices.Contains(sq.actors, a.actorID) {
+		return false
+	}
+	if len(sq.types) > 0 && !slices.Contains(sq.types, a.typ) {
+		return false
+	}
+	return true
+}
+
+// nextQuery returns the query parameters of the page following the given activity
+func nextQuery(q url.Values, last RawActivity) url.Values {
+	next := url.Values{}
+	for k, v := range q {
+		next[k] = v
+	}
+	next.Set("cursor", cursor{ts: last.Timestamp, eventID: last.EventID}.encode())
+	return next
+}
+
+func compareRaw(a, b RawActivity) int {
+	if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.EventID, b.EventID)
+}
+
+func (c cursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.ts.UTC().Format(time.RFC3339Nano) + "|" + c.eventID))
+}
+
+func decodeCursor(s string) (cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, errInvalidCursor
+	}
+
+	ts, id, ok := strings.Cut(string(b), "|")
+	if !ok || id == "" {
+		return cursor{}, errInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return cursor{}, errInvalidCursor
+	}
+
+	return cursor{ts: t, eventID: id}, nil
+}
+
+// NewActivityRecord creates the export record of an activity, the placeholders of the message are replaced by the variables
+func NewActivityRecord(typ, message string, ts time.Time, eventID string, vars map[string]interface{}) ActivityRecord {
+	r := ActivityRecord{
+		ID:   eventID,
+		Time: ts,
+		Type: typ,
+	}
+	if u, ok := vars["user"].(Actor); ok {
+		r.Actor = u
+	}
+	for _, key := range []string{"resource", "space"} {
+		if res, ok := vars[key].(Resource); ok {
+			r.Resource = res
+			break
+		}
+	}
+
+	r.Message = _placeholder.ReplaceAllStringFunc(message, func(p string) string {
+		switch v := vars[strings.Trim(p, "{}")].(type) {
+		case Resource:
+			return v.Name
+		case Actor:
+			return v.DisplayName
+		case Sharee:
+			return v.DisplayName
+		default:
+			return p
+		}
+	})
+
+	return r
+}
+
+// writeRecords writes the records in the requested export format
+func writeRecords(w io.Writer, format string, records []ActivityRecord) error {
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(_csvHeader); err != nil {
+			return err
+		}
+		for _, r := range records {
+			row := []string{
+				r.ID,
+				r.Time.UTC().Format(time.RFC3339),
+				r.Type,
+				r.Actor.ID,
+				r.Actor.DisplayName,
+				r.Resource.ID,
+				r.Resource.Name,
+				r.Message,
+			}
+			for i := range row {
+				row[i] = csvCell(row[i])
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// csvCell prevents spreadsheet applications from evaluating a cell as formula,
+// names and messages are chosen by users
+func csvCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"bytes"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("spaceQuery", func() {
+	var (
+		t0  = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		raw = []RawActivity{
+			{EventID: "c", Timestamp: t0.Add(2 * time.Hour)},
+			{EventID: "a", Timestamp: t0},
+			{EventID: "d", Timestamp: t0.Add(3 * time.Hour)},
+			{EventID: "b", Timestamp: t0},
+		}
+	)
+
+	eventIDs := func(activities []RawActivity) []string {
+		ids := make([]string, 0, len(activities))
+		for _, a := range activities {
+			ids = append(ids, a.EventID)
+		}
+		return ids
+	}
+
+	Describe("parseSpaceQuery", func() {
+		It("uses a default limit for json responses", func() {
+			sq, err := parseSpaceQuery(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sq.format).To(Equal(FormatJSON))
+			Expect(sq.limit).To(Equal(defaultSpaceActivitiesLimit))
+		})
+
+		It("doesn't limit exports by default", func() {
+			sq, err := parseSpaceQuery(url.Values{"format": {FormatCSV}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sq.limit).To(Equal(0))
+		})
+
+		It("supports repeated and comma separated filters", func() {
+			sq, err := parseSpaceQuery(url.Values{
+				"actor": {"alice", "bob"},
+				"type":  {"resourceCreated, shareCreated"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sq.actors).To(Equal([]string{"alice", "bob"}))
+			Expect(sq.types).To(Equal([]string{"resourceCreated", "shareCreated"}))
+		})
+
+		DescribeTable("rejects invalid parameters",
+			func(q url.Values) {
+				_, err := parseSpaceQuery(q)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("from", url.Values{"from": {"yesterday"}}),
+			Entry("to", url.Values{"to": {"2024-05-01"}}),
+			Entry("format", url.Values{"format": {"xml"}}),
+			Entry("limit", url.Values{"limit": {"-1"}}),
+			Entry("sort", url.Values{"sort": {"random"}}),
+			Entry("cursor", url.Values{"cursor": {"invalid"}}),
+		)
+	})
+
+	Describe("selectRaw", func() {
+		It("sorts by time and event id", func() {
+			Expect(eventIDs(spaceQuery{}.selectRaw(raw))).To(Equal([]string{"a", "b", "c", "d"}))
+			Expect(eventIDs(spaceQuery{desc: true}.selectRaw(raw))).To(Equal([]string{"d", "c", "b", "a"}))
+		})
+
+		It("filters by time range", func() {
+			sq := spaceQuery{from: t0.Add(time.Hour), to: t0.Add(3 * time.Hour)}
+			Expect(eventIDs(sq.selectRaw(raw))).To(Equal([]string{"c"}))
+		})
+
+		It("continues after the cursor", func() {
+			q := nextQuery(url.Values{"limit": {"2"}}, RawActivity{EventID: "a", Timestamp: t0})
+			sq, err := parseSpaceQuery(q)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sq.limit).To(Equal(2))
+			Expect(eventIDs(sq.selectRaw(raw))).To(Equal([]string{"b", "c", "d"}))
+
+			q.Set("sort", "desc")
+			sq, err = parseSpaceQuery(q)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eventIDs(sq.selectRaw(raw))).To(BeEmpty())
+		})
+	})
+
+	Describe("accepts", func() {
+		It("filters by actor and type", func() {
+			sq := spaceQuery{actors: []string{"alice"}, types: []string{ActivityTypeShareCreated}}
+			Expect(sq.accepts(activity{actorID: "alice", typ: ActivityTypeShareCreated})).To(BeTrue())
+			Expect(sq.accepts(activity{actorID: "bob", typ: ActivityTypeShareCreated})).To(BeFalse())
+			Expect(sq.accepts(activity{actorID: "alice", typ: ActivityTypeLinkCreated})).To(BeFalse())
+		})
+	})
+
+	Describe("writeRecords", func() {
+		It("exports the rendered activities", func() {
+			r := NewActivityRecord(ActivityTypeShareCreated, "{user} shared {resource} with {sharee}", t0, "event", map[string]interface{}{
+				"user":     Actor{ID: "alice", DisplayName: "Alice"},
+				"resource": Resource{ID: "rid", Name: "report.pdf"},
+				"sharee":   Sharee{ID: "bob", DisplayName: "Bob", ShareType: "user"},
+			})
+			Expect(r.Message).To(Equal("Alice shared report.pdf with Bob"))
+
+			var csv bytes.Buffer
+			Expect(writeRecords(&csv, FormatCSV, []ActivityRecord{r})).To(Succeed())
+			Expect(csv.String()).To(Equal("id,time,type,actorId,actorName,resourceId,resourceName,message\n" +
+				"event,2024-05-01T12:00:00Z,shareCreated,alice,Alice,rid,report.pdf,Alice shared report.pdf with Bob\n"))
+
+			var jsonl bytes.Buffer
+			Expect(writeRecords(&jsonl, FormatJSONL, []ActivityRecord{r, r})).To(Succeed())
+			Expect(bytes.Count(jsonl.Bytes(), []byte("\n"))).To(Equal(2))
+		})
+
+		It("escapes cells spreadsheets would evaluate as formula", func() {
+			r := NewActivityRecord(ActivityTypeShareCreated, "{user} shared {resource}", t0, "event", map[string]interface{}{
+				"user":     Actor{ID: "alice", DisplayName: "@Alice"},
+				"resource": Resource{ID: "rid", Name: "=HYPERLINK(\"https://example.com\")"},
+			})
+
+			var csv bytes.Buffer
+			Expect(writeRecords(&csv, FormatCSV, []ActivityRecord{r})).To(Succeed())
+			Expect(csv.String()).To(Equal("id,time,type,actorId,actorName,resourceId,resourceName,message\n" +
+				"event,2024-05-01T12:00:00Z,shareCreated,alice,'@Alice,rid,\"'=HYPERLINK(\"\"https://example.com\"\")\",\"'@Alice shared =HYPERLINK(\"\"https://example.com\"\")\"\n"))
+
+			// the JSON export keeps the values
+			var jsonl bytes.Buffer
+			Expect(writeRecords(&jsonl, FormatJSONL, []ActivityRecord{r})).To(Succeed())
+			Expect(jsonl.String()).To(ContainSubstring(`"displayName":"@Alice"`))
+		})
+	})
+})
@@ -44,11 +44,35 @@ var (
 	StrDescription    = l10n.Template("description")
 )
 
+// Activity types
+const (
+	ActivityTypeResourceCreated    = "resourceCreated"
+	ActivityTypeResourceUpdated    = "resourceUpdated"
+	ActivityTypeResourceDownloaded = "resourceDownloaded"
+	ActivityTypeResourceTrashed    = "resourceTrashed"
+	ActivityTypeResourceMoved      = "resourceMoved"
+	ActivityTypeResourceRenamed    = "resourceRenamed"
+	ActivityTypeShareCreated       = "shareCreated"
+	ActivityTypeShareUpdated       = "shareUpdated"
+	ActivityTypeShareDeleted       = "shareDeleted"
+	ActivityTypeLinkCreated        = "linkCreated"
+	ActivityTypeLinkUpdated        = "linkUpdated"
+	ActivityTypeLinkDeleted        = "linkDeleted"
+	ActivityTypeSpaceShared        = "spaceShared"
+	ActivityTypeSpaceUnshared      = "spaceUnshared"
+)
+
 // GetActivitiesResponse is the response on GET activities requests
 type GetActivitiesResponse struct {
 	Activities []libregraph.Activity `json:"value"`
 }
 
+// GetSpaceActivitiesResponse is the response on GET space activities requests
+type GetSpaceActivitiesResponse struct {
+	Activities []libregraph.Activity `json:"value"`
+	NextLink   string                `json:"@odata.nextLink,omitempty"`
+}
+
 // Resource represents an item such as a file or folder
 type Resource struct {
 	ID   string `json:"id"`
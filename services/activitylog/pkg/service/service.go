@@ -218,6 +218,7 @@ func New(opts ...Option) (*ActivitylogService, error) {
 	}
 
 	s.mux.Get("/graph/v1beta1/extensions/org.libregraph/activities", s.HandleGetItemActivities)
+	s.mux.Get("/graph/v1beta1/extensions/org.libregraph/activities/spaces/{spaceID}", s.HandleGetSpaceActivities)
 
 	for _, e := range o.RegisteredEvents {
 		typ := reflect.TypeOf(e)
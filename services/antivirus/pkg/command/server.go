@@ -3,7 +3,6 @@ package command
 import (
 	"context"
 	"fmt"
-	"os"
 	"os/signal"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
@@ -43,8 +42,8 @@ func Server(cfg *config.Config) *cobra.Command {
 			{
 				svc, err := service.NewAntivirus(cfg, logger, traceProvider)
 				if err != nil {
-					fmt.Errorf("failed to initialize antivirus service: %v", err)
-					os.Exit(1)
+					logger.Error().Err(err).Msg("failed to initialize antivirus service")
+					return err
 				}
 
 				gr.Add(runner.New(cfg.Service.Name+".svc", func() error {
@@ -15,6 +15,8 @@ const (
 	ScannerTypeClamAV ScannerType = "clamav"
 	// ScannerTypeICap defines that icap is used
 	ScannerTypeICap ScannerType = "icap"
+	// ScannerTypeYARA defines that yara rules are used
+	ScannerTypeYARA ScannerType = "yara"
 )
 
 // MaxScanSizeMode defines the mode of handling files that exceed the maximum scan size
@@ -75,10 +77,11 @@ type Events struct {
 
 // Scanner provides configuration options for the virus scanner
 type Scanner struct {
-	Type ScannerType `yaml:"type" env:"ANTIVIRUS_SCANNER_TYPE" desc:"The antivirus scanner to use. Supported values are 'clamav' and 'icap'." introductionVersion:"1.0.0"`
+	Type ScannerType `yaml:"type" env:"ANTIVIRUS_SCANNER_TYPE" desc:"The antivirus scanner to use. Supported values are 'clamav', 'icap' and 'yara'." introductionVersion:"1.0.0"`
 
 	ClamAV ClamAV // only if Type == clamav
 	ICAP   ICAP   // only if Type == icap
+	YARA   YARA   // only if Type == yara
 }
 
 // ClamAV provides configuration option for clamav
@@ -93,3 +96,8 @@ type ICAP struct {
 	URL     string        `yaml:"url" env:"ANTIVIRUS_ICAP_URL" desc:"URL of the ICAP server." introductionVersion:"1.0.0"`
 	Service string        `yaml:"service" env:"ANTIVIRUS_ICAP_SERVICE" desc:"The name of the ICAP service." introductionVersion:"1.0.0"`
 }
+
+// YARA provides configuration options for yara
+type YARA struct {
+	RulesPath string `yaml:"rules_path" env:"ANTIVIRUS_YARA_RULES_PATH" desc:"Path to a yara rule file or to a directory containing '.yar' and '.yara' rule files. Changed rules are reloaded automatically. If not defined, the root directory derives from $OC_BASE_DATA_PATH/antivirus/yara." introductionVersion:"%%NEXT%%"`
+}
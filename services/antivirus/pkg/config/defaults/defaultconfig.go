@@ -1,8 +1,10 @@
 package defaults
 
 import (
+	"path/filepath"
 	"time"
 
+	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config"
 )
 
@@ -45,6 +47,9 @@ func DefaultConfig() *config.Config {
 				Service: "avscan",
 				Timeout: 5 * time.Minute,
 			},
+			YARA: config.YARA{
+				RulesPath: filepath.Join(defaults.BaseDataPath(), "antivirus", "yara"),
+			},
 		},
 	}
 }
@@ -0,0 +1,182 @@
+package scanners
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+)
+
+// _yaraReloadDelay is the time to wait after the last change of a rule file before reloading the rules
+const _yaraReloadDelay = 200 * time.Millisecond
+
+// NewYARA returns a Scanner evaluating the yara rules found at rulesPath, which is either a rule file or a
+// directory containing `.yar` and `.yara` files. The rules are reloaded when the files change.
+func NewYARA(rulesPath string, logger log.Logger) (*YARA, error) {
+	y := &YARA{path: rulesPath, log: logger}
+	if err := y.Load(); err != nil {
+		return nil, err
+	}
+
+	if err := y.watch(); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+// YARA is responsible for scanning files using yara rules
+type YARA struct {
+	path  string
+	log   log.Logger
+	rules atomic.Pointer[[]*yaraRule]
+}
+
+// Load reads the rule files, the current rules are kept if one of them is invalid
+func (s *YARA) Load() error {
+	files := []string{s.path}
+	if fi, err := os.Stat(s.path); err != nil {
+		return err
+	} else if fi.IsDir() {
+		entries, err := os.ReadDir(s.path)
+		if err != nil {
+			return err
+		}
+		files = files[:0]
+		for _, e := range entries {
+			if !e.IsDir() && isRuleFile(e.Name()) {
+				files = append(files, filepath.Join(s.path, e.Name()))
+			}
+		}
+	}
+
+	var rules []*yaraRule
+	names := map[string]bool{}
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		parsed, err := parseYARA(string(src))
+		if err != nil {
+			return fmt.Errorf("invalid yara rules in '%s': %w", f, err)
+		}
+		for _, r := range parsed {
+			if names[r.name] {
+				return fmt.Errorf("duplicate yara rule '%s' in '%s'", r.name, f)
+			}
+			names[r.name] = true
+		}
+		rules = append(rules, parsed...)
+	}
+
+	s.rules.Store(&rules)
+	return nil
+}
+
+// Scan evaluates the rules against a file, the names of the matching rules are returned as description
+func (s *YARA) Scan(in Input) (Result, error) {
+	data, err := io.ReadAll(in.Body)
+	if err != nil {
+		return Result{}, err
+	}
+	lower := asciiLower(data)
+
+	scope := &yaraScope{
+		filesize: int64(len(data)),
+		rules:    map[string]bool{},
+	}
+
+	var matched []string
+	for _, r := range *s.rules.Load() {
+		scope.counts = make(map[string]int, len(r.strings))
+		for _, str := range r.strings {
+			scope.counts[str.id] = str.count(data, lower)
+		}
+
+		ok := r.condition(scope)
+		scope.rules[r.name] = ok
+		switch {
+		case !ok && r.global:
+			// a global rule that doesn't match prevents all rules from matching
+			return Result{ScanTime: time.Now()}, nil
+		case ok && !r.private:
+			matched = append(matched, r.name)
+		}
+	}
+
+	return Result{
+		Infected:    len(matched) > 0,
+		ScanTime:    time.Now(),
+		Description: strings.Join(matched, ", "),
+	}, nil
+}
+
+// watch reloads the rules when files in the rules path change. The parent directory of a
+// rule file is watched as editors often replace files instead of writing to them.
+func (s *YARA) watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	dir := s.path
+	if fi, err := os.Stat(s.path); err == nil && !fi.IsDir() {
+		dir = filepath.Dir(s.path)
+	}
+	if err := w.Add(dir); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	reload := time.AfterFunc(time.Hour, func() {
+		if err := s.Load(); err != nil {
+			s.log.Error().Err(err).Str("path", s.path).Msg("could not reload yara rules, keeping the current rules")
+			return
+		}
+		s.log.Info().Str("path", s.path).Msg("reloaded yara rules")
+	})
+	reload.Stop()
+
+	go func() {
+		for {
+			select {
+			case ev, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if s.affects(ev) {
+					// files are often written in several steps, wait until they settle
+					reload.Reset(_yaraReloadDelay)
+				}
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				s.log.Error().Err(err).Str("path", s.path).Msg("error watching yara rules")
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *YARA) affects(ev fsnotify.Event) bool {
+	if !ev.Has(fsnotify.Write) && !ev.Has(fsnotify.Create) && !ev.Has(fsnotify.Remove) && !ev.Has(fsnotify.Rename) {
+		return false
+	}
+	if ev.Name == s.path {
+		return true
+	}
+	return filepath.Dir(ev.Name) == s.path && isRuleFile(ev.Name)
+}
+
+func isRuleFile(name string) bool {
+	return slices.Contains([]string{".yar", ".yara"}, filepath.Ext(name))
+}
@@ -0,0 +1,913 @@
+package scanners
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// yaraRule is a parsed yara rule. Only the subset of the yara language that is needed for content
+// based detection is supported: text, hex and regular expression strings and conditions made of
+// string matches, string counts, quantifiers, filesize comparisons and references to other rules.
+// Modules, includes, offsets and loops are not supported.
+type yaraRule struct {
+	name      string
+	private   bool
+	global    bool
+	tags      []string
+	strings   []*yaraString
+	condition yaraExpr
+}
+
+// yaraString is a string of a rule, count returns the number of its matches in the data
+type yaraString struct {
+	id    string
+	count func(data, lower []byte) int
+}
+
+// yaraScope is the state a condition is evaluated against
+type yaraScope struct {
+	counts   map[string]int
+	filesize int64
+	rules    map[string]bool
+}
+
+type yaraExpr func(s *yaraScope) bool
+
+// parseYARA parses the rules of a yara source file
+func parseYARA(src string) ([]*yaraRule, error) {
+	p := &yaraParser{src: src}
+	var rules []*yaraRule
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return rules, nil
+		}
+		r, err := p.rule()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.line(), err)
+		}
+		rules = append(rules, r)
+	}
+}
+
+type yaraParser struct {
+	src string
+	pos int
+}
+
+func (p *yaraParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *yaraParser) line() int {
+	return strings.Count(p.src[:min(p.pos, len(p.src))], "\n") + 1
+}
+
+func (p *yaraParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skipSpace skips whitespace and comments
+func (p *yaraParser) skipSpace() {
+	for !p.eof() {
+		switch {
+		case unicode.IsSpace(rune(p.peek())):
+			p.pos++
+		case strings.HasPrefix(p.src[p.pos:], "//"):
+			if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+				p.pos += i
+			} else {
+				p.pos = len(p.src)
+			}
+		case strings.HasPrefix(p.src[p.pos:], "/*"):
+			if i := strings.Index(p.src[p.pos+2:], "*/"); i >= 0 {
+				p.pos += i + 4
+			} else {
+				p.pos = len(p.src)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *yaraParser) ident() string {
+	p.skipSpace()
+	start := p.pos
+	for !p.eof() && isIdentChar(p.peek()) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// peekIdent returns the next identifier without consuming it
+func (p *yaraParser) peekIdent() string {
+	pos := p.pos
+	id := p.ident()
+	p.pos = pos
+	return id
+}
+
+func (p *yaraParser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		return fmt.Errorf("expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *yaraParser) rule() (*yaraRule, error) {
+	r := &yaraRule{}
+	for {
+		switch kw := p.ident(); kw {
+		case "private":
+			r.private = true
+			continue
+		case "global":
+			r.global = true
+			continue
+		case "rule":
+		case "import", "include":
+			return nil, fmt.Errorf("'%s' is not supported", kw)
+		default:
+			return nil, fmt.Errorf("expected 'rule', got '%s'", kw)
+		}
+		break
+	}
+
+	if r.name = p.ident(); r.name == "" {
+		return nil, fmt.Errorf("missing rule name")
+	}
+
+	p.skipSpace()
+	if p.peek() == ':' {
+		p.pos++
+		for {
+			p.skipSpace()
+			if p.peek() == '{' {
+				break
+			}
+			tag := p.ident()
+			if tag == "" {
+				return nil, fmt.Errorf("invalid tag in rule '%s'", r.name)
+			}
+			r.tags = append(r.tags, tag)
+		}
+	}
+
+	if err := p.expect('{'); err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if p.peek() == '}' {
+			p.pos++
+			break
+		}
+
+		section := p.ident()
+		if err := p.expect(':'); err != nil {
+			return nil, fmt.Errorf("rule '%s': %w", r.name, err)
+		}
+
+		var err error
+		switch section {
+		case "meta":
+			err = p.meta()
+		case "strings":
+			r.strings, err = p.strings()
+		case "condition":
+			r.condition, err = p.condition()
+		default:
+			err = fmt.Errorf("unknown section '%s'", section)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rule '%s': %w", r.name, err)
+		}
+	}
+
+	if r.condition == nil {
+		return nil, fmt.Errorf("rule '%s' has no condition", r.name)
+	}
+	return r, nil
+}
+
+// meta skips the meta section, its values are not used for scanning
+func (p *yaraParser) meta() error {
+	for {
+		p.skipSpace()
+		pos := p.pos
+		key := p.ident()
+		p.skipSpace()
+		if key == "" || p.peek() != '=' {
+			p.pos = pos
+			return nil
+		}
+		p.pos++
+		p.skipSpace()
+		if p.peek() == '"' {
+			if _, err := p.text(); err != nil {
+				return err
+			}
+			continue
+		}
+		if p.peek() == '-' {
+			p.pos++
+		}
+		if v := p.ident(); v == "" {
+			return fmt.Errorf("invalid value of meta '%s'", key)
+		}
+	}
+}
+
+func (p *yaraParser) strings() ([]*yaraString, error) {
+	var strs []*yaraString
+	for {
+		p.skipSpace()
+		if p.peek() != '$' {
+			return strs, nil
+		}
+		p.pos++
+		id := "$" + p.ident()
+		if id == "$" {
+			id = fmt.Sprintf("$%d", len(strs))
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+
+		p.skipSpace()
+		var (
+			s   *yaraString
+			err error
+		)
+		switch p.peek() {
+		case '"':
+			s, err = p.textString()
+		case '{':
+			s, err = p.hexString()
+		case '/':
+			s, err = p.regexString()
+		default:
+			err = fmt.Errorf("invalid value of string '%s'", id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		s.id = id
+		strs = append(strs, s)
+	}
+}
+
+// modifiers consumes the string modifiers following a string
+func (p *yaraParser) modifiers() map[string]bool {
+	mods := map[string]bool{}
+	for {
+		switch m := p.peekIdent(); m {
+		case "nocase", "wide", "ascii", "fullword", "private":
+			p.ident()
+			mods[m] = true
+		default:
+			return mods
+		}
+	}
+}
+
+// text reads a double quoted text with escape sequences
+func (p *yaraParser) text() ([]byte, error) {
+	p.pos++ // opening quote
+	var b []byte
+	for {
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated string")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return b, nil
+		case '\n':
+			return nil, fmt.Errorf("unterminated string")
+		case '\\':
+			if p.eof() {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			e := p.src[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				b = append(b, '\n')
+			case 't':
+				b = append(b, '\t')
+			case 'r':
+				b = append(b, '\r')
+			case 'x':
+				if p.pos+2 > len(p.src) {
+					return nil, fmt.Errorf("invalid escape sequence")
+				}
+				v, err := strconv.ParseUint(p.src[p.pos:p.pos+2], 16, 8)
+				if err != nil {
+					return nil, fmt.Errorf("invalid escape sequence")
+				}
+				p.pos += 2
+				b = append(b, byte(v))
+			default:
+				b = append(b, e)
+			}
+		default:
+			b = append(b, c)
+		}
+	}
+}
+
+func (p *yaraParser) textString() (*yaraString, error) {
+	t, err := p.text()
+	if err != nil {
+		return nil, err
+	}
+	if len(t) == 0 {
+		return nil, fmt.Errorf("empty string")
+	}
+	mods := p.modifiers()
+
+	var patterns [][]byte
+	if mods["ascii"] || !mods["wide"] {
+		patterns = append(patterns, t)
+	}
+	if mods["wide"] {
+		w := make([]byte, 0, 2*len(t))
+		for _, c := range t {
+			w = append(w, c, 0)
+		}
+		patterns = append(patterns, w)
+	}
+
+	nocase := mods["nocase"]
+	if nocase {
+		for i := range patterns {
+			patterns[i] = asciiLower(patterns[i])
+		}
+	}
+
+	return &yaraString{count: func(data, lower []byte) int {
+		if nocase {
+			data = lower
+		}
+		n := 0
+		for _, pattern := range patterns {
+			n += countText(data, pattern, mods["fullword"])
+		}
+		return n
+	}}, nil
+}
+
+func countText(data, pattern []byte, fullword bool) int {
+	n := 0
+	for off := 0; off < len(data); {
+		i := bytes.Index(data[off:], pattern)
+		if i < 0 {
+			break
+		}
+		start := off + i
+		end := start + len(pattern)
+		if !fullword || (!isWordByte(data, start-1) && !isWordByte(data, end)) {
+			n++
+		}
+		off = start + 1
+	}
+	return n
+}
+
+// asciiLower folds the ASCII letters of binary data, unlike bytes.ToLower it keeps the length and offsets
+func asciiLower(data []byte) []byte {
+	lower := make([]byte, len(data))
+	for i, c := range data {
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		lower[i] = c
+	}
+	return lower
+}
+
+func isWordByte(data []byte, i int) bool {
+	return i >= 0 && i < len(data) && isIdentChar(data[i])
+}
+
+// _maxHexJump is the largest number of bytes a jump of a hex string can skip, unbounded jumps skip up to it
+const _maxHexJump = 64 << 10
+
+// hexToken is a byte of a hex string, compared under a mask to support `??` and `?A` wildcards,
+// or a jump of minJump to maxJump arbitrary bytes.
+type hexToken struct {
+	value, mask      byte
+	jump             bool
+	minJump, maxJump int
+}
+
+func (p *yaraParser) hexString() (*yaraString, error) {
+	end := strings.IndexByte(p.src[p.pos:], '}')
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated hex string")
+	}
+	body := p.src[p.pos+1 : p.pos+end]
+	p.pos += end + 1
+
+	var toks []hexToken
+	for i := 0; i < len(body); {
+		c := body[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '[':
+			j := strings.IndexByte(body[i:], ']')
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated jump in hex string")
+			}
+			t, err := parseJump(body[i+1 : i+j])
+			if err != nil {
+				return nil, err
+			}
+			toks = append(toks, t)
+			i += j + 1
+		case c == '(' || c == '|':
+			return nil, fmt.Errorf("alternatives in hex strings are not supported")
+		default:
+			if i+2 > len(body) {
+				return nil, fmt.Errorf("invalid hex string")
+			}
+			t := hexToken{mask: 0xff}
+			for k, n := range []byte(body[i : i+2]) {
+				shift := uint(4 * (1 - k))
+				if n == '?' {
+					t.mask &^= 0xf << shift
+					continue
+				}
+				v, err := strconv.ParseUint(string(n), 16, 8)
+				if err != nil {
+					return nil, fmt.Errorf("invalid hex string")
+				}
+				t.value |= byte(v) << shift
+			}
+			toks = append(toks, t)
+			i += 2
+		}
+	}
+
+	if len(toks) == 0 || toks[0].jump || toks[len(toks)-1].jump {
+		return nil, fmt.Errorf("hex strings must start and end with a byte")
+	}
+	p.modifiers()
+
+	return &yaraString{count: func(data, _ []byte) int {
+		return countHex(data, toks)
+	}}, nil
+}
+
+func parseJump(s string) (hexToken, error) {
+	t := hexToken{jump: true}
+	lo, hi, ranged := strings.Cut(strings.TrimSpace(s), "-")
+	var err error
+	if t.minJump, err = strconv.Atoi(strings.TrimSpace(lo)); err != nil && strings.TrimSpace(lo) != "" {
+		return t, fmt.Errorf("invalid jump [%s]", s)
+	}
+	switch {
+	case !ranged:
+		t.maxJump = t.minJump
+	case strings.TrimSpace(hi) == "":
+		t.maxJump = _maxHexJump
+	default:
+		if t.maxJump, err = strconv.Atoi(strings.TrimSpace(hi)); err != nil || t.maxJump < t.minJump {
+			return t, fmt.Errorf("invalid jump [%s]", s)
+		}
+	}
+	if t.minJump < 0 || t.maxJump > _maxHexJump || t.minJump > t.maxJump {
+		return t, fmt.Errorf("invalid jump [%s], jumps can skip at most %d bytes", s, _maxHexJump)
+	}
+	return t, nil
+}
+
+// countHex counts the offsets the hex string matches at. The tokens are matched from the last to the first,
+// match[p] records if the remaining tokens match at offset p. Every token is checked once per offset,
+// jumps look up a sliding window of the following offsets instead of backtracking.
+func countHex(data []byte, toks []hexToken) int {
+	match := make([]bool, len(data)+1)
+	next := make([]bool, len(data)+1)
+	for p := range match {
+		match[p] = true
+	}
+
+	for k := len(toks) - 1; k >= 0; k-- {
+		t := toks[k]
+		match, next = next, match
+		if !t.jump {
+			for p, c := range data {
+				match[p] = next[p+1] && c&t.mask == t.value&t.mask
+			}
+			match[len(data)] = false
+			continue
+		}
+
+		// the number of matching offsets in [p+minJump, p+maxJump]
+		window := 0
+		for p := len(data); p >= 0; p-- {
+			if q := p + t.minJump; q <= len(data) && next[q] {
+				window++
+			}
+			if q := p + t.maxJump + 1; q <= len(data) && next[q] {
+				window--
+			}
+			match[p] = window > 0
+		}
+	}
+
+	n := 0
+	for _, m := range match {
+		if m {
+			n++
+		}
+	}
+	return n
+}
+
+func (p *yaraParser) regexString() (*yaraString, error) {
+	p.pos++ // opening slash
+	var b strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return nil, fmt.Errorf("unterminated regular expression")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		if c == '/' {
+			break
+		}
+		b.WriteByte(c)
+		if c == '\\' && !p.eof() {
+			b.WriteByte(p.src[p.pos])
+			p.pos++
+		}
+	}
+
+	flags := ""
+	for !p.eof() && (p.peek() == 'i' || p.peek() == 's') {
+		flags += string(p.peek())
+		p.pos++
+	}
+	if p.modifiers()["nocase"] && !strings.Contains(flags, "i") {
+		flags += "i"
+	}
+
+	expr := b.String()
+	if flags != "" {
+		expr = "(?" + flags + ")" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+
+	return &yaraString{count: func(data, _ []byte) int {
+		return len(re.FindAllIndex(data, -1))
+	}}, nil
+}
+
+// condition parses the condition section, it ends with the closing brace of the rule
+func (p *yaraParser) condition() (yaraExpr, error) {
+	end := strings.IndexByte(p.src[p.pos:], '}')
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated condition")
+	}
+	toks, err := tokenizeCondition(p.src[p.pos : p.pos+end])
+	if err != nil {
+		return nil, err
+	}
+	p.pos += end
+
+	c := &conditionParser{toks: toks}
+	expr, err := c.or()
+	if err != nil {
+		return nil, err
+	}
+	if !c.done() {
+		return nil, fmt.Errorf("unexpected '%s' in condition", c.next())
+	}
+	return expr, nil
+}
+
+func tokenizeCondition(s string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(s[i:], "//"):
+			if j := strings.IndexByte(s[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				i = len(s)
+			}
+		case strings.HasPrefix(s[i:], "/*"):
+			j := strings.Index(s[i+2:], "*/")
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += j + 4
+		case c == '(' || c == ')' || c == ',':
+			toks = append(toks, string(c))
+			i++
+		case strings.ContainsRune("=!<>", rune(c)):
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			op := s[i:j]
+			if op == "=" || op == "!" {
+				return nil, fmt.Errorf("invalid operator '%s'", op)
+			}
+			toks = append(toks, op)
+			i = j
+		case c == '$' || c == '#' || isIdentChar(c):
+			j := i + 1
+			for j < len(s) && (isIdentChar(s[j]) || s[j] == '*') {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected '%c' in condition", c)
+		}
+	}
+	return toks, nil
+}
+
+type conditionParser struct {
+	toks []string
+	pos  int
+}
+
+func (c *conditionParser) done() bool {
+	return c.pos >= len(c.toks)
+}
+
+func (c *conditionParser) next() string {
+	if c.done() {
+		return ""
+	}
+	return c.toks[c.pos]
+}
+
+func (c *conditionParser) consume(tok string) bool {
+	if c.next() == tok {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+func (c *conditionParser) or() (yaraExpr, error) {
+	left, err := c.and()
+	if err != nil {
+		return nil, err
+	}
+	for c.consume("or") {
+		l := left
+		r, err := c.and()
+		if err != nil {
+			return nil, err
+		}
+		left = func(s *yaraScope) bool { return l(s) || r(s) }
+	}
+	return left, nil
+}
+
+func (c *conditionParser) and() (yaraExpr, error) {
+	left, err := c.not()
+	if err != nil {
+		return nil, err
+	}
+	for c.consume("and") {
+		l := left
+		r, err := c.not()
+		if err != nil {
+			return nil, err
+		}
+		left = func(s *yaraScope) bool { return l(s) && r(s) }
+	}
+	return left, nil
+}
+
+func (c *conditionParser) not() (yaraExpr, error) {
+	if c.consume("not") {
+		e, err := c.not()
+		if err != nil {
+			return nil, err
+		}
+		return func(s *yaraScope) bool { return !e(s) }, nil
+	}
+	return c.primary()
+}
+
+func (c *conditionParser) primary() (yaraExpr, error) {
+	tok := c.next()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of condition")
+	case tok == "(":
+		c.pos++
+		e, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		if !c.consume(")") {
+			return nil, fmt.Errorf("missing ')' in condition")
+		}
+		return e, nil
+	case tok == "true" || tok == "false":
+		c.pos++
+		v := tok == "true"
+		return func(*yaraScope) bool { return v }, nil
+	case tok == "any" || tok == "all" || tok == "none":
+		return c.quantifier()
+	case strings.HasPrefix(tok, "$"):
+		c.pos++
+		return func(s *yaraScope) bool { return s.counts[tok] > 0 }, nil
+	case strings.HasPrefix(tok, "#"), tok == "filesize":
+		return c.comparison()
+	case isNumber(tok):
+		if c.pos+1 < len(c.toks) && c.toks[c.pos+1] == "of" {
+			return c.quantifier()
+		}
+		return c.comparison()
+	default:
+		c.pos++
+		return func(s *yaraScope) bool { return s.rules[tok] }, nil
+	}
+}
+
+// quantifier parses expressions like `any of them` or `2 of ($a, $b*)`
+func (c *conditionParser) quantifier() (yaraExpr, error) {
+	q := c.next()
+	c.pos++
+	if !c.consume("of") {
+		return nil, fmt.Errorf("expected 'of' after '%s'", q)
+	}
+
+	var set []string
+	switch {
+	case c.consume("them"):
+		set = []string{"$*"}
+	case c.consume("("):
+		for {
+			id := c.next()
+			if !strings.HasPrefix(id, "$") {
+				return nil, fmt.Errorf("expected string identifier, got '%s'", id)
+			}
+			c.pos++
+			set = append(set, id)
+			if c.consume(")") {
+				break
+			}
+			if !c.consume(",") {
+				return nil, fmt.Errorf("expected ',' or ')' in string set")
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected 'them' or a string set")
+	}
+
+	var n int64
+	if isNumber(q) {
+		var err error
+		if n, err = parseNumber(q); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(s *yaraScope) bool {
+		total, matched := 0, 0
+		for id, count := range s.counts {
+			if !inSet(set, id) {
+				continue
+			}
+			total++
+			if count > 0 {
+				matched++
+			}
+		}
+		switch q {
+		case "any":
+			return matched > 0
+		case "all":
+			return total > 0 && matched == total
+		case "none":
+			return matched == 0
+		default:
+			return int64(matched) >= n
+		}
+	}, nil
+}
+
+func inSet(set []string, id string) bool {
+	for _, s := range set {
+		if prefix, ok := strings.CutSuffix(s, "*"); ok {
+			if strings.HasPrefix(id, prefix) {
+				return true
+			}
+		} else if s == id {
+			return true
+		}
+	}
+	return false
+}
+
+// comparison parses comparisons of string counts, the file size and numbers
+func (c *conditionParser) comparison() (yaraExpr, error) {
+	left, err := c.operand()
+	if err != nil {
+		return nil, err
+	}
+	op := c.next()
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		c.pos++
+	default:
+		return nil, fmt.Errorf("expected comparison operator, got '%s'", op)
+	}
+	right, err := c.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(s *yaraScope) bool {
+		l, r := left(s), right(s)
+		switch op {
+		case "==":
+			return l == r
+		case "!=":
+			return l != r
+		case "<":
+			return l < r
+		case "<=":
+			return l <= r
+		case ">":
+			return l > r
+		default:
+			return l >= r
+		}
+	}, nil
+}
+
+func (c *conditionParser) operand() (func(s *yaraScope) int64, error) {
+	tok := c.next()
+	c.pos++
+	switch {
+	case tok == "filesize":
+		return func(s *yaraScope) int64 { return s.filesize }, nil
+	case strings.HasPrefix(tok, "#"):
+		id := "$" + tok[1:]
+		return func(s *yaraScope) int64 { return int64(s.counts[id]) }, nil
+	case isNumber(tok):
+		n, err := parseNumber(tok)
+		if err != nil {
+			return nil, err
+		}
+		return func(*yaraScope) int64 { return n }, nil
+	default:
+		return nil, fmt.Errorf("unexpected '%s' in comparison", tok)
+	}
+}
+
+func isNumber(tok string) bool {
+	return tok != "" && tok[0] >= '0' && tok[0] <= '9'
+}
+
+// parseNumber parses decimal and hex numbers with optional KB and MB suffixes
+func parseNumber(tok string) (int64, error) {
+	mult := int64(1)
+	switch {
+	case strings.HasSuffix(tok, "KB"):
+		mult, tok = 1024, strings.TrimSuffix(tok, "KB")
+	case strings.HasSuffix(tok, "MB"):
+		mult, tok = 1024*1024, strings.TrimSuffix(tok, "MB")
+	}
+	n, err := strconv.ParseInt(tok, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number '%s'", tok)
+	}
+	return n * mult, nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -50,6 +50,8 @@ func NewAntivirus(cfg *config.Config, logger log.Logger, tracerProvider trace.Tr
 		scanner, err = scanners.NewClamAV(cfg.Scanner.ClamAV.Socket, cfg.Scanner.ClamAV.Timeout)
 	case config.ScannerTypeICap:
 		scanner, err = scanners.NewICAP(cfg.Scanner.ICAP.URL, cfg.Scanner.ICAP.Service, cfg.Scanner.ICAP.Timeout)
+	case config.ScannerTypeYARA:
+		scanner, err = scanners.NewYARA(cfg.Scanner.YARA.RulesPath, logger)
 	}
 	if err != nil {
 		return Antivirus{}, err
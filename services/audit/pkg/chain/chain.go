@@ -0,0 +1,153 @@
+// Package chain implements tamper-evident audit log files.
+//
+// Every record of a hash chained file carries the hash of the previous record,
+// so editing, removing or reordering records breaks the chain. Signed checkpoints
+// allow proving that the chain has not been rewritten as a whole.
+package chain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Line types of a hash chained file
+const (
+	// TypeHeader starts every file and links it to the previous file
+	TypeHeader = "header"
+	// TypeRecord is an audit record
+	TypeRecord = "record"
+	// TypeCheckpoint is a signed checkpoint
+	TypeCheckpoint = "checkpoint"
+)
+
+// Genesis is the previous hash of the first record of a chain
+var Genesis = hex.EncodeToString(make([]byte, sha256.Size))
+
+// Line is a line of a hash chained file
+type Line struct {
+	Type string `json:"type"`
+	// Seq is the sequence number of the record, it is continuous across rotated files.
+	// Headers carry the sequence number of the next record.
+	Seq uint64 `json:"seq"`
+	// Prev is the hash of the previous record
+	Prev string `json:"prev,omitempty"`
+	// Hash is the hash of the record, for checkpoints the hash of the last record
+	Hash string `json:"hash,omitempty"`
+	// Record is the audit record, records not being JSON are stored as JSON string
+	Record    json.RawMessage `json:"record,omitempty"`
+	Time      string          `json:"time,omitempty"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+// Hash returns the hash of a record
+func Hash(prev string, seq uint64, record []byte) string {
+	h := sha256.New()
+	h.Write([]byte(prev))
+	h.Write([]byte("\n" + strconv.FormatUint(seq, 10) + "\n"))
+	h.Write(record)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// encode renders a line without escaping HTML characters, so the record
+// stays byte-identical to the hashed content
+func encode(l Line) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(l); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalize returns the form of a record that is stored and hashed
+func normalize(record []byte) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if json.Valid(record) {
+		if err := json.Compact(&buf, record); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(string(record)); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// checkpointPayload returns the signed content of a checkpoint
+func checkpointPayload(seq uint64, hash string, t string) []byte {
+	return []byte(fmt.Sprintf("%d:%s:%s", seq, hash, t))
+}
+
+// LoadPrivateKey reads a PEM encoded Ed25519 private key in PKCS #8 format
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an ed25519 key")
+	}
+	return pk, nil
+}
+
+// LoadPublicKey reads a PEM encoded Ed25519 public key. A private key is accepted
+// as well, the public key is derived from it.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type == "PRIVATE KEY" {
+		pk, err := LoadPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+		return pk.Public().(ed25519.PublicKey), nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("key is not an ed25519 key")
+	}
+	return pub, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in '%s'", path)
+	}
+	return block, nil
+}
@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	w, err := NewWriter(Options{Path: path, HashChain: true, SigningKey: priv, CheckpointInterval: 2})
+	require.NoError(t, err)
+
+	for _, r := range []string{`{"Action":"a","Message":"<b>"}`, "minimal\n   record", `{"Action":"c"}`} {
+		require.NoError(t, w.Write([]byte(r)))
+	}
+
+	v := Verifier{PublicKey: pub}
+	res, err := v.Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Records)
+	require.Equal(t, 1, res.Checkpoints)
+	require.True(t, res.Anchored)
+
+	// a restarted writer continues the chain
+	w, err = NewWriter(Options{Path: path, HashChain: true, SigningKey: priv, CheckpointInterval: 2})
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]byte(`{"Action":"d"}`)))
+
+	res, err = v.Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, 4, res.Records)
+	require.Equal(t, 2, res.Checkpoints)
+
+	// tamper with a record
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bytes.Replace(b, []byte(`"c"`), []byte(`"x"`), 1), 0600))
+
+	_, err = v.Verify(path)
+	require.Equal(t, BrokenLinkError{File: path, Line: 5, Reason: "record 3 has been modified"}, err)
+}
+
+func TestChainRemovedRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	w, err := NewWriter(Options{Path: path, HashChain: true})
+	require.NoError(t, err)
+	for _, r := range []string{"1", "2", "3"} {
+		require.NoError(t, w.Write([]byte(r)))
+	}
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	require.NoError(t, os.WriteFile(path, bytes.Join(append(lines[:2], lines[3:]...), nil), 0600))
+
+	_, err = (&Verifier{}).Verify(path)
+	require.Equal(t, BrokenLinkError{File: path, Line: 3, Reason: "expected record 2, found 3"}, err)
+}
+
+func TestChainRotation(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+	w, err := NewWriter(Options{Path: path, HashChain: true, SigningKey: priv, CheckpointInterval: 100, MaxSize: 200})
+	require.NoError(t, err)
+
+	now := time.Now()
+	w.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, w.Write([]byte(`{"Action":"file_read"}`)))
+	}
+
+	rotated, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated)
+	sort.Strings(rotated)
+
+	v := Verifier{PublicKey: priv.Public().(ed25519.PublicKey)}
+	res, err := v.Verify(append(rotated, path)...)
+	require.NoError(t, err)
+	require.Equal(t, 10, res.Records)
+	require.Equal(t, len(rotated), res.Checkpoints)
+
+	// the current file alone is verified from its header
+	res, err = v.Verify(path)
+	require.NoError(t, err)
+	require.False(t, res.Anchored)
+
+	// a missing file in between breaks the chain
+	if len(rotated) > 1 {
+		_, err = v.Verify(rotated[0], path)
+		require.ErrorAs(t, err, &BrokenLinkError{})
+	}
+
+	// the chain continues after a restart without a current file
+	require.NoError(t, os.Rename(path, path+"."+now.Add(time.Second).UTC().Format(rotationTimeFormat)))
+	w, err = NewWriter(Options{Path: path, HashChain: true})
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]byte(`{"Action":"file_read"}`)))
+
+	rotated, err = filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	sort.Strings(rotated)
+	res, err = v.Verify(append(rotated, path)...)
+	require.NoError(t, err)
+	require.Equal(t, 11, res.Records)
+}
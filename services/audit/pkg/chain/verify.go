@@ -0,0 +1,156 @@
+package chain
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BrokenLinkError describes the first position where a chain is broken
+type BrokenLinkError struct {
+	File   string
+	Line   int
+	Reason string
+}
+
+// Error fulfills the error interface
+func (e BrokenLinkError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Reason)
+}
+
+// Result summarizes a successful verification
+type Result struct {
+	// Records is the number of verified records
+	Records int
+	// FirstSeq and LastSeq are the sequence numbers of the first and last verified record
+	FirstSeq uint64
+	LastSeq  uint64
+	// Checkpoints is the number of checkpoints with a valid signature
+	Checkpoints int
+	// UnverifiedCheckpoints is the number of checkpoints that were not checked because no key was given
+	UnverifiedCheckpoints int
+	// Anchored is true if the chain starts at the genesis record, otherwise the
+	// files before the first given one are missing.
+	Anchored bool
+}
+
+// Verifier checks the integrity of hash chained files
+type Verifier struct {
+	// PublicKey is used to check the checkpoint signatures, checkpoints are not checked if it is nil
+	PublicKey ed25519.PublicKey
+
+	result  Result
+	started bool
+	seq     uint64
+	prev    string
+}
+
+// Verify walks the given files, which have to be passed oldest first, and
+// returns a BrokenLinkError for the first broken link found.
+func (v *Verifier) Verify(files ...string) (Result, error) {
+	v.result, v.started = Result{}, false
+	for _, f := range files {
+		if err := v.verifyFile(f); err != nil {
+			return v.result, err
+		}
+	}
+	return v.result, nil
+}
+
+func (v *Verifier) verifyFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := newScanner(f)
+	n := 0
+	for s.Scan() {
+		n++
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+
+		var l Line
+		if err := json.Unmarshal(s.Bytes(), &l); err != nil {
+			return BrokenLinkError{File: path, Line: n, Reason: "line is not part of a hash chain"}
+		}
+
+		if reason := v.check(l, n == 1); reason != "" {
+			return BrokenLinkError{File: path, Line: n, Reason: reason}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return BrokenLinkError{File: path, Line: 0, Reason: "file is empty"}
+	}
+	return nil
+}
+
+// check returns the reason why a line breaks the chain or an empty string
+func (v *Verifier) check(l Line, first bool) string {
+	switch l.Type {
+	case TypeHeader:
+		if !first {
+			return "header in the middle of a file"
+		}
+		if !v.started {
+			// the files before are not known, trust the header
+			v.start(l.Seq, l.Prev)
+			return ""
+		}
+		if l.Seq != v.seq+1 || l.Prev != v.prev {
+			return fmt.Sprintf("file does not continue the chain, expected seq %d after hash %s", v.seq+1, v.prev)
+		}
+	case TypeRecord:
+		if !v.started {
+			v.start(l.Seq, l.Prev)
+		}
+		if l.Seq != v.seq+1 {
+			return fmt.Sprintf("expected record %d, found %d", v.seq+1, l.Seq)
+		}
+		if l.Prev != v.prev {
+			return fmt.Sprintf("record %d does not link to the previous record", l.Seq)
+		}
+		if Hash(l.Prev, l.Seq, l.Record) != l.Hash {
+			return fmt.Sprintf("record %d has been modified", l.Seq)
+		}
+		v.seq, v.prev = l.Seq, l.Hash
+		if v.result.Records == 0 {
+			v.result.FirstSeq = l.Seq
+		}
+		v.result.Records++
+		v.result.LastSeq = l.Seq
+	case TypeCheckpoint:
+		if !v.started {
+			return "checkpoint without records"
+		}
+		if l.Seq != v.seq || l.Hash != v.prev {
+			return fmt.Sprintf("checkpoint for record %d does not match the chain", l.Seq)
+		}
+		if v.PublicKey == nil {
+			v.result.UnverifiedCheckpoints++
+			return ""
+		}
+		sig, err := base64.StdEncoding.DecodeString(l.Signature)
+		if err != nil || !ed25519.Verify(v.PublicKey, checkpointPayload(l.Seq, l.Hash, l.Time), sig) {
+			return fmt.Sprintf("invalid signature of checkpoint for record %d", l.Seq)
+		}
+		v.result.Checkpoints++
+	default:
+		return "line is not part of a hash chain"
+	}
+	return ""
+}
+
+func (v *Verifier) start(seq uint64, prev string) {
+	v.started = true
+	v.seq, v.prev = seq-1, prev
+	v.result.Anchored = seq == 1 && prev == Genesis
+}
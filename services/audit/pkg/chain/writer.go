@@ -0,0 +1,239 @@
+package chain
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotationTimeFormat is used as suffix for rotated files, it sorts chronologically
+const rotationTimeFormat = "20060102T150405.000000000Z"
+
+// Options configure a Writer
+type Options struct {
+	// Path of the log file
+	Path string
+	// HashChain enables hash chaining, otherwise records are written as they are
+	HashChain bool
+	// SigningKey is used to sign checkpoints, no checkpoints are written if it is nil
+	SigningKey ed25519.PrivateKey
+	// CheckpointInterval is the number of records between two checkpoints
+	CheckpointInterval int
+	// MaxSize is the size in bytes after which the file is rotated
+	MaxSize int64
+	// MaxAge is the age after which the file is rotated
+	MaxAge time.Duration
+}
+
+// Writer appends records to a log file and rotates it. With hash chaining enabled
+// the chain continues across rotated files.
+type Writer struct {
+	opts Options
+
+	mu      sync.Mutex
+	seq     uint64
+	prev    string
+	size    int64
+	created time.Time
+	now     func() time.Time
+}
+
+// NewWriter returns a Writer continuing the chain of an existing log file
+func NewWriter(opts Options) (*Writer, error) {
+	w := &Writer{
+		opts: opts,
+		prev: Genesis,
+		now:  time.Now,
+	}
+
+	if err := w.recover(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends a record
+func (w *Writer) Write(record []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.size > 0 && w.rotationDue() {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if !w.opts.HashChain {
+		return w.append(append(record[:len(record):len(record)], '\n'))
+	}
+
+	if w.size == 0 {
+		if err := w.writeLine(Line{Type: TypeHeader, Seq: w.seq + 1, Prev: w.prev, Time: w.timestamp()}); err != nil {
+			return err
+		}
+	}
+
+	r, err := normalize(record)
+	if err != nil {
+		return err
+	}
+
+	seq := w.seq + 1
+	hash := Hash(w.prev, seq, r)
+	if err := w.writeLine(Line{Type: TypeRecord, Seq: seq, Prev: w.prev, Hash: hash, Record: r}); err != nil {
+		return err
+	}
+	w.seq, w.prev = seq, hash
+
+	if w.opts.SigningKey != nil && w.opts.CheckpointInterval > 0 && w.seq%uint64(w.opts.CheckpointInterval) == 0 {
+		return w.checkpoint()
+	}
+	return nil
+}
+
+func (w *Writer) rotationDue() bool {
+	if w.opts.MaxSize > 0 && w.size >= w.opts.MaxSize {
+		return true
+	}
+	return w.opts.MaxAge > 0 && w.now().Sub(w.created) >= w.opts.MaxAge
+}
+
+// rotate seals the current file with a checkpoint and moves it away
+func (w *Writer) rotate() error {
+	if w.opts.HashChain && w.opts.SigningKey != nil && w.seq > 0 {
+		if err := w.checkpoint(); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(w.opts.Path, w.opts.Path+"."+w.now().UTC().Format(rotationTimeFormat)); err != nil {
+		return err
+	}
+	w.size = 0
+	return nil
+}
+
+func (w *Writer) checkpoint() error {
+	t := w.timestamp()
+	sig := ed25519.Sign(w.opts.SigningKey, checkpointPayload(w.seq, w.prev, t))
+	return w.writeLine(Line{Type: TypeCheckpoint, Seq: w.seq, Hash: w.prev, Time: t, Signature: base64.StdEncoding.EncodeToString(sig)})
+}
+
+func (w *Writer) writeLine(l Line) error {
+	b, err := encode(l)
+	if err != nil {
+		return err
+	}
+	return w.append(b)
+}
+
+func (w *Writer) append(b []byte) error {
+	f, err := os.OpenFile(w.opts.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if w.size == 0 {
+		w.created = w.now()
+	}
+	n, err := f.Write(b)
+	w.size += int64(n)
+	return err
+}
+
+func (w *Writer) timestamp() string {
+	return w.now().UTC().Format(time.RFC3339Nano)
+}
+
+// recover reads the state of the chain from the current file or, if there is
+// none, from the last rotated file.
+func (w *Writer) recover() error {
+	info, err := os.Stat(w.opts.Path)
+	switch {
+	case err == nil:
+		w.size = info.Size()
+		// the creation time is unknown for plain files, start counting now
+		w.created = w.now()
+	case errors.Is(err, os.ErrNotExist):
+	default:
+		return err
+	}
+
+	if !w.opts.HashChain {
+		return nil
+	}
+
+	path := w.opts.Path
+	if w.size == 0 {
+		matches, err := filepath.Glob(w.opts.Path + ".*")
+		if err != nil {
+			return err
+		}
+		rotated := make([]string, 0, len(matches))
+		for _, m := range matches {
+			if isRotated(w.opts.Path, m) {
+				rotated = append(rotated, m)
+			}
+		}
+		if len(rotated) == 0 {
+			return nil
+		}
+		sort.Strings(rotated)
+		path = rotated[len(rotated)-1]
+	}
+
+	return w.scan(path, path == w.opts.Path)
+}
+
+func (w *Writer) scan(path string, current bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := newScanner(f)
+	for s.Scan() {
+		var l Line
+		if err := json.Unmarshal(s.Bytes(), &l); err != nil {
+			continue
+		}
+		switch l.Type {
+		case TypeHeader:
+			if current {
+				if t, err := time.Parse(time.RFC3339Nano, l.Time); err == nil {
+					w.created = t
+				}
+			}
+			w.seq, w.prev = l.Seq-1, l.Prev
+		case TypeRecord:
+			w.seq, w.prev = l.Seq, l.Hash
+		}
+	}
+	return s.Err()
+}
+
+// isRotated returns true if name is a rotated file of the log file at path
+func isRotated(path, name string) bool {
+	suffix, ok := strings.CutPrefix(name, path+".")
+	if !ok {
+		return false
+	}
+	_, err := time.Parse(rotationTimeFormat, suffix)
+	return err == nil
+}
+
+func newScanner(f *os.File) *bufio.Scanner {
+	s := bufio.NewScanner(f)
+	s.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	return s
+}
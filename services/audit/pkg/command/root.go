@@ -15,6 +15,7 @@ func GetCommands(cfg *config.Config) []*cobra.Command {
 		Server(cfg),
 
 		// interaction with this service
+		Verify(cfg),
 
 		// infos about this service
 		Health(cfg),
@@ -9,8 +9,10 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
+	"github.com/opencloud-eu/opencloud/pkg/version"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/server/debug"
 	svc "github.com/opencloud-eu/opencloud/services/audit/pkg/service"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
@@ -36,6 +38,10 @@ func Server(cfg *config.Config) *cobra.Command {
 			}
 			ctx := cfg.Context
 			logger := log.Configure(cfg.Service.Name, cfg.Commons, cfg.LogLevel)
+
+			m := metrics.New()
+			m.BuildInfo.WithLabelValues(version.GetString()).Set(1)
+
 			gr := runner.NewGroup()
 
 			connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
@@ -54,8 +60,7 @@ func Server(cfg *config.Config) *cobra.Command {
 			defer svcCancel()
 
 			gr.Add(runner.New(cfg.Service.Name+".svc", func() error {
-				svc.AuditLoggerFromConfig(svcCtx, cfg.Auditlog, evts, logger)
-				return nil
+				return svc.AuditLoggerFromConfig(svcCtx, cfg.Auditlog, evts, logger, m)
 			}, func() {
 				svcCancel()
 			}))
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/chain"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config/parser"
+	"github.com/spf13/cobra"
+)
+
+// Verify is the entrypoint for the verify command.
+func Verify(cfg *config.Config) *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify <file>...",
+		Short: "verify the integrity of hash chained audit log files",
+		Long:  "verify the integrity of hash chained audit log files. Rotated files have to be passed oldest first, the chain is checked across all of them.",
+		Args:  cobra.MinimumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnError(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyPath, _ := cmd.Flags().GetString("key")
+			if keyPath == "" {
+				keyPath = cfg.Auditlog.File.SigningKey
+			}
+
+			var key ed25519.PublicKey
+			if keyPath != "" {
+				var err error
+				key, err = chain.LoadPublicKey(keyPath)
+				if err != nil {
+					return fmt.Errorf("could not load key: %w", err)
+				}
+			}
+
+			v := chain.Verifier{PublicKey: key}
+			res, err := v.Verify(args...)
+			if err != nil {
+				fmt.Printf("verification failed after %d records: %s\n", res.Records, err)
+				return err
+			}
+
+			fmt.Printf("verified %d records (%d to %d)\n", res.Records, res.FirstSeq, res.LastSeq)
+			fmt.Printf("verified %d signed checkpoints\n", res.Checkpoints)
+			if res.UnverifiedCheckpoints > 0 {
+				fmt.Printf("WARNING: %d checkpoints were not verified, use --key to pass the public key\n", res.UnverifiedCheckpoints)
+			}
+			if !res.Anchored {
+				fmt.Println("WARNING: the chain does not start at the first record, files before the first given one were not verified")
+			}
+			return nil
+		},
+	}
+
+	verifyCmd.Flags().String(
+		"key",
+		"",
+		"PEM encoded Ed25519 public or private key to verify the checkpoints with. Defaults to the configured AUDIT_FILE_SIGNING_KEY.",
+	)
+
+	return verifyCmd
+}
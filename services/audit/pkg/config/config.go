@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 )
@@ -35,5 +36,42 @@ type Auditlog struct {
 	LogToConsole bool   `yaml:"log_to_console" env:"AUDIT_LOG_TO_CONSOLE" desc:"Logs to stdout if set to 'true'. Independent of the LOG_TO_FILE option." introductionVersion:"1.0.0"`
 	LogToFile    bool   `yaml:"log_to_file" env:"AUDIT_LOG_TO_FILE" desc:"Logs to file if set to 'true'. Independent of the LOG_TO_CONSOLE option." introductionVersion:"1.0.0"`
 	FilePath     string `yaml:"filepath" env:"AUDIT_FILEPATH" desc:"Filepath of the logfile. Mandatory if LOG_TO_FILE is set to 'true'." introductionVersion:"1.0.0"`
-	Format       string `yaml:"format" env:"AUDIT_FORMAT" desc:"Log format. Supported values are '' (empty) and 'json'. Using 'json' is advised, '' (empty) renders the 'minimal' format. See the text description for more details." introductionVersion:"1.0.0"`
+	File         File   `yaml:"file"`
+	Format       string `yaml:"format" env:"AUDIT_FORMAT" desc:"Log format. Supported values are '' (empty), 'json' and 'cef'. Using 'json' is advised, '' (empty) renders the 'minimal' format, 'cef' renders the ArcSight Common Event Format. See the text description for more details." introductionVersion:"1.0.0"`
+
+	LogToSyslog  bool    `yaml:"log_to_syslog" env:"AUDIT_LOG_TO_SYSLOG" desc:"Sends the audit log to a syslog server if set to 'true'. Independent of the other LOG_TO options." introductionVersion:"%%NEXT%%"`
+	Syslog       Syslog  `yaml:"syslog"`
+	LogToWebhook bool    `yaml:"log_to_webhook" env:"AUDIT_LOG_TO_WEBHOOK" desc:"Sends the audit log in batches to a HTTP endpoint if set to 'true'. Independent of the other LOG_TO options." introductionVersion:"%%NEXT%%"`
+	Webhook      Webhook `yaml:"webhook"`
+}
+
+// File configures integrity protection and rotation of the log file
+type File struct {
+	HashChain          bool          `yaml:"hash_chain" env:"AUDIT_FILE_HASH_CHAIN" desc:"Chain the records of the log file by adding the hash of the previous record to each record. Use 'opencloud audit verify' to check the integrity of a log file." introductionVersion:"%%NEXT%%"`
+	SigningKey         string        `yaml:"signing_key" env:"AUDIT_FILE_SIGNING_KEY" desc:"Path to a PEM encoded Ed25519 private key in PKCS #8 format. If set, signed checkpoints are added to hash chained log files." introductionVersion:"%%NEXT%%"`
+	CheckpointInterval int           `yaml:"checkpoint_interval" env:"AUDIT_FILE_CHECKPOINT_INTERVAL" desc:"The number of records after which a signed checkpoint is added. Only applies when AUDIT_FILE_SIGNING_KEY is set." introductionVersion:"%%NEXT%%"`
+	MaxSize            int64         `yaml:"max_size" env:"AUDIT_FILE_MAX_SIZE" desc:"The size in bytes after which the log file is rotated. Set to 0 to disable size based rotation." introductionVersion:"%%NEXT%%"`
+	MaxAge             time.Duration `yaml:"max_age" env:"AUDIT_FILE_MAX_AGE" desc:"The age after which the log file is rotated. Set to 0 to disable age based rotation. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// Syslog configures the syslog output
+type Syslog struct {
+	Network              string `yaml:"network" env:"AUDIT_SYSLOG_NETWORK" desc:"The transport used to reach the syslog server. Supported values are 'udp', 'tcp' and 'tls'." introductionVersion:"%%NEXT%%"`
+	Address              string `yaml:"address" env:"AUDIT_SYSLOG_ADDRESS" desc:"The address of the syslog server, eg. 'siem.example.com:6514'. Mandatory if AUDIT_LOG_TO_SYSLOG is set to 'true'." introductionVersion:"%%NEXT%%"`
+	Facility             int    `yaml:"facility" env:"AUDIT_SYSLOG_FACILITY" desc:"The syslog facility code as defined in RFC 5424. Defaults to '13' (log audit)." introductionVersion:"%%NEXT%%"`
+	AppName              string `yaml:"app_name" env:"AUDIT_SYSLOG_APP_NAME" desc:"The APP-NAME field of the syslog messages." introductionVersion:"%%NEXT%%"`
+	TLSInsecure          bool   `yaml:"tls_insecure" env:"OC_INSECURE;AUDIT_SYSLOG_TLS_INSECURE" desc:"Whether to verify the syslog server TLS certificates. Only applies when the network is 'tls'." introductionVersion:"%%NEXT%%"`
+	TLSRootCACertificate string `yaml:"tls_root_ca_certificate" env:"AUDIT_SYSLOG_TLS_ROOT_CA_CERTIFICATE" desc:"The root CA certificate used to validate the syslog server's TLS certificate. If provided AUDIT_SYSLOG_TLS_INSECURE will be seen as false." introductionVersion:"%%NEXT%%"`
+}
+
+// Webhook configures the HTTP webhook output
+type Webhook struct {
+	URL           string        `yaml:"url" env:"AUDIT_WEBHOOK_URL" desc:"The URL the audit records are POSTed to. Mandatory if AUDIT_LOG_TO_WEBHOOK is set to 'true'." introductionVersion:"%%NEXT%%"`
+	AuthHeader    string        `yaml:"auth_header" env:"AUDIT_WEBHOOK_AUTH_HEADER" desc:"The value of the 'Authorization' header sent with each request, eg. 'Bearer <token>'." introductionVersion:"%%NEXT%%"`
+	BatchSize     int           `yaml:"batch_size" env:"AUDIT_WEBHOOK_BATCH_SIZE" desc:"The maximum number of records sent in one request." introductionVersion:"%%NEXT%%"`
+	FlushInterval time.Duration `yaml:"flush_interval" env:"AUDIT_WEBHOOK_FLUSH_INTERVAL" desc:"The maximum time records are collected before they are sent. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	QueueSize     int           `yaml:"queue_size" env:"AUDIT_WEBHOOK_QUEUE_SIZE" desc:"The maximum number of records waiting to be sent. Records are dropped when the queue is full, for example when the endpoint is slow." introductionVersion:"%%NEXT%%"`
+	MaxRetries    int           `yaml:"max_retries" env:"AUDIT_WEBHOOK_MAX_RETRIES" desc:"The number of retries for a failed request before the batch is spooled to disk." introductionVersion:"%%NEXT%%"`
+	SpoolDir      string        `yaml:"spool_dir" env:"AUDIT_WEBHOOK_SPOOL_DIR" desc:"The directory batches that could not be delivered are stored in. They are sent again with the next successful request. If empty, undeliverable batches are dropped." introductionVersion:"%%NEXT%%"`
+	TLSInsecure   bool          `yaml:"tls_insecure" env:"OC_INSECURE;AUDIT_WEBHOOK_TLS_INSECURE" desc:"Whether to verify the webhook server TLS certificates." introductionVersion:"%%NEXT%%"`
 }
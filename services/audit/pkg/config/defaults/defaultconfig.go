@@ -1,6 +1,10 @@
 package defaults
 
 import (
+	"path/filepath"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
 )
 
@@ -31,6 +35,21 @@ func DefaultConfig() *config.Config {
 		Auditlog: config.Auditlog{
 			LogToConsole: true,
 			Format:       "json",
+			File: config.File{
+				CheckpointInterval: 1000,
+			},
+			Syslog: config.Syslog{
+				Network:  "udp",
+				Facility: 13,
+				AppName:  "opencloud",
+			},
+			Webhook: config.Webhook{
+				BatchSize:     100,
+				FlushInterval: 5 * time.Second,
+				QueueSize:     10000,
+				MaxRetries:    3,
+				SpoolDir:      filepath.Join(defaults.BaseDataPath(), "audit", "spool"),
+			},
 		},
 	}
 }
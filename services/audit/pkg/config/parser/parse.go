@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
@@ -34,5 +35,19 @@ func ParseConfig(cfg *config.Config) error {
 
 // Validate validates the configuration
 func Validate(cfg *config.Config) error {
+	if cfg.Auditlog.LogToSyslog {
+		switch cfg.Auditlog.Syslog.Network {
+		case "udp", "tcp", "tls":
+		default:
+			return fmt.Errorf("unsupported syslog network '%s'", cfg.Auditlog.Syslog.Network)
+		}
+		if cfg.Auditlog.Syslog.Address == "" {
+			return errors.New("syslog address is missing, set AUDIT_SYSLOG_ADDRESS")
+		}
+	}
+
+	if cfg.Auditlog.LogToWebhook && cfg.Auditlog.Webhook.URL == "" {
+		return errors.New("webhook url is missing, set AUDIT_WEBHOOK_URL")
+	}
 	return nil
 }
@@ -0,0 +1,39 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// Namespace defines the namespace for the defines metrics.
+	Namespace = "opencloud"
+
+	// Subsystem defines the subsystem for the defines metrics.
+	Subsystem = "audit"
+)
+
+// Metrics defines the available metrics of this service.
+type Metrics struct {
+	BuildInfo             *prometheus.GaugeVec
+	WebhookDroppedRecords prometheus.Counter
+}
+
+// New initializes the available metrics.
+func New() *Metrics {
+	m := &Metrics{
+		BuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "build_info",
+			Help:      "Build information",
+		}, []string{"version"}),
+		WebhookDroppedRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "webhook_dropped_records_total",
+			Help:      "How many records were dropped because the webhook queue was full",
+		}),
+	}
+
+	_ = prometheus.Register(m.BuildInfo)
+	_ = prometheus.Register(m.WebhookDroppedRecords)
+	return m
+}
@@ -0,0 +1,150 @@
+package svc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/version"
+)
+
+// cefExtensions maps the fields of the audit events to CEF extension keys
+var cefExtensions = map[string]string{
+	"RemoteAddr":  "src",
+	"User":        "suser",
+	"URL":         "request",
+	"Method":      "requestMethod",
+	"UserAgent":   "requestClientApplication",
+	"Message":     "msg",
+	"Path":        "filePath",
+	"FileID":      "fileId",
+	"ItemType":    "fileType",
+	"Permissions": "filePermission",
+	"ShareWith":   "duser",
+}
+
+// cefCustomStrings and cefCustomNumbers are the custom extensions used for fields without a CEF counterpart,
+// they are labeled with the name of the field. When there are more fields than extensions, the last string
+// extension holds the remaining fields as JSON.
+var (
+	cefCustomStrings = []string{"cs1", "cs2", "cs3", "cs4", "cs5", "cs6", "flexString1", "flexString2"}
+	cefCustomNumbers = []string{"cn1", "cn2", "cn3", "flexNumber1", "flexNumber2"}
+)
+
+// cefField is a field of the audit event without a CEF counterpart
+type cefField struct {
+	name  string
+	value string
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// MarshalCEF renders an audit event in the ArcSight Common Event Format.
+// Fields without a CEF counterpart are added as labeled custom extensions.
+func MarshalCEF(ev interface{}) ([]byte, error) {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the numbers as they are, large ones don't fit into a float64
+	m := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	action, _ := m["Action"].(string)
+	message, _ := m["Message"].(string)
+
+	severity := 1
+	if l, ok := m["Level"].(json.Number); ok {
+		if n, err := l.Int64(); err == nil {
+			severity = int(min(max(n, 0), 10))
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "CEF:0|OpenCloud|OpenCloud|%s|%s|%s|%d|",
+		cefHeaderEscaper.Replace(version.GetString()),
+		cefHeaderEscaper.Replace(action),
+		cefHeaderEscaper.Replace(message),
+		severity,
+	)
+
+	var ext []string
+	if t, ok := m["Time"].(string); ok && t != "" {
+		if ts, err := time.Parse(time.RFC3339, t); err == nil {
+			ext = append(ext, fmt.Sprintf("rt=%d", ts.UnixMilli()))
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var customStrings, customNumbers []cefField
+	for _, k := range keys {
+		switch k {
+		case "Action", "Level", "Time", "App":
+			continue
+		}
+
+		var v string
+		switch val := m[k].(type) {
+		case nil:
+			continue
+		case string, bool, json.Number:
+			v = fmt.Sprint(val)
+		default:
+			b, err := json.Marshal(val)
+			if err != nil {
+				return nil, err
+			}
+			v = string(b)
+		}
+		if v == "" {
+			continue
+		}
+
+		if name, ok := cefExtensions[k]; ok {
+			ext = append(ext, name+"="+cefExtensionEscaper.Replace(v))
+			continue
+		}
+		if _, ok := m[k].(json.Number); ok && len(customNumbers) < len(cefCustomNumbers) {
+			customNumbers = append(customNumbers, cefField{name: k, value: v})
+			continue
+		}
+		customStrings = append(customStrings, cefField{name: k, value: v})
+	}
+
+	if n := len(cefCustomStrings); len(customStrings) > n {
+		rest := make(map[string]string, len(customStrings)-n+1)
+		for _, f := range customStrings[n-1:] {
+			rest[f.name] = f.value
+		}
+		b, err := json.Marshal(rest)
+		if err != nil {
+			return nil, err
+		}
+		customStrings = append(customStrings[:n-1], cefField{name: "AdditionalFields", value: string(b)})
+	}
+	for i, f := range customStrings {
+		ext = append(ext, cefCustomStrings[i]+"="+cefExtensionEscaper.Replace(f.value), cefCustomStrings[i]+"Label="+cefExtensionEscaper.Replace(f.name))
+	}
+	for i, f := range customNumbers {
+		ext = append(ext, cefCustomNumbers[i]+"="+f.value, cefCustomNumbers[i]+"Label="+cefExtensionEscaper.Replace(f.name))
+	}
+
+	sb.WriteString(strings.Join(ext, " "))
+	return []byte(sb.String()), nil
+}
@@ -7,7 +7,9 @@ import (
 	"os"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/chain"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 )
@@ -19,7 +21,7 @@ type Log func([]byte)
 type Marshaller func(interface{}) ([]byte, error)
 
 // AuditLoggerFromConfig will start a new AuditLogger generated from the config
-func AuditLoggerFromConfig(ctx context.Context, cfg config.Auditlog, ch <-chan events.Event, log log.Logger) {
+func AuditLoggerFromConfig(ctx context.Context, cfg config.Auditlog, ch <-chan events.Event, log log.Logger, m *metrics.Metrics) error {
 	var logs []Log
 
 	if cfg.LogToConsole {
@@ -27,11 +29,30 @@ func AuditLoggerFromConfig(ctx context.Context, cfg config.Auditlog, ch <-chan e
 	}
 
 	if cfg.LogToFile {
-		logs = append(logs, WriteToFile(cfg.FilePath, log))
+		if cfg.File.HashChain || cfg.File.MaxSize > 0 || cfg.File.MaxAge > 0 {
+			l, err := WriteToChainedFile(cfg.FilePath, cfg.File, log)
+			if err != nil {
+				return err
+			}
+			logs = append(logs, l)
+		} else {
+			logs = append(logs, WriteToFile(cfg.FilePath, log))
+		}
 	}
 
-	StartAuditLogger(ctx, ch, log, Marshal(cfg.Format, log), logs...)
+	if cfg.LogToSyslog {
+		logs = append(logs, WriteToSyslog(cfg.Syslog, log))
+	}
 
+	if cfg.LogToWebhook {
+		l, stop := WriteToWebhook(cfg.Webhook, log, m.WebhookDroppedRecords)
+		// runs after the audit logger returned, no more records are logged then
+		defer stop()
+		logs = append(logs, l)
+	}
+
+	StartAuditLogger(ctx, ch, log, Marshal(cfg.Format, log), logs...)
+	return nil
 }
 
 // StartAuditLogger will block. run in separate go routine
@@ -161,6 +182,36 @@ func WriteToFile(path string, log log.Logger) Log {
 	}
 }
 
+// WriteToChainedFile returns a Log function writing to a file that is rotated and
+// optionally hash chained
+func WriteToChainedFile(path string, cfg config.File, log log.Logger) (Log, error) {
+	opts := chain.Options{
+		Path:               path,
+		HashChain:          cfg.HashChain,
+		CheckpointInterval: cfg.CheckpointInterval,
+		MaxSize:            cfg.MaxSize,
+		MaxAge:             cfg.MaxAge,
+	}
+	if cfg.HashChain && cfg.SigningKey != "" {
+		key, err := chain.LoadPrivateKey(cfg.SigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load audit signing key: %w", err)
+		}
+		opts.SigningKey = key
+	}
+
+	w, err := chain.NewWriter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log file '%s': %w", path, err)
+	}
+
+	return func(content []byte) {
+		if err := w.Write(content); err != nil {
+			log.Error().Err(err).Msgf("error writing to file '%s'", path)
+		}
+	}, nil
+}
+
 // WriteToStdout return a Log function writing to Stdout
 func WriteToStdout() Log {
 	return func(content []byte) {
@@ -176,6 +227,8 @@ func Marshal(format string, log log.Logger) Marshaller {
 		return nil
 	case "json":
 		return json.Marshal
+	case "cef":
+		return MarshalCEF
 	case "minimal":
 		return func(ev interface{}) ([]byte, error) {
 			b, err := json.Marshal(ev)
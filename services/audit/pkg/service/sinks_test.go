@@ -0,0 +1,212 @@
+package svc
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+)
+
+func TestMarshalCEF(t *testing.T) {
+	ev := types.AuditEventFileRead{
+		AuditEventFiles: types.AuditEventFiles{
+			AuditEvent: types.BasicAuditEvent("uid=1|2", "2023-01-01T00:00:00Z", "user 'uid' read file 'a=b'", types.ActionFileRead),
+			Path:       "/some\\path",
+			FileID:     "a=b",
+		},
+	}
+
+	b, err := MarshalCEF(ev)
+	require.NoError(t, err)
+
+	out := string(b)
+	require.True(t, strings.HasPrefix(out, "CEF:0|OpenCloud|OpenCloud|"), out)
+	require.Contains(t, out, "|file_read|user 'uid' read file 'a=b'|1|")
+	require.Contains(t, out, "rt=1672531200000")
+	require.Contains(t, out, `fileId=a\=b`)
+	require.Contains(t, out, `filePath=/some\\path`)
+	require.Contains(t, out, `suser=uid\=1|2`)
+	require.NotContains(t, out, "App=")
+}
+
+func TestMarshalCEFCustomExtensions(t *testing.T) {
+	ev := types.AuditEventShareCreated{
+		AuditEventSharing: types.AuditEventSharing{
+			AuditEvent: types.BasicAuditEvent("uid", "2023-01-01T00:00:00Z", "user 'uid' shared file", types.ActionShareCreated),
+			Owner:      "owner=uid",
+			ShareID:    "shareid",
+		},
+		ItemType:    "file",
+		Permissions: "READ",
+		ShareWith:   "einstein",
+		ShareOwner:  "uid",
+		ShareType:   "user",
+	}
+
+	b, err := MarshalCEF(ev)
+	require.NoError(t, err)
+
+	out := string(b)
+	require.Contains(t, out, "fileType=file")
+	require.Contains(t, out, "filePermission=READ")
+	require.Contains(t, out, "duser=einstein")
+	require.Contains(t, out, "cs1=false cs1Label=CLI cs2=owner\\=uid cs2Label=Owner cs3=shareid cs3Label=ShareID cs4=uid cs4Label=ShareOwner cs5=false cs5Label=SharePass cs6=user cs6Label=ShareType")
+	require.NotContains(t, out, "owner=")
+
+	space := types.AuditEventSpaceUpdated{
+		AuditEventSpaces: types.AuditEventSpaces{
+			AuditEvent: types.BasicAuditEvent("uid", "2023-01-01T00:00:00Z", "user 'uid' updated space", types.ActionSpaceUpdated),
+		},
+		QuotaMaxBytes: 1 << 60,
+	}
+	b, err = MarshalCEF(space)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "cn1=1152921504606846976 cn1Label=QuotaMaxBytes")
+}
+
+func TestMarshalCEFAdditionalFields(t *testing.T) {
+	ev := map[string]interface{}{"Action": "test"}
+	for _, k := range []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"} {
+		ev[k] = strings.ToLower(k)
+	}
+
+	b, err := MarshalCEF(ev)
+	require.NoError(t, err)
+
+	out := string(b)
+	require.Contains(t, out, "cs1=a cs1Label=A")
+	require.Contains(t, out, "flexString1=g flexString1Label=G")
+	require.Contains(t, out, `flexString2={"H":"h","I":"i","J":"j"} flexString2Label=AdditionalFields`)
+}
+
+func TestSyslogFormat(t *testing.T) {
+	w := &syslogWriter{cfg: config.Syslog{Network: "tcp", Facility: 13, AppName: "opencloud"}, hostname: "host"}
+
+	msg := w.format([]byte("hello"), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Regexp(t, `^<109>1 2023-01-01T00:00:00Z host opencloud \d+ audit - hello$`, string(msg))
+
+	framed := w.frame(msg)
+	require.Equal(t, strconv.Itoa(len(msg))+" "+string(msg), string(framed))
+}
+
+func TestWriteToSyslog(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		buf := make([]byte, 1024)
+		n, _ := c.Read(buf)
+		received <- string(buf[:n])
+	}()
+
+	WriteToSyslog(config.Syslog{Network: "tcp", Address: l.Addr().String(), Facility: 13, AppName: "opencloud"}, log.NopLogger())([]byte("record"))
+
+	select {
+	case r := <-received:
+		require.Contains(t, r, " audit - record")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no syslog message received")
+	}
+}
+
+func TestWebhookSpool(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		failing  = true
+		received []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		b, _ := io.ReadAll(r.Body)
+		received = append(received, string(b))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	wh := newWebhook(config.Webhook{URL: srv.URL, AuthHeader: "Bearer secret", BatchSize: 2, MaxRetries: 1, SpoolDir: dir}, log.NopLogger())
+	wh.backoff = time.Millisecond
+
+	wh.flush(context.Background(), [][]byte{[]byte("a"), []byte("b")})
+	require.Len(t, wh.spooled(), 1)
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+
+	wh.flush(context.Background(), [][]byte{[]byte("c")})
+	require.Empty(t, wh.spooled())
+	require.Equal(t, []string{"a\nb", "c"}, received)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestWebhookQueueFull(t *testing.T) {
+	wh := newWebhook(config.Webhook{BatchSize: 2, QueueSize: 2}, log.NopLogger())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 5 {
+			wh.enqueue([]byte("record"))
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("enqueue blocked on a full queue")
+	}
+	require.Len(t, wh.queue, 2)
+	require.Equal(t, uint64(3), wh.dropped.Load())
+}
+
+func TestWriteToWebhookDrainsOnStop(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		b, _ := io.ReadAll(r.Body)
+		received = append(received, string(b))
+	}))
+	defer srv.Close()
+
+	l, stop := WriteToWebhook(config.Webhook{URL: srv.URL, BatchSize: 100, QueueSize: 100, FlushInterval: time.Hour}, log.NopLogger(), nil)
+	l([]byte("a"))
+	l([]byte("b"))
+	stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"a\nb"}, received)
+}
@@ -0,0 +1,112 @@
+package svc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+// syslogSeverityNotice is the severity used for all audit records
+const syslogSeverityNotice = 5
+
+// WriteToSyslog returns a Log function sending RFC 5424 messages to a syslog server.
+// Messages sent via tcp or tls are framed using octet counting as defined in RFC 6587.
+func WriteToSyslog(cfg config.Syslog, log log.Logger) Log {
+	w := &syslogWriter{cfg: cfg}
+	w.hostname, _ = os.Hostname()
+	if w.hostname == "" {
+		w.hostname = "-"
+	}
+
+	return func(content []byte) {
+		if err := w.write(content); err != nil {
+			log.Error().Err(err).Str("address", cfg.Address).Msg("error writing to syslog")
+		}
+	}
+}
+
+type syslogWriter struct {
+	cfg      config.Syslog
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (w *syslogWriter) write(content []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	msg := w.frame(w.format(content, time.Now()))
+
+	// retry once with a fresh connection, the server might have closed the old one
+	var err error
+	for i := 0; i < 2; i++ {
+		if w.conn == nil {
+			if w.conn, err = w.dial(); err != nil {
+				return err
+			}
+		}
+
+		if _, err = w.conn.Write(msg); err == nil {
+			return nil
+		}
+		_ = w.conn.Close()
+		w.conn = nil
+	}
+	return err
+}
+
+// format renders a RFC 5424 syslog message
+func (w *syslogWriter) format(content []byte, t time.Time) []byte {
+	pri := w.cfg.Facility*8 + syslogSeverityNotice
+	appName := w.cfg.AppName
+	if appName == "" {
+		appName = "-"
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s %d audit - ", pri, t.UTC().Format(time.RFC3339Nano), w.hostname, appName, os.Getpid())
+	return append([]byte(header), content...)
+}
+
+// frame adds the octet counting framing for stream transports
+func (w *syslogWriter) frame(msg []byte) []byte {
+	if w.cfg.Network == "udp" {
+		return msg
+	}
+	return append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+}
+
+func (w *syslogWriter) dial() (net.Conn, error) {
+	switch w.cfg.Network {
+	case "udp", "tcp":
+		return net.DialTimeout(w.cfg.Network, w.cfg.Address, 10*time.Second)
+	case "tls":
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: w.cfg.TLSInsecure, //nolint:gosec
+		}
+		if w.cfg.TLSRootCACertificate != "" {
+			pem, err := os.ReadFile(w.cfg.TLSRootCACertificate)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.New("could not load syslog root ca certificate")
+			}
+			tlsConfig.RootCAs = pool
+			tlsConfig.InsecureSkipVerify = false
+		}
+		return tls.DialWithDialer(&net.Dialer{Timeout: 10 * time.Second}, "tcp", w.cfg.Address, tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported syslog network '%s'", w.cfg.Network)
+	}
+}
@@ -0,0 +1,244 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+const (
+	_spoolSuffix = ".batch"
+
+	// _drainTimeout is the time the records left in the queue get to be delivered on shutdown
+	_drainTimeout = 10 * time.Second
+)
+
+// WriteToWebhook returns a Log function sending batches of records to a HTTP endpoint.
+// Each batch is POSTed as newline delimited records. Batches that can't be delivered
+// after the configured retries are spooled to disk and sent again later.
+//
+// The Log function never blocks, records are dropped and counted when the queue is full.
+// The returned stop function sends the records left in the queue and must be called
+// after the last record was logged.
+func WriteToWebhook(cfg config.Webhook, log log.Logger, dropped prometheus.Counter) (Log, func()) {
+	wh := newWebhook(cfg, log)
+	wh.droppedCounter = dropped
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wh.run(ctx)
+	}()
+
+	stop := func() {
+		close(wh.queue)
+		select {
+		case <-done:
+		case <-time.After(_drainTimeout):
+			// abort the delivery, the records are spooled for the next start
+			cancel()
+			<-done
+		}
+		cancel()
+	}
+	return wh.enqueue, stop
+}
+
+type webhook struct {
+	cfg     config.Webhook
+	log     log.Logger
+	client  *http.Client
+	backoff time.Duration
+
+	queue          chan []byte
+	dropped        atomic.Uint64
+	droppedCounter prometheus.Counter
+}
+
+func newWebhook(cfg config.Webhook, log log.Logger) *webhook {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 1
+	}
+	if cfg.QueueSize < cfg.BatchSize {
+		cfg.QueueSize = cfg.BatchSize
+	}
+	return &webhook{
+		cfg: cfg,
+		log: log,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.TLSInsecure}, //nolint:gosec
+			},
+		},
+		backoff: time.Second,
+		queue:   make(chan []byte, cfg.QueueSize),
+	}
+}
+
+// enqueue adds a record to the queue, it is dropped when the queue is full
+func (wh *webhook) enqueue(content []byte) {
+	select {
+	case wh.queue <- content:
+	default:
+		n := wh.dropped.Add(1)
+		if wh.droppedCounter != nil {
+			wh.droppedCounter.Inc()
+		}
+		wh.log.Error().Uint64("dropped", n).Msg("audit webhook queue is full, dropping record")
+	}
+}
+
+// run sends the queued records until the queue is closed
+func (wh *webhook) run(ctx context.Context) {
+	interval := wh.cfg.FlushInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	batch := make([][]byte, 0, wh.cfg.BatchSize)
+	for {
+		select {
+		case r, ok := <-wh.queue:
+			if !ok {
+				// the queue is drained, send what is left
+				wh.flush(ctx, batch)
+				return
+			}
+			batch = append(batch, r)
+			if len(batch) < wh.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		wh.flush(ctx, batch)
+		batch = make([][]byte, 0, wh.cfg.BatchSize)
+	}
+}
+
+// flush sends spooled batches first to keep the order, then the current batch
+func (wh *webhook) flush(ctx context.Context, batch [][]byte) {
+	for _, f := range wh.spooled() {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			wh.log.Error().Err(err).Str("file", f).Msg("error reading spooled audit batch")
+			continue
+		}
+		if err := wh.deliver(ctx, b); err != nil {
+			// endpoint still unavailable, don't try the others
+			if len(batch) > 0 {
+				wh.spool(batch)
+			}
+			return
+		}
+		if err := os.Remove(f); err != nil {
+			wh.log.Error().Err(err).Str("file", f).Msg("error removing spooled audit batch")
+		}
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+	if err := wh.deliver(ctx, bytes.Join(batch, []byte("\n"))); err != nil {
+		wh.log.Error().Err(err).Int("records", len(batch)).Msg("error sending audit batch to webhook")
+		wh.spool(batch)
+	}
+}
+
+// deliver sends a batch and retries with exponential backoff
+func (wh *webhook) deliver(ctx context.Context, body []byte) error {
+	var err error
+	backoff := wh.backoff
+	for i := 0; i <= wh.cfg.MaxRetries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		if err = wh.post(ctx, body); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (wh *webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if wh.cfg.AuthHeader != "" {
+		req.Header.Set("Authorization", wh.cfg.AuthHeader)
+	}
+
+	res, err := wh.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (wh *webhook) spool(batch [][]byte) {
+	if wh.cfg.SpoolDir == "" {
+		wh.log.Error().Int("records", len(batch)).Msg("dropping undeliverable audit batch, no spool directory configured")
+		return
+	}
+
+	if err := os.MkdirAll(wh.cfg.SpoolDir, 0700); err != nil {
+		wh.log.Error().Err(err).Str("dir", wh.cfg.SpoolDir).Msg("error creating audit spool directory")
+		return
+	}
+
+	f := filepath.Join(wh.cfg.SpoolDir, fmt.Sprintf("%020d%s", time.Now().UnixNano(), _spoolSuffix))
+	if err := os.WriteFile(f, bytes.Join(batch, []byte("\n")), 0600); err != nil {
+		wh.log.Error().Err(err).Str("file", f).Msg("error spooling audit batch")
+	}
+}
+
+// spooled returns the spooled batches, oldest first
+func (wh *webhook) spooled() []string {
+	if wh.cfg.SpoolDir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(wh.cfg.SpoolDir)
+	if err != nil {
+		return nil
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), _spoolSuffix) {
+			files = append(files, filepath.Join(wh.cfg.SpoolDir, e.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/restrictions"
 	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 
@@ -85,6 +86,28 @@ func Create(cfg *config.Config) *cobra.Command {
 				return err
 			}
 
+			spaces, _ := cmd.Flags().GetStringSlice("space")
+			path, _ := cmd.Flags().GetString("path")
+			readOnly, _ := cmd.Flags().GetBool("read-only")
+			apis, _ := cmd.Flags().GetStringSlice("api")
+			ipRanges, _ := cmd.Flags().GetStringSlice("ip-range")
+			restr := restrictions.Restrictions{
+				Spaces:   spaces,
+				Path:     path,
+				ReadOnly: readOnly,
+				APIs:     apis,
+				IPRanges: ipRanges,
+			}
+			if err := restr.Validate(); err != nil {
+				return err
+			}
+			scopes, err = restrictions.AddScope(restr, scopes)
+			if err != nil {
+				return err
+			}
+
+			label, _ := cmd.Flags().GetString("label")
+
 			expiry, err := cmd.Flags().GetDuration("expiration")
 			if err != nil {
 				return err
@@ -92,7 +115,7 @@ func Create(cfg *config.Config) *cobra.Command {
 
 			appPassword, err := next.GenerateAppPassword(granteeCtx, &applicationsv1beta1.GenerateAppPasswordRequest{
 				TokenScope: scopes,
-				Label:      "Generated via CLI",
+				Label:      label,
 				Expiration: &typesv1beta1.Timestamp{
 					Seconds: uint64(time.Now().Add(expiry).Unix()),
 				},
@@ -119,6 +142,36 @@ func Create(cfg *config.Config) *cobra.Command {
 		time.Hour*72,
 		"expiration of the app password, e.g. 72h, 1h, 1m, 1s. Default is 72h.",
 	)
+	createCmd.Flags().String(
+		"label",
+		"Generated via CLI",
+		"label of the app password",
+	)
+	createCmd.Flags().StringSlice(
+		"space",
+		nil,
+		"restrict the app password to a space, can be given multiple times",
+	)
+	createCmd.Flags().String(
+		"path",
+		"",
+		"restrict the app password to a path prefix inside of the spaces, e.g. /Photos",
+	)
+	createCmd.Flags().Bool(
+		"read-only",
+		false,
+		"restrict the app password to read-only access",
+	)
+	createCmd.Flags().StringSlice(
+		"api",
+		nil,
+		"restrict the app password to an api, supported are 'webdav', 'graph' and 'ocs', can be given multiple times",
+	)
+	createCmd.Flags().StringSlice(
+		"ip-range",
+		nil,
+		"restrict the app password to clients from a network in CIDR notation, e.g. 192.0.2.0/24, can be given multiple times",
+	)
 
 	return createCmd
 }
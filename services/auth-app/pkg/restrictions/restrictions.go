@@ -0,0 +1,256 @@
+// Package restrictions limits what an app token can be used for.
+//
+// The restrictions are stored as an additional scope of the app password. The scope is
+// copied into the scopes of every reva token minted for the app token, which allows the
+// proxy to enforce it on each request. Only the proxy enforces the restrictions, reva
+// ignores the scope and the minted reva token grants the full access of the user.
+package restrictions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	authpb "github.com/cs3org/go-cs3apis/cs3/auth/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+)
+
+// ScopeKey is the key of the restrictions in the token scopes. It doesn't match any of the
+// scopes reva verifies itself, so reva keeps granting access based on the owner scope. The
+// scope has no role, the restrictions are only evaluated by Check.
+const ScopeKey = "appauth"
+
+const (
+	// APIWebDAV allows the webdav endpoints
+	APIWebDAV = "webdav"
+	// APIGraph allows the graph endpoints
+	APIGraph = "graph"
+	// APIOCS allows the ocs endpoints
+	APIOCS = "ocs"
+)
+
+var (
+	// ErrDenied is returned when the restrictions don't allow a request
+	ErrDenied = errors.New("denied by the app token restrictions")
+
+	apiPrefixes = map[string][]string{
+		APIWebDAV: {"/dav/", "/remote.php/dav/", "/webdav", "/remote.php/webdav"},
+		APIGraph:  {"/graph/"},
+		APIOCS:    {"/ocs/"},
+	}
+
+	// readOnlyMethods are the methods that don't change any resources
+	readOnlyMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, "PROPFIND", "REPORT", "SEARCH"}
+
+	// searchMethods are the methods that search the resources of all spaces of the user,
+	// the url of the request doesn't limit the search to the space it addresses
+	searchMethods = []string{"REPORT", "SEARCH"}
+)
+
+// Restrictions limit the access of an app token. The zero value doesn't restrict anything.
+type Restrictions struct {
+	// Spaces are the ids of the spaces the token can access
+	Spaces []string `json:"spaces,omitempty"`
+	// Path is the path prefix inside of the spaces the token can access
+	Path string `json:"path,omitempty"`
+	// ReadOnly only allows requests which don't change resources
+	ReadOnly bool `json:"read_only,omitempty"`
+	// APIs are the APIs the token can be used for
+	APIs []string `json:"apis,omitempty"`
+	// IPRanges are the networks in CIDR notation the token can be used from
+	IPRanges []string `json:"ip_ranges,omitempty"`
+}
+
+// IsZero returns true when the restrictions don't restrict anything.
+func (r Restrictions) IsZero() bool {
+	return len(r.Spaces) == 0 && r.Path == "" && !r.ReadOnly && len(r.APIs) == 0 && len(r.IPRanges) == 0
+}
+
+// Validate checks that the restrictions are well-formed.
+func (r Restrictions) Validate() error {
+	for _, api := range r.APIs {
+		if _, ok := apiPrefixes[api]; !ok {
+			return fmt.Errorf("unknown api '%s', supported are '%s', '%s' and '%s'", api, APIWebDAV, APIGraph, APIOCS)
+		}
+	}
+	for _, ipRange := range r.IPRanges {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			return fmt.Errorf("invalid ip range '%s': %w", ipRange, err)
+		}
+	}
+	if r.Path != "" && !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("the path '%s' must be absolute", r.Path)
+	}
+	for _, space := range r.Spaces {
+		if space == "" {
+			return errors.New("the space id must not be empty")
+		}
+	}
+	return nil
+}
+
+// AddScope adds the restrictions to the token scopes. Zero restrictions are not added.
+func AddScope(r Restrictions, scopes map[string]*authpb.Scope) (map[string]*authpb.Scope, error) {
+	if r.IsZero() {
+		return scopes, nil
+	}
+	val, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	if scopes == nil {
+		scopes = make(map[string]*authpb.Scope)
+	}
+	scopes[ScopeKey] = &authpb.Scope{
+		Resource: &types.OpaqueEntry{
+			Decoder: "json",
+			Value:   val,
+		},
+	}
+	return scopes, nil
+}
+
+// FromScopes reads the restrictions from the token scopes. It returns zero restrictions
+// when the scopes don't contain any.
+func FromScopes(scopes map[string]*authpb.Scope) (Restrictions, error) {
+	r := Restrictions{}
+	s, ok := scopes[ScopeKey]
+	if !ok {
+		return r, nil
+	}
+	if err := json.Unmarshal(s.GetResource().GetValue(), &r); err != nil {
+		return r, fmt.Errorf("could not decode the app token restrictions: %w", err)
+	}
+	return r, nil
+}
+
+// Check returns ErrDenied when the restrictions don't allow the request sent from clientIP.
+func (r Restrictions) Check(req *http.Request, clientIP string) error {
+	if len(r.IPRanges) > 0 && !r.allowedIP(clientIP) {
+		return fmt.Errorf("%w: the client ip %s is not allowed", ErrDenied, clientIP)
+	}
+
+	if len(r.APIs) > 0 && !r.allowedAPI(req.URL.Path) {
+		return fmt.Errorf("%w: the api is not allowed", ErrDenied)
+	}
+
+	if r.ReadOnly && !slices.Contains(readOnlyMethods, req.Method) {
+		return fmt.Errorf("%w: the token is read-only", ErrDenied)
+	}
+
+	if len(r.Spaces) > 0 || r.Path != "" {
+		if slices.Contains(searchMethods, req.Method) {
+			return fmt.Errorf("%w: searches are not limited to the allowed resources", ErrDenied)
+		}
+		if !r.allowedResource(req.URL.Path) {
+			return fmt.Errorf("%w: the resource is not allowed", ErrDenied)
+		}
+		// copies and moves must not leave the allowed resources
+		if dst := req.Header.Get("Destination"); dst != "" {
+			if !r.allowedResource(destinationPath(dst)) {
+				return fmt.Errorf("%w: the destination is not allowed", ErrDenied)
+			}
+		}
+	}
+	return nil
+}
+
+func (r Restrictions) allowedIP(clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, ipRange := range r.IPRanges {
+		if _, network, err := net.ParseCIDR(ipRange); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Restrictions) allowedAPI(p string) bool {
+	for _, api := range r.APIs {
+		for _, prefix := range apiPrefixes[api] {
+			if strings.HasPrefix(p, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allowedResource checks that the path addresses a resource in the allowed spaces below the
+// allowed path. Only webdav space urls and graph drive urls address spaces, all other urls are
+// denied because they can't be checked.
+func (r Restrictions) allowedResource(p string) bool {
+	id, resourcePath, ok := splitSpacePath(path.Clean(p))
+	if !ok {
+		return false
+	}
+	storageID, spaceID, nodeID, err := storagespace.SplitID(id)
+	if err != nil {
+		return false
+	}
+	if len(r.Spaces) > 0 && !r.allowedSpace(storageID, spaceID) {
+		return false
+	}
+	if r.Path == "" {
+		return true
+	}
+	// the path is relative to the node of the reference. Only the space root, whose node id is
+	// the space id, can be checked without resolving the node.
+	if nodeID != "" && nodeID != spaceID {
+		return false
+	}
+	prefix := path.Clean(r.Path)
+	resourcePath = path.Clean("/" + resourcePath)
+	return prefix == "/" || resourcePath == prefix || strings.HasPrefix(resourcePath, prefix+"/")
+}
+
+func (r Restrictions) allowedSpace(storageID, spaceID string) bool {
+	for _, space := range r.Spaces {
+		allowedStorageID, allowedSpaceID, _, err := storagespace.SplitID(space)
+		if err != nil {
+			continue
+		}
+		if allowedSpaceID == spaceID && (allowedStorageID == "" || allowedStorageID == storageID) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitSpacePath splits webdav space urls and graph drive urls into the space id and the
+// path inside of the space.
+func splitSpacePath(p string) (string, string, bool) {
+	for _, prefix := range []string{"/remote.php/dav/spaces/", "/dav/spaces/"} {
+		if rest, ok := strings.CutPrefix(p, prefix); ok {
+			id, resourcePath, _ := strings.Cut(rest, "/")
+			return id, resourcePath, id != ""
+		}
+	}
+	for _, prefix := range []string{"/graph/v1.0/drives/", "/graph/v1beta1/drives/"} {
+		if rest, ok := strings.CutPrefix(p, prefix); ok {
+			id, _, _ := strings.Cut(rest, "/")
+			// graph urls address resources by id, the path can't be checked
+			return id, "", id != ""
+		}
+	}
+	return "", "", false
+}
+
+// destinationPath returns the path of a webdav Destination header, which can be a full url.
+func destinationPath(dst string) string {
+	u, err := url.Parse(dst)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
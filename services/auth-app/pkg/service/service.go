@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	applications "github.com/cs3org/go-cs3apis/cs3/auth/applications/v1beta1"
@@ -17,6 +20,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/roles"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/restrictions"
 	settings "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
 	"github.com/opencloud-eu/reva/v2/pkg/appctx"
 	"github.com/opencloud-eu/reva/v2/pkg/auth/scope"
@@ -34,6 +38,9 @@ type AuthAppToken struct {
 	ExpirationDate time.Time `json:"expiration_date"`
 	CreatedDate    time.Time `json:"created_date"`
 	Label          string    `json:"label"`
+	// LastUsedDate is nil until the token is used for the first time
+	LastUsedDate *time.Time                 `json:"last_used_date,omitempty"`
+	Restrictions *restrictions.Restrictions `json:"restrictions,omitempty"`
 }
 
 // AuthAppService defines the service interface.
@@ -104,6 +111,13 @@ func (a *AuthAppService) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		label = "Generated via API"
 	}
 
+	restr, err := parseRestrictions(q)
+	if err != nil {
+		sublog.Info().Err(err).Msg("error parsing restrictions")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Impersonated request
 	userID, userName := q.Get("userID"), q.Get("userName")
 	if userID != "" || userName != "" {
@@ -143,6 +157,12 @@ func (a *AuthAppService) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	scopes, err = restrictions.AddScope(restr, scopes)
+	if err != nil {
+		sublog.Error().Err(err).Msg("error adding restrictions scope")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
 
 	res, err := gwc.GenerateAppPassword(ctx, &applications.GenerateAppPasswordRequest{
 		TokenScope: scopes,
@@ -323,11 +343,51 @@ func isAdmin(ctx context.Context, rm *roles.Manager) (bool, error) {
 	return rm.FindPermissionByID(ctx, roleIDs, settings.AccountManagementPermissionID) != nil, nil
 }
 
+// parseRestrictions reads the restrictions of a new token from the query. List values can be
+// given multiple times or comma separated.
+func parseRestrictions(q url.Values) (restrictions.Restrictions, error) {
+	r := restrictions.Restrictions{
+		Spaces:   splitList(q["spaces"]),
+		Path:     q.Get("path"),
+		APIs:     splitList(q["apis"]),
+		IPRanges: splitList(q["ipRanges"]),
+	}
+	if v := q.Get("readOnly"); v != "" {
+		readOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			return r, fmt.Errorf("error parsing readOnly: %w", err)
+		}
+		r.ReadOnly = readOnly
+	}
+	return r, r.Validate()
+}
+
+func splitList(values []string) []string {
+	var l []string
+	for _, v := range values {
+		for _, e := range strings.Split(v, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				l = append(l, e)
+			}
+		}
+	}
+	return l
+}
+
 func convert(ap *applications.AppPassword) AuthAppToken {
-	return AuthAppToken{
+	t := AuthAppToken{
 		Token:          ap.GetPassword(),
 		ExpirationDate: utils.TSToTime(ap.GetExpiration()),
 		CreatedDate:    utils.TSToTime(ap.GetCtime()),
 		Label:          ap.GetLabel(),
 	}
+	// the drivers initialize the update time with the creation time
+	if ap.GetUtime() != nil && utils.TSToTime(ap.GetUtime()).After(t.CreatedDate) {
+		lastUsed := utils.TSToTime(ap.GetUtime())
+		t.LastUsedDate = &lastUsed
+	}
+	if r, err := restrictions.FromScopes(ap.GetTokenScope()); err == nil && !r.IsZero() {
+		t.Restrictions = &r
+	}
+	return t
 }
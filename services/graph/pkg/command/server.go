@@ -47,7 +47,7 @@ func Server(cfg *config.Config) *cobra.Command {
 			mtrcs := metrics.New()
 			mtrcs.BuildInfo.WithLabelValues(version.GetString()).Set(1)
 
-			var kv jetstream.KeyValue
+			var kv, deltakv jetstream.KeyValue
 			// Allow to run without a NATS store (e.g. for the standalone Education provisioning service)
 			if len(cfg.Store.Nodes) > 0 {
 				//Connect to NATS servers
@@ -78,6 +78,16 @@ func Server(cfg *config.Config) *cobra.Command {
 						return fmt.Errorf("failed to create bucket (%s): %w", cfg.Store.Database, err)
 					}
 				}
+
+				// delta tokens live in their own bucket, old sync states expire with the bucket ttl
+				deltaBucket := cfg.Store.Database + "-delta"
+				deltakv, err = js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+					Bucket: deltaBucket,
+					TTL:    cfg.Spaces.DeltaTokenTTL,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create bucket (%s): %w", deltaBucket, err)
+				}
 			}
 
 			gr := runner.NewGroup()
@@ -89,6 +99,7 @@ func Server(cfg *config.Config) *cobra.Command {
 					http.Metrics(mtrcs),
 					http.TraceProvider(traceProvider),
 					http.NatsKeyValue(kv),
+					http.DeltaKeyValue(deltakv),
 				)
 				if err != nil {
 					logger.Error().Err(err).Str("transport", "http").Msg("Failed to initialize server")
@@ -34,6 +34,7 @@ type Config struct {
 	MaxConcurrency    int          `yaml:"max_concurrency" env:"OC_MAX_CONCURRENCY;GRAPH_MAX_CONCURRENCY" desc:"The maximum number of concurrent requests the service will handle." introductionVersion:"1.0.0"`
 
 	Keycloak       Keycloak       `yaml:"keycloak"`
+	SCIM           SCIM           `yaml:"scim"`
 	ServiceAccount ServiceAccount `yaml:"service_account"`
 
 	Context context.Context `yaml:"-"`
@@ -46,15 +47,19 @@ type Config struct {
 }
 
 type Spaces struct {
-	WebDavBase                      string `yaml:"webdav_base" env:"OC_URL;GRAPH_SPACES_WEBDAV_BASE" desc:"The public facing URL of WebDAV." introductionVersion:"1.0.0"`
-	WebDavPath                      string `yaml:"webdav_path" env:"GRAPH_SPACES_WEBDAV_PATH" desc:"The WebDAV sub-path for spaces." introductionVersion:"1.0.0"`
-	DefaultQuota                    string `yaml:"default_quota" env:"GRAPH_SPACES_DEFAULT_QUOTA" desc:"The default quota in bytes." introductionVersion:"1.0.0"`
-	ExtendedSpacePropertiesCacheTTL int    `yaml:"extended_space_properties_cache_ttl" env:"GRAPH_SPACES_EXTENDED_SPACE_PROPERTIES_CACHE_TTL" desc:"Max TTL in seconds for the spaces property cache." introductionVersion:"1.0.0"`
-	UsersCacheTTL                   int    `yaml:"users_cache_ttl" env:"GRAPH_SPACES_USERS_CACHE_TTL" desc:"Max TTL in seconds for the spaces users cache." introductionVersion:"1.0.0"`
-	GroupsCacheTTL                  int    `yaml:"groups_cache_ttl" env:"GRAPH_SPACES_GROUPS_CACHE_TTL" desc:"Max TTL in seconds for the spaces groups cache." introductionVersion:"1.0.0"`
-	StorageUsersAddress             string `yaml:"storage_users_address" env:"GRAPH_SPACES_STORAGE_USERS_ADDRESS" desc:"The address of the storage-users service." introductionVersion:"1.0.0"`
-	DefaultLanguage                 string `yaml:"default_language" env:"OC_DEFAULT_LANGUAGE" desc:"The default language used by services and the WebUI. If not defined, English will be used as default. See the documentation for more details." introductionVersion:"1.0.0"`
-	TranslationPath                 string `yaml:"translation_path" env:"OC_TRANSLATION_PATH;GRAPH_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
+	WebDavBase                      string        `yaml:"webdav_base" env:"OC_URL;GRAPH_SPACES_WEBDAV_BASE" desc:"The public facing URL of WebDAV." introductionVersion:"1.0.0"`
+	WebDavPath                      string        `yaml:"webdav_path" env:"GRAPH_SPACES_WEBDAV_PATH" desc:"The WebDAV sub-path for spaces." introductionVersion:"1.0.0"`
+	DefaultQuota                    string        `yaml:"default_quota" env:"GRAPH_SPACES_DEFAULT_QUOTA" desc:"The default quota in bytes." introductionVersion:"1.0.0"`
+	ExtendedSpacePropertiesCacheTTL int           `yaml:"extended_space_properties_cache_ttl" env:"GRAPH_SPACES_EXTENDED_SPACE_PROPERTIES_CACHE_TTL" desc:"Max TTL in seconds for the spaces property cache." introductionVersion:"1.0.0"`
+	UsersCacheTTL                   int           `yaml:"users_cache_ttl" env:"GRAPH_SPACES_USERS_CACHE_TTL" desc:"Max TTL in seconds for the spaces users cache." introductionVersion:"1.0.0"`
+	GroupsCacheTTL                  int           `yaml:"groups_cache_ttl" env:"GRAPH_SPACES_GROUPS_CACHE_TTL" desc:"Max TTL in seconds for the spaces groups cache." introductionVersion:"1.0.0"`
+	StorageUsersAddress             string        `yaml:"storage_users_address" env:"GRAPH_SPACES_STORAGE_USERS_ADDRESS" desc:"The address of the storage-users service." introductionVersion:"1.0.0"`
+	DefaultLanguage                 string        `yaml:"default_language" env:"OC_DEFAULT_LANGUAGE" desc:"The default language used by services and the WebUI. If not defined, English will be used as default. See the documentation for more details." introductionVersion:"1.0.0"`
+	TranslationPath                 string        `yaml:"translation_path" env:"OC_TRANSLATION_PATH;GRAPH_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
+	DeltaTokenTTL                   time.Duration `yaml:"delta_token_ttl" env:"GRAPH_SPACES_DELTA_TOKEN_TTL" desc:"The time a delta token returned by the 'delta' function stays valid after it was issued. Clients using an expired token must sync again from scratch. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	DeltaPageSize                   int           `yaml:"delta_page_size" env:"GRAPH_SPACES_DELTA_PAGE_SIZE" desc:"The maximum number of changed items returned in one page of the 'delta' function." introductionVersion:"%%NEXT%%"`
+	DeltaTokenRetention             int           `yaml:"delta_token_retention" env:"GRAPH_SPACES_DELTA_TOKEN_RETENTION" desc:"The number of delta tokens kept for every user and synced item. When more tokens are issued, the oldest ones expire before 'GRAPH_SPACES_DELTA_TOKEN_TTL' and clients using them must sync again from scratch." introductionVersion:"%%NEXT%%"`
+	DeltaListLimit                  int           `yaml:"delta_list_limit" env:"GRAPH_SPACES_DELTA_LIST_LIMIT" desc:"The maximum number of folders listed in one request of the 'delta' function. The remaining folders of larger trees are listed when the client follows the '@odata.nextLink'." introductionVersion:"%%NEXT%%"`
 }
 
 type LDAP struct {
@@ -123,6 +128,7 @@ type API struct {
 	AssignDefaultUserRole   bool   `yaml:"graph_assign_default_user_role" env:"GRAPH_ASSIGN_DEFAULT_USER_ROLE" desc:"Whether to assign newly created users the default role 'User'. Set this to 'false' if you want to assign roles manually, or if the role assignment should happen at first login. Set this to 'true' (the default) to assign the role 'User' when creating a new user." introductionVersion:"1.0.0"`
 	IdentitySearchMinLength int    `yaml:"graph_identity_search_min_length" env:"GRAPH_IDENTITY_SEARCH_MIN_LENGTH" desc:"The minimum length the search term needs to have for unprivileged users when searching for users or groups." introductionVersion:"1.0.0"`
 	ShowUserEmailInResults  bool   `yaml:"show_email_in_results" env:"OC_SHOW_USER_EMAIL_IN_RESULTS" desc:"Include user email addresses in responses. If absent or set to false emails will be omitted from results. Please note that admin users can always see all email addresses." introductionVersion:"1.0.0"`
+	BatchRequestLimit       int    `yaml:"batch_request_limit" env:"GRAPH_BATCH_REQUEST_LIMIT" desc:"The maximum number of requests allowed in a single JSON batch request to the '$batch' endpoint." introductionVersion:"%%NEXT%%"`
 }
 
 // Events combines the configuration options for the event bus.
@@ -154,6 +160,13 @@ type Keycloak struct {
 	InsecureSkipVerify bool   `yaml:"insecure_skip_verify" env:"OC_KEYCLOAK_INSECURE_SKIP_VERIFY;GRAPH_KEYCLOAK_INSECURE_SKIP_VERIFY" desc:"Disable TLS certificate validation for Keycloak connections. Do not set this in production environments." introductionVersion:"1.0.0"`
 }
 
+// SCIM configures the SCIM 2.0 provisioning endpoint
+type SCIM struct {
+	Enabled          bool   `yaml:"enabled" env:"GRAPH_SCIM_ENABLED" desc:"Enable the SCIM 2.0 provisioning endpoint at '/scim/v2'. It allows identity providers to create, update, disable and delete users and groups in the configured identity backend." introductionVersion:"%%NEXT%%"`
+	Token            string `yaml:"token" env:"GRAPH_SCIM_TOKEN" desc:"The bearer token SCIM clients have to send in the 'Authorization' header. Required when the SCIM endpoint is enabled." introductionVersion:"%%NEXT%%"`
+	ExternalIDIssuer string `yaml:"external_id_issuer" env:"GRAPH_SCIM_EXTERNAL_ID_ISSUER" desc:"The issuer used to store the SCIM 'externalId' of users as an identity of the user." introductionVersion:"%%NEXT%%"`
+}
+
 // ServiceAccount is the configuration for the used service account
 type ServiceAccount struct {
 	ServiceAccountID     string `yaml:"service_account_id" env:"OC_SERVICE_ACCOUNT_ID;GRAPH_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use. See the 'auth-service' service description for more details." introductionVersion:"1.0.0"`
@@ -62,6 +62,7 @@ func DefaultConfig() *config.Config {
 			UsernameMatch:           "default",
 			AssignDefaultUserRole:   true,
 			IdentitySearchMinLength: 3,
+			BatchRequestLimit:       20,
 		},
 		Reva: shared.DefaultRevaConfig(),
 		Spaces: config.Spaces{
@@ -74,7 +75,11 @@ func DefaultConfig() *config.Config {
 			// 1 minute
 			GroupsCacheTTL: 60,
 			// 1 minute
-			UsersCacheTTL: 60,
+			UsersCacheTTL:       60,
+			DeltaTokenTTL:       7 * 24 * time.Hour,
+			DeltaPageSize:       200,
+			DeltaTokenRetention: 10,
+			DeltaListLimit:      100,
 		},
 		Identity: config.Identity{
 			Backend: "ldap",
@@ -123,7 +128,8 @@ func DefaultConfig() *config.Config {
 		},
 		MaxConcurrency: 20,
 		UnifiedRoles: config.UnifiedRoles{
-			AvailableRoles: nil, // will be populated with defaults in EnsureDefaults
+			AvailableRoles:            nil, // will be populated with defaults in EnsureDefaults
+			CustomRolesReloadInterval: time.Minute,
 		},
 		Metadata: config.Metadata{
 			GatewayAddress: "eu.opencloud.api.storage-system",
@@ -131,6 +137,9 @@ func DefaultConfig() *config.Config {
 			SystemUserIDP:  "internal",
 		},
 		UserSoftDeleteRetentionTime: 0,
+		SCIM: config.SCIM{
+			ExternalIDIssuer: "scim",
+		},
 		Store: config.Store{
 			Nodes:    []string{"127.0.0.1:9233"},
 			Database: "graph",
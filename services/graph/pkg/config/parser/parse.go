@@ -75,6 +75,11 @@ func Validate(cfg *config.Config) error {
 			"graph", defaults2.BaseConfigPath())
 	}
 
+	if cfg.SCIM.Enabled && cfg.SCIM.Token == "" {
+		return fmt.Errorf("The SCIM token has not been configured for %s. "+
+			"Set GRAPH_SCIM_TOKEN when enabling the SCIM endpoint.", "graph")
+	}
+
 	if cfg.ServiceAccount.ServiceAccountID == "" {
 		return shared.MissingServiceAccountID(cfg.Service.Name)
 	}
@@ -1,6 +1,9 @@
 package config
 
+import "time"
+
 // UnifiedRoles contains all settings related to unified roles.
 type UnifiedRoles struct {
-	AvailableRoles []string `yaml:"available_roles" env:"GRAPH_AVAILABLE_ROLES" desc:"A comma separated list of roles that are available for assignment." introductionVersion:"1.0.0"`
+	AvailableRoles            []string      `yaml:"available_roles" env:"GRAPH_AVAILABLE_ROLES" desc:"A comma separated list of roles that are available for assignment." introductionVersion:"1.0.0"`
+	CustomRolesReloadInterval time.Duration `yaml:"custom_roles_reload_interval" env:"GRAPH_CUSTOM_ROLES_RELOAD_INTERVAL" desc:"The interval in which the custom sharing roles are reloaded from the settings service. Changes made through another graph instance become visible after this interval. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
 }
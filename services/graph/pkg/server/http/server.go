@@ -89,6 +89,7 @@ func Server(opts ...Option) (http.Service, error) {
 	var requireAdminMiddleware func(stdhttp.Handler) stdhttp.Handler
 	var roleService svc.RoleService
 	var valueService settingssvc.ValueService
+	var bundleService settingssvc.BundleService
 	var gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 	grpcClient, err := grpc.NewClient(append(grpc.GetClientOptions(options.Config.GRPCClientTLS), grpc.WithTraceProvider(options.TraceProvider))...)
 	if err != nil {
@@ -102,6 +103,7 @@ func Server(opts ...Option) (http.Service, error) {
 			))
 		roleService = settingssvc.NewRoleService("eu.opencloud.api.settings", grpcClient)
 		valueService = settingssvc.NewValueService("eu.opencloud.api.settings", grpcClient)
+		bundleService = settingssvc.NewBundleService("eu.opencloud.api.settings", grpcClient)
 		gatewaySelector, err = pool.GatewaySelector(
 			options.Config.Reva.Address,
 			append(
@@ -172,6 +174,7 @@ func Server(opts ...Option) (http.Service, error) {
 		svc.EventsConsumer(eventsStream),
 		svc.WithRoleService(roleService),
 		svc.WithValueService(valueService),
+		svc.WithBundleService(bundleService),
 		svc.WithRequireAdminMiddleware(requireAdminMiddleware),
 		svc.WithGatewaySelector(gatewaySelector),
 		svc.WithSearchService(searchsvc.NewSearchProviderService("eu.opencloud.api.search", grpcClient)),
@@ -179,6 +182,7 @@ func Server(opts ...Option) (http.Service, error) {
 		svc.EventHistoryClient(hClient),
 		svc.TraceProvider(options.TraceProvider),
 		svc.WithNatsKeyValue(options.NatsKeyValue),
+		svc.WithDeltaKeyValue(options.DeltaKeyValue),
 	)
 
 	if err != nil {
@@ -97,7 +97,6 @@ func NewDriveItemPermissionsService(logger log.Logger, gatewaySelector pool.Sele
 			gatewaySelector: gatewaySelector,
 			identityCache:   identityCache,
 			config:          config,
-			availableRoles:  unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(config.UnifiedRoles.AvailableRoles...)),
 		},
 	}, nil
 }
@@ -123,8 +122,8 @@ func (s DriveItemPermissionsService) Invite(ctx context.Context, resourceId *sto
 
 	unifiedRolePermissions := []*libregraph.UnifiedRolePermission{{AllowedResourceActions: invite.LibreGraphPermissionsActions}}
 	for _, roleID := range invite.GetRoles() {
-		// only allow roles that are enabled in the config
-		if !slices.Contains(s.config.UnifiedRoles.AvailableRoles, roleID) {
+		// only allow roles that are enabled in the config and custom roles
+		if !slices.Contains(availableRoleIDs(s.config), roleID) {
 			return libregraph.Permission{}, unifiedrole.ErrUnknownRole
 		}
 
@@ -139,7 +138,7 @@ func (s DriveItemPermissionsService) Invite(ctx context.Context, resourceId *sto
 			return libregraph.Permission{}, errorcode.New(errorcode.InvalidRequest, "role not applicable to this resource")
 		}
 
-		unifiedRolePermissions = append(unifiedRolePermissions, conversions.ToPointerSlice(role.GetRolePermissions())...)
+		unifiedRolePermissions = append(unifiedRolePermissions, unifiedrole.GetRolePermissions(role, condition)...)
 	}
 
 	driveRecipient := invite.GetRecipients()[0]
@@ -148,8 +147,7 @@ func (s DriveItemPermissionsService) Invite(ctx context.Context, resourceId *sto
 	cs3ResourcePermissions := unifiedrole.PermissionsToCS3ResourcePermissions(unifiedRolePermissions)
 
 	permission := &libregraph.Permission{}
-	availableRoles := unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(s.config.UnifiedRoles.AvailableRoles...))
-	if role := unifiedrole.CS3ResourcePermissionsToRole(availableRoles, cs3ResourcePermissions, condition, false); role != nil {
+	if role := unifiedrole.CS3ResourcePermissionsToRole(s.availableRoles(), cs3ResourcePermissions, condition, false); role != nil {
 		permission.Roles = []string{role.GetId()}
 	}
 
@@ -383,7 +381,7 @@ func (s DriveItemPermissionsService) ListPermissions(ctx context.Context, itemID
 	if len(queryOptions.SelectedAttrs) == 0 || slices.Contains(queryOptions.SelectedAttrs, "@libre.graph.permissions.roles.allowedValues") {
 		collectionOfPermissions.LibreGraphPermissionsRolesAllowedValues = conversions.ToValueSlice(
 			unifiedrole.GetRolesByPermissions(
-				unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(s.config.UnifiedRoles.AvailableRoles...)),
+				s.availableRoles(),
 				allowedActions,
 				condition,
 				queryOptions.FilterFederatedRoles,
@@ -670,7 +668,7 @@ func (api DriveItemPermissionsApi) Invite(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), api.config.UnifiedRoles.AvailableRoles)
+	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), availableRoleIDs(api.config))
 	if err = validate.StructCtx(ctx, driveItemInvite); err != nil {
 		api.logger.Debug().Err(err).Interface("Body", r.Body).Msg("invalid request body")
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
@@ -703,7 +701,7 @@ func (api DriveItemPermissionsApi) SpaceRootInvite(w http.ResponseWriter, r *htt
 		return
 	}
 
-	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), api.config.UnifiedRoles.AvailableRoles)
+	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), availableRoleIDs(api.config))
 	if err = validate.StructCtx(ctx, driveItemInvite); err != nil {
 		api.logger.Debug().Err(err).Interface("Body", r.Body).Msg("invalid request body")
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
@@ -48,7 +48,11 @@ type BaseGraphService struct {
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 	identityCache   cache.IdentityCache
 	config          *config.Config
-	availableRoles  []*libregraph.UnifiedRoleDefinition
+}
+
+// availableRoles returns the roles enabled in the config and the custom roles
+func (g BaseGraphService) availableRoles() []*libregraph.UnifiedRoleDefinition {
+	return unifiedrole.GetRoles(availableRolesFilter(g.config))
 }
 
 func (g BaseGraphService) getSpaceRootPermissions(ctx context.Context, spaceID *storageprovider.StorageSpaceId, countOnly bool) ([]libregraph.Permission, int, error) {
@@ -90,7 +94,7 @@ func (g BaseGraphService) CS3ReceivedSharesToDriveItems(ctx context.Context, rec
 		return nil, err
 	}
 
-	return cs3ReceivedSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, receivedShares, g.availableRoles)
+	return cs3ReceivedSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, receivedShares, g.availableRoles())
 }
 
 func (g BaseGraphService) CS3ReceivedOCMSharesToDriveItems(ctx context.Context, receivedShares []*ocm.ReceivedShare) ([]libregraph.DriveItem, error) {
@@ -99,7 +103,7 @@ func (g BaseGraphService) CS3ReceivedOCMSharesToDriveItems(ctx context.Context,
 		return nil, err
 	}
 
-	return cs3ReceivedOCMSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, receivedShares, g.availableRoles)
+	return cs3ReceivedOCMSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, receivedShares, g.availableRoles())
 }
 
 func (g BaseGraphService) cs3SpacePermissionsToLibreGraph(ctx context.Context, space *storageprovider.StorageSpace, countOnly bool, apiVersion APIVersion) ([]libregraph.Permission, int) {
@@ -205,7 +209,7 @@ func (g BaseGraphService) cs3SpacePermissionsToLibreGraph(ctx context.Context, s
 		}
 
 		if role := unifiedrole.CS3ResourcePermissionsToRole(
-			g.availableRoles,
+			g.availableRoles(),
 			perm,
 			unifiedrole.UnifiedRoleConditionDrive,
 			false,
@@ -608,7 +612,7 @@ func (g BaseGraphService) cs3UserShareToPermission(ctx context.Context, share *c
 		perm.SetCreatedDateTime(cs3TimestampToTime(share.GetCtime()))
 	}
 	role := unifiedrole.CS3ResourcePermissionsToRole(
-		g.availableRoles,
+		g.availableRoles(),
 		share.GetPermissions().GetPermissions(),
 		roleCondition,
 		false,
@@ -697,7 +701,7 @@ func (g BaseGraphService) cs3OCMShareToPermission(ctx context.Context, share *oc
 	}
 
 	role := unifiedrole.CS3ResourcePermissionsToRole(
-		g.availableRoles,
+		g.availableRoles(),
 		permissions,
 		roleCondition,
 		true,
@@ -0,0 +1,215 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+)
+
+// batchRequest is a single request of a JSON batch as described in
+// https://docs.oasis-open.org/odata/odata-json-format/v4.01/odata-json-format-v4.01.html#sec_BatchRequestsandResponses
+type batchRequest struct {
+	ID        string            `json:"id"`
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Body      json.RawMessage   `json:"body,omitempty"`
+	DependsOn []string          `json:"dependsOn,omitempty"`
+}
+
+type batchResponse struct {
+	ID      string            `json:"id"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+type batchRequests struct {
+	Requests []batchRequest `json:"requests"`
+}
+
+type batchResponses struct {
+	Responses []batchResponse `json:"responses"`
+}
+
+var (
+	// the headers that carry the identity of the caller, sub requests can't override them
+	batchIdentityHeaders = []string{"Authorization", "X-Access-Token"}
+
+	// the API versions the requests of a batch can address
+	batchAPIVersions = []string{"v1.0", "v1beta1"}
+
+	// matches the API version a url starts with
+	batchVersionRegex = regexp.MustCompile(`^/(v\d[^/?]*)`)
+)
+
+// Batch runs the requests of a JSON batch against the graph API and returns their responses.
+// Each request passes the same middlewares as a single request with the identity of the caller.
+// Requests are run concurrently unless they depend on another request of the batch.
+// Their urls are relative to the API version of the batch unless they start with another version.
+func (g Graph) Batch(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	logger.Info().Msg("calling batch")
+
+	version, _, err := batchURL("", strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(g.config.HTTP.Root, "/")))
+	if err != nil || version == "" {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "unsupported API version")
+		return
+	}
+
+	var batch batchRequests
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+		return
+	}
+	if err := g.validateBatch(version, batch.Requests); err != nil {
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	responses := make([]batchResponse, len(batch.Requests))
+	done := make(map[string]chan struct{}, len(batch.Requests))
+	index := make(map[string]int, len(batch.Requests))
+	for i, req := range batch.Requests {
+		done[req.ID] = make(chan struct{})
+		index[req.ID] = i
+	}
+
+	for i, req := range batch.Requests {
+		go func() {
+			defer close(done[req.ID])
+			for _, dep := range req.DependsOn {
+				<-done[dep]
+				if status := responses[index[dep]].Status; status < 200 || status > 299 {
+					responses[i] = batchFailedDependency(r.Context(), req.ID, dep)
+					return
+				}
+			}
+			responses[i] = g.runBatchRequest(r, version, req)
+		}()
+	}
+	for _, c := range done {
+		<-c
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &batchResponses{Responses: responses})
+}
+
+func (g Graph) validateBatch(version string, requests []batchRequest) error {
+	if len(requests) == 0 {
+		return errorcode.New(errorcode.InvalidRequest, "the batch contains no requests")
+	}
+	if len(requests) > g.config.API.BatchRequestLimit {
+		return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("the batch is limited to %d requests", g.config.API.BatchRequestLimit))
+	}
+
+	seen := make(map[string]struct{}, len(requests))
+	for _, req := range requests {
+		switch {
+		case req.ID == "":
+			return errorcode.New(errorcode.InvalidRequest, "a request of the batch has no id")
+		case req.Method == "":
+			return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("request %s has no method", req.ID))
+		case !strings.HasPrefix(req.URL, "/"):
+			return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("request %s must use a url relative to the service root", req.ID))
+		}
+		_, rest, err := batchURL(version, req.URL)
+		switch {
+		case err != nil:
+			return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("request %s: %s", req.ID, err.Error()))
+		case strings.HasPrefix(strings.ToLower(rest), "/$batch"):
+			return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("request %s must not be a batch request", req.ID))
+		}
+		if _, ok := seen[req.ID]; ok {
+			return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("the request id %s is not unique", req.ID))
+		}
+		// requests can only depend on requests listed before them, which rules out cycles
+		for _, dep := range req.DependsOn {
+			if _, ok := seen[dep]; !ok {
+				return errorcode.New(errorcode.InvalidRequest, fmt.Sprintf("request %s depends on %s which is not listed before it", req.ID, dep))
+			}
+		}
+		seen[req.ID] = struct{}{}
+	}
+	return nil
+}
+
+func (g Graph) runBatchRequest(r *http.Request, version string, req batchRequest) batchResponse {
+	// reset the route context of the batch request, chi would reuse it otherwise
+	ctx := context.WithValue(r.Context(), chi.RouteCtxKey, nil)
+	version, rest, _ := batchURL(version, req.URL)
+	sub, err := http.NewRequestWithContext(ctx, strings.ToUpper(req.Method), strings.TrimSuffix(g.config.HTTP.Root, "/")+"/"+version+rest, bytes.NewReader(req.Body))
+	if err != nil {
+		return batchResponse{ID: req.ID, Status: http.StatusBadRequest, Body: batchErrorBody(ctx, errorcode.InvalidRequest, err.Error())}
+	}
+	for k, v := range req.Headers {
+		sub.Header.Set(k, v)
+	}
+	if len(req.Body) > 0 && sub.Header.Get("Content-Type") == "" {
+		sub.Header.Set("Content-Type", "application/json")
+	}
+	for _, h := range batchIdentityHeaders {
+		sub.Header.Del(h)
+		if v := r.Header.Get(h); v != "" {
+			sub.Header.Set(h, v)
+		}
+	}
+	sub.RemoteAddr = r.RemoteAddr
+
+	rec := httptest.NewRecorder()
+	g.mux.ServeHTTP(rec, sub)
+
+	res := batchResponse{ID: req.ID, Status: rec.Code}
+	if len(rec.Header()) > 0 {
+		res.Headers = make(map[string]string, len(rec.Header()))
+		for k := range rec.Header() {
+			res.Headers[k] = rec.Header().Get(k)
+		}
+	}
+	if b := bytes.TrimSpace(rec.Body.Bytes()); len(b) > 0 {
+		if json.Valid(b) {
+			res.Body = b
+		} else {
+			res.Body, _ = json.Marshal(string(b))
+		}
+	}
+	return res
+}
+
+// batchURL splits the url into the API version it addresses and the rest of the url. Urls without
+// a version address the given one.
+func batchURL(version, url string) (string, string, error) {
+	m := batchVersionRegex.FindStringSubmatch(url)
+	if m == nil {
+		return version, url, nil
+	}
+	if !slices.Contains(batchAPIVersions, m[1]) {
+		return "", "", fmt.Errorf("the API version %s is not supported", m[1])
+	}
+	return m[1], url[len(m[0]):], nil
+}
+
+func batchFailedDependency(ctx context.Context, id, dep string) batchResponse {
+	return batchResponse{
+		ID:     id,
+		Status: http.StatusFailedDependency,
+		Body:   batchErrorBody(ctx, errorcode.GeneralException, fmt.Sprintf("the request %s this request depends on failed", dep)),
+	}
+}
+
+func batchErrorBody(ctx context.Context, code errorcode.ErrorCode, msg string) json.RawMessage {
+	b, _ := json.Marshal(code.CreateOdataError(ctx, msg))
+	return b
+}
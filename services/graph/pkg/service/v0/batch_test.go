@@ -0,0 +1,159 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/stretchr/testify/mock"
+
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	identitymocks "github.com/opencloud-eu/opencloud/services/graph/pkg/identity/mocks"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+type batchResponses struct {
+	Responses []struct {
+		ID     string          `json:"id"`
+		Status int             `json:"status"`
+		Body   json.RawMessage `json:"body"`
+	} `json:"responses"`
+}
+
+var _ = Describe("Batch", func() {
+	var (
+		svc             service.Service
+		ctx             context.Context
+		identityBackend *identitymocks.Backend
+
+		currentUser = &userpb.User{
+			Id: &userpb.UserId{
+				OpaqueId: "user",
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		identityBackend = &identitymocks.Backend{}
+		ctx = revactx.ContextSetUser(context.Background(), currentUser)
+
+		cfg := defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.API.BatchRequestLimit = 3
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.WithIdentityBackend(identityBackend),
+			service.WithRequireAdminMiddleware(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+				})
+			}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	batch := func(body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/graph/v1.0/$batch", bytes.NewBufferString(body)).WithContext(ctx)
+		svc.ServeHTTP(rr, r)
+		return rr
+	}
+
+	It("runs the requests and returns their responses in order", func() {
+		group := libregraph.NewGroup()
+		group.SetId("group1")
+		group.SetDisplayName("Group 1")
+		identityBackend.On("GetGroup", mock.Anything, "group1", mock.Anything).Return(group, nil)
+
+		rr := batch(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/groups/group1"},
+			{"id": "2", "method": "POST", "url": "/groups", "body": {"displayName": "new"}},
+			{"id": "3", "method": "GET", "url": "/groups/group1", "dependsOn": ["2"]}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := batchResponses{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res.Responses).To(HaveLen(3))
+
+		Expect(res.Responses[0].ID).To(Equal("1"))
+		Expect(res.Responses[0].Status).To(Equal(http.StatusOK))
+		g := libregraph.Group{}
+		Expect(json.Unmarshal(res.Responses[0].Body, &g)).To(Succeed())
+		Expect(g.GetDisplayName()).To(Equal("Group 1"))
+
+		// the admin middleware applies to the single requests
+		Expect(res.Responses[1].Status).To(Equal(http.StatusForbidden))
+		Expect(res.Responses[2].Status).To(Equal(http.StatusFailedDependency))
+		identityBackend.AssertNumberOfCalls(GinkgoT(), "GetGroup", 1)
+	})
+
+	It("runs requests addressing another API version", func() {
+		group := libregraph.NewGroup()
+		group.SetId("group1")
+		identityBackend.On("GetGroup", mock.Anything, "group1", mock.Anything).Return(group, nil)
+
+		rr := batch(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/v1.0/groups/group1"},
+			{"id": "2", "method": "GET", "url": "/v1beta1/groups/group1"}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := batchResponses{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res.Responses).To(HaveLen(2))
+		Expect(res.Responses[0].Status).To(Equal(http.StatusOK))
+		// the group routes only exist in v1.0
+		Expect(res.Responses[1].Status).To(Equal(http.StatusNotFound))
+	})
+
+	It("runs the requests of a v1beta1 batch against v1beta1", func() {
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/graph/v1beta1/$batch", bytes.NewBufferString(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/groups"}
+		]}`)).WithContext(ctx)
+		svc.ServeHTTP(rr, r)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := batchResponses{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res.Responses).To(HaveLen(1))
+		Expect(res.Responses[0].Status).To(Equal(http.StatusNotFound))
+		identityBackend.AssertNotCalled(GinkgoT(), "GetGroups", mock.Anything, mock.Anything)
+	})
+
+	DescribeTable("rejects invalid batches",
+		func(body string) {
+			rr := batch(body)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		},
+		Entry("no json", `requests`),
+		Entry("empty", `{"requests": []}`),
+		Entry("too many requests", `{"requests": [
+			{"id": "1", "method": "GET", "url": "/me"},
+			{"id": "2", "method": "GET", "url": "/me"},
+			{"id": "3", "method": "GET", "url": "/me"},
+			{"id": "4", "method": "GET", "url": "/me"}
+		]}`),
+		Entry("duplicate ids", `{"requests": [{"id": "1", "method": "GET", "url": "/me"}, {"id": "1", "method": "GET", "url": "/me"}]}`),
+		Entry("unknown dependency", `{"requests": [{"id": "1", "method": "GET", "url": "/me", "dependsOn": ["2"]}, {"id": "2", "method": "GET", "url": "/me"}]}`),
+		Entry("absolute url", `{"requests": [{"id": "1", "method": "GET", "url": "https://example.com/me"}]}`),
+		Entry("nested batch", `{"requests": [{"id": "1", "method": "POST", "url": "/$batch"}]}`),
+		Entry("nested versioned batch", `{"requests": [{"id": "1", "method": "POST", "url": "/v1beta1/$batch"}]}`),
+		Entry("unsupported API version", `{"requests": [{"id": "1", "method": "GET", "url": "/v2.0/me"}]}`),
+	)
+})
@@ -0,0 +1,162 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/middleware"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/store/defaults"
+)
+
+// customRoleSettingName is the name of the settings holding a custom role in the custom roles bundle
+const customRoleSettingName = "unified-role"
+
+// customRolesWriteMu serializes the changes of the custom roles bundle
+var customRolesWriteMu sync.Mutex
+
+// availableRolesFilter matches the roles enabled in the config and the custom roles
+func availableRolesFilter(cfg *config.Config) unifiedrole.RoleFilter {
+	return unifiedrole.RoleFilterAny(
+		unifiedrole.RoleFilterIDs(cfg.UnifiedRoles.AvailableRoles...),
+		unifiedrole.RoleFilterCustom(),
+	)
+}
+
+// availableRoleIDs returns the ids of the roles enabled in the config and of the custom roles
+func availableRoleIDs(cfg *config.Config) []string {
+	ids := slices.Clone(cfg.UnifiedRoles.AvailableRoles)
+	for _, role := range unifiedrole.GetCustomRoles() {
+		ids = append(ids, role.GetId())
+	}
+	return ids
+}
+
+// customRolesContext returns a context which makes the settings service treat the request as coming from the
+// service account, only it is allowed to read the custom roles bundle.
+func (g Graph) customRolesContext(ctx context.Context) context.Context {
+	return metadata.NewContext(ctx, metadata.Metadata{
+		middleware.AccountID: g.config.ServiceAccount.ServiceAccountID,
+	})
+}
+
+// readCustomRoles reads the custom roles from the settings service
+func (g Graph) readCustomRoles(ctx context.Context) ([]*libregraph.UnifiedRoleDefinition, error) {
+	res, err := g.bundleService.GetBundle(g.customRolesContext(ctx), &settingssvc.GetBundleRequest{
+		BundleId: defaults.BundleUUIDUnifiedRoles,
+	})
+	switch {
+	case err == nil:
+		// continue
+	case merrors.FromError(err).GetCode() == http.StatusNotFound:
+		// no custom roles were created yet, or all of them were deleted
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	roles := make([]*libregraph.UnifiedRoleDefinition, 0, len(res.GetBundle().GetSettings()))
+	for _, setting := range res.GetBundle().GetSettings() {
+		role := &libregraph.UnifiedRoleDefinition{}
+		if err := json.Unmarshal([]byte(setting.GetStringValue().GetDefault()), role); err != nil {
+			g.logger.Error().Err(err).Str("roleID", setting.GetId()).Msg("could not decode custom role")
+			continue
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// saveCustomRoles writes the custom roles to the settings service, each role is stored as setting of the custom roles bundle
+func (g Graph) saveCustomRoles(ctx context.Context, roles []*libregraph.UnifiedRoleDefinition) error {
+	settings := make([]*settingsmsg.Setting, 0, len(roles))
+	for _, role := range roles {
+		b, err := json.Marshal(role)
+		if err != nil {
+			return err
+		}
+		settings = append(settings, &settingsmsg.Setting{
+			Id:          role.GetId(),
+			Name:        customRoleSettingName,
+			DisplayName: role.GetDisplayName(),
+			Description: role.GetDescription(),
+			Resource: &settingsmsg.Resource{
+				Type: settingsmsg.Resource_TYPE_SYSTEM,
+			},
+			Value: &settingsmsg.Setting_StringValue{
+				StringValue: &settingsmsg.String{
+					Default: string(b),
+				},
+			},
+		})
+	}
+
+	_, err := g.bundleService.SaveBundle(g.customRolesContext(ctx), &settingssvc.SaveBundleRequest{
+		Bundle: &settingsmsg.Bundle{
+			Id:          defaults.BundleUUIDUnifiedRoles,
+			Name:        "unified-roles",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Extension:   "opencloud-graph",
+			DisplayName: "Custom Sharing Roles",
+			Resource: &settingsmsg.Resource{
+				Type: settingsmsg.Resource_TYPE_SYSTEM,
+			},
+			Settings: settings,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	unifiedrole.SetCustomRoles(roles)
+	return nil
+}
+
+// removeCustomRole removes the custom role from the settings service
+func (g Graph) removeCustomRole(ctx context.Context, roleID string) error {
+	_, err := g.bundleService.RemoveSettingFromBundle(g.customRolesContext(ctx), &settingssvc.RemoveSettingFromBundleRequest{
+		BundleId:  defaults.BundleUUIDUnifiedRoles,
+		SettingId: roleID,
+	})
+	if err != nil {
+		return err
+	}
+
+	unifiedrole.SetCustomRoles(slices.DeleteFunc(unifiedrole.GetCustomRoles(), func(role *libregraph.UnifiedRoleDefinition) bool {
+		return role.GetId() == roleID
+	}))
+	return nil
+}
+
+// syncCustomRoles loads the custom roles and reloads them in the configured interval to pick up
+// the changes made through other graph instances. It returns when the context is done.
+func (g Graph) syncCustomRoles(ctx context.Context) {
+	ticker := time.NewTicker(g.config.UnifiedRoles.CustomRolesReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		roles, err := g.readCustomRoles(ctx)
+		if err != nil {
+			g.logger.Warn().Err(err).Msg("could not load custom roles")
+		} else {
+			unifiedrole.SetCustomRoles(roles)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,554 @@
+package svc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	cs3rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	storageprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+)
+
+const (
+	// _deltaChunkSize is the maximum size of a single value written to the delta store,
+	// it stays well below the default max payload of nats.
+	_deltaChunkSize = 512 * 1024
+
+	// _deltaMaxConflicts is the number of times the list of issued states is read again when
+	// another request changed it in the meantime
+	_deltaMaxConflicts = 5
+)
+
+var errDeltaStateNotFound = errors.New("delta state not found")
+
+// deltaEntry is the state of a single item at the time a delta token was issued. The name
+// is kept as renaming an item doesn't change its etag.
+type deltaEntry struct {
+	ETag   string `json:"e"`
+	Name   string `json:"n,omitempty"`
+	Parent string `json:"p,omitempty"`
+	Folder bool   `json:"f,omitempty"`
+}
+
+// deltaState is stored for every issued delta token. It contains the snapshot of
+// the synced tree and the changes that are paged to the client. The walk of large trees
+// is split across requests, a state with pending folders is continued by the next request.
+type deltaState struct {
+	User    string                  `json:"user"`
+	Root    string                  `json:"root"`
+	Items   map[string]deltaEntry   `json:"items"`
+	Changes []*libregraph.DriveItem `json:"changes"`
+	// Base is the id of the state the changes are computed against
+	Base string `json:"base,omitempty"`
+	// Pending contains the folders that still have to be listed
+	Pending []string `json:"pending,omitempty"`
+}
+
+// deltaToken is the opaque token handed out in the nextLink and deltaLink. It points to
+// a stored state and the number of changes the client has already received.
+type deltaToken struct {
+	ID   string `json:"id"`
+	Skip int    `json:"skip"`
+}
+
+type deltaResponse struct {
+	Value     []*libregraph.DriveItem `json:"value"`
+	NextLink  string                  `json:"@odata.nextLink,omitempty"`
+	DeltaLink string                  `json:"@odata.deltaLink,omitempty"`
+}
+
+func (t deltaToken) encode() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeDeltaToken(s string) (deltaToken, error) {
+	var t deltaToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, err
+	}
+	if _, err := uuid.Parse(t.ID); err != nil || t.Skip < 0 {
+		return t, errors.New("malformed token")
+	}
+	return t, nil
+}
+
+// GetDriveItemDelta returns the driveItems below a driveItem that changed since the state
+// described by the token query parameter. Without a token all items are returned.
+func (g Graph) GetDriveItemDelta(w http.ResponseWriter, r *http.Request) {
+	g.logger.Info().Msg("Calling GetDriveItemDelta")
+	ctx := r.Context()
+	logger := g.logger.SubloggerWithRequestID(ctx)
+
+	driveID, err := parseIDParam(r, "driveID")
+	if err != nil {
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	driveItemID, err := parseIDParam(r, "driveItemID")
+	if err != nil {
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	if driveID.GetStorageId() != driveItemID.GetStorageId() || driveID.GetSpaceId() != driveItemID.GetSpaceId() {
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, "Item does not exist")
+		return
+	}
+
+	if g.deltakv == nil {
+		errorcode.NotSupported.Render(w, r, http.StatusNotImplemented, "delta is not available without a persistent store")
+		return
+	}
+
+	user := revactx.ContextMustGetUser(ctx)
+	root := storagespace.FormatResourceID(&driveItemID)
+
+	var previous *deltaState
+	var previousID string
+	if t := r.URL.Query().Get("token"); t != "" {
+		token, err := decodeDeltaToken(t)
+		if err != nil {
+			errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid token")
+			return
+		}
+		previousID = token.ID
+		previous, err = g.loadDeltaState(ctx, token.ID)
+		switch {
+		case errors.Is(err, errDeltaStateNotFound):
+			errorcode.ResyncRequired.Render(w, r, http.StatusGone, "the token is expired, sync again without a token")
+			return
+		case err != nil:
+			logger.Error().Err(err).Str("token", token.ID).Msg("could not load delta state")
+			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if previous.User != user.GetId().GetOpaqueId() || previous.Root != root {
+			errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "the token was not issued for this item")
+			return
+		}
+
+		// the client is still paging through the changes of the token
+		if token.Skip < len(previous.Changes) {
+			g.renderDeltaPage(w, r, token.ID, previous, token.Skip)
+			return
+		}
+	}
+
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	next := &deltaState{
+		User:  user.GetId().GetOpaqueId(),
+		Root:  root,
+		Items: map[string]deltaEntry{},
+	}
+	base := previous
+	continued := previous != nil && len(previous.Pending) > 0
+	var rootInfo *storageprovider.ResourceInfo
+	if continued {
+		// the walk of the tree didn't finish in the previous request
+		next.Base, next.Items, next.Pending = previous.Base, previous.Items, previous.Pending
+		base = nil
+		if previous.Base != "" {
+			base, err = g.loadDeltaState(ctx, previous.Base)
+			switch {
+			case errors.Is(err, errDeltaStateNotFound):
+				errorcode.ResyncRequired.Render(w, r, http.StatusGone, "the token is expired, sync again without a token")
+				return
+			case err != nil:
+				logger.Error().Err(err).Str("token", previous.Base).Msg("could not load delta state")
+				errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+	} else {
+		if previous != nil {
+			next.Base = previousID
+		}
+		var ok bool
+		if rootInfo, ok = statDeltaRoot(w, r, gatewayClient, &driveItemID); !ok {
+			return
+		}
+	}
+
+	dw := newDeltaWalker(g.logger, gatewayClient, base, next)
+	if rootInfo != nil {
+		if err := dw.add(rootInfo, ""); err != nil {
+			logger.Error().Err(err).Str("item", root).Msg("could not compute delta")
+			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if err := dw.walk(ctx, max(g.config.Spaces.DeltaListLimit, 1)); err != nil {
+		logger.Error().Err(err).Str("item", root).Msg("could not compute delta")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(next.Pending) == 0 {
+		dw.addDeleted()
+	}
+
+	id := uuid.New().String()
+	if err := g.saveDeltaState(ctx, id, next); err != nil {
+		logger.Error().Err(err).Str("item", root).Msg("could not store delta state")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(next.Pending) == 0 {
+		if err := g.retainDeltaState(ctx, next.User, root, id); err != nil {
+			// the old states expire with the ttl of the store
+			logger.Error().Err(err).Str("item", root).Msg("could not remove old delta states")
+		}
+	}
+	if continued {
+		// the unfinished state is replaced by the new one, its links can't be followed again
+		if err := g.deleteDeltaState(ctx, previousID); err != nil {
+			logger.Error().Err(err).Str("token", previousID).Msg("could not remove unfinished delta state")
+		}
+	}
+
+	g.renderDeltaPage(w, r, id, next, 0)
+}
+
+// statDeltaRoot stats the synced item and renders the error response if that fails
+func statDeltaRoot(w http.ResponseWriter, r *http.Request, gatewayClient gateway.GatewayAPIClient, id *storageprovider.ResourceId) (*storageprovider.ResourceInfo, bool) {
+	res, err := gatewayClient.Stat(r.Context(), &storageprovider.StatRequest{Ref: &storageprovider.Reference{ResourceId: id}})
+	switch {
+	case err != nil:
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return nil, false
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_OK:
+		return res.GetInfo(), true
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_NOT_FOUND, res.GetStatus().GetCode() == cs3rpc.Code_CODE_PERMISSION_DENIED:
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, res.GetStatus().GetMessage())
+		return nil, false
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_UNAUTHENTICATED:
+		errorcode.Unauthenticated.Render(w, r, http.StatusUnauthorized, res.GetStatus().GetMessage())
+		return nil, false
+	default:
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, res.GetStatus().GetMessage())
+		return nil, false
+	}
+}
+
+func (g Graph) renderDeltaPage(w http.ResponseWriter, r *http.Request, id string, state *deltaState, skip int) {
+	end := min(skip+max(g.config.Spaces.DeltaPageSize, 1), len(state.Changes))
+
+	link, err := url.Parse(g.config.Commons.OpenCloudURL)
+	if err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	link = link.JoinPath(r.URL.Path)
+	link.RawQuery = url.Values{"token": []string{deltaToken{ID: id, Skip: end}.encode()}}.Encode()
+
+	res := deltaResponse{Value: state.Changes[skip:end]}
+	if res.Value == nil {
+		res.Value = []*libregraph.DriveItem{}
+	}
+	if end < len(state.Changes) || len(state.Pending) > 0 {
+		res.NextLink = link.String()
+	} else {
+		res.DeltaLink = link.String()
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &res)
+}
+
+// deltaWalker walks a tree and compares it to the snapshot of a previous sync. Folders with an
+// unchanged etag are not listed, the etag propagation guarantees that nothing below them changed.
+// The folders to list are queued in the next state, so the walk can stop and be continued later.
+type deltaWalker struct {
+	logger        *log.Logger
+	gatewayClient gateway.GatewayAPIClient
+	previous      *deltaState
+	next          *deltaState
+	children      map[string][]string
+}
+
+func newDeltaWalker(logger *log.Logger, gatewayClient gateway.GatewayAPIClient, previous, next *deltaState) *deltaWalker {
+	dw := &deltaWalker{
+		logger:        logger,
+		gatewayClient: gatewayClient,
+		previous:      previous,
+		next:          next,
+		children:      map[string][]string{},
+	}
+	if previous != nil {
+		for id, e := range previous.Items {
+			dw.children[e.Parent] = append(dw.children[e.Parent], id)
+		}
+	}
+	return dw
+}
+
+// add records an item in the next snapshot and queues the folders whose content changed.
+func (dw *deltaWalker) add(info *storageprovider.ResourceInfo, parent string) error {
+	id := storagespace.FormatResourceID(info.GetId())
+	entry := deltaEntry{
+		ETag:   info.GetEtag(),
+		Name:   path.Base(info.GetPath()),
+		Parent: parent,
+		Folder: info.GetType() == storageprovider.ResourceType_RESOURCE_TYPE_CONTAINER,
+	}
+	dw.next.Items[id] = entry
+
+	prev, known := deltaEntry{}, false
+	if dw.previous != nil {
+		prev, known = dw.previous.Items[id]
+	}
+	if !known || prev != entry {
+		item, err := cs3ResourceToDriveItem(dw.logger, info)
+		if err != nil {
+			return err
+		}
+		dw.next.Changes = append(dw.next.Changes, item)
+	}
+
+	if !entry.Folder {
+		return nil
+	}
+	if known && prev.Folder && prev.ETag == entry.ETag {
+		dw.keep(id)
+		return nil
+	}
+	dw.next.Pending = append(dw.next.Pending, id)
+	return nil
+}
+
+// walk lists the queued folders until all are listed or the limit of listed folders is reached.
+func (dw *deltaWalker) walk(ctx context.Context, limit int) error {
+	for n := 0; n < limit && len(dw.next.Pending) > 0; n++ {
+		id := dw.next.Pending[0]
+		dw.next.Pending = dw.next.Pending[1:]
+
+		rid, err := storagespace.ParseID(id)
+		if err != nil {
+			return err
+		}
+		res, err := dw.gatewayClient.ListContainer(ctx, &storageprovider.ListContainerRequest{
+			Ref: &storageprovider.Reference{ResourceId: &rid},
+		})
+		switch {
+		case err != nil:
+			return err
+		case res.GetStatus().GetCode() == cs3rpc.Code_CODE_NOT_FOUND:
+			// the folder was removed while walking the tree, the next sync reports it as deleted
+			continue
+		case res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK:
+			return fmt.Errorf("could not list %s: %s", id, res.GetStatus().GetMessage())
+		}
+
+		for _, child := range res.GetInfos() {
+			if err := dw.add(child, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keep copies the unchanged subtree below a folder from the previous snapshot.
+func (dw *deltaWalker) keep(id string) {
+	for _, child := range dw.children[id] {
+		dw.next.Items[child] = dw.previous.Items[child]
+		dw.keep(child)
+	}
+}
+
+// addDeleted adds the items that are part of the previous snapshot but not of the new one.
+func (dw *deltaWalker) addDeleted() {
+	if dw.previous == nil {
+		return
+	}
+	for id, e := range dw.previous.Items {
+		if _, ok := dw.next.Items[id]; ok {
+			continue
+		}
+		item := libregraph.NewDriveItem()
+		item.SetId(id)
+		item.SetDeleted(libregraph.Deleted{State: libregraph.PtrString("deleted")})
+		if e.Parent != "" {
+			parentRef := libregraph.NewItemReference()
+			parentRef.SetId(e.Parent)
+			item.SetParentReference(*parentRef)
+		}
+		if e.Folder {
+			item.SetFolder(libregraph.Folder{})
+		}
+		dw.next.Changes = append(dw.next.Changes, item)
+	}
+}
+
+// saveDeltaState stores the gzipped state in chunks. The key of the state itself is written
+// last and holds the number of chunks, so a partially written state is never read.
+func (g Graph) saveDeltaState(ctx context.Context, id string, state *deltaState) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(state); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	data := buf.Bytes()
+	chunks := 0
+	for len(data) > 0 {
+		n := min(len(data), _deltaChunkSize)
+		if _, err := g.deltakv.Put(ctx, id+"."+strconv.Itoa(chunks), data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+		chunks++
+	}
+	_, err := g.deltakv.Put(ctx, id, []byte(strconv.Itoa(chunks)))
+	return err
+}
+
+// deltaIndexKey returns the key of the list of states issued to a user for an item
+func deltaIndexKey(user, root string) string {
+	sum := sha256.Sum256([]byte(user + "\x00" + root))
+	return "index." + hex.EncodeToString(sum[:])
+}
+
+// retainDeltaState adds a state to the list of states issued to the user for the item and
+// deletes the oldest states exceeding the configured retention. The list is only written if
+// it wasn't changed by another request since it was read.
+func (g Graph) retainDeltaState(ctx context.Context, user, root, id string) error {
+	key := deltaIndexKey(user, root)
+	for range _deltaMaxConflicts {
+		var ids []string
+		var revision uint64
+		entry, err := g.deltakv.Get(ctx, key)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(entry.Value(), &ids); err != nil {
+				return err
+			}
+			revision = entry.Revision()
+		case !errors.Is(err, jetstream.ErrKeyNotFound):
+			return err
+		}
+
+		ids = append(ids, id)
+		var expired []string
+		if n := len(ids) - max(g.config.Spaces.DeltaTokenRetention, 1); n > 0 {
+			expired, ids = ids[:n], ids[n:]
+		}
+		value, err := json.Marshal(ids)
+		if err != nil {
+			return err
+		}
+		if revision == 0 {
+			_, err = g.deltakv.Create(ctx, key, value)
+		} else {
+			_, err = g.deltakv.Update(ctx, key, value, revision)
+		}
+		switch {
+		case errors.Is(err, jetstream.ErrKeyExists):
+			continue
+		case err != nil:
+			return err
+		}
+
+		for _, old := range expired {
+			if err := g.deleteDeltaState(ctx, old); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.New("too many concurrent updates of the delta states")
+}
+
+// deleteDeltaState deletes the key of the state before its chunks, so the state can't be
+// read partially.
+func (g Graph) deleteDeltaState(ctx context.Context, id string) error {
+	entry, err := g.deltakv.Get(ctx, id)
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+	chunks, err := strconv.Atoi(string(entry.Value()))
+	if err != nil {
+		return err
+	}
+	if err := g.deltakv.Delete(ctx, id); err != nil {
+		return err
+	}
+	for i := 0; i < chunks; i++ {
+		if err := g.deltakv.Delete(ctx, id+"."+strconv.Itoa(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g Graph) loadDeltaState(ctx context.Context, id string) (*deltaState, error) {
+	entry, err := g.deltakv.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, errDeltaStateNotFound
+		}
+		return nil, err
+	}
+	chunks, err := strconv.Atoi(string(entry.Value()))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for i := 0; i < chunks; i++ {
+		entry, err := g.deltakv.Get(ctx, id+"."+strconv.Itoa(i))
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				return nil, errDeltaStateNotFound
+			}
+			return nil, err
+		}
+		buf.Write(entry.Value())
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	state := &deltaState{}
+	if err := json.NewDecoder(zr).Decode(state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
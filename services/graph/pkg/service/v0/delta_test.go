@@ -0,0 +1,310 @@
+package svc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+type deltaList struct {
+	Value     []*libregraph.DriveItem
+	NextLink  string `json:"@odata.nextLink"`
+	DeltaLink string `json:"@odata.deltaLink"`
+}
+
+var _ = Describe("Delta", func() {
+	var (
+		svc           service.Service
+		ctx           context.Context
+		gatewayClient *cs3mocks.GatewayAPIClient
+		deltaKV       *mocks.KeyValue
+		kv            map[string][]byte
+		revisions     map[string]uint64
+		cfg           *config.Config
+
+		// tree maps a folder id to its children
+		tree  map[string][]*provider.ResourceInfo
+		root  *provider.ResourceInfo
+		lists map[string]int
+
+		currentUser = &userpb.User{
+			Id: &userpb.UserId{
+				OpaqueId: "user",
+			},
+		}
+	)
+
+	resource := func(id, etag string, folder bool) *provider.ResourceInfo {
+		info := &provider.ResourceInfo{
+			Id:   &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: id},
+			Etag: etag,
+			Path: id,
+			Type: provider.ResourceType_RESOURCE_TYPE_FILE,
+		}
+		if folder {
+			info.Type = provider.ResourceType_RESOURCE_TYPE_CONTAINER
+		}
+		return info
+	}
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		ctx = context.Background()
+		kv = map[string][]byte{}
+		revisions = map[string]uint64{}
+		lists = map[string]int{}
+		root = resource("nodeid", "1", true)
+		tree = map[string][]*provider.ResourceInfo{
+			"nodeid": {resource("a", "a1", false), resource("b", "b1", true)},
+			"b":      {resource("c", "c1", false)},
+		}
+
+		var revision uint64
+		put := func(key string, val []byte) uint64 {
+			revision++
+			kv[key], revisions[key] = val, revision
+			return revision
+		}
+		deltaKV = &mocks.KeyValue{}
+		deltaKV.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, val []byte) (uint64, error) {
+			return put(key, val), nil
+		}).Maybe()
+		deltaKV.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, val []byte, _ ...jetstream.KVCreateOpt) (uint64, error) {
+			if _, ok := kv[key]; ok {
+				return 0, jetstream.ErrKeyExists
+			}
+			return put(key, val), nil
+		}).Maybe()
+		deltaKV.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, val []byte, rev uint64) (uint64, error) {
+			if revisions[key] != rev {
+				return 0, jetstream.ErrKeyExists
+			}
+			return put(key, val), nil
+		}).Maybe()
+		deltaKV.EXPECT().Delete(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, _ ...jetstream.KVDeleteOpt) error {
+			delete(kv, key)
+			return nil
+		}).Maybe()
+		deltaKV.EXPECT().Get(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+			v, ok := kv[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+			kve := &mocks.KeyValueEntry{}
+			kve.On("Value").Return(v)
+			kve.On("Revision").Return(revisions[key])
+			return kve, nil
+		}).Maybe()
+
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(func(_ context.Context, _ *provider.StatRequest, _ ...grpc.CallOption) (*provider.StatResponse, error) {
+			return &provider.StatResponse{Status: status.NewOK(ctx), Info: root}, nil
+		})
+		gatewayClient.On("ListContainer", mock.Anything, mock.Anything).Return(func(_ context.Context, req *provider.ListContainerRequest, _ ...grpc.CallOption) (*provider.ListContainerResponse, error) {
+			id := req.GetRef().GetResourceId().GetOpaqueId()
+			lists[id]++
+			return &provider.ListContainerResponse{Status: status.NewOK(ctx), Infos: tree[id]}, nil
+		})
+
+		cfg = defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{OpenCloudURL: "https://localhost:9200"}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.Spaces.DeltaPageSize = 3
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.WithGatewaySelector(gatewaySelector),
+			service.WithDeltaKeyValue(deltaKV),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	delta := func(link string, code int) deltaList {
+		target := "/graph/v1.0/drives/storageid$spaceid/items/storageid$spaceid!nodeid/delta"
+		if link != "" {
+			u, err := url.Parse(link)
+			Expect(err).ToNot(HaveOccurred())
+			target = u.RequestURI()
+		}
+
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("driveID", "storageid$spaceid")
+		rctx.URLParams.Add("driveItemID", "storageid$spaceid!nodeid")
+		r = r.WithContext(context.WithValue(revactx.ContextSetUser(ctx, currentUser), chi.RouteCtxKey, rctx))
+		svc.GetDriveItemDelta(rr, r)
+		Expect(rr.Code).To(Equal(code))
+
+		res := deltaList{}
+		if code == http.StatusOK {
+			Expect(json.NewDecoder(rr.Body).Decode(&res)).To(Succeed())
+		}
+		return res
+	}
+
+	ids := func(items []*libregraph.DriveItem) []string {
+		res := make([]string, 0, len(items))
+		for _, item := range items {
+			res = append(res, item.GetId())
+		}
+		return res
+	}
+
+	sync := func() (string, []*libregraph.DriveItem) {
+		res := delta("", http.StatusOK)
+		items := res.Value
+		for res.NextLink != "" {
+			res = delta(res.NextLink, http.StatusOK)
+			items = append(items, res.Value...)
+		}
+		return res.DeltaLink, items
+	}
+
+	It("pages through the initial sync", func() {
+		page := delta("", http.StatusOK)
+		Expect(page.Value).To(HaveLen(3))
+		Expect(page.NextLink).To(HavePrefix("https://localhost:9200/graph/v1.0/drives/"))
+		Expect(page.DeltaLink).To(BeEmpty())
+
+		page = delta(page.NextLink, http.StatusOK)
+		Expect(ids(page.Value)).To(Equal([]string{"storageid$spaceid!c"}))
+		Expect(page.NextLink).To(BeEmpty())
+		Expect(page.DeltaLink).ToNot(BeEmpty())
+	})
+
+	It("splits the walk of large trees across requests", func() {
+		cfg.Spaces.DeltaListLimit = 1
+
+		page := delta("", http.StatusOK)
+		Expect(ids(page.Value)).To(Equal([]string{"storageid$spaceid!nodeid", "storageid$spaceid!a", "storageid$spaceid!b"}))
+		Expect(lists).To(Equal(map[string]int{"nodeid": 1}))
+		Expect(page.NextLink).ToNot(BeEmpty())
+
+		res := delta(page.NextLink, http.StatusOK)
+		Expect(ids(res.Value)).To(Equal([]string{"storageid$spaceid!c"}))
+		Expect(lists).To(Equal(map[string]int{"nodeid": 1, "b": 1}))
+		Expect(res.DeltaLink).ToNot(BeEmpty())
+
+		// the unfinished state was replaced
+		delta(page.NextLink, http.StatusGone)
+
+		root = resource("nodeid", "2", true)
+		tree["nodeid"] = []*provider.ResourceInfo{resource("a", "a1", false), resource("b", "b2", true)}
+		tree["b"] = []*provider.ResourceInfo{resource("d", "d1", false)}
+
+		page = delta(res.DeltaLink, http.StatusOK)
+		Expect(ids(page.Value)).To(Equal([]string{"storageid$spaceid!nodeid", "storageid$spaceid!b"}))
+		Expect(page.NextLink).ToNot(BeEmpty())
+
+		res = delta(page.NextLink, http.StatusOK)
+		Expect(ids(res.Value)).To(ConsistOf("storageid$spaceid!d", "storageid$spaceid!c"))
+		Expect(res.Value[1].Deleted).ToNot(BeNil())
+		Expect(res.DeltaLink).ToNot(BeEmpty())
+	})
+
+	It("returns changed items and skips unchanged folders", func() {
+		link, items := sync()
+		Expect(items).To(HaveLen(4))
+
+		root = resource("nodeid", "2", true)
+		tree["nodeid"] = []*provider.ResourceInfo{resource("a", "a2", false), resource("b", "b1", true)}
+
+		res := delta(link, http.StatusOK)
+		Expect(ids(res.Value)).To(Equal([]string{"storageid$spaceid!nodeid", "storageid$spaceid!a"}))
+		Expect(lists["b"]).To(Equal(1))
+
+		// nothing changed since the last sync
+		root = resource("nodeid", "2", true)
+		res = delta(res.DeltaLink, http.StatusOK)
+		Expect(res.Value).To(BeEmpty())
+		Expect(res.DeltaLink).ToNot(BeEmpty())
+	})
+
+	It("returns deleted items", func() {
+		link, _ := sync()
+
+		root = resource("nodeid", "2", true)
+		tree["nodeid"] = []*provider.ResourceInfo{resource("a", "a1", false)}
+
+		res := delta(link, http.StatusOK)
+		Expect(ids(res.Value)).To(ConsistOf("storageid$spaceid!nodeid", "storageid$spaceid!b", "storageid$spaceid!c"))
+		for _, item := range res.Value[1:] {
+			Expect(item.Deleted).ToNot(BeNil())
+			Expect(item.Deleted.GetState()).To(Equal("deleted"))
+		}
+	})
+
+	It("returns renamed items", func() {
+		link, _ := sync()
+
+		// renaming doesn't change the etag of the item
+		renamed := resource("c", "c1", false)
+		renamed.Path = "renamed"
+		root = resource("nodeid", "2", true)
+		tree["nodeid"] = []*provider.ResourceInfo{resource("a", "a1", false), resource("b", "b2", true)}
+		tree["b"] = []*provider.ResourceInfo{renamed}
+
+		res := delta(link, http.StatusOK)
+		Expect(ids(res.Value)).To(Equal([]string{"storageid$spaceid!nodeid", "storageid$spaceid!b", "storageid$spaceid!c"}))
+		Expect(res.Value[2].GetName()).To(Equal("renamed"))
+	})
+
+	It("only keeps the newest tokens", func() {
+		cfg.Spaces.DeltaTokenRetention = 2
+		first, _ := sync()
+		second, _ := sync()
+		third, _ := sync()
+
+		delta(first, http.StatusGone)
+		delta(second, http.StatusOK)
+		delta(third, http.StatusOK)
+	})
+
+	It("requires a resync for unknown tokens", func() {
+		link, _ := sync()
+		for k := range kv {
+			delete(kv, k)
+		}
+		delta(link, http.StatusGone)
+	})
+
+	It("rejects malformed tokens", func() {
+		delta("https://localhost:9200/graph/v1.0/drives/storageid$spaceid/items/storageid$spaceid!nodeid/delta?token=invalid", http.StatusBadRequest)
+	})
+})
@@ -61,6 +61,7 @@ type Graph struct {
 	roleService              RoleService
 	permissionsService       Permissions
 	valueService             settingssvc.ValueService
+	bundleService            settingssvc.BundleService
 	specialDriveItemsCache   *ttlcache.Cache[string, interface{}]
 	eventsPublisher          events.Publisher
 	eventsConsumer           events.Consumer
@@ -69,6 +70,8 @@ type Graph struct {
 	historyClient            ehsvc.EventHistoryService
 	traceProvider            trace.TracerProvider
 	natskv                   jetstream.KeyValue
+	deltakv                  jetstream.KeyValue
+	scim                     http.Handler
 }
 
 // ServeHTTP implements the Service interface.
@@ -80,6 +83,11 @@ func (g Graph) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// https://github.com/go-chi/chi/issues/641#issuecomment-883156692
 	r.URL.RawPath = r.URL.EscapedPath()
 
+	// the SCIM endpoint is authenticated with its own token, it must not pass the graph middlewares
+	if g.scim != nil && (r.URL.Path == SCIMBasePath || strings.HasPrefix(r.URL.Path, SCIMBasePath+"/")) {
+		g.scim.ServeHTTP(w, r)
+		return
+	}
 	g.mux.ServeHTTP(w, r)
 }
 
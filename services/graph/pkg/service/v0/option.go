@@ -37,6 +37,7 @@ type Options struct {
 	UserProfilePhotoService  UsersUserProfilePhotoProvider
 	PermissionService        Permissions
 	ValueService             settingssvc.ValueService
+	BundleService            settingssvc.BundleService
 	RoleManager              *roles.Manager
 	EventsPublisher          events.Publisher
 	EventsConsumer           events.Consumer
@@ -45,6 +46,7 @@ type Options struct {
 	EventHistoryClient       ehsvc.EventHistoryService
 	TraceProvider            trace.TracerProvider
 	NatsKeyValue             jetstream.KeyValue
+	DeltaKeyValue            jetstream.KeyValue
 }
 
 // newOptions initializes the available default options.
@@ -121,6 +123,13 @@ func WithNatsKeyValue(val jetstream.KeyValue) Option {
 	}
 }
 
+// WithDeltaKeyValue provides a function to set the DeltaKeyValue option.
+func WithDeltaKeyValue(val jetstream.KeyValue) Option {
+	return func(o *Options) {
+		o.DeltaKeyValue = val
+	}
+}
+
 // WithRoleService provides a function to set the RoleService option.
 func WithRoleService(val RoleService) Option {
 	return func(o *Options) {
@@ -135,6 +144,13 @@ func WithValueService(val settingssvc.ValueService) Option {
 	}
 }
 
+// WithBundleService provides a function to set the BundleService option.
+func WithBundleService(val settingssvc.BundleService) Option {
+	return func(o *Options) {
+		o.BundleService = val
+	}
+}
+
 // WithSearchService provides a function to set the SearchService option.
 func WithSearchService(val searchsvc.SearchProviderService) Option {
 	return func(o *Options) {
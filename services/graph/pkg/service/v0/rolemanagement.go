@@ -1,11 +1,16 @@
 package svc
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
 
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
@@ -14,7 +19,7 @@ import (
 // GetRoleDefinitions a list of permission roles than can be used when sharing with users or groups
 func (g Graph) GetRoleDefinitions(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, g.availableRoles)
+	render.JSON(w, r, g.availableRoles())
 }
 
 // GetRoleDefinition a permission role than can be used when sharing with users or groups
@@ -35,3 +40,162 @@ func (g Graph) GetRoleDefinition(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, role)
 }
+
+// CreateRoleDefinition creates a custom permission role
+func (g Graph) CreateRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	role := &libregraph.UnifiedRoleDefinition{}
+	if err := StrictJSONUnmarshal(r.Body, role); err != nil {
+		logger.Debug().Err(err).Msg("could not create role: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	// the id is generated, like the ids of users and groups
+	if _, ok := role.GetIdOk(); ok {
+		logger.Debug().Msg("could not create role: id is a read-only attribute")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "role id is a read-only attribute")
+		return
+	}
+	role.SetId(uuid.NewString())
+
+	if err := unifiedrole.ValidateCustomRole(role); err != nil {
+		logger.Debug().Err(err).Msg("could not create role: invalid role")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	customRolesWriteMu.Lock()
+	defer customRolesWriteMu.Unlock()
+
+	roles, err := g.readCustomRoles(r.Context())
+	if err != nil {
+		logger.Error().Err(err).Msg("could not create role: reading custom roles failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not read custom roles")
+		return
+	}
+
+	if err := g.saveCustomRoles(r.Context(), append(roles, role)); err != nil {
+		logger.Error().Err(err).Msg("could not create role: saving custom roles failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not save custom role")
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, role)
+}
+
+// UpdateRoleDefinition updates a custom permission role, built-in roles can't be changed
+func (g Graph) UpdateRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	roleID, ok := g.customRoleID(w, r)
+	if !ok {
+		return
+	}
+
+	changes := &libregraph.UnifiedRoleDefinition{}
+	if err := StrictJSONUnmarshal(r.Body, changes); err != nil {
+		logger.Debug().Err(err).Msg("could not update role: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	if id, ok := changes.GetIdOk(); ok && *id != roleID {
+		logger.Debug().Msg("could not update role: id is a read-only attribute")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "role id is a read-only attribute")
+		return
+	}
+
+	customRolesWriteMu.Lock()
+	defer customRolesWriteMu.Unlock()
+
+	roles, err := g.readCustomRoles(r.Context())
+	if err != nil {
+		logger.Error().Err(err).Msg("could not update role: reading custom roles failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not read custom roles")
+		return
+	}
+
+	i := slices.IndexFunc(roles, func(role *libregraph.UnifiedRoleDefinition) bool {
+		return role.GetId() == roleID
+	})
+	if i < 0 {
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, unifiedrole.ErrUnknownRole.Error())
+		return
+	}
+
+	role := roles[i]
+	if displayName, ok := changes.GetDisplayNameOk(); ok {
+		role.SetDisplayName(*displayName)
+	}
+	if description, ok := changes.GetDescriptionOk(); ok {
+		role.SetDescription(*description)
+	}
+	if permissions, ok := changes.GetRolePermissionsOk(); ok {
+		role.SetRolePermissions(permissions)
+	}
+	if weight, ok := changes.GetLibreGraphWeightOk(); ok {
+		role.SetLibreGraphWeight(*weight)
+	}
+
+	if err := unifiedrole.ValidateCustomRole(role); err != nil {
+		logger.Debug().Err(err).Msg("could not update role: invalid role")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := g.saveCustomRoles(r.Context(), roles); err != nil {
+		logger.Error().Err(err).Msg("could not update role: saving custom roles failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not save custom role")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, role)
+}
+
+// DeleteRoleDefinition deletes a custom permission role, existing shares keep their permissions
+func (g Graph) DeleteRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	roleID, ok := g.customRoleID(w, r)
+	if !ok {
+		return
+	}
+
+	customRolesWriteMu.Lock()
+	defer customRolesWriteMu.Unlock()
+
+	if err := g.removeCustomRole(r.Context(), roleID); err != nil {
+		logger.Error().Err(err).Str("roleID", roleID).Msg("could not delete role")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not delete custom role")
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+// customRoleID returns the id of the custom role addressed by the request, it renders an error if it
+// addresses a built-in or unknown role.
+func (g Graph) customRoleID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	roleID, err := url.PathUnescape(chi.URLParam(r, "roleID"))
+	if err != nil {
+		logger.Debug().Err(err).Str("roleID", chi.URLParam(r, "roleID")).Msg("could not get roleID: unescaping is failed")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "unescaping role id failed")
+		return "", false
+	}
+
+	role, err := unifiedrole.GetRole(unifiedrole.RoleFilterIDs(roleID))
+	switch {
+	case errors.Is(err, unifiedrole.ErrUnknownRole):
+		logger.Debug().Str("roleID", roleID).Msg("could not get role: not found")
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, err.Error())
+		return "", false
+	case !unifiedrole.RoleFilterCustom()(role):
+		logger.Debug().Str("roleID", roleID).Msg("built-in roles can't be changed")
+		errorcode.NotAllowed.Render(w, r, http.StatusForbidden, "built-in roles can't be changed")
+		return "", false
+	}
+	return roleID, true
+}
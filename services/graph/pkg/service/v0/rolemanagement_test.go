@@ -0,0 +1,198 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"go-micro.dev/v4/client"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	settings "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	identitymocks "github.com/opencloud-eu/opencloud/services/graph/pkg/identity/mocks"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
+)
+
+// bundleStore keeps the bundles of the settings service in memory
+type bundleStore struct {
+	bundles map[string]*settingsmsg.Bundle
+}
+
+func (s *bundleStore) SaveBundle(_ context.Context, in *settings.SaveBundleRequest, _ ...client.CallOption) (*settings.SaveBundleResponse, error) {
+	s.bundles[in.GetBundle().GetId()] = in.GetBundle()
+	return &settings.SaveBundleResponse{Bundle: in.GetBundle()}, nil
+}
+
+func (s *bundleStore) GetBundle(_ context.Context, in *settings.GetBundleRequest, _ ...client.CallOption) (*settings.GetBundleResponse, error) {
+	b, ok := s.bundles[in.GetBundleId()]
+	if !ok || len(b.GetSettings()) == 0 {
+		return nil, merrors.NotFound("eu.opencloud.api.settings", "could not read bundle: %s", in.GetBundleId())
+	}
+	return &settings.GetBundleResponse{Bundle: b}, nil
+}
+
+func (s *bundleStore) ListBundles(_ context.Context, _ *settings.ListBundlesRequest, _ ...client.CallOption) (*settings.ListBundlesResponse, error) {
+	return &settings.ListBundlesResponse{}, nil
+}
+
+func (s *bundleStore) AddSettingToBundle(_ context.Context, in *settings.AddSettingToBundleRequest, _ ...client.CallOption) (*settings.AddSettingToBundleResponse, error) {
+	return &settings.AddSettingToBundleResponse{Setting: in.GetSetting()}, nil
+}
+
+func (s *bundleStore) RemoveSettingFromBundle(_ context.Context, in *settings.RemoveSettingFromBundleRequest, _ ...client.CallOption) (*emptypb.Empty, error) {
+	b, ok := s.bundles[in.GetBundleId()]
+	if !ok {
+		return nil, merrors.BadRequest("eu.opencloud.api.settings", "bundle not found")
+	}
+	b.Settings = slices.DeleteFunc(b.Settings, func(setting *settingsmsg.Setting) bool {
+		return setting.GetId() == in.GetSettingId()
+	})
+	return &emptypb.Empty{}, nil
+}
+
+var _ = Describe("RoleManagement", func() {
+	var (
+		svc         service.Service
+		bundles     *bundleStore
+		rr          *httptest.ResponseRecorder
+		uploadsOnly = libregraph.UnifiedRoleDefinition{
+			DisplayName: libregraph.PtrString("Can upload without listing"),
+			Description: libregraph.PtrString("Upload files."),
+			RolePermissions: []libregraph.UnifiedRolePermission{
+				{
+					AllowedResourceActions: []string{unifiedrole.DriveItemUploadCreate, unifiedrole.DriveItemChildrenCreate},
+					Condition:              libregraph.PtrString(unifiedrole.UnifiedRoleConditionFolder),
+				},
+			},
+		}
+	)
+
+	do := func(method, target string, body any) *httptest.ResponseRecorder {
+		var b []byte
+		if body != nil {
+			var err error
+			b, err = json.Marshal(body)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		rr = httptest.NewRecorder()
+		svc.ServeHTTP(rr, httptest.NewRequest(method, target, bytes.NewReader(b)))
+		return rr
+	}
+
+	create := func() *libregraph.UnifiedRoleDefinition {
+		Expect(do(http.MethodPost, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", uploadsOnly).Code).To(Equal(http.StatusCreated))
+		role := &libregraph.UnifiedRoleDefinition{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), role)).To(Succeed())
+		return role
+	}
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient := &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		cfg := defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+
+		bundles = &bundleStore{bundles: map[string]*settingsmsg.Bundle{}}
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.WithGatewaySelector(gatewaySelector),
+			service.WithIdentityBackend(&identitymocks.Backend{}),
+			service.WithBundleService(bundles),
+			service.WithRequireAdminMiddleware(func(next http.Handler) http.Handler {
+				return next
+			}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		unifiedrole.SetCustomRoles(nil)
+	})
+
+	It("creates a custom role and lists it with the available roles", func() {
+		role := create()
+		Expect(role.GetId()).ToNot(BeEmpty())
+		Expect(role.GetDisplayName()).To(Equal(uploadsOnly.GetDisplayName()))
+		Expect(bundles.bundles).To(HaveLen(1))
+
+		Expect(do(http.MethodGet, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", nil).Code).To(Equal(http.StatusOK))
+		var roles []*libregraph.UnifiedRoleDefinition
+		Expect(json.Unmarshal(rr.Body.Bytes(), &roles)).To(Succeed())
+		Expect(roles).To(ContainElement(HaveField("Id", HaveValue(Equal(role.GetId())))))
+
+		Expect(do(http.MethodGet, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+role.GetId(), nil).Code).To(Equal(http.StatusOK))
+	})
+
+	It("rejects invalid roles", func() {
+		invalid := uploadsOnly
+		invalid.RolePermissions = []libregraph.UnifiedRolePermission{
+			{
+				AllowedResourceActions: []string{unifiedrole.DriveItemPermissionsDeny},
+				Condition:              libregraph.PtrString(unifiedrole.UnifiedRoleConditionFolder),
+			},
+		}
+		Expect(do(http.MethodPost, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", invalid).Code).To(Equal(http.StatusBadRequest))
+
+		withID := uploadsOnly
+		withID.Id = libregraph.PtrString(unifiedrole.UnifiedRoleViewerID)
+		Expect(do(http.MethodPost, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", withID).Code).To(Equal(http.StatusBadRequest))
+		Expect(bundles.bundles).To(BeEmpty())
+	})
+
+	It("updates a custom role", func() {
+		role := create()
+
+		Expect(do(http.MethodPatch, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+role.GetId(), libregraph.UnifiedRoleDefinition{
+			DisplayName: libregraph.PtrString("Can drop files"),
+		}).Code).To(Equal(http.StatusOK))
+
+		updated, err := unifiedrole.GetRole(unifiedrole.RoleFilterIDs(role.GetId()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.GetDisplayName()).To(Equal("Can drop files"))
+		Expect(updated.GetRolePermissions()).To(Equal(uploadsOnly.GetRolePermissions()))
+	})
+
+	It("does not change built-in roles", func() {
+		Expect(do(http.MethodPatch, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+unifiedrole.UnifiedRoleViewerID, libregraph.UnifiedRoleDefinition{
+			DisplayName: libregraph.PtrString("Can peek"),
+		}).Code).To(Equal(http.StatusForbidden))
+		Expect(do(http.MethodDelete, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+unifiedrole.UnifiedRoleViewerID, nil).Code).To(Equal(http.StatusForbidden))
+		Expect(do(http.MethodDelete, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/unknown", nil).Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes a custom role", func() {
+		role := create()
+
+		Expect(do(http.MethodDelete, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+role.GetId(), nil).Code).To(Equal(http.StatusNoContent))
+		Expect(unifiedrole.GetCustomRoles()).To(BeEmpty())
+		Expect(do(http.MethodGet, "/graph/v1beta1/roleManagement/permissions/roleDefinitions/"+role.GetId(), nil).Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,357 @@
+package svc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+	graphm "github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
+)
+
+// SCIMBasePath is the path the SCIM 2.0 endpoint is served at.
+const SCIMBasePath = "/scim/v2"
+
+const (
+	scimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	scimSchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+
+	scimContentType = "application/scim+json"
+
+	// scimMaxResults is the maximum number of resources returned by a list request
+	scimMaxResults = 1000
+)
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimMultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// scimRouter returns the handler for the SCIM endpoint. It is protected by a static bearer token
+// instead of the user authentication of the graph API.
+func (g Graph) scimRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Use(
+		middleware.RequestID,
+		middleware.StripSlashes,
+		graphm.Token(g.config.SCIM.Token),
+	)
+	r.Route(SCIMBasePath, func(r chi.Router) {
+		r.Get("/ServiceProviderConfig", g.scimServiceProviderConfig)
+		r.Get("/ResourceTypes", g.scimResourceTypes)
+		r.Get("/Schemas", g.scimSchemas)
+		r.Route("/Users", func(r chi.Router) {
+			r.Get("/", g.scimListUsers)
+			r.Post("/", g.scimCreateUser)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Get("/", g.scimGetUser)
+				r.Put("/", g.scimReplaceUser)
+				r.Patch("/", g.scimPatchUser)
+				r.Delete("/", g.scimDeleteUser)
+			})
+		})
+		r.Route("/Groups", func(r chi.Router) {
+			r.Get("/", g.scimListGroups)
+			r.Post("/", g.scimCreateGroup)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Get("/", g.scimGetGroup)
+				r.Put("/", g.scimReplaceGroup)
+				r.Patch("/", g.scimPatchGroup)
+				r.Delete("/", g.scimDeleteGroup)
+			})
+		})
+	})
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		renderSCIMError(w, r, http.StatusNotFound, "", "unknown endpoint")
+	})
+	return r
+}
+
+func (g Graph) scimLocation(segments ...string) string {
+	return strings.TrimSuffix(g.config.Commons.OpenCloudURL, "/") + SCIMBasePath + "/" + strings.Join(segments, "/")
+}
+
+// scimListRequest returns the list query of a request, an empty godata request lists everything
+func scimListRequest() *godata.GoDataRequest {
+	return &godata.GoDataRequest{Query: &godata.GoDataQuery{}}
+}
+
+func renderSCIM(w http.ResponseWriter, r *http.Request, status int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		renderSCIMError(w, r, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func renderSCIMError(w http.ResponseWriter, r *http.Request, status int, scimType, detail string) {
+	b, _ := json.Marshal(scimError{
+		Schemas:  []string{scimSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// renderSCIMBackendError maps the errors of the identity backend to SCIM errors
+func renderSCIMBackendError(w http.ResponseWriter, r *http.Request, err error) {
+	var e errorcode.Error
+	if !errors.As(err, &e) {
+		renderSCIMError(w, r, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	switch e.GetCode() {
+	case errorcode.ItemNotFound:
+		renderSCIMError(w, r, http.StatusNotFound, "", e.Error())
+	case errorcode.NameAlreadyExists:
+		renderSCIMError(w, r, http.StatusConflict, "uniqueness", e.Error())
+	case errorcode.InvalidRequest:
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", e.Error())
+	case errorcode.NotAllowed, errorcode.NotSupported:
+		renderSCIMError(w, r, http.StatusNotImplemented, "", e.Error())
+	default:
+		renderSCIMError(w, r, http.StatusInternalServerError, "", e.Error())
+	}
+}
+
+func decodeSCIM(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+// toSCIMMap returns the JSON representation of a resource used for filtering and patching
+func toSCIMMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	return m, json.Unmarshal(b, &m)
+}
+
+func fromSCIMMap(m map[string]any, v any) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// scimListParams parses the filter and the pagination of a list request
+func scimListParams(r *http.Request) (filter scimFilter, startIndex, count int, err error) {
+	q := r.URL.Query()
+	if f := q.Get("filter"); f != "" {
+		if filter, err = parseSCIMFilter(f); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+
+	startIndex, count = 1, scimMaxResults
+	if s := q.Get("startIndex"); s != "" {
+		if startIndex, err = strconv.Atoi(s); err != nil {
+			return nil, 0, 0, fmt.Errorf("invalid startIndex: %w", err)
+		}
+		startIndex = max(startIndex, 1)
+	}
+	if c := q.Get("count"); c != "" {
+		if count, err = strconv.Atoi(c); err != nil {
+			return nil, 0, 0, fmt.Errorf("invalid count: %w", err)
+		}
+		count = min(max(count, 0), scimMaxResults)
+	}
+	return filter, startIndex, count, nil
+}
+
+// scimEqualsFilter returns the value if the filter is a plain comparison like `userName eq "alice"`
+func scimEqualsFilter(filter scimFilter, attr string) (string, bool) {
+	c, ok := filter.(scimCompare)
+	if !ok || c.op != "eq" || len(c.path) != 1 || !strings.EqualFold(c.path[0], attr) {
+		return "", false
+	}
+	s, ok := c.value.(string)
+	return s, ok
+}
+
+// renderSCIMList filters and pages the resources
+func renderSCIMList[T any](w http.ResponseWriter, r *http.Request, resources []T, filter scimFilter, startIndex, count int) {
+	matched := make([]any, 0, len(resources))
+	for _, res := range resources {
+		if filter != nil {
+			m, err := toSCIMMap(res)
+			if err != nil {
+				renderSCIMError(w, r, http.StatusInternalServerError, "", err.Error())
+				return
+			}
+			if !filter.match(m) {
+				continue
+			}
+		}
+		matched = append(matched, res)
+	}
+
+	page := []any{}
+	if startIndex-1 < len(matched) {
+		page = matched[startIndex-1 : min(startIndex-1+count, len(matched))]
+	}
+	renderSCIM(w, r, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: len(matched),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func (g Graph) scimServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	supported := func(s bool) map[string]any { return map[string]any{"supported": s} }
+	renderSCIM(w, r, http.StatusOK, map[string]any{
+		"schemas":        []string{scimSchemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxResults},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "Authentication with a static bearer token",
+			"primary":     true,
+		}},
+		"meta": scimMeta{ResourceType: "ServiceProviderConfig", Location: g.scimLocation("ServiceProviderConfig")},
+	})
+}
+
+func (g Graph) scimResourceTypes(w http.ResponseWriter, r *http.Request) {
+	types := []any{
+		map[string]any{
+			"schemas":  []string{scimSchemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   scimSchemaUser,
+			"meta":     scimMeta{ResourceType: "ResourceType", Location: g.scimLocation("ResourceTypes", "User")},
+		},
+		map[string]any{
+			"schemas":  []string{scimSchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   scimSchemaGroup,
+			"meta":     scimMeta{ResourceType: "ResourceType", Location: g.scimLocation("ResourceTypes", "Group")},
+		},
+	}
+	renderSCIMList(w, r, types, nil, 1, len(types))
+}
+
+func (g Graph) scimSchemas(w http.ResponseWriter, r *http.Request) {
+	attribute := func(name, typ string, multiValued, required bool, mutability string, sub ...map[string]any) map[string]any {
+		a := map[string]any{
+			"name":        name,
+			"type":        typ,
+			"multiValued": multiValued,
+			"required":    required,
+			"caseExact":   false,
+			"mutability":  mutability,
+			"returned":    "default",
+			"uniqueness":  "none",
+		}
+		if name == "password" {
+			a["returned"] = "never"
+		}
+		if len(sub) > 0 {
+			a["subAttributes"] = sub
+		}
+		return a
+	}
+	schemas := []any{
+		map[string]any{
+			"schemas":     []string{scimSchemaSchema},
+			"id":          scimSchemaUser,
+			"name":        "User",
+			"description": "User Account",
+			"attributes": []map[string]any{
+				attribute("userName", "string", false, true, "readWrite"),
+				attribute("externalId", "string", false, false, "readWrite"),
+				attribute("displayName", "string", false, false, "readWrite"),
+				attribute("name", "complex", false, false, "readWrite",
+					attribute("givenName", "string", false, false, "readWrite"),
+					attribute("familyName", "string", false, false, "readWrite"),
+				),
+				attribute("emails", "complex", true, false, "readWrite",
+					attribute("value", "string", false, false, "readWrite"),
+					attribute("type", "string", false, false, "readWrite"),
+					attribute("primary", "boolean", false, false, "readWrite"),
+				),
+				attribute("active", "boolean", false, false, "readWrite"),
+				attribute("password", "string", false, false, "writeOnly"),
+			},
+			"meta": scimMeta{ResourceType: "Schema", Location: g.scimLocation("Schemas", scimSchemaUser)},
+		},
+		map[string]any{
+			"schemas":     []string{scimSchemaSchema},
+			"id":          scimSchemaGroup,
+			"name":        "Group",
+			"description": "Group",
+			"attributes": []map[string]any{
+				attribute("displayName", "string", false, true, "readWrite"),
+				attribute("members", "complex", true, false, "readWrite",
+					attribute("value", "string", false, false, "immutable"),
+					attribute("display", "string", false, false, "readOnly"),
+				),
+			},
+			"meta": scimMeta{ResourceType: "Schema", Location: g.scimLocation("Schemas", scimSchemaGroup)},
+		},
+	}
+	renderSCIMList(w, r, schemas, nil, 1, len(schemas))
+}
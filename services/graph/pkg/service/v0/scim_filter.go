@@ -0,0 +1,401 @@
+package svc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// scimFilter is a parsed SCIM filter expression, see https://www.rfc-editor.org/rfc/rfc7644#section-3.4.2.2
+// Filters are evaluated against the JSON representation of a resource.
+type scimFilter interface {
+	match(res map[string]any) bool
+}
+
+type scimAnd struct{ left, right scimFilter }
+
+type scimOr struct{ left, right scimFilter }
+
+type scimNot struct{ filter scimFilter }
+
+// scimCompare compares the values of an attribute, op "pr" checks if the attribute is present.
+type scimCompare struct {
+	path  []string
+	op    string
+	value any
+}
+
+// scimValuePath matches if an element of a multi-valued attribute matches the filter, e.g. emails[type eq "work"]
+type scimValuePath struct {
+	attr   string
+	filter scimFilter
+}
+
+func (f scimAnd) match(res map[string]any) bool { return f.left.match(res) && f.right.match(res) }
+
+func (f scimOr) match(res map[string]any) bool { return f.left.match(res) || f.right.match(res) }
+
+func (f scimNot) match(res map[string]any) bool { return !f.filter.match(res) }
+
+func (f scimValuePath) match(res map[string]any) bool {
+	for _, v := range scimValues(res, []string{f.attr}) {
+		if m, ok := v.(map[string]any); ok && f.filter.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f scimCompare) match(res map[string]any) bool {
+	values := scimValues(res, f.path)
+	switch f.op {
+	case "pr":
+		for _, v := range values {
+			if s, ok := v.(string); !ok || s != "" {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		return !scimCompare{path: f.path, op: "eq", value: f.value}.match(res)
+	}
+
+	if f.value == nil {
+		return f.op == "eq" && len(values) == 0
+	}
+	for _, v := range values {
+		if scimCompareValue(v, f.op, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+func scimCompareValue(v any, op string, want any) bool {
+	switch want := want.(type) {
+	case string:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		s, want = strings.ToLower(s), strings.ToLower(want)
+		switch op {
+		case "eq":
+			return s == want
+		case "co":
+			return strings.Contains(s, want)
+		case "sw":
+			return strings.HasPrefix(s, want)
+		case "ew":
+			return strings.HasSuffix(s, want)
+		case "gt":
+			return s > want
+		case "ge":
+			return s >= want
+		case "lt":
+			return s < want
+		case "le":
+			return s <= want
+		}
+	case bool:
+		b, ok := v.(bool)
+		return ok && op == "eq" && b == want
+	case float64:
+		n, ok := v.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return n == want
+		case "gt":
+			return n > want
+		case "ge":
+			return n >= want
+		case "lt":
+			return n < want
+		case "le":
+			return n <= want
+		}
+	}
+	return false
+}
+
+// scimValues returns the values of an attribute path. Values of multi-valued attributes are flattened.
+func scimValues(res map[string]any, path []string) []any {
+	current := []any{res}
+	for _, name := range path {
+		var next []any
+		for _, c := range current {
+			m, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			v, ok := scimGet(m, name)
+			if !ok || v == nil {
+				continue
+			}
+			if list, ok := v.([]any); ok {
+				next = append(next, list...)
+			} else {
+				next = append(next, v)
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// scimGet looks up an attribute, attribute names are case-insensitive.
+func scimGet(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// scimKey returns the key used for an attribute in the map, or the name itself if it is not set.
+func scimKey(m map[string]any, name string) string {
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+// scimAttrPath splits an attribute path like "name.givenName" and strips the schema urn.
+func scimAttrPath(path string) []string {
+	for _, schema := range []string{scimSchemaUser, scimSchemaGroup} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			path = path[len(schema)+1:]
+			break
+		}
+	}
+	return strings.Split(path, ".")
+}
+
+type scimFilterParser struct {
+	tokens []string
+	pos    int
+}
+
+func parseSCIMFilter(filter string) (scimFilter, error) {
+	tokens, err := tokenizeSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &scimFilterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token '%s'", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+func (p *scimFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *scimFilterParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *scimFilterParser) expect(token string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t != token {
+		return fmt.Errorf("expected '%s' but got '%s'", token, t)
+	}
+	return nil
+}
+
+func (p *scimFilterParser) parseOr() (scimFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = scimOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseAnd() (scimFilter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = scimAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseNot() (scimFilter, error) {
+	if !strings.EqualFold(p.peek(), "not") {
+		return p.parseAtom()
+	}
+	p.pos++
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return scimNot{filter: f}, nil
+}
+
+func (p *scimFilterParser) parseAtom() (scimFilter, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t == "(" {
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return f, p.expect(")")
+	}
+	if !isSCIMAttrPath(t) {
+		return nil, fmt.Errorf("invalid attribute path '%s'", t)
+	}
+
+	if p.peek() == "[" {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return scimValuePath{attr: scimAttrPath(t)[0], filter: f}, nil
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op = strings.ToLower(op)
+	switch op {
+	case "pr":
+		return scimCompare{path: scimAttrPath(t), op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator '%s'", op)
+	}
+
+	raw, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := parseSCIMFilterValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	return scimCompare{path: scimAttrPath(t), op: op, value: value}, nil
+}
+
+func parseSCIMFilterValue(raw string) (any, error) {
+	switch {
+	case strings.HasPrefix(raw, `"`):
+		var s string
+		if err := json.Unmarshal([]byte(raw), &s); err != nil {
+			return nil, fmt.Errorf("invalid string %s", raw)
+		}
+		return s, nil
+	case strings.EqualFold(raw, "true"):
+		return true, nil
+	case strings.EqualFold(raw, "false"):
+		return false, nil
+	case strings.EqualFold(raw, "null"):
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value '%s'", raw)
+	}
+	return n, nil
+}
+
+func isSCIMAttrPath(t string) bool {
+	if t == "" || !unicode.IsLetter(rune(t[0])) {
+		return false
+	}
+	for _, r := range t {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("._-:$", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizeSCIMFilter splits a filter into attribute paths, operators, values and brackets.
+func tokenizeSCIMFilter(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.IndexByte("()[]", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(filter) && strings.IndexByte(" \t()[]\"", filter[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
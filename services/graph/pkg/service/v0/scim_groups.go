@@ -0,0 +1,283 @@
+package svc
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+)
+
+type scimGroup struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []scimMultiValue `json:"members"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+func (grp scimGroup) memberIDs() []string {
+	ids := make([]string, 0, len(grp.Members))
+	for _, m := range grp.Members {
+		if m.Value != "" && !slices.Contains(ids, m.Value) {
+			ids = append(ids, m.Value)
+		}
+	}
+	return ids
+}
+
+func (g Graph) toSCIMGroup(grp *libregraph.Group, members []*libregraph.User) scimGroup {
+	res := scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          grp.GetId(),
+		DisplayName: grp.GetDisplayName(),
+		Members:     make([]scimMultiValue, 0, len(members)),
+		Meta:        &scimMeta{ResourceType: "Group", Location: g.scimLocation("Groups", grp.GetId())},
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, scimMultiValue{
+			Value:   m.GetId(),
+			Display: m.GetDisplayName(),
+			Ref:     g.scimLocation("Users", m.GetId()),
+		})
+	}
+	return res
+}
+
+// scimGroupWithMembers loads the members of a group unless the client excluded them
+func (g Graph) scimGroupWithMembers(r *http.Request, grp *libregraph.Group) (scimGroup, error) {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			res := g.toSCIMGroup(grp, nil)
+			res.Members = nil
+			return res, nil
+		}
+	}
+	members, err := g.identityBackend.GetGroupMembers(r.Context(), grp.GetId(), scimListRequest())
+	if err != nil {
+		return scimGroup{}, err
+	}
+	return g.toSCIMGroup(grp, members), nil
+}
+
+func (g Graph) scimListGroups(w http.ResponseWriter, r *http.Request) {
+	filter, startIndex, count, err := scimListParams(r)
+	if err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	var groups []*libregraph.Group
+	if displayName, ok := scimEqualsFilter(filter, "displayName"); ok {
+		grp, err := g.identityBackend.GetGroup(r.Context(), displayName, url.Values{})
+		switch {
+		case err == nil:
+			groups = append(groups, grp)
+		case !isNotFound(err):
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+	} else {
+		groups, err = g.identityBackend.GetGroups(r.Context(), scimListRequest())
+		if err != nil {
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+	}
+
+	resources := make([]scimGroup, 0, len(groups))
+	for _, grp := range groups {
+		res, err := g.scimGroupWithMembers(r, grp)
+		if err != nil {
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		resources = append(resources, res)
+	}
+	renderSCIMList(w, r, resources, filter, startIndex, count)
+}
+
+func (g Graph) scimGetGroup(w http.ResponseWriter, r *http.Request) {
+	grp, err := g.identityBackend.GetGroup(r.Context(), chi.URLParam(r, "id"), url.Values{})
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	res, err := g.scimGroupWithMembers(r, grp)
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	renderSCIM(w, r, http.StatusOK, res)
+}
+
+func (g Graph) scimCreateGroup(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+
+	var req scimGroup
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if !isValidGroupName(req.DisplayName) {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid displayName")
+		return
+	}
+
+	grp := libregraph.NewGroup()
+	grp.SetDisplayName(req.DisplayName)
+	grp, err := g.identityBackend.CreateGroup(r.Context(), *grp)
+	if err != nil {
+		logger.Debug().Err(err).Str("group", req.DisplayName).Msg("could not create scim group: backend error")
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	g.publishEvent(r.Context(), events.GroupCreated{GroupID: grp.GetId()})
+
+	if ids := req.memberIDs(); len(ids) > 0 {
+		if err := g.identityBackend.AddMembersToGroup(r.Context(), grp.GetId(), ids); err != nil {
+			logger.Debug().Err(err).Str("id", grp.GetId()).Msg("could not add scim group members: backend error")
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		for _, id := range ids {
+			g.publishEvent(r.Context(), events.GroupMemberAdded{GroupID: grp.GetId(), UserID: id})
+		}
+	}
+
+	res, err := g.scimGroupWithMembers(r, grp)
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	renderSCIM(w, r, http.StatusCreated, res)
+}
+
+func (g Graph) scimReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	var req scimGroup
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	g.scimUpdateGroup(w, r, func(scimGroup) (scimGroup, error) {
+		return req, nil
+	})
+}
+
+func (g Graph) scimPatchGroup(w http.ResponseWriter, r *http.Request) {
+	var req scimPatchOp
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	g.scimUpdateGroup(w, r, func(current scimGroup) (scimGroup, error) {
+		m, err := toSCIMMap(current)
+		if err != nil {
+			return current, err
+		}
+		if err := applySCIMPatch(m, req.Operations); err != nil {
+			return current, err
+		}
+		res := scimGroup{}
+		return res, fromSCIMMap(m, &res)
+	})
+}
+
+// scimUpdateGroup applies the difference between the current and the changed group to the backend
+func (g Graph) scimUpdateGroup(w http.ResponseWriter, r *http.Request, change func(scimGroup) (scimGroup, error)) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+
+	grp, err := g.identityBackend.GetGroup(r.Context(), chi.URLParam(r, "id"), url.Values{})
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	members, err := g.identityBackend.GetGroupMembers(r.Context(), grp.GetId(), scimListRequest())
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	current := g.toSCIMGroup(grp, members)
+	changed, err := change(current)
+	if err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	if changed.DisplayName != "" && changed.DisplayName != current.DisplayName {
+		if !isValidGroupName(changed.DisplayName) {
+			renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid displayName")
+			return
+		}
+		if err := g.identityBackend.UpdateGroupName(r.Context(), grp.GetId(), changed.DisplayName); err != nil {
+			logger.Debug().Err(err).Str("id", grp.GetId()).Msg("could not rename scim group: backend error")
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		grp.SetDisplayName(changed.DisplayName)
+		g.publishEvent(r.Context(), events.GroupFeatureChanged{
+			GroupID:   grp.GetId(),
+			Features:  []events.GroupFeature{{Name: "displayname", Value: changed.DisplayName}},
+			Timestamp: utils.TSNow(),
+		})
+	}
+
+	currentIDs, changedIDs := current.memberIDs(), changed.memberIDs()
+	var added []string
+	for _, id := range changedIDs {
+		if !slices.Contains(currentIDs, id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) > 0 {
+		if err := g.identityBackend.AddMembersToGroup(r.Context(), grp.GetId(), added); err != nil {
+			logger.Debug().Err(err).Str("id", grp.GetId()).Msg("could not add scim group members: backend error")
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		for _, id := range added {
+			g.publishEvent(r.Context(), events.GroupMemberAdded{GroupID: grp.GetId(), UserID: id})
+		}
+	}
+	for _, id := range currentIDs {
+		if slices.Contains(changedIDs, id) {
+			continue
+		}
+		if err := g.identityBackend.RemoveMemberFromGroup(r.Context(), grp.GetId(), id); err != nil {
+			logger.Debug().Err(err).Str("id", grp.GetId()).Str("member", id).Msg("could not remove scim group member: backend error")
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		g.publishEvent(r.Context(), events.GroupMemberRemoved{GroupID: grp.GetId(), UserID: id})
+	}
+
+	res, err := g.scimGroupWithMembers(r, grp)
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	renderSCIM(w, r, http.StatusOK, res)
+}
+
+func (g Graph) scimDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+
+	grp, err := g.identityBackend.GetGroup(r.Context(), chi.URLParam(r, "id"), url.Values{})
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	if err := g.identityBackend.DeleteGroup(r.Context(), grp.GetId()); err != nil {
+		logger.Debug().Err(err).Str("id", grp.GetId()).Msg("could not delete scim group: backend error")
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+
+	g.publishEvent(r.Context(), events.GroupDeleted{GroupID: grp.GetId()})
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,211 @@
+package svc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// scimPatchOp is the body of a PATCH request, see https://www.rfc-editor.org/rfc/rfc7644#section-3.5.2
+type scimPatchOp struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// scimPath is a parsed PATCH path like "name.givenName" or `emails[type eq "work"].value`
+type scimPath struct {
+	attr   string
+	filter scimFilter
+	sub    string
+}
+
+func parseSCIMPath(path string) (scimPath, error) {
+	p := scimPath{}
+	if i := strings.IndexByte(path, '['); i >= 0 {
+		j := strings.LastIndexByte(path, ']')
+		if j < i {
+			return p, fmt.Errorf("invalid path '%s'", path)
+		}
+		f, err := parseSCIMFilter(path[i+1 : j])
+		if err != nil {
+			return p, err
+		}
+		p.attr = scimAttrPath(path[:i])[0]
+		p.filter = f
+		p.sub = strings.TrimPrefix(path[j+1:], ".")
+		return p, nil
+	}
+
+	parts := scimAttrPath(path)
+	if len(parts) > 2 || parts[0] == "" {
+		return p, fmt.Errorf("invalid path '%s'", path)
+	}
+	p.attr = parts[0]
+	if len(parts) == 2 {
+		p.sub = parts[1]
+	}
+	return p, nil
+}
+
+// applySCIMPatch applies the operations to the JSON representation of a resource.
+func applySCIMPatch(res map[string]any, ops []scimPatchOperation) error {
+	for _, op := range ops {
+		var value any
+		if len(op.Value) > 0 {
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return fmt.Errorf("invalid value: %w", err)
+			}
+		}
+
+		kind := strings.ToLower(op.Op)
+		switch kind {
+		case "add", "replace", "remove":
+		default:
+			return fmt.Errorf("unsupported operation '%s'", op.Op)
+		}
+
+		if op.Path == "" {
+			if kind == "remove" {
+				return fmt.Errorf("remove operations require a path")
+			}
+			// without a path the value contains the attributes to change
+			attrs, ok := value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("operations without a path require an object value")
+			}
+			for k, v := range attrs {
+				if err := applySCIMOperation(res, kind, k, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := applySCIMOperation(res, kind, op.Path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applySCIMOperation(res map[string]any, kind, path string, value any) error {
+	p, err := parseSCIMPath(path)
+	if err != nil {
+		return err
+	}
+	key := scimKey(res, p.attr)
+
+	if p.filter != nil {
+		list, _ := res[key].([]any)
+		kept := list[:0:0]
+		for _, e := range list {
+			m, ok := e.(map[string]any)
+			if !ok || !p.filter.match(m) {
+				kept = append(kept, e)
+				continue
+			}
+			switch {
+			case kind == "remove" && p.sub == "":
+				continue
+			case kind == "remove":
+				delete(m, scimKey(m, p.sub))
+			case p.sub != "":
+				m[scimKey(m, p.sub)] = value
+			default:
+				v, ok := value.(map[string]any)
+				if !ok {
+					return fmt.Errorf("the value for '%s' must be an object", path)
+				}
+				for k, sv := range v {
+					m[scimKey(m, k)] = sv
+				}
+			}
+			kept = append(kept, m)
+		}
+		res[key] = kept
+		return nil
+	}
+
+	if p.sub != "" {
+		m, ok := res[key].(map[string]any)
+		if !ok {
+			if kind == "remove" {
+				return nil
+			}
+			m = map[string]any{}
+			res[key] = m
+		}
+		if kind == "remove" {
+			delete(m, scimKey(m, p.sub))
+		} else {
+			m[scimKey(m, p.sub)] = value
+		}
+		return nil
+	}
+
+	existing, isList := res[key].([]any)
+	values, valueIsList := value.([]any)
+	switch {
+	case kind == "remove" && isList && valueIsList:
+		// some clients remove elements of multi-valued attributes by listing them
+		kept := existing[:0:0]
+		for _, e := range existing {
+			if !scimContainsValue(values, e) {
+				kept = append(kept, e)
+			}
+		}
+		res[key] = kept
+	case kind == "remove":
+		delete(res, key)
+	case kind == "add" && (isList || valueIsList):
+		if !valueIsList {
+			values = []any{value}
+		}
+		for _, v := range values {
+			if !scimContainsValue(existing, v) {
+				existing = append(existing, v)
+			}
+		}
+		res[key] = existing
+	case kind == "add":
+		// add merges complex attributes
+		if v, ok := value.(map[string]any); ok {
+			if m, ok := res[key].(map[string]any); ok {
+				for k, sv := range v {
+					m[scimKey(m, k)] = sv
+				}
+				return nil
+			}
+		}
+		res[key] = value
+	default:
+		res[key] = value
+	}
+	return nil
+}
+
+// scimContainsValue checks if a list contains a value, elements of multi-valued attributes are
+// compared by their "value" sub-attribute.
+func scimContainsValue(list []any, v any) bool {
+	vm, isMap := v.(map[string]any)
+	for _, e := range list {
+		em, ok := e.(map[string]any)
+		if isMap && ok {
+			a, aok := scimGet(em, "value")
+			b, bok := scimGet(vm, "value")
+			if aok && bok && reflect.DeepEqual(a, b) {
+				return true
+			}
+		}
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
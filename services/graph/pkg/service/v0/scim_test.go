@@ -0,0 +1,271 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	typesv1beta1 "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/mock"
+	"go-micro.dev/v4/client"
+	"google.golang.org/grpc"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	settings "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+	identitymocks "github.com/opencloud-eu/opencloud/services/graph/pkg/identity/mocks"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+var _ = Describe("SCIM", func() {
+	var (
+		svc             service.Service
+		cfg             *config.Config
+		eventsPublisher mocks.Publisher
+		roleService     *mocks.RoleService
+		identityBackend *identitymocks.Backend
+
+		rr *httptest.ResponseRecorder
+	)
+
+	scimRequest := func(method, target string, body any) *http.Request {
+		var b []byte
+		if body != nil {
+			b, _ = json.Marshal(body)
+		}
+		r := httptest.NewRequest(method, target, bytes.NewReader(b))
+		r.Header.Set("Authorization", "Bearer secret")
+		r.Header.Set("Content-Type", "application/scim+json")
+		return r
+	}
+
+	BeforeEach(func() {
+		eventsPublisher = mocks.Publisher{}
+		eventsPublisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		identityBackend = &identitymocks.Backend{}
+		roleService = &mocks.RoleService{}
+		rr = httptest.NewRecorder()
+
+		cfg = defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = ""
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{OpenCloudURL: "https://cloud.example.com"}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.SCIM.Enabled = true
+		cfg.SCIM.Token = "secret"
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.EventsPublisher(&eventsPublisher),
+			service.WithIdentityBackend(identityBackend),
+			service.WithRoleService(roleService),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects requests without the token", func() {
+		r := scimRequest(http.MethodGet, "/scim/v2/Users", nil)
+		r.Header.Set("Authorization", "Bearer wrong")
+		svc.ServeHTTP(rr, r)
+		Expect(rr.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("serves the service provider config", func() {
+		svc.ServeHTTP(rr, scimRequest(http.MethodGet, "/scim/v2/ServiceProviderConfig", nil))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		Expect(rr.Header().Get("Content-Type")).To(ContainSubstring("application/scim+json"))
+
+		res := map[string]any{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["patch"]).To(HaveKeyWithValue("supported", true))
+	})
+
+	It("creates users", func() {
+		identityBackend.On("CreateUser", mock.Anything, mock.Anything).Return(func(ctx context.Context, u libregraph.User) (*libregraph.User, error) {
+			Expect(u.GetOnPremisesSamAccountName()).To(Equal("alice"))
+			Expect(u.GetMail()).To(Equal("alice@example.com"))
+			Expect(u.GetIdentities()).To(HaveLen(1))
+			Expect(u.GetIdentities()[0].GetIssuerAssignedId()).To(Equal("ext-1"))
+			u.SetId("alice-id")
+			return &u, nil
+		})
+		roleService.On("AssignRoleToUser", mock.Anything, mock.Anything, mock.Anything).Return(func(ctx context.Context, in *settings.AssignRoleToUserRequest, opts ...client.CallOption) (*settings.AssignRoleToUserResponse, error) {
+			Expect(in.GetAccountUuid()).To(Equal("alice-id"))
+			return &settings.AssignRoleToUserResponse{}, nil
+		})
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodPost, "/scim/v2/Users", map[string]any{
+			"schemas":    []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+			"userName":   "alice",
+			"externalId": "ext-1",
+			"name":       map[string]any{"givenName": "Alice", "familyName": "Smith"},
+			"emails":     []map[string]any{{"value": "alice@example.com", "primary": true}},
+		}))
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+
+		res := map[string]any{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["id"]).To(Equal("alice-id"))
+		Expect(res["displayName"]).To(Equal("alice"))
+		Expect(res["meta"]).To(HaveKeyWithValue("location", "https://cloud.example.com/scim/v2/Users/alice-id"))
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, events.UserCreated{UserID: "alice-id"}, mock.Anything)
+	})
+
+	It("looks up users by userName", func() {
+		user := libregraph.NewUser("Alice", "alice")
+		user.SetId("alice-id")
+		identityBackend.On("GetUser", mock.Anything, "alice", mock.Anything).Return(user, nil)
+		identityBackend.On("GetUser", mock.Anything, "bob", mock.Anything).Return(nil, errorcode.New(errorcode.ItemNotFound, "not found"))
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodGet, `/scim/v2/Users?filter=userName+eq+"alice"`, nil))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res := map[string]any{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["totalResults"]).To(BeEquivalentTo(1))
+
+		rr = httptest.NewRecorder()
+		svc.ServeHTTP(rr, scimRequest(http.MethodGet, `/scim/v2/Users?filter=userName+eq+"bob"`, nil))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["totalResults"]).To(BeEquivalentTo(0))
+		identityBackend.AssertNotCalled(GinkgoT(), "GetUsers", mock.Anything, mock.Anything)
+	})
+
+	It("filters the user list", func() {
+		alice := libregraph.NewUser("Alice", "alice")
+		alice.SetMail("alice@example.com")
+		bob := libregraph.NewUser("Bob", "bob")
+		bob.SetMail("bob@example.org")
+		identityBackend.On("GetUsers", mock.Anything, mock.Anything).Return([]*libregraph.User{alice, bob}, nil)
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodGet, `/scim/v2/Users?filter=emails.value+ew+"example.org"+or+displayName+sw+"x"`, nil))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res := map[string]any{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["totalResults"]).To(BeEquivalentTo(1))
+		Expect(res["Resources"]).To(ConsistOf(HaveKeyWithValue("userName", "bob")))
+	})
+
+	It("disables users with PATCH", func() {
+		user := libregraph.NewUser("Alice", "alice")
+		user.SetId("alice-id")
+		identityBackend.On("GetUser", mock.Anything, "alice-id", mock.Anything).Return(user, nil)
+		identityBackend.On("UpdateUser", mock.Anything, "alice-id", mock.Anything).Return(func(ctx context.Context, id string, update libregraph.UserUpdate) (*libregraph.User, error) {
+			Expect(update.GetAccountEnabled()).To(BeFalse())
+			Expect(update.HasDisplayName()).To(BeFalse())
+			user.SetAccountEnabled(false)
+			return user, nil
+		})
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodPatch, "/scim/v2/Users/alice-id", map[string]any{
+			"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]any{{"op": "Replace", "path": "active", "value": "False"}},
+		}))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res := map[string]any{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res["active"]).To(BeFalse())
+	})
+
+	It("updates group members with PATCH", func() {
+		group := libregraph.NewGroup()
+		group.SetId("group-id")
+		group.SetDisplayName("Marketing")
+		member := libregraph.NewUser("Alice", "alice")
+		member.SetId("alice-id")
+		identityBackend.On("GetGroup", mock.Anything, "group-id", mock.Anything).Return(group, nil)
+		identityBackend.On("GetGroupMembers", mock.Anything, "group-id", mock.Anything).Return([]*libregraph.User{member}, nil)
+		identityBackend.On("AddMembersToGroup", mock.Anything, "group-id", []string{"bob-id"}).Return(nil)
+		identityBackend.On("RemoveMemberFromGroup", mock.Anything, "group-id", "alice-id").Return(nil)
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodPatch, "/scim/v2/Groups/group-id", map[string]any{
+			"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+			"Operations": []map[string]any{
+				{"op": "add", "path": "members", "value": []map[string]any{{"value": "bob-id"}}},
+				{"op": "remove", "path": `members[value eq "alice-id"]`},
+			},
+		}))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		identityBackend.AssertExpectations(GinkgoT())
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, events.GroupMemberAdded{GroupID: "group-id", UserID: "bob-id"}, mock.Anything)
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, events.GroupMemberRemoved{GroupID: "group-id", UserID: "alice-id"}, mock.Anything)
+	})
+
+	It("deletes users and their personal space with DELETE", func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient := &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+		natsKeyValue := &mocks.KeyValue{}
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.EventsPublisher(&eventsPublisher),
+			service.WithIdentityBackend(identityBackend),
+			service.WithRoleService(roleService),
+			service.WithGatewaySelector(gatewaySelector),
+			service.WithNatsKeyValue(natsKeyValue),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		user := libregraph.NewUser("Alice", "alice")
+		user.SetId("alice-id")
+		user.SetAccountEnabled(true)
+		identityBackend.On("GetUser", mock.Anything, "alice-id", mock.Anything).Return(user, nil)
+		identityBackend.On("DeleteUser", mock.Anything, "alice-id").Return(nil)
+		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+			Status: status.NewOK(context.Background()),
+			Token:  "service-account-token",
+		}, nil)
+		gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&provider.ListStorageSpacesResponse{
+			Status: status.NewOK(context.Background()),
+			StorageSpaces: []*provider.StorageSpace{{
+				Opaque:    &typesv1beta1.Opaque{},
+				Id:        &provider.StorageSpaceId{OpaqueId: "drive1"},
+				SpaceType: "personal",
+				Owner:     &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "alice-id"}},
+			}},
+		}, nil)
+		gatewayClient.On("DeleteStorageSpace", mock.Anything, mock.Anything).Return(&provider.DeleteStorageSpaceResponse{
+			Status: status.NewOK(context.Background()),
+		}, nil)
+		natsKeyValue.EXPECT().Get(mock.Anything, "alice-id").Return(nil, jetstream.ErrKeyNotFound).Once()
+		natsKeyValue.EXPECT().Put(mock.Anything, "alice-id", mock.Anything).Return(1, nil).Once()
+
+		svc.ServeHTTP(rr, scimRequest(http.MethodDelete, "/scim/v2/Users/alice-id", nil))
+		Expect(rr.Code).To(Equal(http.StatusNoContent))
+		identityBackend.AssertExpectations(GinkgoT())
+		// the personal space is trashed and purged
+		gatewayClient.AssertNumberOfCalls(GinkgoT(), "DeleteStorageSpace", 2)
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, events.UserDeleted{UserID: "alice-id"}, mock.Anything)
+	})
+
+	It("rejects invalid filters", func() {
+		svc.ServeHTTP(rr, scimRequest(http.MethodGet, `/scim/v2/Users?filter=userName+xx+"alice"`, nil))
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		Expect(rr.Body.String()).To(ContainSubstring("invalidFilter"))
+	})
+})
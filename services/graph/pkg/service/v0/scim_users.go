@@ -0,0 +1,361 @@
+package svc
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	gmmetadata "go-micro.dev/v4/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/middleware"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+	ocsettingssvc "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
+)
+
+type scimUser struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	UserName    string           `json:"userName"`
+	Name        *scimName        `json:"name,omitempty"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Emails      []scimMultiValue `json:"emails,omitempty"`
+	Active      *bool            `json:"active,omitempty"`
+	Password    string           `json:"password,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+func (u scimUser) mail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+func (u scimUser) active() bool {
+	return u.Active == nil || *u.Active
+}
+
+func (g Graph) toSCIMUser(u *libregraph.User) scimUser {
+	res := scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          u.GetId(),
+		UserName:    u.GetOnPremisesSamAccountName(),
+		DisplayName: u.GetDisplayName(),
+		Active:      libregraph.PtrBool(true),
+		Meta:        &scimMeta{ResourceType: "User", Location: g.scimLocation("Users", u.GetId())},
+	}
+	if enabled, ok := u.GetAccountEnabledOk(); ok {
+		res.Active = enabled
+	}
+	if u.GetGivenName() != "" || u.GetSurname() != "" {
+		res.Name = &scimName{GivenName: u.GetGivenName(), FamilyName: u.GetSurname()}
+	}
+	if u.GetMail() != "" {
+		res.Emails = []scimMultiValue{{Value: u.GetMail(), Type: "work", Primary: true}}
+	}
+	for _, identity := range u.GetIdentities() {
+		if identity.GetIssuer() == g.config.SCIM.ExternalIDIssuer {
+			res.ExternalID = identity.GetIssuerAssignedId()
+		}
+	}
+	return res
+}
+
+// scimIdentities replaces the identity holding the external id and keeps all other identities
+func (g Graph) scimIdentities(identities []libregraph.ObjectIdentity, externalID string) []libregraph.ObjectIdentity {
+	res := make([]libregraph.ObjectIdentity, 0, len(identities)+1)
+	for _, identity := range identities {
+		if identity.GetIssuer() != g.config.SCIM.ExternalIDIssuer {
+			res = append(res, identity)
+		}
+	}
+	if externalID != "" {
+		identity := libregraph.NewObjectIdentity()
+		identity.SetIssuer(g.config.SCIM.ExternalIDIssuer)
+		identity.SetIssuerAssignedId(externalID)
+		res = append(res, *identity)
+	}
+	return res
+}
+
+func (g Graph) scimListUsers(w http.ResponseWriter, r *http.Request) {
+	filter, startIndex, count, err := scimListParams(r)
+	if err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	var users []*libregraph.User
+	if userName, ok := scimEqualsFilter(filter, "userName"); ok {
+		// identity providers look up users by their name before creating them, avoid listing all users
+		u, err := g.identityBackend.GetUser(r.Context(), userName, scimListRequest())
+		switch {
+		case err == nil:
+			users = append(users, u)
+		case !isNotFound(err):
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+	} else {
+		users, err = g.identityBackend.GetUsers(r.Context(), scimListRequest())
+		if err != nil {
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+	}
+
+	resources := make([]scimUser, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, g.toSCIMUser(u))
+	}
+	renderSCIMList(w, r, resources, filter, startIndex, count)
+}
+
+func (g Graph) scimGetUser(w http.ResponseWriter, r *http.Request) {
+	u, err := g.identityBackend.GetUser(r.Context(), chi.URLParam(r, "id"), scimListRequest())
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	renderSCIM(w, r, http.StatusOK, g.toSCIMUser(u))
+}
+
+func (g Graph) scimCreateUser(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+
+	var req scimUser
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	if !g.isValidUsername(req.UserName) {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid userName")
+		return
+	}
+	mail := req.mail()
+	if mail != "" && !isValidEmail(mail) {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid email address")
+		return
+	}
+
+	displayName := req.DisplayName
+	if displayName == "" {
+		displayName = req.UserName
+	}
+	u := libregraph.NewUser(displayName, req.UserName)
+	u.SetUserType("Member")
+	u.SetAccountEnabled(req.active())
+	if mail != "" {
+		u.SetMail(mail)
+	}
+	if req.Name != nil {
+		if req.Name.GivenName != "" {
+			u.SetGivenName(req.Name.GivenName)
+		}
+		if req.Name.FamilyName != "" {
+			u.SetSurname(req.Name.FamilyName)
+		}
+	}
+	if req.Password != "" {
+		u.SetPasswordProfile(libregraph.PasswordProfile{Password: &req.Password})
+	}
+	if req.ExternalID != "" {
+		u.SetIdentities(g.scimIdentities(nil, req.ExternalID))
+	}
+
+	u, err := g.identityBackend.CreateUser(r.Context(), *u)
+	if err != nil {
+		logger.Debug().Err(err).Str("username", req.UserName).Msg("could not create scim user: backend error")
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+
+	if g.roleService != nil && g.config.API.AssignDefaultUserRole {
+		// there is no user in the context of a SCIM request, users are allowed to assign the default role to themselves
+		ctx := gmmetadata.Set(r.Context(), middleware.AccountID, u.GetId())
+		if _, err := g.roleService.AssignRoleToUser(ctx, &settingssvc.AssignRoleToUserRequest{
+			AccountUuid: u.GetId(),
+			RoleId:      ocsettingssvc.BundleUUIDRoleUser,
+		}); err != nil {
+			logger.Error().Err(err).Str("id", u.GetId()).Str("role", ocsettingssvc.BundleUUIDRoleUser).Msg("could not create scim user: role assignment failed")
+			renderSCIMError(w, r, http.StatusInternalServerError, "", "role assignment failed")
+			return
+		}
+	}
+
+	g.publishEvent(r.Context(), events.UserCreated{UserID: u.GetId()})
+	renderSCIM(w, r, http.StatusCreated, g.toSCIMUser(u))
+}
+
+func (g Graph) scimReplaceUser(w http.ResponseWriter, r *http.Request) {
+	var req scimUser
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	g.scimUpdateUser(w, r, func(scimUser) (scimUser, error) {
+		return req, nil
+	})
+}
+
+func (g Graph) scimPatchUser(w http.ResponseWriter, r *http.Request) {
+	var req scimPatchOp
+	if err := decodeSCIM(r, &req); err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+	g.scimUpdateUser(w, r, func(current scimUser) (scimUser, error) {
+		// the password is never returned, keep it out of the comparison
+		current.Password = ""
+		m, err := toSCIMMap(current)
+		if err != nil {
+			return current, err
+		}
+		if err := applySCIMPatch(m, req.Operations); err != nil {
+			return current, err
+		}
+		// some identity providers send booleans as strings
+		if k := scimKey(m, "active"); m[k] != nil {
+			if s, ok := m[k].(string); ok {
+				b, err := strconv.ParseBool(s)
+				if err != nil {
+					return current, err
+				}
+				m[k] = b
+			}
+		}
+		res := scimUser{}
+		return res, fromSCIMMap(m, &res)
+	})
+}
+
+// scimUpdateUser applies the difference between the current and the changed user to the backend
+func (g Graph) scimUpdateUser(w http.ResponseWriter, r *http.Request, change func(scimUser) (scimUser, error)) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	id := chi.URLParam(r, "id")
+
+	u, err := g.identityBackend.GetUser(r.Context(), id, scimListRequest())
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	current := g.toSCIMUser(u)
+	changed, err := change(current)
+	if err != nil {
+		renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	update := libregraph.UserUpdate{}
+	var features []events.UserFeature
+	if changed.UserName != "" && changed.UserName != current.UserName {
+		if !g.isValidUsername(changed.UserName) {
+			renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid userName")
+			return
+		}
+		update.SetOnPremisesSamAccountName(changed.UserName)
+	}
+	if changed.DisplayName != "" && changed.DisplayName != current.DisplayName {
+		update.SetDisplayName(changed.DisplayName)
+		features = append(features, events.UserFeature{Name: "displayname", Value: changed.DisplayName, OldValue: &current.DisplayName})
+	}
+	if mail := changed.mail(); mail != current.mail() {
+		if mail != "" && !isValidEmail(mail) {
+			renderSCIMError(w, r, http.StatusBadRequest, "invalidValue", "invalid email address")
+			return
+		}
+		update.SetMail(mail)
+		old := current.mail()
+		features = append(features, events.UserFeature{Name: "email", Value: mail, OldValue: &old})
+	}
+	var oldName, newName scimName
+	if current.Name != nil {
+		oldName = *current.Name
+	}
+	if changed.Name != nil {
+		newName = *changed.Name
+	}
+	if newName.GivenName != oldName.GivenName {
+		update.SetGivenName(newName.GivenName)
+	}
+	if newName.FamilyName != oldName.FamilyName {
+		update.SetSurname(newName.FamilyName)
+	}
+	if changed.active() != current.active() {
+		update.SetAccountEnabled(changed.active())
+		old := strconv.FormatBool(current.active())
+		features = append(features, events.UserFeature{Name: "accountEnabled", Value: strconv.FormatBool(changed.active()), OldValue: &old})
+	}
+	if changed.Password != "" {
+		update.SetPasswordProfile(libregraph.PasswordProfile{Password: &changed.Password})
+		features = append(features, events.UserFeature{Name: "passwordChanged"})
+	}
+	if changed.ExternalID != current.ExternalID {
+		update.SetIdentities(g.scimIdentities(u.GetIdentities(), changed.ExternalID))
+	}
+
+	if m, _ := update.ToMap(); len(m) > 0 {
+		if u, err = g.identityBackend.UpdateUser(r.Context(), id, update); err != nil {
+			logger.Debug().Err(err).Str("id", id).Msg("could not update scim user: backend error")
+			renderSCIMBackendError(w, r, err)
+			return
+		}
+		g.publishEvent(r.Context(), events.UserFeatureChanged{
+			UserID:    u.GetId(),
+			Features:  features,
+			Timestamp: utils.TSNow(),
+		})
+	}
+	renderSCIM(w, r, http.StatusOK, g.toSCIMUser(u))
+}
+
+func (g Graph) scimDeleteUser(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	id := chi.URLParam(r, "id")
+
+	u, err := g.identityBackend.GetUser(r.Context(), id, scimListRequest())
+	if err != nil {
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+
+	// SCIM clients are not users, the personal space is deleted as the service account
+	ctx := r.Context()
+	if g.gatewaySelector != nil {
+		client, err := g.gatewaySelector.Next()
+		if err != nil {
+			logger.Error().Err(err).Msg("could not delete scim user: error selecting next gateway client")
+			renderSCIMError(w, r, http.StatusInternalServerError, "", "error selecting next gateway client")
+			return
+		}
+		ctx, err = utils.GetServiceUserContextWithContext(ctx, client, g.config.ServiceAccount.ServiceAccountID, g.config.ServiceAccount.ServiceAccountSecret)
+		if err != nil {
+			logger.Error().Err(err).Msg("could not delete scim user: failed to authenticate the service account")
+			renderSCIMError(w, r, http.StatusInternalServerError, "", "could not authenticate the service account")
+			return
+		}
+	}
+
+	// the same as deleting the user via the graph API, users are soft deleted first when a retention time is configured
+	if err := g.deleteUser(ctx, u, false, nil); err != nil {
+		logger.Debug().Err(err).Str("id", id).Msg("could not delete scim user")
+		renderSCIMBackendError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func isNotFound(err error) bool {
+	e, ok := errorcode.ToError(err)
+	return ok && e.GetCode() == errorcode.ItemNotFound
+}
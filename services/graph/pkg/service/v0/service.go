@@ -34,7 +34,6 @@ import (
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/identity"
 	graphm "github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
-	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
 )
 
 const (
@@ -117,6 +116,9 @@ type Service interface { //nolint:interfacebloat
 	GetRootDriveChildren(w http.ResponseWriter, r *http.Request)
 	GetDriveItem(w http.ResponseWriter, r *http.Request)
 	GetDriveItemChildren(w http.ResponseWriter, r *http.Request)
+	GetDriveItemDelta(w http.ResponseWriter, r *http.Request)
+
+	Batch(w http.ResponseWriter, r *http.Request)
 
 	CreateUploadSession(w http.ResponseWriter, r *http.Request)
 
@@ -159,7 +161,6 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		identityCache:   identityCache,
 		gatewaySelector: options.GatewaySelector,
 		config:          options.Config,
-		availableRoles:  unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(options.Config.UnifiedRoles.AvailableRoles...)),
 	}
 
 	drivesDriveItemService, err := NewDrivesDriveItemService(options.Logger, options.GatewaySelector)
@@ -199,7 +200,9 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		historyClient:            options.EventHistoryClient,
 		traceProvider:            options.TraceProvider,
 		valueService:             options.ValueService,
+		bundleService:            options.BundleService,
 		natskv:                   options.NatsKeyValue,
+		deltakv:                  options.DeltaKeyValue,
 	}
 
 	if err := setIdentityBackends(options, &svc); err != nil {
@@ -248,6 +251,7 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		r.Use(middleware.StripSlashes)
 
 		r.Route("/v1beta1", func(r chi.Router) {
+			r.Post("/$batch", svc.Batch)
 			r.Route("/me", func(r chi.Router) {
 				r.Get("/drives", svc.GetDrives(APIVersion_1_Beta_1))
 				r.Route("/drive", func(r chi.Router) {
@@ -290,10 +294,16 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 			})
 			r.Route("/roleManagement/permissions/roleDefinitions", func(r chi.Router) {
 				r.Get("/", svc.GetRoleDefinitions)
-				r.Get("/{roleID}", svc.GetRoleDefinition)
+				r.With(requireAdmin).Post("/", svc.CreateRoleDefinition)
+				r.Route("/{roleID}", func(r chi.Router) {
+					r.Get("/", svc.GetRoleDefinition)
+					r.With(requireAdmin).Patch("/", svc.UpdateRoleDefinition)
+					r.With(requireAdmin).Delete("/", svc.DeleteRoleDefinition)
+				})
 			})
 		})
 		r.Route("/v1.0", func(r chi.Router) {
+			r.Post("/$batch", svc.Batch)
 			r.Route("/extensions/org.libregraph", func(r chi.Router) {
 				r.Get("/tags", svc.GetTags)
 				r.Put("/tags", svc.AssignTags)
@@ -364,6 +374,7 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 					r.Route("/items/{driveItemID}", func(r chi.Router) {
 						r.Get("/", svc.GetDriveItem)
 						r.Get("/children", svc.GetDriveItemChildren)
+						r.Get("/delta", svc.GetDriveItemDelta)
 						r.Post("/createUploadSession", svc.CreateUploadSession)
 					})
 				})
@@ -425,6 +436,14 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		return nil
 	})
 
+	if options.Config.SCIM.Enabled {
+		svc.scim = svc.scimRouter()
+	}
+
+	if svc.bundleService != nil && options.Context != nil {
+		go svc.syncCustomRoles(options.Context)
+	}
+
 	return svc, nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/CiscoM31/godata"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	invitepb "github.com/cs3org/go-cs3apis/cs3/ocm/invite/v1beta1"
 	cs3rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	storageprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -647,19 +648,46 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	us, err := g.getUserStateFromNatsKeyValue(r.Context(), userID)
+	currentUser, ok := revactx.ContextGetUser(r.Context())
+	if !ok {
+		logger.Debug().Msg("could not delete user: user not in context")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "user not in context")
+		return
+	}
+
+	if currentUser.GetId().GetOpaqueId() == user.GetId() {
+		logger.Debug().Msg("could not delete user: self deletion forbidden")
+		errorcode.NotAllowed.Render(w, r, http.StatusForbidden, "self deletion forbidden")
+		return
+	}
+
+	if err := g.deleteUser(r.Context(), user, purgeUser, currentUser.GetId()); err != nil {
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+// deleteUser deletes a user and its personal space. When a soft delete retention time is configured,
+// the user is disabled first and only deleted when purge is set for a disabled user. The executant
+// is nil for users deleted by the SCIM provisioning endpoint.
+func (g Graph) deleteUser(ctx context.Context, user *libregraph.User, purge bool, executant *userpb.UserId) error {
+	logger := g.logger.SubloggerWithRequestID(ctx)
+	var stateErr error
+
+	us, err := g.getUserStateFromNatsKeyValue(ctx, user.GetId())
 	if err != nil {
-		logger.Error().Err(err).Str("id", userID).Msg("could not get user state")
+		logger.Error().Err(err).Str("id", user.GetId()).Msg("could not get user state")
 		us = userstate.UserState{
-			UserId: userID,
+			UserId: user.GetId(),
 			State:  userstate.UserStateUnspecified,
 		}
 	}
 
 	if us.State == userstate.UserStateHardDeleted {
-		logger.Debug().Str("id", userID).Msg("could not delete user: user already hard deleted")
-		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, "user not found")
-		return
+		logger.Debug().Str("id", user.GetId()).Msg("could not delete user: user already hard deleted")
+		return errorcode.New(errorcode.ItemNotFound, "user not found")
 	}
 
 	if us.State == userstate.UserStateUnspecified {
@@ -670,23 +698,9 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if g.config.UserSoftDeleteRetentionTime > 0 && purgeUser && us.State == userstate.UserStateEnabled {
-		logger.Debug().Msg("could not delete user: purgeUser is set but user is still enabled")
-		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "user should be hard deleted, but is still enabled, please soft delete first")
-		return
-	}
-
-	currentUser, ok := revactx.ContextGetUser(r.Context())
-	if !ok {
-		logger.Debug().Msg("could not delete user: user not in context")
-		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "user not in context")
-		return
-	}
-
-	if currentUser.GetId().GetOpaqueId() == user.GetId() {
-		logger.Debug().Msg("could not delete user: self deletion forbidden")
-		errorcode.NotAllowed.Render(w, r, http.StatusForbidden, "self deletion forbidden")
-		return
+	if g.config.UserSoftDeleteRetentionTime > 0 && purge && us.State == userstate.UserStateEnabled {
+		logger.Debug().Msg("could not delete user: purge is set but user is still enabled")
+		return errorcode.New(errorcode.InvalidRequest, "user should be hard deleted, but is still enabled, please soft delete first")
 	}
 
 	if g.gatewaySelector != nil {
@@ -698,18 +712,16 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		client, err := g.gatewaySelector.Next()
 		if err != nil {
 			logger.Error().Err(err).Msg("error selecting next gateway client")
-			errorcode.ServiceNotAvailable.Render(w, r, http.StatusInternalServerError, "error selecting next gateway client, aborting")
-			return
+			return errorcode.New(errorcode.ServiceNotAvailable, "error selecting next gateway client, aborting")
 		}
-		lspr, err := client.ListStorageSpaces(r.Context(), &storageprovider.ListStorageSpacesRequest{
+		lspr, err := client.ListStorageSpaces(ctx, &storageprovider.ListStorageSpacesRequest{
 			Opaque:  opaque,
 			Filters: []*storageprovider.ListStorageSpacesRequest_Filter{f},
 		})
 		if err != nil {
 			// transport error, log as error
 			logger.Error().Err(err).Msg("could not fetch spaces: transport error")
-			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not fetch spaces for deletion, aborting")
-			return
+			return errorcode.New(errorcode.GeneralException, "could not fetch spaces for deletion, aborting")
 		}
 		for _, sp := range lspr.GetStorageSpaces() {
 			// if the spacetype equals _spaceTypePersonal and the owner id equals the user id
@@ -724,21 +736,20 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 			// Deleting a space a two step process (1. disabling/trashing, 2. purging)
 			// Do the "disable/trash" step only if the space is not marked as trashed yet:
 			if _, ok := sp.Opaque.Map[_spaceStateTrashed]; !ok {
-				_, err := client.DeleteStorageSpace(r.Context(), &storageprovider.DeleteStorageSpaceRequest{
+				_, err := client.DeleteStorageSpace(ctx, &storageprovider.DeleteStorageSpaceRequest{
 					Id: &storageprovider.StorageSpaceId{
 						OpaqueId: sp.Id.OpaqueId,
 					},
 				})
 				if err != nil {
 					logger.Error().Err(err).Msg("could not disable homespace: transport error")
-					errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not disable homespace, aborting")
-					return
+					return errorcode.New(errorcode.GeneralException, "could not disable homespace, aborting")
 				}
 			}
 			// the space will if the system does not have a UserSoftDeleteRetentionTime configured, e.g. SoftDelete disabled
-			if g.config.UserSoftDeleteRetentionTime == 0 || (purgeUser && us.State == userstate.UserStateSoftDeleted) {
+			if g.config.UserSoftDeleteRetentionTime == 0 || (purge && us.State == userstate.UserStateSoftDeleted) {
 				purgeSpaceFlag := utils.AppendPlainToOpaque(nil, "purge", "")
-				_, err := client.DeleteStorageSpace(r.Context(), &storageprovider.DeleteStorageSpaceRequest{
+				_, err := client.DeleteStorageSpace(ctx, &storageprovider.DeleteStorageSpaceRequest{
 					Opaque: purgeSpaceFlag,
 					Id: &storageprovider.StorageSpaceId{
 						OpaqueId: sp.Id.OpaqueId,
@@ -747,29 +758,27 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 				if err != nil {
 					// transport error, log as error
 					logger.Error().Err(err).Msg("could not delete homespace: transport error")
-					errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not delete homespace, aborting")
-					return
+					return errorcode.New(errorcode.GeneralException, "could not delete homespace, aborting")
 				}
 			}
 			break
 		}
 	}
 
-	if (g.config.UserSoftDeleteRetentionTime > 0 && us.State == userstate.UserStateSoftDeleted && purgeUser) ||
+	if (g.config.UserSoftDeleteRetentionTime > 0 && us.State == userstate.UserStateSoftDeleted && purge) ||
 		(g.config.UserSoftDeleteRetentionTime == 0) {
 		logger.Debug().Str("id", user.GetId()).Msg("calling delete user on backend")
-		err = g.identityBackend.DeleteUser(r.Context(), user.GetId())
+		err = g.identityBackend.DeleteUser(ctx, user.GetId())
 		if err != nil {
 			logger.Debug().Err(err).Msg("could not delete user: backend error")
-			errorcode.RenderError(w, r, err)
-			return
+			return err
 		}
 
 		us.State = userstate.UserStateHardDeleted
-		err = g.setUserStateToNatsKeyValue(r.Context(), userID, us)
-		if err != nil {
-			logger.Error().Err(err).Str("id", userID).Msg("could not set user state")
-			errorcode.RenderError(w, r, err)
+		// the user is gone, the event is published even if the state can't be stored
+		stateErr = g.setUserStateToNatsKeyValue(ctx, user.GetId(), us)
+		if stateErr != nil {
+			logger.Error().Err(stateErr).Str("id", user.GetId()).Msg("could not set user state")
 		}
 	} else {
 		logger.Debug().Str("id", user.GetId()).Msg("calling soft delete user on backend")
@@ -779,20 +788,19 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		us.RetentionPeriod = g.config.UserSoftDeleteRetentionTime
 		us.Reason = "User soft deleted via Graph API" // TODO: this needs a proper implementation through the request
 		us.TimeStamp = time.Now()
-		err = g.setUserStateToNatsKeyValue(r.Context(), userID, us)
+		err = g.setUserStateToNatsKeyValue(ctx, user.GetId(), us)
 		if err != nil {
-			logger.Error().Err(err).Str("id", userID).Msg("could not set user state")
-			errorcode.RenderError(w, r, err)
-			return
+			logger.Error().Err(err).Str("id", user.GetId()).Msg("could not set user state")
+			return err
 		}
-		g.identityBackend.UpdateUser(r.Context(), user.GetId(), userUpdate)
+		g.identityBackend.UpdateUser(ctx, user.GetId(), userUpdate)
 	}
 
 	if g.config.UserSoftDeleteRetentionTime == 0 ||
-		(g.config.UserSoftDeleteRetentionTime > 0 && purgeUser && us.State == userstate.UserStateSoftDeleted) {
+		(g.config.UserSoftDeleteRetentionTime > 0 && purge && us.State == userstate.UserStateSoftDeleted) {
 		e := events.UserDeleted{UserID: user.GetId()}
-		e.Executant = currentUser.GetId()
-		g.publishEvent(r.Context(), e)
+		e.Executant = executant
+		g.publishEvent(ctx, e)
 	} else {
 		e := events.UserSoftDeleted{
 			UserID:        user.GetId(),
@@ -803,11 +811,10 @@ func (g Graph) DeleteUser(w http.ResponseWriter, r *http.Request) {
 			},
 			Reason: "User deleted via Graph API", // TODO: this needs a proper implementation through the request
 		}
-		e.Executant = currentUser.GetId()
-		g.publishEvent(r.Context(), e)
+		e.Executant = executant
+		g.publishEvent(ctx, e)
 	}
-	render.Status(r, http.StatusNoContent)
-	render.NoContent(w, r)
+	return stateErr
 }
 
 // PatchMe implements the Service Interface. Updates the specified attributes of the current user
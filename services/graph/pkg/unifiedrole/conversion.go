@@ -144,7 +144,8 @@ func CS3ResourcePermissionsToLibregraphActions(p *provider.ResourcePermissions)
 	return actions
 }
 
-// CS3ResourcePermissionsToRole converts the provided cs3 ResourcePermissions to a libregraph UnifiedRoleDefinition
+// CS3ResourcePermissionsToRole converts the provided cs3 ResourcePermissions to a libregraph UnifiedRoleDefinition,
+// the first matching role of the set wins, so built-in roles take precedence over custom roles allowing the same actions.
 func CS3ResourcePermissionsToRole(roleSet []*libregraph.UnifiedRoleDefinition, p *provider.ResourcePermissions, constraints string, listFederatedRoles bool) *libregraph.UnifiedRoleDefinition {
 	actionSet := map[string]struct{}{}
 	for _, action := range CS3ResourcePermissionsToLibregraphActions(p) {
@@ -0,0 +1,109 @@
+package unifiedrole
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+)
+
+var (
+	// customRoles contains the roles defined by the admins, they are persisted in the settings service
+	customRoles   []*libregraph.UnifiedRoleDefinition
+	customRolesMu sync.RWMutex
+
+	// customRoleConditions are the conditions a custom role can define permissions for
+	customRoleConditions = []string{
+		UnifiedRoleConditionDrive,
+		UnifiedRoleConditionFolder,
+		UnifiedRoleConditionFile,
+		UnifiedRoleConditionFolderFederatedUser,
+		UnifiedRoleConditionFileFederatedUser,
+	}
+
+	// customRoleActions are the actions a custom role can allow, denying access is reserved to the built-in denied role
+	customRoleActions = []string{
+		DriveItemPermissionsCreate,
+		DriveItemChildrenCreate,
+		DriveItemStandardDelete,
+		DriveItemPathRead,
+		DriveItemQuotaRead,
+		DriveItemContentRead,
+		DriveItemUploadCreate,
+		DriveItemPermissionsRead,
+		DriveItemChildrenRead,
+		DriveItemVersionsRead,
+		DriveItemDeletedRead,
+		DriveItemPathUpdate,
+		DriveItemPermissionsDelete,
+		DriveItemDeletedDelete,
+		DriveItemVersionsUpdate,
+		DriveItemDeletedUpdate,
+		DriveItemBasicRead,
+		DriveItemPermissionsUpdate,
+	}
+)
+
+// SetCustomRoles replaces the custom roles
+func SetCustomRoles(roles []*libregraph.UnifiedRoleDefinition) {
+	customRolesMu.Lock()
+	defer customRolesMu.Unlock()
+
+	customRoles = slices.Clone(roles)
+}
+
+// GetCustomRoles returns the custom roles
+func GetCustomRoles() []*libregraph.UnifiedRoleDefinition {
+	customRolesMu.RLock()
+	defer customRolesMu.RUnlock()
+
+	return slices.Clone(customRoles)
+}
+
+// ValidateCustomRole checks that the provided role can be used as custom role
+func ValidateCustomRole(role *libregraph.UnifiedRoleDefinition) error {
+	if slices.ContainsFunc(buildInRoles, func(r *libregraph.UnifiedRoleDefinition) bool {
+		return r.GetId() == role.GetId()
+	}) {
+		return fmt.Errorf("%w: the id is used by a built-in role", ErrInvalidRoleDefinition)
+	}
+
+	if strings.TrimSpace(role.GetDisplayName()) == "" {
+		return fmt.Errorf("%w: the display name must not be empty", ErrInvalidRoleDefinition)
+	}
+
+	if len(role.GetRolePermissions()) == 0 {
+		return fmt.Errorf("%w: at least one role permission is required", ErrInvalidRoleDefinition)
+	}
+
+	conditions := make([]string, 0, len(role.GetRolePermissions()))
+	for _, permission := range role.GetRolePermissions() {
+		switch condition := permission.GetCondition(); {
+		case !slices.Contains(customRoleConditions, condition):
+			return fmt.Errorf("%w: unsupported condition '%s'", ErrInvalidRoleDefinition, condition)
+		case slices.Contains(conditions, condition):
+			return fmt.Errorf("%w: the condition '%s' is defined more than once", ErrInvalidRoleDefinition, condition)
+		default:
+			conditions = append(conditions, condition)
+		}
+
+		if len(permission.GetAllowedResourceActions()) == 0 {
+			return fmt.Errorf("%w: the condition '%s' allows no actions", ErrInvalidRoleDefinition, permission.GetCondition())
+		}
+
+		for _, action := range permission.GetAllowedResourceActions() {
+			if !slices.Contains(customRoleActions, action) {
+				return fmt.Errorf("%w: unsupported action '%s'", ErrInvalidRoleDefinition, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+// allRoles returns the built-in roles followed by the custom roles
+func allRoles() []*libregraph.UnifiedRoleDefinition {
+	return append(slices.Clone(buildInRoles), GetCustomRoles()...)
+}
@@ -0,0 +1,157 @@
+package unifiedrole_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
+)
+
+func newCustomRole(id string, condition string, actions ...string) *libregraph.UnifiedRoleDefinition {
+	return &libregraph.UnifiedRoleDefinition{
+		Id:          proto.String(id),
+		DisplayName: proto.String("Can comment"),
+		RolePermissions: []libregraph.UnifiedRolePermission{
+			{
+				AllowedResourceActions: actions,
+				Condition:              proto.String(condition),
+			},
+		},
+	}
+}
+
+func TestValidateCustomRole(t *testing.T) {
+	tests := map[string]struct {
+		role        *libregraph.UnifiedRoleDefinition
+		expectError bool
+	}{
+		"valid": {
+			role: newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile, unifiedrole.DriveItemBasicRead, unifiedrole.DriveItemContentRead),
+		},
+		"built-in id": {
+			role:        newCustomRole(unifiedrole.UnifiedRoleViewerID, unifiedrole.UnifiedRoleConditionFile, unifiedrole.DriveItemBasicRead),
+			expectError: true,
+		},
+		"missing display name": {
+			role: &libregraph.UnifiedRoleDefinition{
+				Id:              proto.String("custom"),
+				RolePermissions: newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile, unifiedrole.DriveItemBasicRead).RolePermissions,
+			},
+			expectError: true,
+		},
+		"missing permissions": {
+			role: &libregraph.UnifiedRoleDefinition{
+				Id:          proto.String("custom"),
+				DisplayName: proto.String("Can comment"),
+			},
+			expectError: true,
+		},
+		"unknown condition": {
+			role:        newCustomRole("custom", "exists @Resource.Shortcut", unifiedrole.DriveItemBasicRead),
+			expectError: true,
+		},
+		"duplicate condition": {
+			role: &libregraph.UnifiedRoleDefinition{
+				Id:          proto.String("custom"),
+				DisplayName: proto.String("Can comment"),
+				RolePermissions: append(
+					newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile, unifiedrole.DriveItemBasicRead).RolePermissions,
+					newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile, unifiedrole.DriveItemContentRead).RolePermissions...,
+				),
+			},
+			expectError: true,
+		},
+		"no actions": {
+			role:        newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile),
+			expectError: true,
+		},
+		"unknown action": {
+			role:        newCustomRole("custom", unifiedrole.UnifiedRoleConditionFile, "libre.graph/driveItem/comments/create"),
+			expectError: true,
+		},
+		"deny action": {
+			role:        newCustomRole("custom", unifiedrole.UnifiedRoleConditionFolder, unifiedrole.DriveItemPermissionsDeny),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := unifiedrole.ValidateCustomRole(tc.role)
+
+			if tc.expectError {
+				g.Expect(err).To(MatchError(unifiedrole.ErrInvalidRoleDefinition))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestCustomRoles(t *testing.T) {
+	g := NewWithT(t)
+
+	uploadOnly := newCustomRole("upload-only", unifiedrole.UnifiedRoleConditionFolder, unifiedrole.DriveItemUploadCreate, unifiedrole.DriveItemChildrenCreate)
+	unifiedrole.SetCustomRoles([]*libregraph.UnifiedRoleDefinition{uploadOnly})
+	t.Cleanup(func() { unifiedrole.SetCustomRoles(nil) })
+
+	role, err := unifiedrole.GetRole(unifiedrole.RoleFilterIDs("upload-only"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(role).To(Equal(uploadOnly))
+
+	g.Expect(unifiedrole.GetRoles(unifiedrole.RoleFilterCustom())).To(ConsistOf(uploadOnly))
+	g.Expect(unifiedrole.GetRoles(unifiedrole.RoleFilterAll())).To(HaveLen(len(unifiedrole.BuildInRoles) + 1))
+	g.Expect(unifiedrole.GetRoles(unifiedrole.RoleFilterAny(
+		unifiedrole.RoleFilterIDs(unifiedrole.UnifiedRoleViewerID),
+		unifiedrole.RoleFilterCustom(),
+	))).To(ConsistOf(unifiedrole.RoleViewer, uploadOnly))
+
+	g.Expect(unifiedrole.GetRolesByPermissions(
+		unifiedrole.GetRoles(unifiedrole.RoleFilterAll()),
+		[]string{unifiedrole.DriveItemUploadCreate, unifiedrole.DriveItemChildrenCreate},
+		unifiedrole.UnifiedRoleConditionFolder,
+		false,
+		false,
+	)).To(ConsistOf(uploadOnly))
+
+	g.Expect(unifiedrole.CS3ResourcePermissionsToRole(
+		unifiedrole.GetRoles(unifiedrole.RoleFilterAll()),
+		unifiedrole.PermissionsToCS3ResourcePermissions(unifiedrole.GetRolePermissions(uploadOnly, unifiedrole.UnifiedRoleConditionFolder)),
+		unifiedrole.UnifiedRoleConditionFolder,
+		false,
+	)).To(Equal(uploadOnly))
+
+	unifiedrole.SetCustomRoles(nil)
+	_, err = unifiedrole.GetRole(unifiedrole.RoleFilterIDs("upload-only"))
+	g.Expect(err).To(MatchError(unifiedrole.ErrUnknownRole))
+}
+
+func TestGetRolePermissions(t *testing.T) {
+	g := NewWithT(t)
+
+	role := &libregraph.UnifiedRoleDefinition{
+		Id:          proto.String("custom"),
+		DisplayName: proto.String("Can comment"),
+		RolePermissions: []libregraph.UnifiedRolePermission{
+			{
+				AllowedResourceActions: []string{unifiedrole.DriveItemBasicRead, unifiedrole.DriveItemContentRead},
+				Condition:              proto.String(unifiedrole.UnifiedRoleConditionFile),
+			},
+			{
+				AllowedResourceActions: []string{unifiedrole.DriveItemBasicRead, unifiedrole.DriveItemChildrenRead},
+				Condition:              proto.String(unifiedrole.UnifiedRoleConditionFolder),
+			},
+		},
+	}
+
+	permissions := unifiedrole.GetRolePermissions(role, unifiedrole.UnifiedRoleConditionFile)
+	g.Expect(permissions).To(HaveLen(1))
+	g.Expect(permissions[0].GetAllowedResourceActions()).To(ConsistOf(unifiedrole.DriveItemBasicRead, unifiedrole.DriveItemContentRead))
+
+	// the denied role only defines a permission for folders, it keeps it for files
+	g.Expect(unifiedrole.GetRolePermissions(unifiedrole.RoleDenied, unifiedrole.UnifiedRoleConditionFile)).To(HaveLen(1))
+}
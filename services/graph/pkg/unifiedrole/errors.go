@@ -7,4 +7,7 @@ import (
 var (
 	// ErrUnknownRole is returned when an unknown unified role is requested.
 	ErrUnknownRole = errors.New("unknown role, check if the role is enabled")
+
+	// ErrInvalidRoleDefinition is returned when a custom role definition is not valid.
+	ErrInvalidRoleDefinition = errors.New("invalid role definition")
 )
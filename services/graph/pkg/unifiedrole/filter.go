@@ -33,6 +33,24 @@ func RoleFilterIDs(ids ...string) RoleFilter {
 	}
 }
 
+// RoleFilterCustom returns a role filter that matches the custom roles
+func RoleFilterCustom() RoleFilter {
+	return func(r *libregraph.UnifiedRoleDefinition) bool {
+		return slices.ContainsFunc(GetCustomRoles(), func(c *libregraph.UnifiedRoleDefinition) bool {
+			return c.GetId() == r.GetId()
+		})
+	}
+}
+
+// RoleFilterAny returns a role filter that matches if any of the provided filters matches
+func RoleFilterAny(filters ...RoleFilter) RoleFilter {
+	return func(r *libregraph.UnifiedRoleDefinition) bool {
+		return slices.ContainsFunc(filters, func(f RoleFilter) bool {
+			return f(r)
+		})
+	}
+}
+
 // filterRoles filters the provided roles by the provided filter
 func filterRoles(roles []*libregraph.UnifiedRoleDefinition, f RoleFilter) []*libregraph.UnifiedRoleDefinition {
 	return slices.DeleteFunc(
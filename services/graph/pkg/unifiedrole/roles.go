@@ -467,14 +467,14 @@ var (
 	}()
 )
 
-// GetRoles returns a role filter that matches the provided resources
+// GetRoles returns the built-in and custom roles that match the provided filter
 func GetRoles(f RoleFilter) []*libregraph.UnifiedRoleDefinition {
-	return filterRoles(buildInRoles, f)
+	return filterRoles(allRoles(), f)
 }
 
-// GetRole returns a role filter that matches the provided resources
+// GetRole returns the first built-in or custom role that matches the provided filter
 func GetRole(f RoleFilter) (*libregraph.UnifiedRoleDefinition, error) {
-	roles := filterRoles(buildInRoles, f)
+	roles := filterRoles(allRoles(), f)
 	if len(roles) == 0 {
 		return nil, ErrUnknownRole
 	}
@@ -560,3 +560,23 @@ func GetAllowedResourceActions(role *libregraph.UnifiedRoleDefinition, condition
 
 	return []string{}
 }
+
+// GetRolePermissions returns the role permissions that apply to the provided condition,
+// roles without a permission for the condition, like the denied role, keep all their permissions.
+func GetRolePermissions(role *libregraph.UnifiedRoleDefinition, condition string) []*libregraph.UnifiedRolePermission {
+	var permissions []*libregraph.UnifiedRolePermission
+	for _, p := range role.GetRolePermissions() {
+		if p.GetCondition() == condition {
+			permissions = append(permissions, &p)
+		}
+	}
+
+	if len(permissions) > 0 {
+		return permissions
+	}
+
+	for _, p := range role.GetRolePermissions() {
+		permissions = append(permissions, &p)
+	}
+	return permissions
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/mfa"
+
+	"github.com/spf13/cobra"
+)
+
+// ResetMFA is the entrypoint for the resetmfa command
+func ResetMFA(cfg *config.Config) *cobra.Command {
+	resetMFACmd := &cobra.Command{
+		Use:   "resetmfa",
+		Short: "Reset the second factors of a user",
+		Long:  "Removes the TOTP secret, the WebAuthn credentials and the recovery codes of a user and unlocks the second factor. The user has to enroll a new second factor with the next login if it is required.",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userName, _ := cmd.Flags().GetString("user-name")
+			return resetMFA(cfg, userName)
+		},
+	}
+	resetMFACmd.Flags().StringP(
+		"user-name",
+		"u",
+		"",
+		"User name",
+	)
+	_ = resetMFACmd.MarkFlagRequired("user-name")
+
+	return resetMFACmd
+}
+
+func resetMFA(cfg *config.Config, userName string) error {
+	m, err := mfa.NewManager(cfg, mfa.NewStore(cfg.MFA.Store), nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize mfa: %v\n", err)
+		return err
+	}
+
+	user := mfa.NormalizeUser(userName)
+	fmt.Printf("Resetting second factors for user '%s'.\n", user)
+	if err := m.Reset(user); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to reset second factors: %v\n", err)
+		return err
+	}
+	fmt.Printf("Second factors for user '%s' removed.\n", user)
+	return nil
+}
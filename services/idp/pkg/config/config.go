@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 )
@@ -26,6 +27,7 @@ type Config struct {
 	IDP     Settings `yaml:"idp"`
 	Clients []Client `yaml:"clients"`
 	Ldap    Ldap     `yaml:"ldap"`
+	MFA     MFA      `yaml:"mfa"`
 
 	Context context.Context `yaml:"-"`
 }
@@ -50,6 +52,31 @@ type Ldap struct {
 	UserEnabledAttribute string `yaml:"user_enabled_attribute" env:"OC_LDAP_USER_ENABLED_ATTRIBUTE;IDP_USER_ENABLED_ATTRIBUTE" desc:"LDAP Attribute to use as a flag telling if the user is enabled or disabled." introductionVersion:"1.0.0"`
 	Filter               string `yaml:"filter" env:"OC_LDAP_USER_FILTER;IDP_LDAP_FILTER" desc:"LDAP filter to add to the default filters for user search like '(objectclass=openCloudUser)'." introductionVersion:"1.0.0"`
 	ObjectClass          string `yaml:"objectclass" env:"OC_LDAP_USER_OBJECTCLASS;IDP_LDAP_OBJECTCLASS" desc:"LDAP User ObjectClass like 'inetOrgPerson'." introductionVersion:"1.0.0"`
+
+	GroupBaseDN          string `yaml:"group_base_dn" env:"OC_LDAP_GROUP_BASE_DN;IDP_LDAP_GROUP_BASE_DN" desc:"Search base DN for looking up LDAP groups. Only used to check if members of the groups in 'IDP_MFA_REQUIRED_GROUPS' need a second factor." introductionVersion:"%%NEXT%%"`
+	GroupObjectClass     string `yaml:"group_objectclass" env:"OC_LDAP_GROUP_OBJECTCLASS;IDP_LDAP_GROUP_OBJECTCLASS" desc:"The object class to use for groups in the default group search filter like 'groupOfNames'." introductionVersion:"%%NEXT%%"`
+	GroupNameAttribute   string `yaml:"group_name_attribute" env:"OC_LDAP_GROUP_SCHEMA_GROUPNAME;IDP_LDAP_GROUP_NAME_ATTRIBUTE" desc:"LDAP Attribute to use for the name of groups." introductionVersion:"%%NEXT%%"`
+	GroupMemberAttribute string `yaml:"group_member_attribute" env:"OC_LDAP_GROUP_SCHEMA_MEMBER;IDP_LDAP_GROUP_MEMBER_ATTRIBUTE" desc:"LDAP Attribute that is used for group members." introductionVersion:"%%NEXT%%"`
+}
+
+// MFA defines the configuration of the second factor authentication.
+type MFA struct {
+	Enabled         bool          `yaml:"enabled" env:"IDP_MFA_ENABLED" desc:"Enables TOTP and WebAuthn as second factor for the login. Users can enroll a second factor when logging in. Requires 'IDP_ENCRYPTION_SECRET_FILE' to be set, the secrets of the users are encrypted with it." introductionVersion:"%%NEXT%%"`
+	RequiredGroups  []string      `yaml:"required_groups" env:"IDP_MFA_REQUIRED_GROUPS" desc:"Names of the groups whose members have to use a second factor. Members without a second factor have to enroll one at their next login. Only supported with the 'ldap' identity manager. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Issuer          string        `yaml:"issuer" env:"IDP_MFA_ISSUER" desc:"The name of the service shown in authenticator apps and when registering security keys." introductionVersion:"%%NEXT%%"`
+	MaxAttempts     int           `yaml:"max_attempts" env:"IDP_MFA_MAX_ATTEMPTS" desc:"The number of failed second factor attempts after which the second factor of a user is locked for 'IDP_MFA_LOCKOUT_DURATION'." introductionVersion:"%%NEXT%%"`
+	LockoutDuration time.Duration `yaml:"lockout_duration" env:"IDP_MFA_LOCKOUT_DURATION" desc:"The duration the second factor of a user is locked after too many failed attempts. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Store           MFAStore      `yaml:"store"`
+}
+
+// MFAStore defines the store the second factors of the users are kept in.
+type MFAStore struct {
+	Store        string   `yaml:"store" env:"OC_PERSISTENT_STORE;IDP_MFA_STORE" desc:"The type of the store. Supported values are: 'memory', 'nats-js-kv', 'redis-sentinel'. Use a persistent store, the users lose their second factors with the 'memory' store. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string `yaml:"nodes" env:"OC_PERSISTENT_STORE_NODES;IDP_MFA_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string   `yaml:"database" env:"IDP_MFA_STORE_DATABASE" desc:"The database name the configured store should use." introductionVersion:"%%NEXT%%"`
+	Table        string   `yaml:"table" env:"IDP_MFA_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	AuthUsername string   `yaml:"username" env:"OC_PERSISTENT_STORE_AUTH_USERNAME;IDP_MFA_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string   `yaml:"password" env:"OC_PERSISTENT_STORE_AUTH_PASSWORD;IDP_MFA_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
 }
 
 // Asset defines the available asset configuration.
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
@@ -132,6 +133,22 @@ func DefaultConfig() *config.Config {
 			Filter:               "",
 			ObjectClass:          "inetOrgPerson",
 			UserEnabledAttribute: "openCloudUserEnabled",
+			GroupBaseDN:          "ou=groups,o=libregraph-idm",
+			GroupObjectClass:     "groupOfNames",
+			GroupNameAttribute:   "cn",
+			GroupMemberAttribute: "member",
+		},
+		MFA: config.MFA{
+			Enabled:         false,
+			Issuer:          "OpenCloud",
+			MaxAttempts:     10,
+			LockoutDuration: 15 * time.Minute,
+			Store: config.MFAStore{
+				Store:    "nats-js-kv",
+				Nodes:    []string{"127.0.0.1:9233"},
+				Database: "idp",
+				Table:    "mfa",
+			},
 		},
 	}
 }
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
@@ -45,5 +46,14 @@ func Validate(cfg *config.Config) error {
 		}
 	}
 
+	if cfg.MFA.Enabled {
+		if cfg.IDP.EncryptionSecretFile == "" {
+			return fmt.Errorf("the second factor authentication of the %s service requires an encryption secret file", cfg.Service.Name)
+		}
+		if len(cfg.MFA.RequiredGroups) > 0 && cfg.IDP.IdentityManager != "ldap" {
+			return fmt.Errorf("the groups requiring a second factor are only supported with the 'ldap' identity manager of the %s service", cfg.Service.Name)
+		}
+	}
+
 	return nil
 }
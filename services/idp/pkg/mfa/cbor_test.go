@@ -0,0 +1,138 @@
+package mfa
+
+import (
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// encodeCBOR encodes the values decodeCBOR supports, it is used to create test data.
+func encodeCBOR(v any) []byte {
+	head := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg <= 0xff:
+			return []byte{major<<5 | 24, byte(arg)}
+		case arg <= 0xffff:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+		case arg <= 0xffffffff:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+		default:
+			return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, arg)
+		}
+	}
+
+	switch v := v.(type) {
+	case int:
+		return encodeCBOR(int64(v))
+	case int64:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case bool:
+		if v {
+			return []byte{0xf5}
+		}
+		return []byte{0xf4}
+	case nil:
+		return []byte{0xf6}
+	case []any:
+		b := head(4, uint64(len(v)))
+		for _, item := range v {
+			b = append(b, encodeCBOR(item)...)
+		}
+		return b
+	case map[any]any:
+		// sort the keys to get a stable encoding
+		keys := make([][]byte, 0, len(v))
+		values := map[string][]byte{}
+		for k, value := range v {
+			key := encodeCBOR(k)
+			keys = append(keys, key)
+			values[string(key)] = encodeCBOR(value)
+		}
+		sort.Slice(keys, func(i, j int) bool { return string(keys[i]) < string(keys[j]) })
+		b := head(5, uint64(len(v)))
+		for _, key := range keys {
+			b = append(append(b, key...), values[string(key)]...)
+		}
+		return b
+	default:
+		panic("unsupported type")
+	}
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"small integer", int64(10)},
+		{"one byte integer", int64(100)},
+		{"two byte integer", int64(1000)},
+		{"four byte integer", int64(1000000)},
+		{"eight byte integer", int64(1000000000000)},
+		{"negative integer", int64(-7)},
+		{"large negative integer", int64(-257)},
+		{"byte string", []byte{1, 2, 3}},
+		{"text string", "authData"},
+		{"true", true},
+		{"false", false},
+		{"null", nil},
+		{"array", []any{int64(1), "a", []byte{2}}},
+		{"map", map[any]any{int64(1): int64(2), int64(-1): []byte{3}, "fmt": "none"}},
+		{"nested", map[any]any{"a": []any{map[any]any{int64(1): []any{}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := append(encodeCBOR(tt.value), 0xff)
+			got, rest, err := decodeCBOR(data)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, got)
+			assert.Equal(t, []byte{0xff}, rest)
+		})
+	}
+}
+
+func TestDecodeCBORErrors(t *testing.T) {
+	deep := []byte{}
+	for range maxCBORDepth + 2 {
+		deep = append(deep, 0x81)
+	}
+	deep = append(deep, 0x00)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated argument", []byte{0x19, 0x01}},
+		{"truncated byte string", []byte{0x43, 0x01, 0x02}},
+		{"truncated array", []byte{0x82, 0x01}},
+		{"truncated map", []byte{0xa1, 0x01}},
+		{"array longer than data", []byte{0x9a, 0xff, 0xff, 0xff, 0xff}},
+		{"map longer than data", []byte{0xba, 0xff, 0xff, 0xff, 0xff}},
+		{"byte string longer than data", []byte{0x5b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"integer overflow", []byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"negative integer overflow", []byte{0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"indefinite length", []byte{0x5f, 0x41, 0x01, 0xff}},
+		{"tag", []byte{0xc1, 0x01}},
+		{"float", []byte{0xf9, 0x3c, 0x00}},
+		{"unsupported map key", []byte{0xa1, 0x41, 0x01, 0x01}},
+		{"nesting too deep", deep},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := decodeCBOR(tt.data)
+			assert.Error(t, err)
+		})
+	}
+}
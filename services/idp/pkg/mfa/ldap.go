@@ -0,0 +1,89 @@
+package mfa
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+)
+
+// LDAPGroups resolves the groups of the users in the LDAP server the IDP authenticates against.
+type LDAPGroups struct {
+	cfg       config.Ldap
+	tlsConfig *tls.Config
+}
+
+// NewLDAPGroups returns a new LDAPGroups.
+func NewLDAPGroups(cfg config.Ldap, insecure bool) (*LDAPGroups, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+	if !insecure && cfg.TLSCACert != "" {
+		pem, err := os.ReadFile(cfg.TLSCACert)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the ldap ca cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("could not load the ldap ca cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return &LDAPGroups{cfg: cfg, tlsConfig: tlsConfig}, nil
+}
+
+// Groups implements the GroupResolver interface.
+func (g *LDAPGroups) Groups(_ context.Context, user string) ([]string, error) {
+	conn, err := ldap.DialURL(g.cfg.URI, ldap.DialWithTLSConfig(g.tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(g.cfg.BindDN, g.cfg.BindPassword); err != nil {
+		return nil, err
+	}
+
+	scope := ldap.ScopeWholeSubtree
+	switch g.cfg.Scope {
+	case "base":
+		scope = ldap.ScopeBaseObject
+	case "one":
+		scope = ldap.ScopeSingleLevel
+	}
+	users, err := conn.Search(ldap.NewSearchRequest(
+		g.cfg.BaseDN, scope, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf("(&(objectClass=%s)%s(%s=%s))",
+			ldap.EscapeFilter(g.cfg.ObjectClass), g.cfg.Filter, g.cfg.LoginAttribute, ldap.EscapeFilter(user)),
+		[]string{"dn"}, nil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(users.Entries) != 1 {
+		return nil, fmt.Errorf("could not find the user %s", user)
+	}
+
+	groups, err := conn.Search(ldap.NewSearchRequest(
+		g.cfg.GroupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf("(&(objectClass=%s)(%s=%s))",
+			ldap.EscapeFilter(g.cfg.GroupObjectClass), g.cfg.GroupMemberAttribute, ldap.EscapeFilter(users.Entries[0].DN)),
+		[]string{g.cfg.GroupNameAttribute}, nil,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(groups.Entries))
+	for _, e := range groups.Entries {
+		names = append(names, e.GetEqualFoldAttributeValue(g.cfg.GroupNameAttribute))
+	}
+	return names, nil
+}
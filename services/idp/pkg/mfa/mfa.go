@@ -0,0 +1,502 @@
+// Package mfa implements TOTP and WebAuthn as second factor for the login at the IDP.
+//
+// The second factors are kept in a store, encrypted with the encryption secret of the IDP. The
+// login of users with a second factor, and of users required to have one, is only completed after
+// the second factor was verified, see the MFA middleware.
+package mfa
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+)
+
+const (
+	// MethodTOTP is a time-based one-time password of an authenticator app
+	MethodTOTP = "totp"
+	// MethodWebAuthn is a security key or passkey
+	MethodWebAuthn = "webauthn"
+	// MethodRecoveryCode is a one-time recovery code
+	MethodRecoveryCode = "recovery_code"
+
+	// pendingTTL is the time a user has to answer a challenge or to finish the enrollment
+	pendingTTL = 5 * time.Minute
+
+	recoveryCodeCount = 10
+
+	keyEnrollment = "enrollment"
+	keyPending    = "pending"
+	keyAttempts   = "attempts"
+)
+
+var (
+	// ErrInvalid is returned when the second factor could not be verified
+	ErrInvalid = errors.New("invalid second factor")
+	// ErrLocked is returned when the second factor is locked after too many failed attempts
+	ErrLocked = errors.New("second factor locked after too many failed attempts")
+	// ErrNoChallenge is returned when the answer to a challenge is verified but there is no challenge
+	ErrNoChallenge = errors.New("no pending challenge")
+)
+
+// Enrollment holds the second factors of a user.
+type Enrollment struct {
+	TOTPSecret string `json:"totp_secret,omitempty"`
+	// TOTPStep is the time step of the last used code, codes must not be used twice
+	TOTPStep    int64        `json:"totp_step,omitempty"`
+	Credentials []Credential `json:"credentials,omitempty"`
+	// RecoveryCodes are the hashes of the unused recovery codes
+	RecoveryCodes []string  `json:"recovery_codes,omitempty"`
+	Created       time.Time `json:"created"`
+}
+
+// Methods returns the methods the user can use to verify the second factor.
+func (e *Enrollment) Methods() []string {
+	var methods []string
+	if e.TOTPSecret != "" {
+		methods = append(methods, MethodTOTP)
+	}
+	if len(e.Credentials) > 0 {
+		methods = append(methods, MethodWebAuthn)
+	}
+	if len(e.RecoveryCodes) > 0 {
+		methods = append(methods, MethodRecoveryCode)
+	}
+	return methods
+}
+
+// pending holds the state of a challenge or an enrollment between two requests.
+type pending struct {
+	Challenge  []byte    `json:"challenge"`
+	TOTPSecret string    `json:"totp_secret,omitempty"`
+	Expires    time.Time `json:"expires"`
+}
+
+// attempts counts the failed attempts of a user.
+type attempts struct {
+	Failed  int       `json:"failed"`
+	Expires time.Time `json:"expires"`
+}
+
+// Request is the second factor sent by the client with the logon request.
+type Request struct {
+	TOTP         string       `json:"totp,omitempty"`
+	RecoveryCode string       `json:"recovery_code,omitempty"`
+	WebAuthn     *Assertion   `json:"webauthn,omitempty"`
+	Attestation  *Attestation `json:"attestation,omitempty"`
+	// Enroll requests the enrollment of a second factor
+	Enroll bool `json:"enroll,omitempty"`
+}
+
+// IsZero returns true when the request contains no second factor.
+func (r *Request) IsZero() bool {
+	return r == nil || (r.TOTP == "" && r.RecoveryCode == "" && r.WebAuthn == nil && r.Attestation == nil)
+}
+
+// Challenge is sent to users which need to verify their second factor.
+type Challenge struct {
+	Methods  []string        `json:"methods"`
+	WebAuthn *RequestOptions `json:"webauthn,omitempty"`
+}
+
+// EnrollmentChallenge is sent to users which need to enroll a second factor.
+type EnrollmentChallenge struct {
+	TOTPSecret string           `json:"totp_secret"`
+	TOTPURI    string           `json:"totp_uri"`
+	WebAuthn   *CreationOptions `json:"webauthn"`
+}
+
+// GroupResolver returns the names of the groups of a user.
+type GroupResolver interface {
+	Groups(ctx context.Context, user string) ([]string, error)
+}
+
+// Manager manages the second factors of the users.
+type Manager struct {
+	cfg    config.MFA
+	store  microstore.Store
+	aead   cipher.AEAD
+	rp     relyingParty
+	groups GroupResolver
+	now    func() time.Time
+
+	// mu serializes the updates of the records in this instance
+	mu sync.Mutex
+}
+
+// NewStore creates the store of the second factors.
+func NewStore(cfg config.MFAStore) microstore.Store {
+	return store.Create(
+		store.Store(cfg.Store),
+		microstore.Nodes(cfg.Nodes...),
+		microstore.Database(cfg.Database),
+		microstore.Table(cfg.Table),
+		store.Authentication(cfg.AuthUsername, cfg.AuthPassword),
+	)
+}
+
+// NewManager returns a new Manager. The groups are only needed when some groups are required to
+// use a second factor.
+func NewManager(cfg *config.Config, s microstore.Store, groups GroupResolver) (*Manager, error) {
+	secret, err := os.ReadFile(cfg.IDP.EncryptionSecretFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the encryption secret: %w", err)
+	}
+	// derive a dedicated key, the secret is also used to encrypt the sessions
+	key := sha256.Sum256(append([]byte("opencloud-idp-mfa\x00"), secret...))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	iss, err := url.Parse(cfg.IDP.Iss)
+	if err != nil || iss.Hostname() == "" {
+		return nil, fmt.Errorf("could not determine the webauthn relying party from the issuer '%s'", cfg.IDP.Iss)
+	}
+
+	return &Manager{
+		cfg:   cfg.MFA,
+		store: s,
+		aead:  aead,
+		rp: relyingParty{
+			ID:     iss.Hostname(),
+			Origin: iss.Scheme + "://" + iss.Host,
+			Name:   cfg.MFA.Issuer,
+		},
+		groups: groups,
+		now:    time.Now,
+	}, nil
+}
+
+// Enrollment returns the second factors of the user or nil when the user has none.
+func (m *Manager) Enrollment(user string) (*Enrollment, error) {
+	e := &Enrollment{}
+	ok, err := m.read(keyEnrollment, user, e)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Required returns true when the user is a member of a group which requires a second factor.
+func (m *Manager) Required(ctx context.Context, user string) (bool, error) {
+	if len(m.cfg.RequiredGroups) == 0 {
+		return false, nil
+	}
+	if m.groups == nil {
+		return false, errors.New("the groups requiring a second factor can't be resolved")
+	}
+	groups, err := m.groups.Groups(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	for _, g := range groups {
+		if slices.ContainsFunc(m.cfg.RequiredGroups, func(required string) bool {
+			return strings.EqualFold(required, g)
+		}) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Challenge creates a challenge for the second factors of the user.
+func (m *Manager) Challenge(user string, e *Enrollment) (*Challenge, error) {
+	c := &Challenge{Methods: e.Methods()}
+	if len(e.Credentials) == 0 {
+		return c, nil
+	}
+	challenge, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(keyPending, user, pending{Challenge: challenge, Expires: m.now().Add(pendingTTL)}, pendingTTL); err != nil {
+		return nil, err
+	}
+	options := m.rp.requestOptions(challenge, e.Credentials)
+	c.WebAuthn = &options
+	return c, nil
+}
+
+// Verify verifies the second factor of the user. Too many failed attempts lock the second factor.
+func (m *Manager) Verify(user string, r *Request) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// read the enrollment again while holding the lock, codes must only be used once
+	e, err := m.Enrollment(user)
+	switch {
+	case err != nil:
+		return err
+	case e == nil:
+		return ErrInvalid
+	}
+
+	a := attempts{}
+	if _, err := m.read(keyAttempts, user, &a); err != nil {
+		return err
+	}
+	if a.Expires.Before(m.now()) {
+		a = attempts{}
+	}
+	if m.cfg.MaxAttempts > 0 && a.Failed >= m.cfg.MaxAttempts {
+		return ErrLocked
+	}
+
+	err = m.verify(user, e, r)
+	switch {
+	case errors.Is(err, ErrInvalid):
+		a.Failed++
+		a.Expires = m.now().Add(m.cfg.LockoutDuration)
+		if werr := m.write(keyAttempts, user, a, m.cfg.LockoutDuration); werr != nil {
+			return werr
+		}
+		return err
+	case err != nil:
+		return err
+	}
+
+	if err := m.write(keyEnrollment, user, e, 0); err != nil {
+		return err
+	}
+	return m.delete(keyAttempts, user)
+}
+
+// verify checks the second factor and updates the enrollment, e.g. removes used recovery codes.
+func (m *Manager) verify(user string, e *Enrollment, r *Request) error {
+	switch {
+	case r.TOTP != "" && e.TOTPSecret != "":
+		step, ok := validateTOTP(e.TOTPSecret, r.TOTP, m.now(), e.TOTPStep)
+		if !ok {
+			return ErrInvalid
+		}
+		e.TOTPStep = step
+		return nil
+
+	case r.RecoveryCode != "":
+		hash := hashRecoveryCode(r.RecoveryCode)
+		for i, code := range e.RecoveryCodes {
+			if subtle.ConstantTimeCompare([]byte(code), []byte(hash)) == 1 {
+				e.RecoveryCodes = slices.Delete(e.RecoveryCodes, i, i+1)
+				return nil
+			}
+		}
+		return ErrInvalid
+
+	case r.WebAuthn != nil && len(e.Credentials) > 0:
+		p := pending{}
+		ok, err := m.read(keyPending, user, &p)
+		if err != nil {
+			return err
+		}
+		if !ok || p.Expires.Before(m.now()) || len(p.Challenge) == 0 {
+			return ErrNoChallenge
+		}
+		// every challenge can only be answered once
+		if err := m.delete(keyPending, user); err != nil {
+			return err
+		}
+		i, signCount, err := m.rp.verifyAssertion(r.WebAuthn, p.Challenge, e.Credentials)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+		e.Credentials[i].SignCount = signCount
+		return nil
+
+	default:
+		return ErrInvalid
+	}
+}
+
+// BeginEnrollment creates a new TOTP secret and a WebAuthn challenge for the enrollment of a second
+// factor. The user can confirm one of them with FinishEnrollment.
+func (m *Manager) BeginEnrollment(user string) (*EnrollmentChallenge, error) {
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(keyPending, user, pending{Challenge: challenge, TOTPSecret: secret, Expires: m.now().Add(pendingTTL)}, pendingTTL); err != nil {
+		return nil, err
+	}
+	options := m.rp.creationOptions(challenge, user, nil)
+	return &EnrollmentChallenge{
+		TOTPSecret: secret,
+		TOTPURI:    totpURI(m.cfg.Issuer, user, secret),
+		WebAuthn:   &options,
+	}, nil
+}
+
+// FinishEnrollment enrolls the second factor confirmed by the request. It returns the recovery
+// codes of the user, which are only shown once.
+func (m *Manager) FinishEnrollment(user string, r *Request) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p := pending{}
+	ok, err := m.read(keyPending, user, &p)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || p.Expires.Before(m.now()) {
+		return nil, ErrNoChallenge
+	}
+
+	e := &Enrollment{Created: m.now()}
+	switch {
+	case r.TOTP != "" && p.TOTPSecret != "":
+		step, ok := validateTOTP(p.TOTPSecret, r.TOTP, m.now(), 0)
+		if !ok {
+			return nil, ErrInvalid
+		}
+		e.TOTPSecret = p.TOTPSecret
+		e.TOTPStep = step
+	case r.Attestation != nil:
+		c, err := m.rp.verifyAttestation(r.Attestation, p.Challenge)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+		c.Created = m.now()
+		e.Credentials = append(e.Credentials, c)
+	default:
+		return nil, ErrInvalid
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		e.RecoveryCodes = append(e.RecoveryCodes, hashRecoveryCode(code))
+	}
+
+	if err := m.write(keyEnrollment, user, e, 0); err != nil {
+		return nil, err
+	}
+	if err := m.delete(keyPending, user); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Reset removes the second factors of the user and unlocks them.
+func (m *Manager) Reset(user string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, kind := range []string{keyEnrollment, keyPending, keyAttempts} {
+		if err := m.delete(kind, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NormalizeUser returns the key the second factors of a user are stored under. Logins are case
+// insensitive, so must be the lookup of the second factors.
+func NormalizeUser(user string) string {
+	return strings.ToLower(strings.TrimSpace(user))
+}
+
+// key returns the store key of a record. The key is hashed to only use characters all stores support.
+func key(kind, user string) string {
+	sum := sha256.Sum256([]byte(kind + "\x00" + NormalizeUser(user)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (m *Manager) read(kind, user string, v any) (bool, error) {
+	records, err := m.store.Read(key(kind, user))
+	switch {
+	case errors.Is(err, microstore.ErrNotFound) || (err == nil && len(records) == 0):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	value := records[0].Value
+	size := m.aead.NonceSize()
+	if len(value) < size {
+		return false, errors.New("invalid mfa record")
+	}
+	// the key is used as additional data, records can't be moved to other users
+	plain, err := m.aead.Open(nil, value[:size], value[size:], []byte(key(kind, user)))
+	if err != nil {
+		return false, fmt.Errorf("could not decrypt the mfa record: %w", err)
+	}
+	return true, json.Unmarshal(plain, v)
+}
+
+func (m *Manager) write(kind, user string, v any, expiry time.Duration) error {
+	plain, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	nonce, err := randomBytes(m.aead.NonceSize())
+	if err != nil {
+		return err
+	}
+	k := key(kind, user)
+	return m.store.Write(&microstore.Record{
+		Key:    k,
+		Value:  m.aead.Seal(nonce, nonce, plain, []byte(k)),
+		Expiry: expiry,
+	})
+}
+
+func (m *Manager) delete(kind, user string) error {
+	if err := m.store.Delete(key(kind, user)); err != nil && !errors.Is(err, microstore.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// newRecoveryCode generates a recovery code like "abcde-fghij".
+func newRecoveryCode() (string, error) {
+	b, err := randomBytes(7)
+	if err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
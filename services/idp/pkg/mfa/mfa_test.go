@@ -0,0 +1,340 @@
+package mfa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config/defaults"
+)
+
+type staticGroups map[string][]string
+
+func (g staticGroups) Groups(_ context.Context, user string) ([]string, error) {
+	groups, ok := g[user]
+	if !ok {
+		return nil, errors.New("unknown user")
+	}
+	return groups, nil
+}
+
+// newTestManager returns a manager with a memory store and a clock the test controls.
+func newTestManager(t *testing.T, groups GroupResolver, change func(*config.Config)) (*Manager, *time.Time) {
+	cfg := defaults.DefaultConfig()
+	cfg.IDP.Iss = "https://cloud.example.com/"
+	cfg.IDP.EncryptionSecretFile = filepath.Join(t.TempDir(), "encryption.key")
+	require.NoError(t, os.WriteFile(cfg.IDP.EncryptionSecretFile, []byte("encryption secret"), 0o600))
+	cfg.MFA.Store = config.MFAStore{Store: "memory", Database: "idp", Table: "mfa"}
+	cfg.MFA.MaxAttempts = 3
+	if change != nil {
+		change(cfg)
+	}
+
+	m, err := NewManager(cfg, NewStore(cfg.MFA.Store), groups)
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+// enrollTOTP enrolls a TOTP second factor and returns the key and the recovery codes.
+func enrollTOTP(t *testing.T, m *Manager, user string) ([]byte, []string) {
+	c, err := m.BeginEnrollment(user)
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(c.TOTPSecret)
+	require.NoError(t, err)
+	codes, err := m.FinishEnrollment(user, &Request{TOTP: totpCode(key, m.now().Unix()/totpPeriod)})
+	require.NoError(t, err)
+	return key, codes
+}
+
+func TestNewManager(t *testing.T) {
+	m, _ := newTestManager(t, nil, nil)
+	assert.Equal(t, relyingParty{ID: "cloud.example.com", Origin: "https://cloud.example.com", Name: "OpenCloud"}, m.rp)
+
+	m, _ = newTestManager(t, nil, func(cfg *config.Config) { cfg.IDP.Iss = "https://cloud.example.com:9200" })
+	assert.Equal(t, "cloud.example.com", m.rp.ID)
+	assert.Equal(t, "https://cloud.example.com:9200", m.rp.Origin)
+
+	cfg := defaults.DefaultConfig()
+	cfg.IDP.EncryptionSecretFile = filepath.Join(t.TempDir(), "missing")
+	_, err := NewManager(cfg, microstore.NewMemoryStore(), nil)
+	assert.Error(t, err)
+}
+
+func TestTOTPEnrollment(t *testing.T) {
+	m, now := newTestManager(t, nil, nil)
+
+	// finishing without an enrollment
+	_, err := m.FinishEnrollment("alice", &Request{TOTP: "123456"})
+	assert.ErrorIs(t, err, ErrNoChallenge)
+
+	c, err := m.BeginEnrollment("alice")
+	require.NoError(t, err)
+	assert.Contains(t, c.TOTPURI, "secret="+c.TOTPSecret)
+	assert.Equal(t, "cloud.example.com", c.WebAuthn.RP.ID)
+	key, err := totpEncoding.DecodeString(c.TOTPSecret)
+	require.NoError(t, err)
+
+	_, err = m.FinishEnrollment("alice", &Request{TOTP: "abcdef"})
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, err = m.FinishEnrollment("alice", &Request{RecoveryCode: "abcde-fghij"})
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	// the enrollment expires
+	*now = now.Add(pendingTTL + time.Second)
+	_, err = m.FinishEnrollment("alice", &Request{TOTP: totpCode(key, now.Unix()/totpPeriod)})
+	assert.ErrorIs(t, err, ErrNoChallenge)
+
+	key, codes := enrollTOTP(t, m, "alice")
+	assert.Len(t, codes, recoveryCodeCount)
+	e, err := m.Enrollment("ALICE ")
+	require.NoError(t, err)
+	require.NotNil(t, e)
+	assert.Equal(t, []string{MethodTOTP, MethodRecoveryCode}, e.Methods())
+
+	// the code of the enrollment can't be used to login
+	assert.ErrorIs(t, m.Verify("alice", &Request{TOTP: totpCode(key, now.Unix()/totpPeriod)}), ErrInvalid)
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	m, now := newTestManager(t, nil, func(cfg *config.Config) { cfg.MFA.MaxAttempts = 10 })
+	key, _ := enrollTOTP(t, m, "alice")
+	code := func(offset time.Duration) string { return totpCode(key, now.Add(offset).Unix()/totpPeriod) }
+
+	*now = now.Add(totpPeriod * time.Second)
+	tests := []struct {
+		name string
+		code string
+		err  error
+	}{
+		{"next code", code(totpPeriod * time.Second), nil},
+		{"replay of the same code", code(totpPeriod * time.Second), ErrInvalid},
+		{"code older than the last one", code(0), ErrInvalid},
+		{"wrong code", "abcdef", ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.Verify("alice", &Request{TOTP: tt.code})
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+
+	*now = now.Add(2 * totpPeriod * time.Second)
+	assert.NoError(t, m.Verify("alice", &Request{TOTP: code(0)}))
+
+	// users without second factor can't verify one
+	assert.ErrorIs(t, m.Verify("bob", &Request{TOTP: code(0)}), ErrInvalid)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	m, _ := newTestManager(t, nil, func(cfg *config.Config) { cfg.MFA.MaxAttempts = 100 })
+	_, codes := enrollTOTP(t, m, "alice")
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		assert.False(t, seen[code], "recovery codes must be unique")
+		seen[code] = true
+	}
+
+	tests := []struct {
+		name string
+		code string
+		err  error
+	}{
+		{"unused code", codes[0], nil},
+		{"used code", codes[0], ErrInvalid},
+		{"code without dash in upper case", strings.ToUpper(strings.ReplaceAll(codes[1], "-", "")), nil},
+		{"used code in other format", codes[1], ErrInvalid},
+		{"code with spaces", " " + codes[2] + " ", nil},
+		{"unknown code", "aaaaa-aaaaa", ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.Verify("alice", &Request{RecoveryCode: tt.code})
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+
+	for _, code := range codes[3:] {
+		assert.NoError(t, m.Verify("alice", &Request{RecoveryCode: code}))
+	}
+	e, err := m.Enrollment("alice")
+	require.NoError(t, err)
+	assert.Empty(t, e.RecoveryCodes)
+	assert.Equal(t, []string{MethodTOTP}, e.Methods())
+	for _, code := range codes {
+		assert.ErrorIs(t, m.Verify("alice", &Request{RecoveryCode: code}), ErrInvalid)
+	}
+}
+
+func TestLockout(t *testing.T) {
+	m, now := newTestManager(t, nil, nil)
+	key, codes := enrollTOTP(t, m, "alice")
+	*now = now.Add(totpPeriod * time.Second)
+	code := totpCode(key, now.Unix()/totpPeriod)
+
+	// a successful verification resets the failed attempts
+	for range m.cfg.MaxAttempts - 1 {
+		assert.ErrorIs(t, m.Verify("alice", &Request{TOTP: "abcdef"}), ErrInvalid)
+	}
+	assert.NoError(t, m.Verify("alice", &Request{RecoveryCode: codes[0]}))
+
+	for range m.cfg.MaxAttempts {
+		assert.ErrorIs(t, m.Verify("alice", &Request{TOTP: "abcdef"}), ErrInvalid)
+	}
+	// the correct second factor is rejected while locked and is not consumed
+	assert.ErrorIs(t, m.Verify("alice", &Request{TOTP: code}), ErrLocked)
+	assert.ErrorIs(t, m.Verify("alice", &Request{RecoveryCode: codes[1]}), ErrLocked)
+
+	// other users are not affected
+	_, _ = enrollTOTP(t, m, "bob")
+	assert.ErrorIs(t, m.Verify("bob", &Request{TOTP: "abcdef"}), ErrInvalid)
+
+	*now = now.Add(m.cfg.LockoutDuration + time.Second)
+	assert.NoError(t, m.Verify("alice", &Request{RecoveryCode: codes[1]}))
+
+	// the reset unlocks the second factor
+	for range m.cfg.MaxAttempts {
+		assert.ErrorIs(t, m.Verify("alice", &Request{TOTP: "abcdef"}), ErrInvalid)
+	}
+	require.NoError(t, m.Reset("alice"))
+	e, err := m.Enrollment("alice")
+	require.NoError(t, err)
+	assert.Nil(t, e)
+	_, codes = enrollTOTP(t, m, "alice")
+	assert.NoError(t, m.Verify("alice", &Request{RecoveryCode: codes[0]}))
+}
+
+func TestWebAuthn(t *testing.T) {
+	m, now := newTestManager(t, nil, func(cfg *config.Config) { cfg.MFA.MaxAttempts = 100 })
+	a := newTestAuthenticator(t)
+
+	c, err := m.BeginEnrollment("alice")
+	require.NoError(t, err)
+	challenge, err := b64.DecodeString(c.WebAuthn.Challenge)
+	require.NoError(t, err)
+	_, err = m.FinishEnrollment("alice", &Request{Attestation: a.create(t, challenge, func(c *ceremony) { c.origin = "https://evil.example.com" })})
+	assert.ErrorIs(t, err, ErrInvalid)
+	codes, err := m.FinishEnrollment("alice", &Request{Attestation: a.create(t, challenge, nil)})
+	require.NoError(t, err)
+	assert.Len(t, codes, recoveryCodeCount)
+
+	// the enrollment challenge is gone
+	_, err = m.FinishEnrollment("alice", &Request{Attestation: a.create(t, challenge, nil)})
+	assert.ErrorIs(t, err, ErrNoChallenge)
+
+	e, err := m.Enrollment("alice")
+	require.NoError(t, err)
+	assert.Equal(t, []string{MethodWebAuthn, MethodRecoveryCode}, e.Methods())
+
+	// an assertion without a challenge
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: a.get(t, challenge, nil)}), ErrNoChallenge)
+
+	login := func() []byte {
+		c, err := m.Challenge("alice", e)
+		require.NoError(t, err)
+		require.NotNil(t, c.WebAuthn)
+		assert.Equal(t, "cloud.example.com", c.WebAuthn.RPID)
+		assert.Equal(t, []CredentialDescriptor{{Type: "public-key", ID: b64.EncodeToString(a.id)}}, c.WebAuthn.AllowCredentials)
+		challenge, err := b64.DecodeString(c.WebAuthn.Challenge)
+		require.NoError(t, err)
+		return challenge
+	}
+
+	challenge = login()
+	assertion := a.get(t, challenge, nil)
+	assert.NoError(t, m.Verify("alice", &Request{WebAuthn: assertion}))
+
+	// challenge reuse
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: assertion}), ErrNoChallenge)
+	newChallenge := login()
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: assertion}), ErrInvalid)
+	// the failed attempt used up the challenge
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: a.get(t, newChallenge, nil)}), ErrNoChallenge)
+
+	// the sign count is stored, a cloned authenticator with an older count is rejected
+	a.signCount = 10
+	assert.NoError(t, m.Verify("alice", &Request{WebAuthn: a.get(t, login(), nil)}))
+	a.signCount = 5
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: a.get(t, login(), nil)}), ErrInvalid)
+
+	// expired challenge
+	challenge = login()
+	*now = now.Add(pendingTTL + time.Second)
+	assert.ErrorIs(t, m.Verify("alice", &Request{WebAuthn: a.get(t, challenge, nil)}), ErrNoChallenge)
+
+	// users with only a TOTP second factor get no webauthn challenge
+	enrollTOTP(t, m, "bob")
+	e, err = m.Enrollment("bob")
+	require.NoError(t, err)
+	bc, err := m.Challenge("bob", e)
+	require.NoError(t, err)
+	assert.Nil(t, bc.WebAuthn)
+	assert.ErrorIs(t, m.Verify("bob", &Request{WebAuthn: a.get(t, challenge, nil)}), ErrInvalid)
+}
+
+func TestRequired(t *testing.T) {
+	groups := staticGroups{"alice": {"Admins", "users"}, "bob": {"users"}}
+
+	m, _ := newTestManager(t, groups, nil)
+	required, err := m.Required(context.Background(), "alice")
+	assert.NoError(t, err)
+	assert.False(t, required, "no groups require a second factor")
+
+	m, _ = newTestManager(t, groups, func(cfg *config.Config) { cfg.MFA.RequiredGroups = []string{"admins"} })
+	required, err = m.Required(context.Background(), "alice")
+	assert.NoError(t, err)
+	assert.True(t, required)
+	required, err = m.Required(context.Background(), "bob")
+	assert.NoError(t, err)
+	assert.False(t, required)
+	_, err = m.Required(context.Background(), "carol")
+	assert.Error(t, err)
+
+	m, _ = newTestManager(t, nil, func(cfg *config.Config) { cfg.MFA.RequiredGroups = []string{"admins"} })
+	_, err = m.Required(context.Background(), "alice")
+	assert.Error(t, err)
+}
+
+func TestRecordsAreEncrypted(t *testing.T) {
+	m, _ := newTestManager(t, nil, nil)
+	c, err := m.BeginEnrollment("alice")
+	require.NoError(t, err)
+	secret, err := totpEncoding.DecodeString(c.TOTPSecret)
+	require.NoError(t, err)
+	codes, err := m.FinishEnrollment("alice", &Request{TOTP: totpCode(secret, m.now().Unix()/totpPeriod)})
+	require.NoError(t, err)
+
+	records, err := m.store.Read(key(keyEnrollment, "alice"))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.NotContains(t, string(records[0].Value), c.TOTPSecret)
+	assert.False(t, bytes.Contains(records[0].Value, []byte("alice")))
+	assert.NotContains(t, string(records[0].Value), hashRecoveryCode(codes[0]))
+
+	// a record copied to another user can't be read
+	records[0].Key = key(keyEnrollment, "mallory")
+	require.NoError(t, m.store.Write(records[0]))
+	_, err = m.Enrollment("mallory")
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package mfa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// test vectors of RFC 6238 appendix B for SHA1, truncated to 6 digits
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, totpCode(key, tt.time/totpPeriod), "time %d", tt.time)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	key := []byte("12345678901234567890")
+	secret := totpEncoding.EncodeToString(key)
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / totpPeriod
+	code := func(offset int64) string { return totpCode(key, step+offset) }
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		lastStep int64
+		ok       bool
+		step     int64
+	}{
+		{name: "current code", secret: secret, code: code(0), ok: true, step: step},
+		{name: "previous code", secret: secret, code: code(-1), ok: true, step: step - 1},
+		{name: "next code", secret: secret, code: code(1), ok: true, step: step + 1},
+		{name: "too old", secret: secret, code: code(-2)},
+		{name: "too new", secret: secret, code: code(2)},
+		{name: "spaces are ignored", secret: secret, code: code(0)[:3] + " " + code(0)[3:], ok: true, step: step},
+		{name: "lowercase secret", secret: "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", code: code(0), ok: true, step: step},
+		{name: "replay of the same step", secret: secret, code: code(0), lastStep: step},
+		{name: "replay of an older step", secret: secret, code: code(-1), lastStep: step},
+		{name: "step after the last one", secret: secret, code: code(1), lastStep: step, ok: true, step: step + 1},
+		{name: "too short", secret: secret, code: code(0)[:5]},
+		{name: "too long", secret: secret, code: code(0) + "0"},
+		{name: "empty", secret: secret, code: ""},
+		{name: "wrong code", secret: secret, code: "abcdef"},
+		{name: "invalid secret", secret: "not base32!", code: code(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := validateTOTP(tt.secret, tt.code, now, tt.lastStep)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.step, got)
+		})
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	assert.Equal(t,
+		"otpauth://totp/Open%20Cloud:alice@example.com?algorithm=SHA1&digits=6&issuer=Open+Cloud&period=30&secret=ABC",
+		totpURI("Open Cloud", "alice@example.com", "ABC"),
+	)
+}
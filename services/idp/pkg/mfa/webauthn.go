@@ -0,0 +1,357 @@
+package mfa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// COSE algorithms supported for WebAuthn credentials
+const (
+	coseAlgES256 = -7
+	coseAlgRS256 = -257
+)
+
+// authenticator data flags
+const (
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+)
+
+var b64 = base64.RawURLEncoding
+
+// relyingParty identifies the IDP towards the authenticators.
+type relyingParty struct {
+	ID     string
+	Origin string
+	Name   string
+}
+
+// Credential is a registered WebAuthn credential.
+type Credential struct {
+	ID []byte `json:"id"`
+	// PublicKey is the PKIX encoded public key of the credential
+	PublicKey []byte    `json:"public_key"`
+	Algorithm int64     `json:"algorithm"`
+	SignCount uint32    `json:"sign_count"`
+	Created   time.Time `json:"created"`
+}
+
+// CredentialDescriptor identifies a credential in the options.
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// CreationOptions are the options the browser needs to create a credential. Binary values are
+// base64url encoded.
+type CreationOptions struct {
+	Challenge string `json:"challenge"`
+	RP        struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	PubKeyCredParams []struct {
+		Type string `json:"type"`
+		Alg  int64  `json:"alg"`
+	} `json:"pubKeyCredParams"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	Timeout                int64                  `json:"timeout"`
+	Attestation            string                 `json:"attestation"`
+	AuthenticatorSelection struct {
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+}
+
+// RequestOptions are the options the browser needs to sign in with a credential. Binary values
+// are base64url encoded.
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	Timeout          int64                  `json:"timeout"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// Attestation is the response of the browser to the creation of a credential. Binary values are
+// base64url encoded.
+type Attestation struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+// Assertion is the response of the browser to the sign in with a credential. Binary values are
+// base64url encoded.
+type Assertion struct {
+	ID                string `json:"id"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+func (rp relyingParty) creationOptions(challenge []byte, user string, credentials []Credential) CreationOptions {
+	o := CreationOptions{
+		Challenge:   b64.EncodeToString(challenge),
+		Timeout:     int64(pendingTTL / time.Millisecond),
+		Attestation: "none",
+	}
+	o.RP.ID = rp.ID
+	o.RP.Name = rp.Name
+	// the user handle must not contain personal information
+	handle := sha256.Sum256([]byte(user))
+	o.User.ID = b64.EncodeToString(handle[:])
+	o.User.Name = user
+	o.User.DisplayName = user
+	for _, alg := range []int64{coseAlgES256, coseAlgRS256} {
+		o.PubKeyCredParams = append(o.PubKeyCredParams, struct {
+			Type string `json:"type"`
+			Alg  int64  `json:"alg"`
+		}{Type: "public-key", Alg: alg})
+	}
+	for _, c := range credentials {
+		o.ExcludeCredentials = append(o.ExcludeCredentials, CredentialDescriptor{Type: "public-key", ID: b64.EncodeToString(c.ID)})
+	}
+	o.AuthenticatorSelection.UserVerification = "discouraged"
+	return o
+}
+
+func (rp relyingParty) requestOptions(challenge []byte, credentials []Credential) RequestOptions {
+	o := RequestOptions{
+		Challenge:        b64.EncodeToString(challenge),
+		RPID:             rp.ID,
+		Timeout:          int64(pendingTTL / time.Millisecond),
+		UserVerification: "discouraged",
+	}
+	for _, c := range credentials {
+		o.AllowCredentials = append(o.AllowCredentials, CredentialDescriptor{Type: "public-key", ID: b64.EncodeToString(c.ID)})
+	}
+	return o
+}
+
+// verifyClientData checks the client data the browser signed.
+func (rp relyingParty) verifyClientData(raw []byte, typ string, challenge []byte) error {
+	cd := clientData{}
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != typ {
+		return fmt.Errorf("unexpected client data type %s", cd.Type)
+	}
+	got, err := b64.DecodeString(cd.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return errors.New("challenge mismatch")
+	}
+	if cd.Origin != rp.Origin {
+		return fmt.Errorf("unexpected origin %s", cd.Origin)
+	}
+	return nil
+}
+
+// verifyAuthenticatorData checks the rp id hash and the user presence flag and returns the flags,
+// the sign count and the remaining data.
+func (rp relyingParty) verifyAuthenticatorData(data []byte) (byte, uint32, []byte, error) {
+	if len(data) < 37 {
+		return 0, 0, nil, errors.New("authenticator data too short")
+	}
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(data[:32], rpIDHash[:]) {
+		return 0, 0, nil, errors.New("rp id mismatch")
+	}
+	flags := data[32]
+	if flags&flagUserPresent == 0 {
+		return 0, 0, nil, errors.New("user not present")
+	}
+	return flags, binary.BigEndian.Uint32(data[33:37]), data[37:], nil
+}
+
+// verifyAttestation verifies the creation of a credential and returns it. The attestation statement
+// is not verified, the IDP requests no attestation and doesn't restrict the authenticator models.
+func (rp relyingParty) verifyAttestation(a *Attestation, challenge []byte) (Credential, error) {
+	rawClientData, err := b64.DecodeString(a.ClientDataJSON)
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid client data: %w", err)
+	}
+	if err := rp.verifyClientData(rawClientData, "webauthn.create", challenge); err != nil {
+		return Credential{}, err
+	}
+
+	rawObject, err := b64.DecodeString(a.AttestationObject)
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	item, _, err := decodeCBOR(rawObject)
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	object, ok := item.(map[any]any)
+	if !ok {
+		return Credential{}, errors.New("invalid attestation object")
+	}
+	authData, ok := object["authData"].([]byte)
+	if !ok {
+		return Credential{}, errors.New("attestation object without authenticator data")
+	}
+
+	flags, signCount, rest, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return Credential{}, err
+	}
+	if flags&flagAttestedCredentialData == 0 {
+		return Credential{}, errors.New("authenticator data without credential")
+	}
+
+	// attested credential data: aaguid (16), credential id length (2), credential id, public key
+	if len(rest) < 18 {
+		return Credential{}, errors.New("attested credential data too short")
+	}
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < idLen {
+		return Credential{}, errors.New("attested credential data too short")
+	}
+	id := append([]byte(nil), rest[:idLen]...)
+
+	coseKey, _, err := decodeCBOR(rest[idLen:])
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid credential public key: %w", err)
+	}
+	pub, alg, err := parseCOSEKey(coseKey)
+	if err != nil {
+		return Credential{}, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	return Credential{
+		ID:        id,
+		PublicKey: der,
+		Algorithm: alg,
+		SignCount: signCount,
+	}, nil
+}
+
+// verifyAssertion verifies the sign in with one of the credentials. It returns the index of the
+// credential and its new sign count.
+func (rp relyingParty) verifyAssertion(a *Assertion, challenge []byte, credentials []Credential) (int, uint32, error) {
+	id, err := b64.DecodeString(a.ID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid credential id: %w", err)
+	}
+	index := -1
+	for i, c := range credentials {
+		if bytes.Equal(c.ID, id) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return 0, 0, errors.New("unknown credential")
+	}
+	credential := credentials[index]
+
+	rawClientData, err := b64.DecodeString(a.ClientDataJSON)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if err := rp.verifyClientData(rawClientData, "webauthn.get", challenge); err != nil {
+		return 0, 0, err
+	}
+	authData, err := b64.DecodeString(a.AuthenticatorData)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid authenticator data: %w", err)
+	}
+	_, signCount, _, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return 0, 0, err
+	}
+	signature, err := b64.DecodeString(a.Signature)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(credential.PublicKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	clientDataHash := sha256.Sum256(rawClientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return 0, 0, errors.New("invalid signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return 0, 0, errors.New("invalid signature")
+		}
+	default:
+		return 0, 0, errors.New("unsupported public key")
+	}
+
+	// authenticators which count the signatures must increase the counter, otherwise the
+	// credential might have been cloned
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return 0, 0, errors.New("sign count did not increase")
+	}
+	return index, signCount, nil
+}
+
+// parseCOSEKey converts a COSE key (RFC 8152) to a public key.
+func parseCOSEKey(item any) (crypto.PublicKey, int64, error) {
+	key, ok := item.(map[any]any)
+	if !ok {
+		return nil, 0, errors.New("invalid credential public key")
+	}
+	kty, _ := key[int64(1)].(int64)
+	alg, _ := key[int64(3)].(int64)
+
+	switch {
+	case kty == 2 && alg == coseAlgES256:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, 0, errors.New("unsupported elliptic curve key")
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		// reject points which are not on the curve
+		if _, err := pub.ECDH(); err != nil {
+			return nil, 0, fmt.Errorf("invalid elliptic curve key: %w", err)
+		}
+		return pub, alg, nil
+	case kty == 3 && alg == coseAlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, 0, errors.New("unsupported rsa key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, alg, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported credential algorithm %d", alg)
+	}
+}
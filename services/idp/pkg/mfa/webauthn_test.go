@@ -0,0 +1,278 @@
+package mfa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRP = relyingParty{ID: "cloud.example.com", Origin: "https://cloud.example.com", Name: "OpenCloud"}
+
+// testAuthenticator is a software authenticator with an ES256 credential.
+type testAuthenticator struct {
+	key       *ecdsa.PrivateKey
+	id        []byte
+	signCount uint32
+}
+
+// ceremony are the values the authenticator and the browser put into a response, tests change
+// them to create invalid responses.
+type ceremony struct {
+	typ       string
+	origin    string
+	challenge []byte
+	rpID      string
+	flags     byte
+	signCount uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testAuthenticator{key: key, id: []byte("credential-" + t.Name())}
+}
+
+func (a *testAuthenticator) clientData(t *testing.T, c ceremony) []byte {
+	data, err := json.Marshal(map[string]string{
+		"type":      c.typ,
+		"challenge": b64.EncodeToString(c.challenge),
+		"origin":    c.origin,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func (a *testAuthenticator) authData(c ceremony) []byte {
+	rpIDHash := sha256.Sum256([]byte(c.rpID))
+	data := append(rpIDHash[:], c.flags)
+	return binary.BigEndian.AppendUint32(data, c.signCount)
+}
+
+func (a *testAuthenticator) coseKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+	return encodeCBOR(map[any]any{
+		int64(1):  int64(2),
+		int64(3):  int64(coseAlgES256),
+		int64(-1): int64(1),
+		int64(-2): x,
+		int64(-3): y,
+	})
+}
+
+func (a *testAuthenticator) create(t *testing.T, challenge []byte, change func(*ceremony)) *Attestation {
+	c := ceremony{typ: "webauthn.create", origin: testRP.Origin, challenge: challenge, rpID: testRP.ID, flags: flagUserPresent | flagAttestedCredentialData}
+	if change != nil {
+		change(&c)
+	}
+	authData := a.authData(c)
+	authData = append(authData, make([]byte, 16)...) // aaguid
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.id)))
+	authData = append(authData, a.id...)
+	authData = append(authData, a.coseKey()...)
+
+	return &Attestation{
+		ClientDataJSON: b64.EncodeToString(a.clientData(t, c)),
+		AttestationObject: b64.EncodeToString(encodeCBOR(map[any]any{
+			"fmt":      "none",
+			"attStmt":  map[any]any{},
+			"authData": authData,
+		})),
+	}
+}
+
+func (a *testAuthenticator) get(t *testing.T, challenge []byte, change func(*ceremony)) *Assertion {
+	a.signCount++
+	c := ceremony{typ: "webauthn.get", origin: testRP.Origin, challenge: challenge, rpID: testRP.ID, flags: flagUserPresent, signCount: a.signCount}
+	if change != nil {
+		change(&c)
+	}
+	a.signCount = c.signCount
+	clientData := a.clientData(t, c)
+	authData := a.authData(c)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return &Assertion{
+		ID:                b64.EncodeToString(a.id),
+		ClientDataJSON:    b64.EncodeToString(clientData),
+		AuthenticatorData: b64.EncodeToString(authData),
+		Signature:         b64.EncodeToString(signature),
+	}
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	challenge := []byte("registration challenge")
+
+	tests := []struct {
+		name   string
+		change func(*ceremony)
+		ok     bool
+	}{
+		{name: "valid", ok: true},
+		{name: "wrong origin", change: func(c *ceremony) { c.origin = "https://evil.example.com" }},
+		{name: "origin with other port", change: func(c *ceremony) { c.origin = "https://cloud.example.com:8443" }},
+		{name: "wrong rp id hash", change: func(c *ceremony) { c.rpID = "evil.example.com" }},
+		{name: "wrong challenge", change: func(c *ceremony) { c.challenge = []byte("other challenge") }},
+		{name: "wrong type", change: func(c *ceremony) { c.typ = "webauthn.get" }},
+		{name: "user not present", change: func(c *ceremony) { c.flags = flagAttestedCredentialData }},
+		{name: "no credential", change: func(c *ceremony) { c.flags = flagUserPresent }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestAuthenticator(t)
+			c, err := testRP.verifyAttestation(a.create(t, challenge, tt.change), challenge)
+			if !tt.ok {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, a.id, c.ID)
+			assert.Equal(t, int64(coseAlgES256), c.Algorithm)
+		})
+	}
+}
+
+func TestVerifyAttestationMalformed(t *testing.T) {
+	challenge := []byte("registration challenge")
+	valid := newTestAuthenticator(t).create(t, challenge, nil)
+
+	tests := []struct {
+		name string
+		a    Attestation
+	}{
+		{"invalid client data encoding", Attestation{ClientDataJSON: "!", AttestationObject: valid.AttestationObject}},
+		{"invalid client data", Attestation{ClientDataJSON: b64.EncodeToString([]byte("{")), AttestationObject: valid.AttestationObject}},
+		{"invalid attestation object encoding", Attestation{ClientDataJSON: valid.ClientDataJSON, AttestationObject: "!"}},
+		{"attestation object is no map", Attestation{ClientDataJSON: valid.ClientDataJSON, AttestationObject: b64.EncodeToString(encodeCBOR("x"))}},
+		{"attestation object without authenticator data", Attestation{ClientDataJSON: valid.ClientDataJSON, AttestationObject: b64.EncodeToString(encodeCBOR(map[any]any{"fmt": "none"}))}},
+		{"authenticator data too short", Attestation{ClientDataJSON: valid.ClientDataJSON, AttestationObject: b64.EncodeToString(encodeCBOR(map[any]any{"authData": []byte{1, 2, 3}}))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testRP.verifyAttestation(&tt.a, challenge)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	challenge := []byte("login challenge")
+
+	tests := []struct {
+		name   string
+		change func(*ceremony)
+		// signCount is the sign count stored for the credential
+		signCount uint32
+		ok        bool
+	}{
+		{name: "valid", ok: true},
+		{name: "wrong origin", change: func(c *ceremony) { c.origin = "https://evil.example.com" }},
+		{name: "wrong rp id hash", change: func(c *ceremony) { c.rpID = "evil.example.com" }},
+		{name: "wrong challenge", change: func(c *ceremony) { c.challenge = []byte("old challenge") }},
+		{name: "wrong type", change: func(c *ceremony) { c.typ = "webauthn.create" }},
+		{name: "user not present", change: func(c *ceremony) { c.flags = 0 }},
+		{name: "sign count increased", change: func(c *ceremony) { c.signCount = 11 }, signCount: 10, ok: true},
+		{name: "sign count did not increase", change: func(c *ceremony) { c.signCount = 10 }, signCount: 10},
+		{name: "sign count regression", change: func(c *ceremony) { c.signCount = 9 }, signCount: 10},
+		{name: "sign count reset to zero", change: func(c *ceremony) { c.signCount = 0 }, signCount: 10},
+		{name: "authenticator without sign count", change: func(c *ceremony) { c.signCount = 0 }, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestAuthenticator(t)
+			c, err := testRP.verifyAttestation(a.create(t, challenge, nil), challenge)
+			require.NoError(t, err)
+			c.SignCount = tt.signCount
+			other := newTestAuthenticator(t)
+			other.id = []byte("other")
+			o, err := testRP.verifyAttestation(other.create(t, challenge, nil), challenge)
+			require.NoError(t, err)
+
+			i, signCount, err := testRP.verifyAssertion(a.get(t, challenge, tt.change), challenge, []Credential{o, c})
+			if !tt.ok {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, i)
+			assert.Equal(t, a.signCount, signCount)
+		})
+	}
+}
+
+func TestVerifyAssertionSignature(t *testing.T) {
+	challenge := []byte("login challenge")
+	a := newTestAuthenticator(t)
+	c, err := testRP.verifyAttestation(a.create(t, challenge, nil), challenge)
+	require.NoError(t, err)
+
+	// a signature of another key with the same credential id
+	other := newTestAuthenticator(t)
+	other.id = a.id
+	_, _, err = testRP.verifyAssertion(other.get(t, challenge, nil), challenge, []Credential{c})
+	assert.Error(t, err)
+
+	// authenticator data changed after signing
+	assertion := a.get(t, challenge, nil)
+	authData, err := b64.DecodeString(assertion.AuthenticatorData)
+	require.NoError(t, err)
+	authData[36]++
+	assertion.AuthenticatorData = b64.EncodeToString(authData)
+	_, _, err = testRP.verifyAssertion(assertion, challenge, []Credential{c})
+	assert.Error(t, err)
+
+	// unknown credential
+	assertion = a.get(t, challenge, nil)
+	assertion.ID = b64.EncodeToString([]byte("unknown"))
+	_, _, err = testRP.verifyAssertion(assertion, challenge, []Credential{c})
+	assert.Error(t, err)
+}
+
+func TestParseCOSEKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	x := ecKey.X.FillBytes(make([]byte, 32))
+	y := ecKey.Y.FillBytes(make([]byte, 32))
+	offCurve := append([]byte(nil), y...)
+	offCurve[31]++
+
+	tests := []struct {
+		name string
+		key  any
+		ok   bool
+	}{
+		{"es256", map[any]any{int64(1): int64(2), int64(3): int64(-7), int64(-1): int64(1), int64(-2): x, int64(-3): y}, true},
+		{"rs256", map[any]any{int64(1): int64(3), int64(3): int64(-257), int64(-1): rsaKey.N.Bytes(), int64(-2): []byte{1, 0, 1}}, true},
+		{"point not on the curve", map[any]any{int64(1): int64(2), int64(3): int64(-7), int64(-1): int64(1), int64(-2): x, int64(-3): offCurve}, false},
+		{"other curve", map[any]any{int64(1): int64(2), int64(3): int64(-7), int64(-1): int64(2), int64(-2): x, int64(-3): y}, false},
+		{"short rsa key", map[any]any{int64(1): int64(3), int64(3): int64(-257), int64(-1): rsaKey.N.Bytes()[:128], int64(-2): []byte{1, 0, 1}}, false},
+		{"unsupported algorithm", map[any]any{int64(1): int64(1), int64(3): int64(-8)}, false},
+		{"algorithm of other key type", map[any]any{int64(1): int64(3), int64(3): int64(-7), int64(-1): int64(1), int64(-2): x, int64(-3): y}, false},
+		{"no map", []any{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseCOSEKey(tt.key)
+			if tt.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/mfa"
+	"github.com/rs/zerolog"
+)
+
+// maxLogonRequestSize limits the size of the logon requests read by the MFA middleware
+const maxLogonRequestSize = 1 << 20
+
+// logonRequest contains the fields of a logon request the MFA middleware needs
+type logonRequest struct {
+	State  string       `json:"state"`
+	Params []string     `json:"params"`
+	MFA    *mfa.Request `json:"mfa"`
+}
+
+// logonResponse contains the fields of a logon response the MFA middleware needs
+type logonResponse struct {
+	Success bool `json:"success"`
+	Hello   *struct {
+		Username string `json:"username"`
+	} `json:"hello"`
+}
+
+// mfaResponse is sent instead of the logon response when the second factor is missing
+type mfaResponse struct {
+	Success bool   `json:"success"`
+	State   string `json:"state"`
+	MFA     struct {
+		Challenge  *mfa.Challenge           `json:"challenge,omitempty"`
+		Enrollment *mfa.EnrollmentChallenge `json:"enrollment,omitempty"`
+		// Invalid is set when the sent second factor was wrong
+		Invalid bool `json:"invalid,omitempty"`
+		Locked  bool `json:"locked,omitempty"`
+	} `json:"mfa"`
+}
+
+// MFA is a middleware which requires a second factor for the password logon of the users
+// which have one or are required to have one. The logon request is passed on to the identifier,
+// which verifies the password and creates the session. The session cookie is only sent to the
+// client after the second factor was verified, otherwise the client gets a challenge. Logons
+// with an existing session are passed on as is, the session was created with the second factor.
+func MFA(m *mfa.Manager, logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/identifier/_/logon") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxLogonRequestSize+1))
+			switch {
+			case err != nil:
+				http.Error(w, "failed to read request", http.StatusBadRequest)
+				return
+			case len(body) > maxLogonRequestSize:
+				http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			// decode the request like the identifier does, which ignores trailing data. Requests
+			// the middleware can't decode are rejected, they must not reach the identifier unchecked.
+			req := logonRequest{}
+			if err := json.NewDecoder(bytes.NewReader(body)).Decode(&req); err != nil {
+				http.Error(w, "failed to decode request JSON", http.StatusBadRequest)
+				return
+			}
+			if len(req.Params) < 2 || req.Params[1] == "" {
+				// logons without a password only succeed with an existing session
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			res := logonResponse{}
+			if rec.status != http.StatusOK || json.Unmarshal(rec.body.Bytes(), &res) != nil || !res.Success {
+				// the password logon failed
+				rec.flush(w)
+				return
+			}
+
+			user := req.Params[0]
+			if res.Hello != nil && res.Hello.Username != "" {
+				user = res.Hello.Username
+			}
+			user = mfa.NormalizeUser(user)
+			sublog := logger.With().Str("user", user).Logger()
+
+			e, err := m.Enrollment(user)
+			if err != nil {
+				sublog.Error().Err(err).Msg("could not read the second factor")
+				http.Error(w, "failed to logon", http.StatusInternalServerError)
+				return
+			}
+
+			out := mfaResponse{State: req.State}
+			if e != nil {
+				if req.MFA.IsZero() {
+					if out.MFA.Challenge, err = m.Challenge(user, e); err != nil {
+						sublog.Error().Err(err).Msg("could not create the second factor challenge")
+						http.Error(w, "failed to logon", http.StatusInternalServerError)
+						return
+					}
+					writeJSON(w, out, &sublog)
+					return
+				}
+
+				err := m.Verify(user, req.MFA)
+				switch {
+				case err == nil:
+					rec.flush(w)
+					return
+				case errors.Is(err, mfa.ErrLocked):
+					sublog.Info().Msg("second factor is locked")
+					out.MFA.Locked = true
+					writeJSON(w, out, &sublog)
+					return
+				case errors.Is(err, mfa.ErrInvalid), errors.Is(err, mfa.ErrNoChallenge):
+					sublog.Info().Err(err).Msg("second factor verification failed")
+					out.MFA.Invalid = errors.Is(err, mfa.ErrInvalid)
+					if out.MFA.Challenge, err = m.Challenge(user, e); err != nil {
+						sublog.Error().Err(err).Msg("could not create the second factor challenge")
+						http.Error(w, "failed to logon", http.StatusInternalServerError)
+						return
+					}
+					writeJSON(w, out, &sublog)
+					return
+				default:
+					sublog.Error().Err(err).Msg("could not verify the second factor")
+					http.Error(w, "failed to logon", http.StatusInternalServerError)
+					return
+				}
+			}
+
+			if !req.MFA.IsZero() {
+				codes, err := m.FinishEnrollment(user, req.MFA)
+				switch {
+				case err == nil:
+					sublog.Info().Msg("second factor enrolled")
+					rec.flushWithRecoveryCodes(w, codes, &sublog)
+					return
+				case errors.Is(err, mfa.ErrInvalid), errors.Is(err, mfa.ErrNoChallenge):
+					sublog.Info().Err(err).Msg("second factor enrollment failed")
+					out.MFA.Invalid = errors.Is(err, mfa.ErrInvalid)
+				default:
+					sublog.Error().Err(err).Msg("could not enroll the second factor")
+					http.Error(w, "failed to logon", http.StatusInternalServerError)
+					return
+				}
+			} else {
+				required, err := m.Required(r.Context(), user)
+				if err != nil {
+					sublog.Error().Err(err).Msg("could not check if a second factor is required")
+					http.Error(w, "failed to logon", http.StatusInternalServerError)
+					return
+				}
+				if !required && (req.MFA == nil || !req.MFA.Enroll) {
+					rec.flush(w)
+					return
+				}
+			}
+
+			if out.MFA.Enrollment, err = m.BeginEnrollment(user); err != nil {
+				sublog.Error().Err(err).Msg("could not begin the enrollment of a second factor")
+				http.Error(w, "failed to logon", http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, out, &sublog)
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any, logger *zerolog.Logger) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Error().Err(err).Msg("could not write the response")
+	}
+}
+
+// bufferedResponse keeps the response of the identifier until the second factor was verified
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) flush(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(b.status)
+	_, _ = w.Write(b.body.Bytes())
+}
+
+// flushWithRecoveryCodes adds the recovery codes of a new enrollment to the logon response
+func (b *bufferedResponse) flushWithRecoveryCodes(w http.ResponseWriter, codes []string, logger *zerolog.Logger) {
+	res := map[string]any{}
+	if err := json.Unmarshal(b.body.Bytes(), &res); err != nil {
+		logger.Error().Err(err).Msg("could not add the recovery codes to the logon response")
+		b.flush(w)
+		return
+	}
+	res["mfa"] = map[string]any{"recovery_codes": codes}
+	body, err := json.Marshal(res)
+	if err != nil {
+		logger.Error().Err(err).Msg("could not add the recovery codes to the logon response")
+		b.flush(w)
+		return
+	}
+	b.body.Reset()
+	b.body.Write(body)
+	b.header.Del("Content-Length")
+	b.flush(w)
+}
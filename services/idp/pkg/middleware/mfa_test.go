@@ -0,0 +1,326 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/mfa"
+)
+
+const logonPath = "/signin/v1/identifier/_/logon"
+
+// fakeIdentifier mimics the logon endpoint of the identifier, the password of all users is "secret"
+func fakeIdentifier(called *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*called++
+		req := struct {
+			State  string   `json:"state"`
+			Params []string `json:"params"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "failed to decode request JSON", http.StatusBadRequest)
+			return
+		}
+		if len(req.Params) < 3 || req.Params[1] != "secret" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "__Secure-KKT", Value: "session"})
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"state":   req.State,
+			"hello":   map[string]any{"username": req.Params[0]},
+		})
+	})
+}
+
+func newTestManager(t *testing.T) *mfa.Manager {
+	cfg := defaults.DefaultConfig()
+	cfg.IDP.Iss = "https://cloud.example.com"
+	cfg.IDP.EncryptionSecretFile = filepath.Join(t.TempDir(), "encryption.key")
+	require.NoError(t, os.WriteFile(cfg.IDP.EncryptionSecretFile, []byte("encryption secret"), 0o600))
+	cfg.MFA.Store = config.MFAStore{Store: "memory", Database: "idp", Table: "mfa"}
+
+	m, err := mfa.NewManager(cfg, mfa.NewStore(cfg.MFA.Store), nil)
+	require.NoError(t, err)
+	return m
+}
+
+// totpCode computes the current code of a base32 encoded secret as defined by RFC 6238
+func totpCode(t *testing.T, secret string, now time.Time) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(now.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:offset+4])&0x7fffffff)%1000000)
+}
+
+// enroll enrolls a TOTP second factor for the user and returns the secret. The enrollment uses
+// the code of the previous time step, which is still accepted, so that the current code can be
+// used to logon.
+func enroll(t *testing.T, m *mfa.Manager, user string) string {
+	c, err := m.BeginEnrollment(user)
+	require.NoError(t, err)
+	_, err = m.FinishEnrollment(user, &mfa.Request{TOTP: totpCode(t, c.TOTPSecret, time.Now().Add(-30*time.Second))})
+	require.NoError(t, err)
+	return c.TOTPSecret
+}
+
+func TestMFARejectsMalformedLogonRequests(t *testing.T) {
+	m := newTestManager(t)
+	enroll(t, m, "alice")
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid json", `{"params":["alice","secret","1"]`},
+		{"wrong params type", `{"params":"alice"}`},
+		{"wrong mfa type", `{"params":["alice","secret","1"],"mfa":"123456"}`},
+		{"wrong totp type", `{"params":["alice","secret","1"],"mfa":{"totp":123456}}`},
+		{"empty body", ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := 0
+			rr := httptest.NewRecorder()
+			MFA(m, log.NopLogger())(fakeIdentifier(&called)).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, logonPath, strings.NewReader(tt.body)))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Zero(t, called, "the identifier must not see the request")
+			assert.Empty(t, rr.Header().Values("Set-Cookie"))
+		})
+	}
+}
+
+func TestMFARejectsLargeLogonRequests(t *testing.T) {
+	called := 0
+	rr := httptest.NewRecorder()
+	body := `{"params":["alice","secret","1"],"state":"` + strings.Repeat("a", maxLogonRequestSize) + `"}`
+	MFA(newTestManager(t), log.NopLogger())(fakeIdentifier(&called)).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, logonPath, strings.NewReader(body)))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	assert.Zero(t, called)
+}
+
+func TestMFALogon(t *testing.T) {
+	m := newTestManager(t)
+	secret := enroll(t, m, "alice")
+	code := totpCode(t, secret, time.Now())
+
+	tests := []struct {
+		name string
+		body string
+		// cookie is true when the session cookie must be sent
+		cookie bool
+		status int
+		// mfa are the fields expected in the mfa object of the response
+		mfa []string
+	}{
+		{
+			name:   "wrong password",
+			body:   `{"state":"s","params":["alice","wrong","1"]}`,
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "enrolled user without second factor",
+			body:   `{"state":"s","params":["alice","secret","1"]}`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge"},
+		},
+		{
+			name:   "trailing data is ignored like the identifier does",
+			body:   `{"state":"s","params":["alice","secret","1"]}x`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge"},
+		},
+		{
+			name:   "login is case insensitive",
+			body:   `{"state":"s","params":["ALICE","secret","1"]}`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge"},
+		},
+		{
+			name:   "password in an unknown mode",
+			body:   `{"state":"s","params":["alice","secret"]}`,
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "enrolled user with wrong code",
+			body:   `{"state":"s","params":["alice","secret","1"],"mfa":{"totp":"abcdef"}}`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge", "invalid"},
+		},
+		{
+			name:   "enrolled user with an enrollment instead of a second factor",
+			body:   `{"state":"s","params":["alice","secret","1"],"mfa":{"enroll":true}}`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge"},
+		},
+		{
+			name:   "enrolled user with code",
+			body:   `{"state":"s","params":["alice","secret","1"],"mfa":{"totp":"` + code + `"}}`,
+			status: http.StatusOK,
+			cookie: true,
+		},
+		{
+			name:   "enrolled user with a used code",
+			body:   `{"state":"s","params":["alice","secret","1"],"mfa":{"totp":"` + code + `"}}`,
+			status: http.StatusOK,
+			mfa:    []string{"challenge", "invalid"},
+		},
+		{
+			name:   "user without second factor",
+			body:   `{"state":"s","params":["bob","secret","1"]}`,
+			status: http.StatusOK,
+			cookie: true,
+		},
+		{
+			name:   "user without second factor requests an enrollment",
+			body:   `{"state":"s","params":["bob","secret","1"],"mfa":{"enroll":true}}`,
+			status: http.StatusOK,
+			mfa:    []string{"enrollment"},
+		},
+		{
+			name:   "user without second factor sends a wrong enrollment code",
+			body:   `{"state":"s","params":["bob","secret","1"],"mfa":{"totp":"abcdef"}}`,
+			status: http.StatusOK,
+			mfa:    []string{"enrollment", "invalid"},
+		},
+		{
+			name:   "user without second factor sends a code without an enrollment",
+			body:   `{"state":"s","params":["carol","secret","1"],"mfa":{"totp":"123456"}}`,
+			status: http.StatusOK,
+			mfa:    []string{"enrollment"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := 0
+			rr := httptest.NewRecorder()
+			MFA(m, log.NopLogger())(fakeIdentifier(&called)).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, logonPath, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.status, rr.Code)
+			assert.Equal(t, 1, called)
+			if tt.cookie {
+				assert.NotEmpty(t, rr.Header().Values("Set-Cookie"))
+			} else {
+				assert.Empty(t, rr.Header().Values("Set-Cookie"))
+			}
+
+			if tt.mfa == nil {
+				return
+			}
+			res := struct {
+				Success bool           `json:"success"`
+				State   string         `json:"state"`
+				MFA     map[string]any `json:"mfa"`
+			}{}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+			assert.False(t, res.Success)
+			assert.Equal(t, "s", res.State)
+			for _, field := range tt.mfa {
+				assert.Contains(t, res.MFA, field)
+			}
+			assert.Len(t, res.MFA, len(tt.mfa))
+		})
+	}
+
+}
+
+func TestMFAPassesOtherRequests(t *testing.T) {
+	m := newTestManager(t)
+	enroll(t, m, "alice")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{"other path", http.MethodPost, "/signin/v1/identifier/_/hello", `not json`},
+		{"other method", http.MethodGet, logonPath, ``},
+		{"logon with existing session", http.MethodPost, logonPath, `{"state":"s","params":["alice","","0"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := 0
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called++
+				w.WriteHeader(http.StatusTeapot)
+			})
+			rr := httptest.NewRecorder()
+			MFA(m, log.NopLogger())(next).ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			assert.Equal(t, http.StatusTeapot, rr.Code)
+			assert.Equal(t, 1, called)
+		})
+	}
+}
+
+func TestMFAEnrollment(t *testing.T) {
+	m := newTestManager(t)
+	logon := func(body string) *httptest.ResponseRecorder {
+		called := 0
+		rr := httptest.NewRecorder()
+		MFA(m, log.NopLogger())(fakeIdentifier(&called)).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, logonPath, strings.NewReader(body)))
+		return rr
+	}
+
+	rr := logon(`{"state":"s","params":["bob","secret","1"],"mfa":{"enroll":true}}`)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Values("Set-Cookie"))
+	res := struct {
+		MFA struct {
+			Enrollment mfa.EnrollmentChallenge `json:"enrollment"`
+		} `json:"mfa"`
+	}{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	require.NotEmpty(t, res.MFA.Enrollment.TOTPSecret)
+
+	// the enrollment needs the password
+	rr = logon(`{"state":"s","params":["bob","wrong","1"],"mfa":{"totp":"` + totpCode(t, res.MFA.Enrollment.TOTPSecret, time.Now()) + `"}}`)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = logon(`{"state":"s","params":["bob","secret","1"],"mfa":{"totp":"` + totpCode(t, res.MFA.Enrollment.TOTPSecret, time.Now()) + `"}}`)
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.NotEmpty(t, rr.Header().Values("Set-Cookie"))
+	done := struct {
+		Success bool   `json:"success"`
+		State   string `json:"state"`
+		MFA     struct {
+			RecoveryCodes []string `json:"recovery_codes"`
+		} `json:"mfa"`
+	}{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &done))
+	assert.True(t, done.Success)
+	assert.Equal(t, "s", done.State)
+	assert.Len(t, done.MFA.RecoveryCodes, 10)
+
+	// bob has a second factor now
+	rr = logon(`{"state":"s","params":["bob","secret","1"]}`)
+	assert.Empty(t, rr.Header().Values("Set-Cookie"))
+	assert.Contains(t, rr.Body.String(), `"challenge"`)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
@@ -24,6 +25,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/idp/pkg/assets"
 	cs3BackendSupport "github.com/opencloud-eu/opencloud/services/idp/pkg/backends/cs3/bootstrap"
 	"github.com/opencloud-eu/opencloud/services/idp/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/idp/pkg/mfa"
 	"github.com/opencloud-eu/opencloud/services/idp/pkg/middleware"
 	"github.com/riandyrn/otelchi"
 	"go.opentelemetry.io/otel/trace"
@@ -133,6 +135,21 @@ func NewService(opts ...Option) Service {
 		tp:     options.TraceProvider,
 	}
 
+	if options.Config.MFA.Enabled {
+		var groups mfa.GroupResolver
+		if len(options.Config.MFA.RequiredGroups) > 0 {
+			ldapGroups, err := mfa.NewLDAPGroups(options.Config.Ldap, options.Config.IDP.Insecure)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("could not initialize the mfa group lookup")
+			}
+			groups = ldapGroups
+		}
+		svc.mfa, err = mfa.NewManager(options.Config, mfa.NewStore(options.Config.MFA.Store), groups)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("could not initialize mfa")
+		}
+	}
+
 	svc.initMux(ctx, routes, handlers, options)
 
 	return svc
@@ -257,6 +274,7 @@ type IDP struct {
 	mux    *chi.Mux
 	assets http.FileSystem
 	tp     trace.TracerProvider
+	mfa    *mfa.Manager
 }
 
 // initMux initializes the internal idp gorilla mux and mounts it in to an OpenCloud chi-router
@@ -292,6 +310,10 @@ func (idp *IDP) initMux(ctx context.Context, r []server.WithRoutes, h http.Handl
 		),
 	)
 
+	if idp.mfa != nil {
+		idp.mux.Use(middleware.MFA(idp.mfa, idp.logger))
+	}
+
 	// handle / | index.html with a template that needs to have the BASE_PREFIX replaced
 	idp.mux.Get("/signin/v1/identifier", idp.Index())
 	idp.mux.Get("/signin/v1/identifier/", idp.Index())
@@ -337,6 +359,8 @@ func (idp IDP) Index() http.HandlerFunc {
 
 	indexHTML = bytes.Replace(indexHTML, []byte("__PASSWORD_RESET_LINK__"), []byte(idp.config.Service.PasswordResetURI), 1)
 
+	indexHTML = bytes.Replace(indexHTML, []byte("__MFA_ENABLED__"), []byte(strconv.FormatBool(idp.mfa != nil)), 1)
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		if _, err := w.Write(indexHTML); err != nil {
@@ -8,6 +8,8 @@ import {
   ERROR_LOGIN_VALIDATE_MISSINGUSERNAME,
   ERROR_LOGIN_VALIDATE_MISSINGPASSWORD,
   ERROR_LOGIN_FAILED,
+  ERROR_LOGIN_MFA_INVALID,
+  ERROR_LOGIN_MFA_LOCKED,
   ERROR_HTTP_UNEXPECTED_RESPONSE_STATUS,
   ERROR_HTTP_UNEXPECTED_RESPONSE_STATE
 } from '../errors';
@@ -53,6 +55,36 @@ export function receiveLogon(logon) {
   };
 }
 
+export function receiveMFA(mfa) {
+  const errors = {};
+  if (mfa.locked) {
+    errors.mfa = new Error(ERROR_LOGIN_MFA_LOCKED);
+  } else if (mfa.invalid) {
+    errors.mfa = new Error(ERROR_LOGIN_MFA_INVALID);
+  }
+
+  return {
+    type: types.RECEIVE_MFA,
+    mfa: mfa.locked ? null : mfa,
+    errors
+  };
+}
+
+export function cancelMFA() {
+  return {
+    type: types.RECEIVE_MFA,
+    mfa: null,
+    errors: {}
+  };
+}
+
+export function receiveRecoveryCodes(recoveryCodes) {
+  return {
+    type: types.RECEIVE_RECOVERY_CODES,
+    recoveryCodes
+  };
+}
+
 export function requestConsent(allow=false) {
   return {
     type: allow ? types.REQUEST_CONSENT_ALLOW : types.REQUEST_CONSENT_CANCEL
@@ -69,7 +101,7 @@ export function receiveConsent(logon) {
   };
 }
 
-export function executeLogon(username, password, mode=ModeLogonUsernamePassword) {
+export function executeLogon(username, password, mode=ModeLogonUsernamePassword, mfa=undefined) {
   return function(dispatch, getState) {
     dispatch(requestLogon(username, password));
     dispatch(receiveHello({
@@ -96,7 +128,8 @@ export function executeLogon(username, password, mode=ModeLogonUsernamePassword)
 
     const r = withClientRequestState({
       params: params,
-      hello: newHelloRequest(flow, query)
+      hello: newHelloRequest(flow, query),
+      mfa
     });
     return axios.post('./identifier/_/logon', r, {
       headers: {
@@ -125,6 +158,15 @@ export function executeLogon(username, password, mode=ModeLogonUsernamePassword)
         throw new ExtendedError(ERROR_HTTP_UNEXPECTED_RESPONSE_STATE, response);
       }
 
+      if (!response.success && response.mfa) {
+        // Password is valid, but a second factor is needed.
+        dispatch(receiveMFA(response.mfa));
+        return Promise.resolve(response);
+      }
+      if (response.mfa && response.mfa.recovery_codes) { // eslint-disable-line camelcase
+        dispatch(receiveRecoveryCodes(response.mfa.recovery_codes)); // eslint-disable-line camelcase
+      }
+
       let { hello } = response;
       if (!hello) {
         hello = {
@@ -227,13 +269,13 @@ export function validateUsernamePassword(username, password, isSignedIn) {
   };
 }
 
-export function executeLogonIfFormValid(username, password, isSignedIn) {
+export function executeLogonIfFormValid(username, password, isSignedIn, mfa=undefined) {
   return (dispatch) => {
     return dispatch(
       validateUsernamePassword(username, password, isSignedIn)
     ).then(() => {
       const mode = isSignedIn ? ModeLogonUsernameEmptyPasswordCookie : ModeLogonUsernamePassword;
-      return dispatch(executeLogon(username, password, mode));
+      return dispatch(executeLogon(username, password, mode, mfa));
     }).catch((errors) => {
       return {
         success: false,
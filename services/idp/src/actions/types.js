@@ -9,6 +9,8 @@ export const REQUEST_LOGON = 'REQUEST_LOGON';
 export const EXECUTE_LOGON = 'EXECUTE_LOGON';
 export const RECEIVE_LOGON = 'RECEIVE_LOGON';
 export const UPDATE_INPUT = 'UPDATE_INPUT';
+export const RECEIVE_MFA = 'RECEIVE_MFA';
+export const RECEIVE_RECOVERY_CODES = 'RECEIVE_RECOVERY_CODES';
 
 export const REQUEST_CONSENT_ALLOW = 'REQUEST_CONSENT_ALLOW';
 export const REQUEST_CONSENT_CANCEL = 'REQUEST_CONSENT_CANCEL';
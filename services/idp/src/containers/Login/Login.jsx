@@ -12,11 +12,15 @@ import Typography from "@material-ui/core/Typography";
 import Link from "@material-ui/core/Link";
 
 import TextInput from "../../components/TextInput";
+import SecondFactor from "./SecondFactor";
 
 import {
   updateInput,
+  executeLogon,
   executeLogonIfFormValid,
   advanceLogonFlow,
+  cancelMFA,
+  ModeLogonUsernamePassword,
 } from "../../actions/login";
 import { ErrorMessage } from "../../errors";
 
@@ -48,7 +52,13 @@ const styles = (theme) => ({
   },
   signinPageText: {
     marginBottom: 12
-  }
+  },
+  recoveryCodes: {
+    fontFamily: "monospace",
+    textAlign: "center",
+    listStyle: "none",
+    padding: 0,
+  },
 });
 
 function Login(props) {
@@ -64,6 +74,9 @@ function Login(props) {
     password,
     passwordResetLink,
     branding,
+    mfa,
+    mfaAvailable,
+    recoveryCodes,
   } = props;
 
   const { t } = useTranslation();
@@ -94,7 +107,7 @@ function Login(props) {
   }
 
   useEffect(() => {
-    if (hello && hello.state && history.action !== "PUSH") {
+    if (hello && hello.state && history.action !== "PUSH" && !recoveryCodes) {
       if (!query.prompt || query.prompt.indexOf("select_account") === -1) {
         dispatch(advanceLogonFlow(true, history));
         return;
@@ -115,14 +128,75 @@ function Login(props) {
     event.preventDefault();
 
     dispatch(executeLogonIfFormValid(username, password, false)).then(
-      (response) => {
-        if (response.success) {
-          dispatch(advanceLogonFlow(response.success, history));
-        }
-      }
+      handleLogonResponse
     );
   };
 
+  const handleLogonResponse = (response) => {
+    // Show new recovery codes before continuing, they are only sent once.
+    if (response.success && !(response.mfa && response.mfa.recovery_codes)) {
+      dispatch(advanceLogonFlow(response.success, history));
+    }
+  };
+
+  const handleSecondFactor = (request) => {
+    dispatch(
+      executeLogon(username, password, ModeLogonUsernamePassword, request)
+    ).then(handleLogonResponse);
+  };
+
+  const handleEnrollClick = (event) => {
+    event.preventDefault();
+
+    dispatch(
+      executeLogonIfFormValid(username, password, false, { enroll: true })
+    ).then(handleLogonResponse);
+  };
+
+  if (recoveryCodes) {
+    return (
+      <div className={classes.main}>
+        <h1 className={classes.header}>
+          {t("konnect.login.mfa.recoveryCodesHeadline", "Recovery codes")}
+        </h1>
+        <Typography variant="body2" className={classes.message}>
+          {t(
+            "konnect.login.mfa.recoveryCodesText",
+            "Store these recovery codes in a safe place. Each code can be used once to sign in when your second factor is not available. They will not be shown again."
+          )}
+        </Typography>
+        <ul className={classes.recoveryCodes} id="oc-login-mfa-recovery-codes">
+          {recoveryCodes.map((code) => (
+            <li key={code}>{code}</li>
+          ))}
+        </ul>
+        <div className={classes.wrapper}>
+          <Button
+            color="primary"
+            variant="contained"
+            className="oc-button-primary oc-mt-l"
+            onClick={() => dispatch(advanceLogonFlow(true, history))}
+          >
+            {t("konnect.login.mfa.continueButton.label", "Continue")}
+          </Button>
+        </div>
+      </div>
+    );
+  }
+
+  if (mfa) {
+    return (
+      <SecondFactor
+        mfa={mfa}
+        errors={errors}
+        loading={loading}
+        classes={classes}
+        onSubmit={handleSecondFactor}
+        onCancel={() => dispatch(cancelMFA())}
+      />
+    );
+  }
+
   return (
     <div className={classes.main}>
       <h1 className={classes.header}>
@@ -198,6 +272,20 @@ function Login(props) {
             <CircularProgress size={24} className={classes.buttonProgress} />
           )}
         </div>
+        {mfaAvailable && (
+          <div className={classes.wrapper}>
+            <Link
+              id="oc-login-mfa-enroll"
+              component="button"
+              type="button"
+              variant="subtitle2"
+              disabled={!!loading}
+              onClick={handleEnrollClick}
+            >
+              {t("konnect.login.mfa.enrollLink", "Set up two-factor authentication")}
+            </Link>
+          </div>
+        )}
       </form>
     </div>
   );
@@ -214,14 +302,19 @@ Login.propTypes = {
   branding: PropTypes.object,
   hello: PropTypes.object,
   query: PropTypes.object.isRequired,
+  mfa: PropTypes.object,
+  mfaAvailable: PropTypes.bool,
+  recoveryCodes: PropTypes.array,
 
   dispatch: PropTypes.func.isRequired,
   history: PropTypes.object.isRequired,
 };
 
 const mapStateToProps = (state) => {
-  const { loading, username, password, errors } = state.login;
-  const { branding, hello, query, passwordResetLink } = state.common;
+  const { loading, username, password, errors, mfa, recoveryCodes } =
+    state.login;
+  const { branding, hello, query, passwordResetLink, mfaAvailable } =
+    state.common;
 
   return {
     loading,
@@ -232,6 +325,9 @@ const mapStateToProps = (state) => {
     hello,
     query,
     passwordResetLink,
+    mfa,
+    mfaAvailable,
+    recoveryCodes,
   };
 };
 
@@ -0,0 +1,185 @@
+import React, { useState } from "react";
+import PropTypes from "prop-types";
+
+import { useTranslation } from "react-i18next";
+
+import Button from "@material-ui/core/Button";
+import Link from "@material-ui/core/Link";
+import Typography from "@material-ui/core/Typography";
+
+import TextInput from "../../components/TextInput";
+import { ErrorMessage, ERROR_LOGIN_MFA_WEBAUTHN } from "../../errors";
+import {
+  isWebAuthnSupported,
+  createCredential,
+  getAssertion,
+} from "../../webauthn";
+
+// SecondFactor asks for the second factor of a user or lets the user enroll one.
+function SecondFactor(props) {
+  const { mfa, errors, loading, classes, onSubmit, onCancel } = props;
+
+  const { t } = useTranslation();
+  const [code, setCode] = useState("");
+  const [useRecoveryCode, setUseRecoveryCode] = useState(false);
+  const [webAuthnError, setWebAuthnError] = useState(null);
+
+  const enrollment = mfa.enrollment;
+  const challenge = mfa.challenge;
+  const methods = challenge ? challenge.methods : [];
+  const canUseCode = !!enrollment || methods.includes("totp");
+  const canUseWebAuthn =
+    isWebAuthnSupported() &&
+    ((enrollment && enrollment.webauthn) || (challenge && challenge.webauthn));
+  const canUseRecoveryCode = methods.includes("recovery_code");
+  const error = webAuthnError || errors.mfa;
+
+  const handleCodeSubmit = (event) => {
+    event.preventDefault();
+    if (!code) {
+      return;
+    }
+    setWebAuthnError(null);
+    onSubmit(
+      useRecoveryCode ? { recovery_code: code } : { totp: code } // eslint-disable-line camelcase
+    );
+    setCode("");
+  };
+
+  const handleWebAuthnClick = (event) => {
+    event.preventDefault();
+    setWebAuthnError(null);
+    const request = enrollment
+      ? createCredential(enrollment.webauthn).then((attestation) => ({
+          attestation,
+        }))
+      : getAssertion(challenge.webauthn).then((webauthn) => ({ webauthn }));
+    request.then(onSubmit).catch(() => {
+      setWebAuthnError(new Error(ERROR_LOGIN_MFA_WEBAUTHN));
+    });
+  };
+
+  return (
+    <div className={classes.main}>
+      <h1 className={classes.header}>
+        {enrollment
+          ? t("konnect.login.mfa.enrollHeadline", "Set up two-factor authentication")
+          : t("konnect.login.mfa.headline", "Two-factor authentication")}
+      </h1>
+      {enrollment && (
+        <React.Fragment>
+          <Typography variant="body2" className={classes.message}>
+            {t(
+              "konnect.login.mfa.enrollText",
+              "Add this key to your authenticator app and enter the generated code, or register a security key."
+            )}
+          </Typography>
+          <Typography
+            variant="body2"
+            className={classes.message}
+            id="oc-login-mfa-secret"
+          >
+            <Link href={enrollment.totp_uri}>{enrollment.totp_secret}</Link>
+          </Typography>
+        </React.Fragment>
+      )}
+      <form
+        action=""
+        className="oc-login-form"
+        onSubmit={(event) => handleCodeSubmit(event)}
+      >
+        {canUseCode && (
+          <TextInput
+            autoFocus
+            autoCapitalize="off"
+            spellCheck="false"
+            autoComplete="one-time-code"
+            inputMode={useRecoveryCode ? "text" : "numeric"}
+            value={code}
+            onChange={(event) => setCode(event.target.value)}
+            label={
+              useRecoveryCode
+                ? t("konnect.login.mfa.recoveryCodeField.label", "Recovery code")
+                : t("konnect.login.mfa.codeField.label", "Authentication code")
+            }
+            id="oc-login-mfa-code"
+            aria-invalid={error ? "true" : "false"}
+            extraClassName={error ? "error" : undefined}
+          />
+        )}
+        {error && (
+          <Typography
+            id="oc-login-error-message"
+            variant="subtitle2"
+            component="span"
+            color="error"
+            className={classes.message}
+          >
+            <ErrorMessage error={error}></ErrorMessage>
+          </Typography>
+        )}
+        <div className={classes.wrapper}>
+          {canUseCode && (
+            <Button
+              type="submit"
+              color="primary"
+              variant="contained"
+              className="oc-button-primary oc-mt-l"
+              disabled={!!loading}
+              onClick={handleCodeSubmit}
+            >
+              {t("konnect.login.mfa.verifyButton.label", "Verify")}
+            </Button>
+          )}
+          {canUseWebAuthn && (
+            <Button
+              variant="outlined"
+              className="oc-mt-l"
+              disabled={!!loading}
+              onClick={handleWebAuthnClick}
+            >
+              {enrollment
+                ? t("konnect.login.mfa.registerKeyButton.label", "Register security key")
+                : t("konnect.login.mfa.useKeyButton.label", "Use security key")}
+            </Button>
+          )}
+        </div>
+        <div className={classes.wrapper}>
+          {canUseRecoveryCode && (
+            <Link
+              component="button"
+              type="button"
+              variant="subtitle2"
+              onClick={() => setUseRecoveryCode(!useRecoveryCode)}
+            >
+              {useRecoveryCode
+                ? t("konnect.login.mfa.useCode", "Use authentication code")
+                : t("konnect.login.mfa.useRecoveryCode", "Use a recovery code")}
+            </Link>
+          )}{" "}
+          <Link
+            component="button"
+            type="button"
+            variant="subtitle2"
+            onClick={onCancel}
+          >
+            {t("konnect.login.mfa.cancel", "Cancel")}
+          </Link>
+        </div>
+      </form>
+    </div>
+  );
+}
+
+SecondFactor.propTypes = {
+  classes: PropTypes.object.isRequired,
+
+  mfa: PropTypes.object.isRequired,
+  errors: PropTypes.object.isRequired,
+  loading: PropTypes.string.isRequired,
+
+  onSubmit: PropTypes.func.isRequired,
+  onCancel: PropTypes.func.isRequired,
+};
+
+export default SecondFactor;
@@ -4,6 +4,9 @@ import PropTypes from 'prop-types';
 export const ERROR_LOGIN_VALIDATE_MISSINGUSERNAME = 'konnect.error.login.validate.missingUsername';
 export const ERROR_LOGIN_VALIDATE_MISSINGPASSWORD = 'konnect.error.login.validate.missingPassword';
 export const ERROR_LOGIN_FAILED = 'konnect.error.login.failed';
+export const ERROR_LOGIN_MFA_INVALID = 'konnect.error.login.mfa.invalid';
+export const ERROR_LOGIN_MFA_LOCKED = 'konnect.error.login.mfa.locked';
+export const ERROR_LOGIN_MFA_WEBAUTHN = 'konnect.error.login.mfa.webauthn';
 export const ERROR_HTTP_NETWORK_ERROR = 'konnect.error.http.networkError';
 export const ERROR_HTTP_UNEXPECTED_RESPONSE_STATUS = 'konnect.error.http.unexpectedResponseStatus';
 export const ERROR_HTTP_UNEXPECTED_RESPONSE_STATE = 'konnect.error.http.unexpectedResponseState';
@@ -46,6 +49,12 @@ function ErrorMessageComponent(props) {
       return t("konnect.error.login.validate.missingPassword", "Enter your password.");
     case ERROR_LOGIN_FAILED:
       return t("konnect.error.login.failed", "Logon failed. Please verify your credentials and try again.");
+    case ERROR_LOGIN_MFA_INVALID:
+      return t("konnect.error.login.mfa.invalid", "The code is invalid. Please try again.");
+    case ERROR_LOGIN_MFA_LOCKED:
+      return t("konnect.error.login.mfa.locked", "Too many failed attempts. Please try again later.");
+    case ERROR_LOGIN_MFA_WEBAUTHN:
+      return t("konnect.error.login.mfa.webauthn", "The security key could not be used. Please try again.");
     case ERROR_HTTP_NETWORK_ERROR:
       return t("konnect.error.http.networkError", "Network error. Please check your connection and try again.");
     case ERROR_HTTP_UNEXPECTED_RESPONSE_STATUS:
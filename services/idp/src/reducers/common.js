@@ -30,6 +30,11 @@ const defaultPasswordResetLink = (() => {
   return link;
 })();
 
+const defaultMFAAvailable = (() => {
+  const root = document.getElementById('root');
+  return root ? root.getAttribute('data-mfa-enabled') === 'true' : false;
+})();
+
 const defaultState = {
   hello: null,
   branding: null,
@@ -38,7 +43,8 @@ const defaultState = {
   query: query,
   updateAvailable: false,
   pathPrefix: defaultPathPrefix,
-  passwordResetLink: defaultPasswordResetLink
+  passwordResetLink: defaultPasswordResetLink,
+  mfaAvailable: defaultMFAAvailable
 };
 
 function commonReducer(state = defaultState, action) {
@@ -6,13 +6,17 @@ import {
   REQUEST_CONSENT_ALLOW,
   REQUEST_CONSENT_CANCEL,
   RECEIVE_CONSENT,
-  UPDATE_INPUT
+  UPDATE_INPUT,
+  RECEIVE_MFA,
+  RECEIVE_RECOVERY_CODES
 } from '../actions/types';
 
 function loginReducer(state = {
   loading: '',
   username: '',
   password: '',
+  mfa: null,
+  recoveryCodes: null,
   errors: {}
 }, action) {
   switch (action.type) {
@@ -37,10 +41,24 @@ function loginReducer(state = {
         loading: ''
       });
 
+    case RECEIVE_MFA:
+      return Object.assign({}, state, {
+        mfa: action.mfa,
+        errors: action.errors,
+        loading: ''
+      });
+
+    case RECEIVE_RECOVERY_CODES:
+      return Object.assign({}, state, {
+        recoveryCodes: action.recoveryCodes
+      });
+
     case RECEIVE_LOGOFF:
       return Object.assign({}, state, {
         username: '',
-        password: ''
+        password: '',
+        mfa: null,
+        recoveryCodes: null
       });
 
     case UPDATE_INPUT:
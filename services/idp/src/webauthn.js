@@ -0,0 +1,48 @@
+// Helpers to use the WebAuthn options of the MFA challenges. The binary values
+// are exchanged with the server as unpadded base64url strings.
+
+export function isWebAuthnSupported() {
+  return !!(window.PublicKeyCredential && navigator.credentials);
+}
+
+function decode(value) {
+  const base64 = value.replace(/-/g, '+').replace(/_/g, '/');
+  const binary = window.atob(base64 + '='.repeat((4 - base64.length % 4) % 4));
+  return Uint8Array.from(binary, c => c.charCodeAt(0));
+}
+
+function encode(buffer) {
+  const binary = String.fromCharCode(...new Uint8Array(buffer));
+  return window.btoa(binary).replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '');
+}
+
+function decodeDescriptors(descriptors) {
+  return (descriptors || []).map(d => Object.assign({}, d, { id: decode(d.id) }));
+}
+
+export function createCredential(options) {
+  const publicKey = Object.assign({}, options, {
+    challenge: decode(options.challenge),
+    user: Object.assign({}, options.user, { id: decode(options.user.id) }),
+    excludeCredentials: decodeDescriptors(options.excludeCredentials)
+  });
+
+  return navigator.credentials.create({ publicKey }).then(credential => ({
+    clientDataJSON: encode(credential.response.clientDataJSON),
+    attestationObject: encode(credential.response.attestationObject)
+  }));
+}
+
+export function getAssertion(options) {
+  const publicKey = Object.assign({}, options, {
+    challenge: decode(options.challenge),
+    allowCredentials: decodeDescriptors(options.allowCredentials)
+  });
+
+  return navigator.credentials.get({ publicKey }).then(credential => ({
+    id: encode(credential.rawId),
+    clientDataJSON: encode(credential.response.clientDataJSON),
+    authenticatorData: encode(credential.response.authenticatorData),
+    signature: encode(credential.response.signature)
+  }));
+}
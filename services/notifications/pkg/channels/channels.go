@@ -17,6 +17,13 @@ import (
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
 )
 
+// Names of the channels users can choose in addition to email, they match the keys of the notification channels setting.
+const (
+	NameMatrix  = "matrix"
+	NameWebhook = "webhook"
+	NamePush    = "push"
+)
+
 // Channel defines the methods of a communication channel.
 type Channel interface {
 	// SendMessage sends a message to users.
@@ -25,6 +32,7 @@ type Channel interface {
 
 // Message represent the already rendered message including the user id opaqueID
 type Message struct {
+	UserID       string
 	Sender       string
 	Recipient    []string
 	Subject      string
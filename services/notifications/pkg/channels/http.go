@@ -0,0 +1,83 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+)
+
+// _httpTimeout is the maximum duration of a request to a chat server, webhook or push service
+const _httpTimeout = 30 * time.Second
+
+// newHTTPClient returns a client for the channels. Clients for addresses provided by users only
+// connect to public addresses, they don't use a proxy as it would hide the dialed address.
+func newHTTPClient(insecure, publicOnly bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+	if publicOnly {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: netx.PublicOnly}
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+	}
+	return &http.Client{
+		Timeout:   _httpTimeout,
+		Transport: transport,
+	}
+}
+
+// doJSON sends the payload as json and decodes the json response into result if it is not nil.
+// Requests without a payload are sent without a body.
+func doJSON(ctx context.Context, client *http.Client, method, url string, header http.Header, payload, result any) error {
+	var body io.Reader = http.NoBody
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", res.Status, strings.TrimSpace(string(body)))
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
+
+// plainText renders the message for channels without support for a subject
+func plainText(message *Message) string {
+	return strings.TrimSpace(message.Subject + "\n\n" + message.TextBody)
+}
@@ -0,0 +1,163 @@
+package channels
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+// ErrMatrixRoomNotAllowed is returned if notifications can't be sent to a room.
+var ErrMatrixRoomNotAllowed = errors.New("matrix room not allowed")
+
+// NewMatrixChannel instantiates a new matrix communication channel.
+func NewMatrixChannel(cfg config.Config, logger log.Logger) (Channel, error) {
+	u, err := url.Parse(cfg.Notifications.Matrix.HomeserverURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid matrix homeserver url '%s'", cfg.Notifications.Matrix.HomeserverURL)
+	}
+
+	return Matrix{
+		homeserverURL: strings.TrimSuffix(u.String(), "/"),
+		accessToken:   cfg.Notifications.Matrix.AccessToken,
+		allowedRooms:  cfg.Notifications.Matrix.AllowedRooms,
+		client:        newHTTPClient(false, false),
+		logger:        logger,
+	}, nil
+}
+
+// Matrix is the communication channel for matrix rooms, it uses the client-server API.
+type Matrix struct {
+	homeserverURL string
+	accessToken   string
+	allowedRooms  []string
+	client        *http.Client
+	logger        log.Logger
+}
+
+type matrixRoomResponse struct {
+	RoomID string `json:"room_id"`
+}
+
+type matrixJoinedRoomsResponse struct {
+	JoinedRooms []string `json:"joined_rooms"`
+}
+
+type matrixSyncResponse struct {
+	Rooms struct {
+		Invite map[string]json.RawMessage `json:"invite"`
+	} `json:"rooms"`
+}
+
+type matrixMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// SendMessage sends the message to all rooms in the recipients.
+func (m Matrix) SendMessage(ctx context.Context, message *Message) error {
+	var errs []error
+	for _, room := range message.Recipient {
+		if err := m.send(ctx, room, plainText(message)); err != nil {
+			errs = append(errs, fmt.Errorf("could not send message to matrix room %s: %w", room, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// send sends a notice to a room. Messages are only sent to rooms the matrix user is a member
+// of or was invited to, it never joins rooms on its own. Invitations are accepted with the
+// first message.
+func (m Matrix) send(ctx context.Context, room, text string) error {
+	header := http.Header{"Authorization": {"Bearer " + m.accessToken}}
+
+	roomID := room
+	if strings.HasPrefix(room, "#") {
+		var alias matrixRoomResponse
+		if err := doJSON(ctx, m.client, http.MethodGet, m.homeserverURL+"/_matrix/client/v3/directory/room/"+url.PathEscape(room), header, nil, &alias); err != nil {
+			return err
+		}
+		roomID = alias.RoomID
+	}
+	if !strings.HasPrefix(roomID, "!") {
+		return fmt.Errorf("invalid room id '%s'", roomID)
+	}
+	if !m.allowed(room) && !m.allowed(roomID) {
+		return ErrMatrixRoomNotAllowed
+	}
+
+	var joined matrixJoinedRoomsResponse
+	if err := doJSON(ctx, m.client, http.MethodGet, m.homeserverURL+"/_matrix/client/v3/joined_rooms", header, nil, &joined); err != nil {
+		return err
+	}
+	if !slices.Contains(joined.JoinedRooms, roomID) {
+		invited, err := m.invited(ctx, header, roomID)
+		if err != nil {
+			return err
+		}
+		if !invited {
+			return fmt.Errorf("%w: the matrix user was not invited to the room", ErrMatrixRoomNotAllowed)
+		}
+		if err := doJSON(ctx, m.client, http.MethodPost, m.homeserverURL+"/_matrix/client/v3/rooms/"+url.PathEscape(roomID)+"/join", header, struct{}{}, nil); err != nil {
+			return err
+		}
+	}
+
+	txnID := make([]byte, 16)
+	if _, err := rand.Read(txnID); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s", m.homeserverURL, url.PathEscape(roomID), hex.EncodeToString(txnID))
+	// notices are meant for automated messages, clients don't reply to them
+	return doJSON(ctx, m.client, http.MethodPut, endpoint, header, matrixMessage{MsgType: "m.notice", Body: text}, nil)
+}
+
+// invited checks if the matrix user has a pending invitation to the room
+func (m Matrix) invited(ctx context.Context, header http.Header, roomID string) (bool, error) {
+	filter, err := json.Marshal(map[string]any{
+		"room": map[string]any{
+			"rooms":        []string{roomID},
+			"timeline":     map[string]any{"limit": 0},
+			"state":        map[string]any{"types": []string{}},
+			"ephemeral":    map[string]any{"types": []string{}},
+			"account_data": map[string]any{"types": []string{}},
+		},
+		"presence":     map[string]any{"types": []string{}},
+		"account_data": map[string]any{"types": []string{}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var sync matrixSyncResponse
+	endpoint := m.homeserverURL + "/_matrix/client/v3/sync?timeout=0&filter=" + url.QueryEscape(string(filter))
+	if err := doJSON(ctx, m.client, http.MethodGet, endpoint, header, nil, &sync); err != nil {
+		return false, err
+	}
+	_, ok := sync.Rooms.Invite[roomID]
+	return ok, nil
+}
+
+// allowed checks the room id or alias against the allowed rooms
+func (m Matrix) allowed(room string) bool {
+	if len(m.allowedRooms) == 0 {
+		return true
+	}
+	_, server, _ := strings.Cut(room, ":")
+	return slices.ContainsFunc(m.allowedRooms, func(allowed string) bool {
+		if strings.HasPrefix(allowed, ":") {
+			return server != "" && strings.EqualFold(allowed[1:], server)
+		}
+		return allowed == room
+	})
+}
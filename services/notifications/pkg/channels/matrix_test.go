@@ -0,0 +1,120 @@
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+// fakeHomeserver is a matrix homeserver with a joined room, a room the user was invited to
+// and a public room the user was not invited to
+type fakeHomeserver struct {
+	sent   map[string][]matrixMessage
+	joined []string
+}
+
+func (h *fakeHomeserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	aliases := map[string]string{
+		"#notifications:example.org": "!joined:example.org",
+		"#public:example.org":        "!public:example.org",
+	}
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/_matrix/client/v3/directory/room/"):
+		id, ok := aliases[strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3/directory/room/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(matrixRoomResponse{RoomID: id})
+	case r.Method == http.MethodGet && r.URL.Path == "/_matrix/client/v3/joined_rooms":
+		_ = json.NewEncoder(w).Encode(matrixJoinedRoomsResponse{JoinedRooms: h.joined})
+	case r.Method == http.MethodGet && r.URL.Path == "/_matrix/client/v3/sync":
+		if strings.Contains(r.URL.Query().Get("filter"), "!invited:example.org") {
+			_, _ = w.Write([]byte(`{"rooms":{"invite":{"!invited:example.org":{}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"rooms":{}}`))
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/_matrix/client/v3/rooms/") && strings.HasSuffix(r.URL.Path, "/join"):
+		// the homeserver lets the user join public rooms without an invitation
+		room := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3/rooms/"), "/join")
+		h.joined = append(h.joined, room)
+		_ = json.NewEncoder(w).Encode(matrixRoomResponse{RoomID: room})
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/_matrix/client/v3/rooms/"):
+		room, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3/rooms/"), "/send/m.room.message/")
+		var m matrixMessage
+		_ = json.NewDecoder(r.Body).Decode(&m)
+		h.sent[room] = append(h.sent[room], m)
+		_, _ = w.Write([]byte(`{"event_id":"$event"}`))
+	default:
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errcode":"M_FORBIDDEN","error":"forbidden"}`))
+	}
+}
+
+func TestMatrix_SendMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowedRooms []string
+		room         string
+		wantRoom     string
+		wantErr      error
+	}{
+		{name: "joined room", room: "!joined:example.org", wantRoom: "!joined:example.org"},
+		{name: "joined room alias", room: "#notifications:example.org", wantRoom: "!joined:example.org"},
+		{name: "invited room", room: "!invited:example.org", wantRoom: "!invited:example.org"},
+		{name: "public room", room: "!public:example.org", wantErr: ErrMatrixRoomNotAllowed},
+		{name: "public room alias", room: "#public:example.org", wantErr: ErrMatrixRoomNotAllowed},
+		{name: "allowed room", allowedRooms: []string{"!joined:example.org"}, room: "#notifications:example.org", wantRoom: "!joined:example.org"},
+		{name: "allowed alias", allowedRooms: []string{"#notifications:example.org"}, room: "#notifications:example.org", wantRoom: "!joined:example.org"},
+		{name: "allowed server", allowedRooms: []string{":example.org"}, room: "!invited:example.org", wantRoom: "!invited:example.org"},
+		{name: "other server", allowedRooms: []string{":example.com"}, room: "!joined:example.org", wantErr: ErrMatrixRoomNotAllowed},
+		{name: "other room", allowedRooms: []string{"!invited:example.org"}, room: "!joined:example.org", wantErr: ErrMatrixRoomNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := &fakeHomeserver{sent: map[string][]matrixMessage{}, joined: []string{"!joined:example.org"}}
+			server := httptest.NewServer(hs)
+			defer server.Close()
+
+			cfg := config.Config{}
+			cfg.Notifications.Matrix = config.Matrix{HomeserverURL: server.URL + "/", AccessToken: "token", AllowedRooms: tt.allowedRooms}
+			m, err := NewMatrixChannel(cfg, log.NopLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = m.SendMessage(context.Background(), &Message{
+				Subject:   "Share received",
+				TextBody:  "Alice shared a file with you",
+				Recipient: []string{tt.room},
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SendMessage() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(hs.sent) != 0 || len(hs.joined) != 1 {
+					t.Errorf("unexpected messages %v or joined rooms %v", hs.sent, hs.joined)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			sent := hs.sent[tt.wantRoom]
+			if len(hs.sent) != 1 || len(sent) != 1 || sent[0].MsgType != "m.notice" || sent[0].Body != "Share received\n\nAlice shared a file with you" {
+				t.Errorf("unexpected messages %v", hs.sent)
+			}
+		})
+	}
+}
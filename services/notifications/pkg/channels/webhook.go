@@ -0,0 +1,84 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+// NewWebhookChannel instantiates a new webhook communication channel.
+func NewWebhookChannel(cfg config.Config, logger log.Logger) (Channel, error) {
+	return Webhook{
+		allowedHosts:         cfg.Notifications.Webhook.AllowedHosts,
+		allowPrivateNetworks: cfg.Notifications.Webhook.AllowPrivateNetworks,
+		client:               newHTTPClient(cfg.Notifications.Webhook.Insecure, !cfg.Notifications.Webhook.AllowPrivateNetworks),
+		logger:               logger,
+	}, nil
+}
+
+// Webhook is the communication channel for webhooks.
+type Webhook struct {
+	allowedHosts         []string
+	allowPrivateNetworks bool
+	client               *http.Client
+	logger               log.Logger
+}
+
+// webhookPayload is understood by the incoming webhooks of Slack, Microsoft Teams and Mattermost,
+// which all show the text field. Subject and body allow other receivers to process the message.
+type webhookPayload struct {
+	Text    string `json:"text"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// SendMessage posts the message to all webhook urls in the recipients.
+func (w Webhook) SendMessage(ctx context.Context, message *Message) error {
+	payload := webhookPayload{
+		Text:    plainText(message),
+		Subject: message.Subject,
+		Body:    message.TextBody,
+	}
+
+	var errs []error
+	for _, recipient := range message.Recipient {
+		if err := w.validate(ctx, recipient); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := doJSON(ctx, w.client, http.MethodPost, recipient, nil, payload, nil); err != nil {
+			errs = append(errs, fmt.Errorf("could not call webhook: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validate checks the url of a webhook. Private addresses are also refused by the client when
+// they are dialed, checking them here gives a better error.
+func (w Webhook) validate(ctx context.Context, recipient string) error {
+	u, err := url.Parse(recipient)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid webhook url, it must be an https url")
+	}
+
+	if len(w.allowedHosts) > 0 && !slices.ContainsFunc(w.allowedHosts, func(h string) bool {
+		return strings.EqualFold(h, u.Hostname())
+	}) {
+		return fmt.Errorf("webhook host %s is not allowed", u.Hostname())
+	}
+
+	if !w.allowPrivateNetworks {
+		if err := netx.CheckPublicHost(ctx, u.Hostname()); err != nil {
+			return fmt.Errorf("webhook host %s is not allowed: %w", u.Hostname(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+func TestWebhook_SendMessage(t *testing.T) {
+	var got []webhookPayload
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		got = append(got, p)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name                 string
+		allowedHosts         []string
+		allowPrivateNetworks bool
+		recipient            string
+		wantErr              bool
+	}{
+		{name: "any host", allowPrivateNetworks: true, recipient: server.URL + "/hook"},
+		{name: "allowed host", allowedHosts: []string{"127.0.0.1"}, allowPrivateNetworks: true, recipient: server.URL + "/hook"},
+		{name: "forbidden host", allowedHosts: []string{"hooks.slack.com"}, allowPrivateNetworks: true, recipient: server.URL + "/hook", wantErr: true},
+		{name: "private address", recipient: server.URL + "/hook", wantErr: true},
+		{name: "private address of an allowed host", allowedHosts: []string{"127.0.0.1"}, recipient: server.URL + "/hook", wantErr: true},
+		{name: "private host name", recipient: strings.Replace(server.URL, "127.0.0.1", "localhost", 1) + "/hook", wantErr: true},
+		{name: "link-local address", recipient: "https://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "plain http", allowPrivateNetworks: true, recipient: strings.Replace(server.URL, "https://", "http://", 1) + "/hook", wantErr: true},
+		{name: "invalid scheme", recipient: "file:///etc/passwd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			cfg := config.Config{}
+			cfg.Notifications.Webhook = config.Webhook{Enabled: true, AllowedHosts: tt.allowedHosts, AllowPrivateNetworks: tt.allowPrivateNetworks, Insecure: true}
+			w, err := NewWebhookChannel(cfg, log.NopLogger())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = w.SendMessage(context.Background(), &Message{
+				Subject:   "Share received",
+				TextBody:  "Alice shared a file with you",
+				Recipient: []string{tt.recipient},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SendMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(got) != 0 {
+					t.Errorf("webhook was called")
+				}
+				return
+			}
+
+			want := webhookPayload{
+				Text:    "Share received\n\nAlice shared a file with you",
+				Subject: "Share received",
+				Body:    "Alice shared a file with you",
+			}
+			if len(got) != 1 || got[0] != want {
+				t.Errorf("unexpected payloads %v", got)
+			}
+		})
+	}
+}
+
+func TestWebhook_RefusesPrivateAddressesWhenDialing(t *testing.T) {
+	called := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	// the host passed the validation but resolves to a private address when it is dialed
+	client := newHTTPClient(true, true)
+	err := doJSON(context.Background(), client, http.MethodPost, server.URL+"/hook", nil, webhookPayload{}, nil)
+	if !errors.Is(err, netx.ErrNotPublic) || called {
+		t.Errorf("doJSON() error = %v, want ErrNotPublic", err)
+	}
+}
@@ -0,0 +1,245 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+const (
+	// _pushRecordSize is the record size of the encrypted push message, the message is sent as a single record
+	_pushRecordSize = 4096
+	// _pushMaxBodyLength limits the message body, push services accept up to 4096 bytes of encrypted payload
+	_pushMaxBodyLength = 3000
+)
+
+// ErrPushSubscriptionExpired is returned if the push service doesn't know the subscription anymore.
+var ErrPushSubscriptionExpired = errors.New("push subscription expired")
+
+// NewWebPushChannel instantiates a new web push communication channel.
+func NewWebPushChannel(cfg config.Config, logger log.Logger) (Channel, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cfg.Notifications.WebPush.VAPIDPrivateKey, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+	key, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+
+	pub := key.PublicKey().Bytes()
+	return WebPush{
+		vapidKey: &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(pub[1:33]),
+				Y:     new(big.Int).SetBytes(pub[33:]),
+			},
+			D: new(big.Int).SetBytes(raw),
+		},
+		vapidPublicKey: base64.RawURLEncoding.EncodeToString(pub),
+		subject:        cfg.Notifications.WebPush.Subject,
+		ttl:            cfg.Notifications.WebPush.TTL,
+		client:         newHTTPClient(false, true),
+		logger:         logger,
+	}, nil
+}
+
+// WebPush is the communication channel for the push api of browsers, the messages are encrypted
+// according to RFC 8291 and the server is identified by VAPID (RFC 8292).
+type WebPush struct {
+	vapidKey       *ecdsa.PrivateKey
+	vapidPublicKey string
+	subject        string
+	ttl            time.Duration
+	client         *http.Client
+	logger         log.Logger
+}
+
+// PushSubscription is the subscription of a browser as returned by PushManager.subscribe().
+type PushSubscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+type pushPayload struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// SendMessage sends the message to all push subscriptions in the recipients, they are json encoded.
+func (p WebPush) SendMessage(ctx context.Context, message *Message) error {
+	payload, err := json.Marshal(pushPayload{
+		Title: message.Subject,
+		Body:  truncate(message.TextBody, _pushMaxBodyLength),
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, recipient := range message.Recipient {
+		var sub PushSubscription
+		if err := json.Unmarshal([]byte(recipient), &sub); err != nil {
+			errs = append(errs, fmt.Errorf("invalid push subscription: %w", err))
+			continue
+		}
+		if err := p.push(ctx, sub, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p WebPush) push(ctx context.Context, sub PushSubscription, payload []byte) error {
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return errors.New("invalid push subscription endpoint")
+	}
+
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+
+	body, err := encryptPushMessage(payload, sub, serverKey, salt)
+	if err != nil {
+		return err
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": time.Now().Add(12 * time.Hour).Unix(),
+		"sub": p.subject,
+	}).SignedString(p.vapidKey)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+p.vapidPublicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(p.ttl.Seconds())))
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound, res.StatusCode == http.StatusGone:
+		return ErrPushSubscriptionExpired
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("push service returned %s: %s", res.Status, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+// encryptPushMessage encrypts the payload for the subscription with the aes128gcm content encoding of RFC 8291
+func encryptPushMessage(payload []byte, sub PushSubscription, serverKey *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	uaPublic, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(sub.Keys.P256dh, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid push subscription key: %w", err)
+	}
+	authSecret, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(sub.Keys.Auth, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid push subscription auth secret: %w", err)
+	}
+
+	uaKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid push subscription key: %w", err)
+	}
+	sharedSecret, err := serverKey.ECDH(uaKey)
+	if err != nil {
+		return nil, err
+	}
+
+	asPublic := serverKey.PublicKey().Bytes()
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, string(keyInfo), 32)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the padding delimiter 0x02 marks the last record
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	if len(plaintext)+gcm.Overhead() > _pushRecordSize {
+		return nil, errors.New("push message too large")
+	}
+
+	header := make([]byte, 0, 21+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, _pushRecordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// truncate shortens s to at most n bytes without splitting runes
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	s = s[:n]
+	for len(s) > 0 && !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s + "…"
+}
@@ -0,0 +1,126 @@
+package channels
+
+import (
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+func decode(t *testing.T, s string) []byte {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// Test_encryptPushMessage uses the example of RFC 8291 Appendix A
+func Test_encryptPushMessage(t *testing.T) {
+	serverKey, err := ecdh.P256().NewPrivateKey(decode(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sub PushSubscription
+	sub.Keys.P256dh = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	sub.Keys.Auth = "BTBZMqHH6r4Tts7J_aSIgg"
+
+	got, err := encryptPushMessage([]byte("When I grow up, I want to be a watermelon"), sub, serverKey, decode(t, "DGv6ra1nlYgDCS1FRnbzlw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+	if base64.RawURLEncoding.EncodeToString(got) != want {
+		t.Errorf("encryptPushMessage() = %s, want %s", base64.RawURLEncoding.EncodeToString(got), want)
+	}
+}
+
+func TestWebPush_SendMessage(t *testing.T) {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		if r.URL.Path == "/expired" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	cfg := config.Config{}
+	cfg.Notifications.WebPush = config.WebPush{
+		VAPIDPrivateKey: "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw",
+		Subject:         "mailto:admin@example.org",
+		TTL:             time.Hour,
+	}
+	ch, err := NewWebPushChannel(cfg, log.NopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := ch.(WebPush)
+	p.client = server.Client()
+
+	uaKey, err := ecdh.P256().NewPrivateKey(decode(t, "q1dXpw3UpT5VOmu_cf_v6ih07Aems3njxI-JWgLcM94"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := PushSubscription{Endpoint: server.URL + "/push"}
+	sub.Keys.P256dh = base64.RawURLEncoding.EncodeToString(uaKey.PublicKey().Bytes())
+	sub.Keys.Auth = "BTBZMqHH6r4Tts7J_aSIgg"
+	recipient, _ := json.Marshal(sub)
+
+	err = p.SendMessage(context.Background(), &Message{Subject: "Share received", TextBody: "Alice shared a file with you", Recipient: []string{string(recipient)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gotHeader.Get("Content-Encoding") != "aes128gcm" || gotHeader.Get("TTL") != "3600" {
+		t.Errorf("unexpected headers %v", gotHeader)
+	}
+	if len(gotBody) <= 86 {
+		t.Fatalf("unexpected body length %d", len(gotBody))
+	}
+
+	auth, ok := strings.CutPrefix(gotHeader.Get("Authorization"), "vapid t=")
+	if !ok {
+		t.Fatalf("unexpected authorization header %s", gotHeader.Get("Authorization"))
+	}
+	token, key, _ := strings.Cut(auth, ", k=")
+	if key != "BP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A8" {
+		t.Errorf("unexpected vapid public key %s", key)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return &p.vapidKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"})); err != nil {
+		t.Fatal(err)
+	}
+	if claims["aud"] != server.URL || claims["sub"] != "mailto:admin@example.org" {
+		t.Errorf("unexpected claims %v", claims)
+	}
+
+	sub.Endpoint = server.URL + "/expired"
+	recipient, _ = json.Marshal(sub)
+	err = p.SendMessage(context.Background(), &Message{Subject: "Share received", Recipient: []string{string(recipient)}})
+	if !errors.Is(err, ErrPushSubscriptionExpired) {
+		t.Errorf("SendMessage() error = %v, want %v", err, ErrPushSubscriptionExpired)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
+	"github.com/opencloud-eu/opencloud/pkg/savedsearch"
 	"github.com/opencloud-eu/opencloud/pkg/service/grpc"
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
@@ -86,6 +87,7 @@ func Server(cfg *config.Config) *cobra.Command {
 				events.SpaceMembershipExpired{},
 				events.ScienceMeshInviteTokenGenerated{},
 				events.SendEmailsEvent{},
+				savedsearch.Matched{},
 			}
 			registeredEvents := make(map[string]events.Unmarshaller)
 			for _, e := range evs {
@@ -106,6 +108,10 @@ func Server(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			userChannels, err := newUserChannels(cfg, logger)
+			if err != nil {
+				return err
+			}
 			tm, err := pool.StringToTLSMode(cfg.Notifications.GRPCClientTLS.Mode)
 			if err != nil {
 				return err
@@ -135,7 +141,7 @@ func Server(cfg *config.Config) *cobra.Command {
 			svc := service.NewEventsNotifier(evts, channel, logger, gatewaySelector, valueService,
 				cfg.ServiceAccount.ServiceAccountID, cfg.ServiceAccount.ServiceAccountSecret,
 				cfg.Notifications.EmailTemplatePath, cfg.Notifications.DefaultLanguage, cfg.WebUIURL,
-				cfg.Notifications.TranslationPath, cfg.Notifications.SMTP.Sender, notificationStore, historyClient, registeredEvents)
+				cfg.Notifications.TranslationPath, cfg.Notifications.SMTP.Sender, notificationStore, historyClient, registeredEvents, userChannels)
 
 			gr.Add(runner.New(cfg.Service.Name+".svc", func() error {
 				return svc.Run()
@@ -155,3 +161,30 @@ func Server(cfg *config.Config) *cobra.Command {
 		},
 	}
 }
+
+// newUserChannels creates the configured channels users can choose in addition to email
+func newUserChannels(cfg *config.Config, logger log.Logger) (map[string]channels.Channel, error) {
+	userChannels := make(map[string]channels.Channel)
+	if cfg.Notifications.Matrix.HomeserverURL != "" {
+		ch, err := channels.NewMatrixChannel(*cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		userChannels[channels.NameMatrix] = ch
+	}
+	if cfg.Notifications.Webhook.Enabled {
+		ch, err := channels.NewWebhookChannel(*cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		userChannels[channels.NameWebhook] = ch
+	}
+	if cfg.Notifications.WebPush.VAPIDPrivateKey != "" {
+		ch, err := channels.NewWebPushChannel(*cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		userChannels[channels.NamePush] = ch
+	}
+	return userChannels, nil
+}
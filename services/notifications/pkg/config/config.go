@@ -31,6 +31,9 @@ type Config struct {
 // Notifications defines the config options for the notifications service.
 type Notifications struct {
 	SMTP              SMTP                  `yaml:"SMTP"`
+	Matrix            Matrix                `yaml:"matrix"`
+	Webhook           Webhook               `yaml:"webhook"`
+	WebPush           WebPush               `yaml:"web_push"`
 	Events            Events                `yaml:"events"`
 	EmailTemplatePath string                `yaml:"email_template_path" env:"OC_EMAIL_TEMPLATE_PATH;NOTIFICATIONS_EMAIL_TEMPLATE_PATH" desc:"Path to Email notification templates overriding embedded ones." introductionVersion:"1.0.0"`
 	TranslationPath   string                `yaml:"translation_path" env:"OC_TRANSLATION_PATH;NOTIFICATIONS_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
@@ -51,6 +54,28 @@ type SMTP struct {
 	Encryption     string `yaml:"smtp_encryption" env:"NOTIFICATIONS_SMTP_ENCRYPTION" desc:"Encryption method for the SMTP communication. Possible values are 'starttls', 'ssltls' and 'none'." introductionVersion:"1.0.0"`
 }
 
+// Matrix combines the configuration options of the matrix channel.
+type Matrix struct {
+	HomeserverURL string   `yaml:"homeserver_url" env:"NOTIFICATIONS_MATRIX_HOMESERVER_URL" desc:"The URL of the Matrix homeserver of the user sending the notifications, e.g. 'https://matrix.example.org'. Users can only choose the Matrix channel if it is set." introductionVersion:"%%NEXT%%"`
+	AccessToken   string   `yaml:"access_token" env:"NOTIFICATIONS_MATRIX_ACCESS_TOKEN" desc:"The access token of the Matrix user sending the notifications." introductionVersion:"%%NEXT%%"`
+	AllowedRooms  []string `yaml:"allowed_rooms" env:"NOTIFICATIONS_MATRIX_ALLOWED_ROOMS" desc:"A list of Matrix rooms notifications may be sent to. Entries are room ids like '!abc:example.org', aliases like '#alerts:example.org' or server names like ':example.org', which allow all rooms of the server. If empty, all rooms the Matrix user was invited to are allowed. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// Webhook combines the configuration options of the webhook channel.
+type Webhook struct {
+	Enabled              bool     `yaml:"enabled" env:"NOTIFICATIONS_WEBHOOK_ENABLED" desc:"Allow users to send their notifications to a webhook." introductionVersion:"%%NEXT%%"`
+	AllowedHosts         []string `yaml:"allowed_hosts" env:"NOTIFICATIONS_WEBHOOK_ALLOWED_HOSTS" desc:"A list of hosts webhooks may point to, e.g. 'hooks.slack.com'. If empty, all hosts are allowed. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Insecure             bool     `yaml:"insecure" env:"OC_INSECURE;NOTIFICATIONS_WEBHOOK_INSECURE" desc:"Ignore untrusted SSL certificates when calling webhooks." introductionVersion:"%%NEXT%%"`
+	AllowPrivateNetworks bool     `yaml:"allow_private_networks" env:"NOTIFICATIONS_WEBHOOK_ALLOW_PRIVATE_NETWORKS" desc:"Allow webhooks with private, loopback or link-local addresses. By default webhooks can only point to public addresses and are not sent via the HTTP proxy configured in the environment, so users can't reach internal services." introductionVersion:"%%NEXT%%"`
+}
+
+// WebPush combines the configuration options of the web push channel.
+type WebPush struct {
+	VAPIDPrivateKey string        `yaml:"vapid_private_key" env:"NOTIFICATIONS_WEB_PUSH_VAPID_PRIVATE_KEY" desc:"The base64url encoded P-256 private key used to identify the server to push services (VAPID). Users can only choose the push channel if it is set." introductionVersion:"%%NEXT%%"`
+	Subject         string        `yaml:"subject" env:"NOTIFICATIONS_WEB_PUSH_SUBJECT" desc:"A 'mailto:' or 'https:' URL push services can use to contact the operator, e.g. 'mailto:admin@example.org'." introductionVersion:"%%NEXT%%"`
+	TTL             time.Duration `yaml:"ttl" env:"NOTIFICATIONS_WEB_PUSH_TTL" desc:"How long push services keep notifications for offline devices. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
 // Events combines the configuration options for the event bus.
 type Events struct {
 	Endpoint             string `yaml:"endpoint" env:"OC_EVENTS_ENDPOINT;NOTIFICATIONS_EVENTS_ENDPOINT" desc:"The address of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture." introductionVersion:"1.0.0"`
@@ -40,6 +40,9 @@ func DefaultConfig() *config.Config {
 				Cluster:   "opencloud-cluster",
 				EnableTLS: false,
 			},
+			WebPush: config.WebPush{
+				TTL: 24 * time.Hour,
+			},
 			RevaGateway: shared.DefaultRevaConfig().Address,
 		},
 		Store: config.Store{
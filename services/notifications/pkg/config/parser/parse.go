@@ -66,6 +66,16 @@ func Validate(cfg *config.Config) error {
 		}
 	}
 
+	if cfg.Notifications.Matrix.HomeserverURL != "" && cfg.Notifications.Matrix.AccessToken == "" {
+		return fmt.Errorf("the Matrix access token must be set in service %s if a Matrix homeserver is configured", cfg.Service.Name)
+	}
+
+	if cfg.Notifications.WebPush.VAPIDPrivateKey != "" {
+		if !strings.HasPrefix(cfg.Notifications.WebPush.Subject, "mailto:") && !strings.HasPrefix(cfg.Notifications.WebPush.Subject, "https:") {
+			return fmt.Errorf("the web push subject in service %s must be a 'mailto:' or 'https:' URL", cfg.Service.Name)
+		}
+	}
+
 	if cfg.ServiceAccount.ServiceAccountID == "" {
 		return shared.MissingServiceAccountID(cfg.Service.Name)
 	}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	micrometadata "go-micro.dev/v4/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/middleware"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/channels"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/store/defaults"
+)
+
+// channelAddressSettings maps the channels users can choose to the settings holding their address for the channel
+var channelAddressSettings = map[string]string{
+	channels.NameMatrix:  defaults.SettingUUIDProfileMatrixRoom,
+	channels.NameWebhook: defaults.SettingUUIDProfileWebhookURL,
+	channels.NamePush:    defaults.SettingUUIDProfileWebPushSubscription,
+}
+
+// channelAddresses returns the addresses of the channels the user enabled in addition to email by channel name.
+// Channels which are not configured or without address are skipped.
+func (s eventsNotifier) channelAddresses(ctx context.Context, u *user.UserId) map[string]string {
+	if len(s.userChannels) == 0 {
+		return nil
+	}
+
+	ctx = micrometadata.Set(ctx, middleware.AccountID, u.GetOpaqueId())
+	resp, err := s.valueService.GetValueByUniqueIdentifiers(ctx, &settingssvc.GetValueByUniqueIdentifiersRequest{
+		AccountUuid: u.GetOpaqueId(),
+		SettingId:   defaults.SettingUUIDProfileNotificationChannels,
+	})
+	if err != nil {
+		// the user didn't choose any channel yet
+		return nil
+	}
+
+	addresses := make(map[string]string)
+	for _, option := range resp.GetValue().GetValue().GetCollectionValue().GetValues() {
+		if _, ok := s.userChannels[option.GetKey()]; !ok || !option.GetBoolValue() {
+			continue
+		}
+
+		res, err := s.valueService.GetValueByUniqueIdentifiers(ctx, &settingssvc.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: u.GetOpaqueId(),
+			SettingId:   channelAddressSettings[option.GetKey()],
+		})
+		if err != nil {
+			s.logger.Debug().Err(err).Str("userid", u.GetOpaqueId()).Str("channel", option.GetKey()).Msg("no address for notification channel")
+			continue
+		}
+
+		if address := strings.TrimSpace(res.GetValue().GetValue().GetStringValue()); address != "" {
+			addresses[option.GetKey()] = address
+		}
+	}
+
+	return addresses
+}
+
+// removeChannelAddress clears the address of a channel the messages can't be delivered to anymore. The address
+// is only cleared if the user didn't change it in the meantime.
+func (s eventsNotifier) removeChannelAddress(ctx context.Context, u *user.UserId, channel, address string) {
+	ctx = micrometadata.Set(ctx, middleware.AccountID, u.GetOpaqueId())
+	res, err := s.valueService.GetValueByUniqueIdentifiers(ctx, &settingssvc.GetValueByUniqueIdentifiersRequest{
+		AccountUuid: u.GetOpaqueId(),
+		SettingId:   channelAddressSettings[channel],
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("userid", u.GetOpaqueId()).Str("channel", channel).Msg("could not get the address of the notification channel")
+		return
+	}
+
+	value := res.GetValue().GetValue()
+	if value == nil || strings.TrimSpace(value.GetStringValue()) != address {
+		return
+	}
+	value.Value = &settingsmsg.Value_StringValue{StringValue: ""}
+	if _, err := s.valueService.SaveValue(ctx, &settingssvc.SaveValueRequest{Value: value}); err != nil {
+		s.logger.Error().Err(err).Str("userid", u.GetOpaqueId()).Str("channel", channel).Msg("could not remove the address of the notification channel")
+	}
+}
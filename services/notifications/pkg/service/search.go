@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/opencloud-eu/opencloud/pkg/savedsearch"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/email"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+)
+
+// handleSavedSearchMatched sends the email of saved search alerts right away, they are opted in per saved search
+func (s eventsNotifier) handleSavedSearchMatched(e savedsearch.Matched) {
+	if !e.Email {
+		return
+	}
+
+	logger := s.logger.With().
+		Str("event", "SavedSearchMatched").
+		Str("itemid", e.ResourceID.GetOpaqueId()).
+		Logger()
+
+	gatewayClient, err := s.gatewaySelector.Next()
+	if err != nil {
+		logger.Error().Err(err).Msg("could not select next gateway client")
+		return
+	}
+
+	ctx, err := utils.GetServiceUserContextWithContext(context.Background(), gatewayClient, s.serviceAccountID, s.serviceAccountSecret)
+	if err != nil {
+		logger.Error().Err(err).Msg("could not get service user context")
+		return
+	}
+
+	recipients := s.ensureGranteeList(ctx, nil, e.UserID, nil)
+	if recipients == nil {
+		return
+	}
+
+	resourceLink, err := urlJoinPath(s.openCloudURL, "f", storagespace.FormatResourceID(e.ResourceID))
+	if err != nil {
+		logger.Error().Err(err).Msg("could not create link to the resource")
+		return
+	}
+
+	emails, err := s.render(ctx, email.SavedSearchMatched,
+		"SearchOwner",
+		map[string]string{
+			"SearchName":   e.SavedSearchName,
+			"ResourceName": e.ResourceName,
+			"ResourceLink": resourceLink,
+		}, recipients, "")
+	if err != nil {
+		logger.Error().Err(err).Msg("could not get render the email")
+		return
+	}
+	s.send(ctx, emails)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/l10n"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/middleware"
+	"github.com/opencloud-eu/opencloud/pkg/savedsearch"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/channels"
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/email"
@@ -56,11 +57,13 @@ func NewEventsNotifier(
 	serviceAccountID, serviceAccountSecret, emailTemplatePath, defaultLanguage, openCloudURL, translationPath, emailSender string,
 	store store.Store,
 	historyClient ehsvc.EventHistoryService,
-	registeredEvents map[string]events.Unmarshaller) Service {
+	registeredEvents map[string]events.Unmarshaller,
+	userChannels map[string]channels.Channel) Service {
 
 	return eventsNotifier{
 		logger:               logger,
 		channel:              channel,
+		userChannels:         userChannels,
 		events:               events,
 		gatewaySelector:      gatewaySelector,
 		valueService:         valueService,
@@ -83,6 +86,7 @@ func NewEventsNotifier(
 type eventsNotifier struct {
 	logger               log.Logger
 	channel              channels.Channel
+	userChannels         map[string]channels.Channel
 	events               <-chan events.Event
 	gatewaySelector      pool.Selectable[gateway.GatewayAPIClient]
 	valueService         settingssvc.ValueService
@@ -133,6 +137,8 @@ EventLoop:
 					s.handleScienceMeshInviteTokenGenerated(e)
 				case events.SendEmailsEvent:
 					s.sendGroupedEmailsJob(e, evt.ID)
+				case savedsearch.Matched:
+					s.handleSavedSearchMatched(e)
 				}
 			}()
 
@@ -168,6 +174,7 @@ func (s eventsNotifier) render(ctx context.Context, template email.MessageTempla
 		if err != nil {
 			return nil, err
 		}
+		rendered.UserID = usr.GetId().GetOpaqueId()
 		rendered.Sender = sender
 		rendered.Recipient = []string{usr.GetMail()}
 		messageList[i] = rendered
@@ -177,13 +184,41 @@ func (s eventsNotifier) render(ctx context.Context, template email.MessageTempla
 
 func (s eventsNotifier) send(ctx context.Context, emails []*channels.Message) {
 	for _, r := range emails {
-		err := s.channel.SendMessage(ctx, r)
-		if err != nil {
-			s.logger.Error().Err(err).Str("event", "SendEmail").Msg("failed to send a message")
+		if r.UserID == "" {
+			// the recipient is not a user, e.g. a sciencemesh invitation
+			s.sendMail(ctx, r)
+			continue
+		}
+
+		u := &user.UserId{OpaqueId: r.UserID}
+		// users can disable emails or miss an email address if they chose other channels
+		if !s.disableEmails(ctx, u) && strings.TrimSpace(strings.Join(r.Recipient, "")) != "" {
+			s.sendMail(ctx, r)
+		}
+
+		for name, address := range s.channelAddresses(ctx, u) {
+			m := *r
+			m.Recipient = []string{address}
+			err := s.userChannels[name].SendMessage(ctx, &m)
+			switch {
+			case errors.Is(err, channels.ErrPushSubscriptionExpired):
+				// the browser unsubscribed, don't push to the subscription again
+				s.logger.Info().Str("channel", name).Str("userid", r.UserID).Msg("removing expired push subscription")
+				s.removeChannelAddress(ctx, u, name, address)
+			case err != nil:
+				s.logger.Error().Err(err).Str("event", "SendMessage").Str("channel", name).Str("userid", r.UserID).Msg("failed to send a message")
+			}
 		}
 	}
 }
 
+func (s eventsNotifier) sendMail(ctx context.Context, r *channels.Message) {
+	err := s.channel.SendMessage(ctx, r)
+	if err != nil {
+		s.logger.Error().Err(err).Str("event", "SendEmail").Msg("failed to send a message")
+	}
+}
+
 func (s eventsNotifier) ensureGranteeList(ctx context.Context, executant, u *user.UserId, g *group.GroupId) []*user.User {
 	granteeList, err := s.getGranteeList(ctx, executant, u, g)
 	if err != nil {
@@ -198,14 +233,15 @@ func (s eventsNotifier) ensureGranteeList(ctx context.Context, executant, u *use
 func (s eventsNotifier) getGranteeList(ctx context.Context, executant, u *user.UserId, g *group.GroupId) ([]*user.User, error) {
 	switch {
 	case u != nil:
-		if s.disableEmails(ctx, u) {
+		hasChannels := len(s.channelAddresses(ctx, u)) > 0
+		if s.disableEmails(ctx, u) && !hasChannels {
 			return nil, nil
 		}
 		usr, err := s.getUser(ctx, u)
 		if err != nil {
 			return nil, err
 		}
-		if strings.TrimSpace(usr.GetMail()) == "" {
+		if strings.TrimSpace(usr.GetMail()) == "" && !hasChannels {
 			s.logger.Debug().Str("event", "getGranteeList").Msgf("User %s has no email, skipped", usr.GetUsername())
 			return nil, nil
 		}
@@ -231,14 +267,15 @@ func (s eventsNotifier) getGranteeList(ctx context.Context, executant, u *user.U
 				continue
 			}
 			// don't add users who opted out
-			if s.disableEmails(ctx, userID) {
+			hasChannels := len(s.channelAddresses(ctx, userID)) > 0
+			if s.disableEmails(ctx, userID) && !hasChannels {
 				continue
 			}
 			usr, err := s.getUser(ctx, userID)
 			if err != nil {
 				return nil, err
 			}
-			if strings.TrimSpace(usr.GetMail()) == "" {
+			if strings.TrimSpace(usr.GetMail()) == "" && !hasChannels {
 				s.logger.Debug().Str("event", "getGranteeList").Msgf("User %s has no email, skipped", usr.GetUsername())
 				continue
 			}
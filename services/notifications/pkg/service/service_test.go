@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
@@ -20,6 +21,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/channels"
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/service"
+	settingsdefaults "github.com/opencloud-eu/opencloud/services/settings/pkg/store/defaults"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
@@ -95,7 +97,7 @@ var _ = Describe("Notifications", func() {
 			ch := make(chan events.Event)
 			evts := service.NewEventsNotifier(ch, tc, log.NewLogger(), gatewaySelector, vs, "",
 				"", "", "", "", "", "",
-				store.Create(), nil, nil)
+				store.Create(), nil, nil, nil)
 			go evts.Run()
 
 			ch <- ev
@@ -308,7 +310,7 @@ var _ = Describe("Notifications X-Site Scripting", func() {
 			ch := make(chan events.Event)
 			evts := service.NewEventsNotifier(ch, tc, log.NewLogger(), gatewaySelector, vs, "",
 				"", "", "", "", "", "",
-				store.Create(), nil, nil)
+				store.Create(), nil, nil, nil)
 			go evts.Run()
 
 			ch <- ev
@@ -457,6 +459,154 @@ https://opencloud.eu
 	)
 })
 
+var _ = Describe("Notification channels", func() {
+	var (
+		gatewayClient   *cs3mocks.GatewayAPIClient
+		gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+		vs              *settingsmocks.ValueService
+		sharer          = &user.User{
+			Id:          &user.UserId{OpaqueId: "sharer"},
+			Mail:        "sharer@opencloud.eu",
+			DisplayName: "Dr. S. Harer",
+		}
+		sharee = &user.User{
+			Id:          &user.UserId{OpaqueId: "sharee"},
+			DisplayName: "Eric Expireling",
+		}
+	)
+
+	setting := func(id string) interface{} {
+		return mock.MatchedBy(func(req *settingssvc.GetValueByUniqueIdentifiersRequest) bool {
+			return req.GetSettingId() == id
+		})
+	}
+	value := func(v *settingsmsg.Value) *settingssvc.GetValueResponse {
+		return &settingssvc.GetValueResponse{Value: &settingsmsg.ValueWithIdentifier{Value: v}}
+	}
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector = pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		gatewayClient.On("GetUser", mock.Anything, mock.Anything).Return(&user.GetUserResponse{Status: &rpc.Status{Code: rpc.Code_CODE_OK}, User: sharer}, nil).Once()
+		gatewayClient.On("GetUser", mock.Anything, mock.Anything).Return(&user.GetUserResponse{Status: &rpc.Status{Code: rpc.Code_CODE_OK}, User: sharee}, nil).Once()
+		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{Status: &rpc.Status{Code: rpc.Code_CODE_OK}, User: sharer}, nil)
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{Status: &rpc.Status{Code: rpc.Code_CODE_OK}, Info: &provider.ResourceInfo{Name: "secrets of the board"}}, nil)
+
+		// the sharee disabled emails, has no email address and chose matrix, a webhook without url and web push
+		vs = &settingsmocks.ValueService{}
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, setting(settingsdefaults.SettingUUIDProfileDisableNotifications)).Return(value(&settingsmsg.Value{
+			Value: &settingsmsg.Value_BoolValue{BoolValue: true},
+		}), nil)
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, setting(settingsdefaults.SettingUUIDProfileNotificationChannels)).Return(value(&settingsmsg.Value{
+			Value: &settingsmsg.Value_CollectionValue{CollectionValue: &settingsmsg.CollectionValue{Values: []*settingsmsg.CollectionOption{
+				{Key: channels.NameMatrix, Option: &settingsmsg.CollectionOption_BoolValue{BoolValue: true}},
+				{Key: channels.NameWebhook, Option: &settingsmsg.CollectionOption_BoolValue{BoolValue: true}},
+				{Key: channels.NamePush, Option: &settingsmsg.CollectionOption_BoolValue{BoolValue: true}},
+			}}},
+		}), nil)
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, setting(settingsdefaults.SettingUUIDProfileMatrixRoom)).Return(value(&settingsmsg.Value{
+			Value: &settingsmsg.Value_StringValue{StringValue: "!room:example.org"},
+		}), nil)
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, setting(settingsdefaults.SettingUUIDProfileWebhookURL)).Return(nil, errors.New("not found"))
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, setting(settingsdefaults.SettingUUIDProfileWebPushSubscription)).Return(value(&settingsmsg.Value{
+			Id:        "push-value-id",
+			SettingId: settingsdefaults.SettingUUIDProfileWebPushSubscription,
+			Value:     &settingsmsg.Value_StringValue{StringValue: `{"endpoint":"https://push.example.org/sub"}`},
+		}), nil)
+		vs.On("GetValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(value(&settingsmsg.Value{
+			Value: &settingsmsg.Value_CollectionValue{CollectionValue: &settingsmsg.CollectionValue{Values: []*settingsmsg.CollectionOption{
+				{Key: "mail", Option: &settingsmsg.CollectionOption_BoolValue{BoolValue: true}},
+			}}},
+		}), nil)
+	})
+
+	It("sends the notifications to the channels the user chose", func() {
+		mail := recordingChannel{messages: make(chan *channels.Message, 1)}
+		matrix := recordingChannel{messages: make(chan *channels.Message, 1)}
+		webhook := recordingChannel{messages: make(chan *channels.Message, 1)}
+
+		ch := make(chan events.Event)
+		evts := service.NewEventsNotifier(ch, mail, log.NewLogger(), gatewaySelector, vs, "",
+			"", "", "", "", "", "",
+			store.Create(), nil, nil, map[string]channels.Channel{
+				channels.NameMatrix:  matrix,
+				channels.NameWebhook: webhook,
+			})
+		go evts.Run()
+		defer evts.Close()
+
+		ch <- events.Event{Event: events.ShareCreated{
+			Sharer:        sharer.GetId(),
+			GranteeUserID: sharee.GetId(),
+			CTime:         utils.TimeToTS(time.Date(2023, 4, 17, 16, 42, 0, 0, time.UTC)),
+			ItemID:        &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "itemid"},
+		}}
+
+		var m *channels.Message
+		Eventually(matrix.messages).Should(Receive(&m))
+		Expect(m.UserID).To(Equal("sharee"))
+		Expect(m.Recipient).To(Equal([]string{"!room:example.org"}))
+		Expect(m.Subject).To(Equal("Dr. S. Harer shared 'secrets of the board' with you"))
+
+		Consistently(mail.messages).ShouldNot(Receive())
+		Consistently(webhook.messages).ShouldNot(Receive())
+	})
+
+	It("removes expired push subscriptions", func() {
+		saved := make(chan *settingsmsg.Value, 1)
+		vs.On("SaveValue", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved <- args.Get(1).(*settingssvc.SaveValueRequest).GetValue()
+		}).Return(&settingssvc.SaveValueResponse{}, nil)
+
+		ch := make(chan events.Event)
+		evts := service.NewEventsNotifier(ch, recordingChannel{messages: make(chan *channels.Message, 1)}, log.NewLogger(), gatewaySelector, vs, "",
+			"", "", "", "", "", "",
+			store.Create(), nil, nil, map[string]channels.Channel{
+				channels.NamePush: expiredChannel{},
+			})
+		go evts.Run()
+		defer evts.Close()
+
+		ch <- events.Event{Event: events.ShareCreated{
+			Sharer:        sharer.GetId(),
+			GranteeUserID: sharee.GetId(),
+			CTime:         utils.TimeToTS(time.Date(2023, 4, 17, 16, 42, 0, 0, time.UTC)),
+			ItemID:        &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "itemid"},
+		}}
+
+		var v *settingsmsg.Value
+		Eventually(saved).Should(Receive(&v))
+		Expect(v.GetId()).To(Equal("push-value-id"))
+		Expect(v.GetSettingId()).To(Equal(settingsdefaults.SettingUUIDProfileWebPushSubscription))
+		Expect(v.GetStringValue()).To(BeEmpty())
+	})
+})
+
+// recordingChannel passes the sent messages to a go channel
+type recordingChannel struct {
+	messages chan *channels.Message
+}
+
+func (rc recordingChannel) SendMessage(_ context.Context, m *channels.Message) error {
+	rc.messages <- m
+	return nil
+}
+
+// expiredChannel fails like a push service that doesn't know the subscription anymore
+type expiredChannel struct{}
+
+func (expiredChannel) SendMessage(_ context.Context, _ *channels.Message) error {
+	return channels.ErrPushSubscriptionExpired
+}
+
 // NOTE: This is explictitly not testing the message itself. Should we?
 type testChannel struct {
 	expectedReceipients []string
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine/opa"
+	"github.com/spf13/cobra"
+)
+
+// Evaluate is the entrypoint for the evaluate command.
+func Evaluate(cfg *config.Config) *cobra.Command {
+	evaluateCmd := &cobra.Command{
+		Use:   "evaluate",
+		Short: "evaluate policies against an environment",
+		Long:  "evaluate rego policies against an environment read from a JSON file to test policies offline. The keys of the environment are the ones the policies get as input.",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnError(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policies, _ := cmd.Flags().GetStringSlice("policy")
+			query, _ := cmd.Flags().GetString("query")
+			input, _ := cmd.Flags().GetString("input")
+
+			b, err := os.ReadFile(input)
+			if err != nil {
+				return fmt.Errorf("could not read the environment: %w", err)
+			}
+
+			env := &engine.Environment{}
+			if err := json.Unmarshal(b, env); err != nil {
+				return fmt.Errorf("could not decode the environment: %w", err)
+			}
+
+			e, err := opa.NewOPA(cfg.Engine.Timeout, log.NopLogger(), config.Engine{
+				Policies: policies,
+				Mimes:    cfg.Engine.Mimes,
+			})
+			if err != nil {
+				return err
+			}
+
+			allowed, err := e.Evaluate(cmd.Context(), query, env)
+			if err != nil {
+				return fmt.Errorf("could not evaluate the policies: %w", err)
+			}
+
+			if !allowed {
+				fmt.Println("denied")
+				return errors.New("the policies deny the operation")
+			}
+			fmt.Println("allowed")
+			return nil
+		},
+	}
+
+	evaluateCmd.Flags().StringSlice(
+		"policy",
+		nil,
+		"Rego file or directory to load the policies from, can be given multiple times.",
+	)
+	evaluateCmd.Flags().String(
+		"query",
+		"",
+		"Complete rule to evaluate, e.g. 'data.proxy.granted'.",
+	)
+	evaluateCmd.Flags().String(
+		"input",
+		"",
+		"JSON file containing the environment to evaluate the policies against.",
+	)
+	_ = evaluateCmd.MarkFlagRequired("policy")
+	_ = evaluateCmd.MarkFlagRequired("query")
+	_ = evaluateCmd.MarkFlagRequired("input")
+
+	return evaluateCmd
+}
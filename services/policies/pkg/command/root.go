@@ -13,6 +13,7 @@ import (
 func GetCommands(cfg *config.Config) []*cobra.Command {
 	return []*cobra.Command{
 		Server(cfg),
+		Evaluate(cfg),
 		Health(cfg),
 		Version(cfg),
 	}
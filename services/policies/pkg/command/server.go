@@ -8,6 +8,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
 	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
 	"github.com/opencloud-eu/opencloud/pkg/service/grpc"
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
@@ -20,6 +21,7 @@ import (
 	svcEvent "github.com/opencloud-eu/opencloud/services/policies/pkg/service/event"
 	svcGRPC "github.com/opencloud-eu/opencloud/services/policies/pkg/service/grpc"
 	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 
 	"github.com/spf13/cobra"
 )
@@ -106,7 +108,23 @@ func Server(cfg *config.Config) *cobra.Command {
 					return err
 				}
 
-				eventSvc, err := svcEvent.New(ctx, bus, logger, traceProvider, e, cfg.Postprocessing.Query)
+				tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to parse tls mode")
+					return err
+				}
+				gatewaySelector, err := pool.GatewaySelector(
+					cfg.RevaGateway,
+					pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+					pool.WithTLSMode(tm),
+					pool.WithRegistry(registry.GetRegistry()),
+					pool.WithTracerProvider(traceProvider),
+				)
+				if err != nil {
+					return err
+				}
+
+				eventSvc, err := svcEvent.New(ctx, bus, logger, traceProvider, gatewaySelector, e, cfg.Postprocessing.Query)
 				if err != nil {
 					return err
 				}
@@ -19,6 +19,7 @@ type Config struct {
 	LogLevel       string                `yaml:"loglevel" env:"OC_LOG_LEVEL;POLICIES_LOG_LEVEL" desc:"The log level. Valid values are: 'panic', 'fatal', 'error', 'warn', 'info', 'debug', 'trace'." introductionVersion:"1.0.0"`
 	Engine         Engine                `yaml:"engine"`
 	Postprocessing Postprocessing        `yaml:"postprocessing"`
+	RevaGateway    string                `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to look up the spaces of the resources in the postprocessing stage." introductionVersion:"%%NEXT%%"`
 }
 
 // Service defines the available service configuration.
@@ -39,6 +40,8 @@ type Engine struct {
 	Policies []string      `yaml:"policies"`
 	// Mimes file path, RFC 4288
 	Mimes string `yaml:"mimes" env:"POLICIES_ENGINE_MIMES" desc:"Sets the mimes file path which maps mimetypes to associated file extensions. See the text description for details." introductionVersion:"1.0.0"`
+	// DryRun only logs the denials
+	DryRun bool `yaml:"dry_run" env:"POLICIES_ENGINE_DRY_RUN" desc:"Only log the operations the policies deny together with the denying rule instead of denying them. This can be used to test new policies in production. See the text description for details." introductionVersion:"%%NEXT%%"`
 }
 
 // Postprocessing defines the config options for the postprocessing policy handling.
@@ -3,6 +3,7 @@ package defaults
 import (
 	"time"
 
+	"github.com/opencloud-eu/opencloud/pkg/shared"
 	"github.com/opencloud-eu/opencloud/pkg/structs"
 	"github.com/opencloud-eu/opencloud/services/policies/pkg/config"
 )
@@ -39,6 +40,7 @@ func DefaultConfig() *config.Config {
 		Engine: config.Engine{
 			Timeout: 10 * time.Second,
 		},
+		RevaGateway: shared.DefaultRevaConfig().Address,
 	}
 }
 
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -12,7 +13,7 @@ import (
 
 // Engine defines the granted handlers.
 type Engine interface {
-	Evaluate(ctx context.Context, query string, env Environment) (bool, error)
+	Evaluate(ctx context.Context, query string, env *Environment) (bool, error)
 }
 
 type (
@@ -34,12 +35,18 @@ type Resource struct {
 	Name string              `json:"name"`
 	URL  string              `json:"url"`
 	Size uint64              `json:"size"`
+	// MimeType is derived from the name of the resource
+	MimeType string `json:"mime_type"`
+	// SpaceType is the type of the space containing the resource, e.g. 'personal' or 'project'
+	SpaceType string `json:"space_type"`
 }
 
 // Request contains request information and is used as part of the evaluated environment.
 type Request struct {
-	Method string `json:"method"`
-	Path   string `json:"path"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	ClientIP  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
 }
 
 // Environment contains every data that is needed to decide if the request should pass or not
@@ -48,19 +55,21 @@ type Environment struct {
 	User     user.User `json:"user"`
 	Request  Request   `json:"request"`
 	Resource Resource  `json:"resource"`
+	// Time is the time of the evaluated operation, it is set to the current time when empty
+	Time time.Time `json:"time"`
 }
 
 // NewEnvironmentFromPB converts a PBEnvironment to Environment.
-func NewEnvironmentFromPB(pEnv *v0.Environment) (Environment, error) {
-	env := Environment{}
+func NewEnvironmentFromPB(pEnv *v0.Environment) (*Environment, error) {
+	env := &Environment{}
 
-	rData, err := protojson.Marshal(pEnv)
+	rData, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(pEnv)
 	if err != nil {
-		return env, err
+		return nil, err
 	}
 
-	if err := json.Unmarshal(rData, &env); err != nil {
-		return env, err
+	if err := json.Unmarshal(rData, env); err != nil {
+		return nil, err
 	}
 
 	switch pEnv.Stage {
@@ -70,5 +79,9 @@ func NewEnvironmentFromPB(pEnv *v0.Environment) (Environment, error) {
 		env.Stage = StagePP
 	}
 
+	if env.Time.IsZero() {
+		env.Time = time.Now()
+	}
+
 	return env, nil
 }
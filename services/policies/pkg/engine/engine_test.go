@@ -1,10 +1,13 @@
 package engine_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	pMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/policies/v0"
 	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("Engine", func() {
@@ -22,4 +25,41 @@ var _ = Describe("Engine", func() {
 		Entry("http stage", pMessage.Stage_STAGE_HTTP, engine.StageHTTP),
 		Entry("pp stage", pMessage.Stage_STAGE_PP, engine.StagePP),
 	)
+
+	It("converts the environment", func() {
+		now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+		env, err := engine.NewEnvironmentFromPB(&pMessage.Environment{
+			Stage: pMessage.Stage_STAGE_HTTP,
+			User: &pMessage.User{
+				Username:    "einstein",
+				DisplayName: "Albert Einstein",
+				Groups:      []string{"physics"},
+			},
+			Request: &pMessage.Request{
+				Method:    "PUT",
+				Path:      "/dav/spaces/1/report.pdf",
+				ClientIp:  "192.0.2.1",
+				UserAgent: "curl/8.0",
+			},
+			Resource: &pMessage.Resource{
+				Name:      "report.pdf",
+				MimeType:  "application/pdf",
+				SpaceType: "project",
+			},
+			Time: timestamppb.New(now),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(env.User.GetDisplayName()).To(Equal("Albert Einstein"))
+		Expect(env.User.GetGroups()).To(ConsistOf("physics"))
+		Expect(env.Request).To(Equal(engine.Request{
+			Method:    "PUT",
+			Path:      "/dav/spaces/1/report.pdf",
+			ClientIP:  "192.0.2.1",
+			UserAgent: "curl/8.0",
+		}))
+		Expect(env.Resource.MimeType).To(Equal("application/pdf"))
+		Expect(env.Resource.SpaceType).To(Equal("project"))
+		Expect(env.Time).To(BeTemporally("==", now))
+	})
 })
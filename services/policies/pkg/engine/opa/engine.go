@@ -2,10 +2,13 @@ package opa
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"time"
 
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/loader"
 	"github.com/open-policy-agent/opa/rego"
 	"github.com/open-policy-agent/opa/topdown/print"
 
@@ -16,9 +19,11 @@ import (
 
 // OPA wraps open policy agent makes it possible to ask if an action is granted.
 type OPA struct {
+	logger    log.Logger
 	printHook print.Hook
 	policies  []string
 	timeout   time.Duration
+	dryRun    bool
 	options   []func(r *rego.Rego)
 }
 
@@ -42,8 +47,10 @@ func NewOPA(timeout time.Duration, logger log.Logger, conf config.Engine) (OPA,
 	}
 
 	return OPA{
+		logger:    logger,
 		policies:  conf.Policies,
 		timeout:   timeout,
+		dryRun:    conf.DryRun,
 		printHook: logPrinter{logger: logger},
 		options: []func(r *rego.Rego){
 			RFMimetypeDetect,
@@ -54,7 +61,28 @@ func NewOPA(timeout time.Duration, logger log.Logger, conf config.Engine) (OPA,
 }
 
 // Evaluate evaluates the opa policies and returns the result.
-func (o OPA) Evaluate(ctx context.Context, qs string, env engine.Environment) (bool, error) {
+// In dry run mode denials and errors are logged and the operation is allowed.
+func (o OPA) Evaluate(ctx context.Context, qs string, env *engine.Environment) (bool, error) {
+	allowed, err := o.evaluate(ctx, qs, env)
+	if !o.dryRun || (allowed && err == nil) {
+		return allowed, err
+	}
+
+	o.logger.Warn().
+		Err(err).
+		Str("query", qs).
+		Strs("rules", o.ruleLocations(qs)).
+		Str("stage", string(env.Stage)).
+		Str("user", env.User.GetUsername()).
+		Str("method", env.Request.Method).
+		Str("path", env.Request.Path).
+		Str("resource", env.Resource.Name).
+		Msg("dry run: the policies deny the operation")
+
+	return true, nil
+}
+
+func (o OPA) evaluate(ctx context.Context, qs string, env *engine.Environment) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, o.timeout)
 	defer cancel()
 
@@ -77,3 +105,26 @@ func (o OPA) Evaluate(ctx context.Context, qs string, env engine.Environment) (b
 
 	return result.Allowed(), nil
 }
+
+// ruleLocations returns the locations of the rules the query refers to, e.g. 'proxy.rego:12'
+func (o OPA) ruleLocations(qs string) []string {
+	ref, err := ast.ParseRef(qs)
+	if err != nil {
+		return nil
+	}
+
+	res, err := loader.NewFileLoader().Filtered(o.policies, nil)
+	if err != nil {
+		return nil
+	}
+
+	var locations []string
+	for _, module := range res.ParsedModules() {
+		for _, rule := range module.Rules {
+			if rule.Path().Equal(ref) {
+				locations = append(locations, fmt.Sprintf("%s:%d", rule.Location.File, rule.Location.Row))
+			}
+		}
+	}
+	return locations
+}
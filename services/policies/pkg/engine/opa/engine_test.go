@@ -0,0 +1,96 @@
+package opa_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine/opa"
+)
+
+const projectUploadsPolicy = `package proxy
+
+import future.keywords.if
+import future.keywords.in
+
+default granted := false
+
+granted if {
+	input.resource.space_type == "project"
+	input.resource.mime_type == "application/pdf"
+	input.request.user_agent != "curl/8.0"
+	"finance" in input.user.groups
+	time.clock(time.parse_rfc3339_ns(input.time))[0] < 18
+}
+`
+
+var _ = Describe("OPA", func() {
+	var (
+		policy string
+		env    *engine.Environment
+	)
+
+	BeforeEach(func() {
+		policy = filepath.Join(GinkgoT().TempDir(), "proxy.rego")
+		Expect(os.WriteFile(policy, []byte(projectUploadsPolicy), 0600)).To(Succeed())
+
+		env = &engine.Environment{
+			Stage: engine.StageHTTP,
+			User:  user.User{Username: "einstein", Groups: []string{"finance"}},
+			Request: engine.Request{
+				Method:    "PUT",
+				Path:      "/dav/spaces/1/report.pdf",
+				ClientIP:  "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+			Resource: engine.Resource{
+				Name:      "report.pdf",
+				MimeType:  "application/pdf",
+				SpaceType: "project",
+			},
+			Time: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		}
+	})
+
+	It("evaluates the environment", func() {
+		e, err := opa.NewOPA(time.Second, log.NopLogger(), config.Engine{Policies: []string{policy}})
+		Expect(err).ToNot(HaveOccurred())
+
+		allowed, err := e.Evaluate(context.Background(), "data.proxy.granted", env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		env.Resource.SpaceType = "personal"
+		allowed, err = e.Evaluate(context.Background(), "data.proxy.granted", env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+
+		env.Resource.SpaceType = "project"
+		env.Time = time.Date(2026, 10, 17, 19, 0, 0, 0, time.UTC)
+		allowed, err = e.Evaluate(context.Background(), "data.proxy.granted", env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	It("allows denied operations in dry run mode", func() {
+		e, err := opa.NewOPA(time.Second, log.NopLogger(), config.Engine{Policies: []string{policy}, DryRun: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		env.User.Groups = nil
+		allowed, err := e.Evaluate(context.Background(), "data.proxy.granted", env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		allowed, err = e.Evaluate(context.Background(), "data.proxy.missing[", env)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+})
@@ -3,11 +3,20 @@ package eventSVC
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/mime"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 // Service defines the service handlers.
@@ -18,17 +27,19 @@ type Service struct {
 	stream  events.Stream
 	engine  engine.Engine
 	tp      trace.TracerProvider
+	gws     pool.Selectable[gateway.GatewayAPIClient]
 	stopCh  chan struct{}
 	stopped *atomic.Bool
 }
 
 // New returns a service implementation for Service.
-func New(ctx context.Context, stream events.Stream, logger log.Logger, tp trace.TracerProvider, engine engine.Engine, query string) (Service, error) {
+func New(ctx context.Context, stream events.Stream, logger log.Logger, tp trace.TracerProvider, gws pool.Selectable[gateway.GatewayAPIClient], engine engine.Engine, query string) (Service, error) {
 	svc := Service{
 		ctx:     ctx,
 		log:     logger,
 		query:   query,
 		tp:      tp,
+		gws:     gws,
 		engine:  engine,
 		stream:  stream,
 		stopCh:  make(chan struct{}, 1),
@@ -95,21 +106,24 @@ func (s Service) processEvent(e events.Event) error {
 		outcome := events.PPOutcomeContinue
 
 		if s.query != "" {
-			env := engine.Environment{
+			env := &engine.Environment{
 				Stage: engine.StagePP,
 				Resource: engine.Resource{
-					Name: ev.Filename,
-					URL:  ev.URL,
-					Size: ev.Filesize,
+					Name:     ev.Filename,
+					URL:      ev.URL,
+					Size:     ev.Filesize,
+					MimeType: mime.Detect(false, ev.Filename),
 				},
+				Time: time.Now(),
 			}
 
 			if ev.ExecutingUser != nil {
-				env.User = *ev.ExecutingUser
+				proto.Merge(&env.User, ev.ExecutingUser)
 			}
 
 			if ev.ResourceID != nil {
-				env.Resource.ID = *ev.ResourceID
+				proto.Merge(&env.Resource.ID, ev.ResourceID)
+				env.Resource.SpaceType = s.spaceType(ctx, ev)
 			}
 
 			result, err := s.engine.Evaluate(context.TODO(), s.query, env)
@@ -134,3 +148,25 @@ func (s Service) processEvent(e events.Event) error {
 	}
 	return nil
 }
+
+// spaceType looks up the type of the space the uploaded resource belongs to
+func (s Service) spaceType(ctx context.Context, ev events.StartPostprocessingStep) string {
+	if s.gws == nil || ev.RevaToken == "" {
+		return ""
+	}
+
+	client, err := s.gws.Next()
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not select next gateway client")
+		return ""
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, ev.RevaToken)
+	space, err := utils.GetSpace(ctx, storagespace.FormatStorageID(ev.ResourceID.GetStorageId(), ev.ResourceID.GetSpaceId()), client)
+	if err != nil {
+		s.log.Debug().Err(err).Str("uploadID", ev.UploadID).Msg("could not look up the space of the upload")
+		return ""
+	}
+
+	return space.GetSpaceType()
+}
@@ -25,16 +25,7 @@ func RestartPostprocessing(cfg *config.Config) *cobra.Command {
 			return configlog.ReturnFatal(parser.ParseConfig(cfg))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
-			stream, err := stream.NatsFromConfig(connName, false, stream.NatsConfig{
-				Endpoint:             cfg.Postprocessing.Events.Endpoint,
-				Cluster:              cfg.Postprocessing.Events.Cluster,
-				EnableTLS:            cfg.Postprocessing.Events.EnableTLS,
-				TLSInsecure:          cfg.Postprocessing.Events.TLSInsecure,
-				TLSRootCACertificate: cfg.Postprocessing.Events.TLSRootCACertificate,
-				AuthUsername:         cfg.Postprocessing.Events.AuthUsername,
-				AuthPassword:         cfg.Postprocessing.Events.AuthPassword,
-			})
+			stream, err := eventsStream(cfg)
 			if err != nil {
 				return err
 			}
@@ -86,3 +77,16 @@ func RestartPostprocessing(cfg *config.Config) *cobra.Command {
 
 	return restartPostprocessingCmd
 }
+
+func eventsStream(cfg *config.Config) (events.Stream, error) {
+	connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+	return stream.NatsFromConfig(connName, false, stream.NatsConfig{
+		Endpoint:             cfg.Postprocessing.Events.Endpoint,
+		Cluster:              cfg.Postprocessing.Events.Cluster,
+		EnableTLS:            cfg.Postprocessing.Events.EnableTLS,
+		TLSInsecure:          cfg.Postprocessing.Events.TLSInsecure,
+		TLSRootCACertificate: cfg.Postprocessing.Events.TLSRootCACertificate,
+		AuthUsername:         cfg.Postprocessing.Events.AuthUsername,
+		AuthPassword:         cfg.Postprocessing.Events.AuthPassword,
+	})
+}
@@ -17,6 +17,10 @@ func GetCommands(cfg *config.Config) []*cobra.Command {
 
 		// interaction with this service
 		RestartPostprocessing(cfg),
+		ListUploads(cfg),
+		ShowUpload(cfg),
+		RetryUpload(cfg),
+		AbortUpload(cfg),
 
 		// infos about this service
 		Health(cfg),
@@ -7,16 +7,19 @@ import (
 	"os/signal"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
+	ogrpc "github.com/opencloud-eu/opencloud/pkg/service/grpc"
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config/parser"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/server/debug"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/server/grpc"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/server/http"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/service"
-	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 
 	"github.com/spf13/cobra"
-	microstore "go-micro.dev/v4/store"
 )
 
 // Server is the entrypoint for the server command.
@@ -47,18 +50,38 @@ func Server(cfg *config.Config) *cobra.Command {
 				return err
 			}
 
+			cfg.GrpcClient, err = ogrpc.NewClient(
+				append(ogrpc.GetClientOptions(cfg.GRPCClientTLS), ogrpc.WithTraceProvider(traceProvider))...,
+			)
+			if err != nil {
+				return err
+			}
+
+			st := newStore(cfg)
+
+			tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+			if err != nil {
+				return err
+			}
+			gatewaySelector, err := pool.GatewaySelector(
+				cfg.RevaGateway,
+				pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+				pool.WithTLSMode(tm),
+				pool.WithRegistry(registry.GetRegistry()),
+				pool.WithTracerProvider(traceProvider),
+			)
+			if err != nil {
+				return fmt.Errorf("could not get reva client selector: %s", err)
+			}
+
+			pub, err := eventsStream(cfg)
+			if err != nil {
+				return err
+			}
+
 			gr := runner.NewGroup()
 			{
-				st := store.Create(
-					store.Store(cfg.Store.Store),
-					store.TTL(cfg.Store.TTL),
-					microstore.Nodes(cfg.Store.Nodes...),
-					microstore.Database(cfg.Store.Database),
-					microstore.Table(cfg.Store.Table),
-					store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
-				)
-
-				svc, err := service.NewPostprocessingService(ctx, logger, st, traceProvider, cfg)
+				svc, err := service.NewPostprocessingService(ctx, logger, st, gatewaySelector, traceProvider, cfg)
 				if err != nil {
 					return err
 				}
@@ -70,6 +93,42 @@ func Server(cfg *config.Config) *cobra.Command {
 				}))
 			}
 
+			{
+				server, err := http.Server(
+					http.Logger(logger),
+					http.Context(ctx),
+					http.Config(cfg),
+					http.Store(st),
+					http.Publisher(pub),
+					http.GatewaySelector(gatewaySelector),
+					http.TraceProvider(traceProvider),
+				)
+				if err != nil {
+					logger.Info().Err(err).Str("transport", "http").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(runner.NewGoMicroHttpServerRunner(cfg.Service.Name+".http", server))
+			}
+
+			{
+				server, err := grpc.Server(
+					grpc.Logger(logger),
+					grpc.Context(ctx),
+					grpc.Config(cfg),
+					grpc.Store(st),
+					grpc.Publisher(pub),
+					grpc.GatewaySelector(gatewaySelector),
+					grpc.TraceProvider(traceProvider),
+				)
+				if err != nil {
+					logger.Info().Err(err).Str("transport", "grpc").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(runner.NewGoMicroGrpcServerRunner(cfg.Service.Name+".grpc", server))
+			}
+
 			{
 				debugServer, err := debug.Server(
 					debug.Logger(logger),
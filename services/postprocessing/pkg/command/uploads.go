@@ -0,0 +1,169 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/spf13/cobra"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/service"
+)
+
+// ListUploads cli command to list the uploads in postprocessing
+func ListUploads(cfg *config.Config) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the uploads in postprocessing",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spaceID, _ := cmd.Flags().GetString("space")
+			step, _ := cmd.Flags().GetString("step")
+			finished, _ := cmd.Flags().GetBool("finished")
+			renderJSON, _ := cmd.Flags().GetBool("json")
+
+			pps, err := service.ListUploads(newStore(cfg), service.UploadFilter{
+				SpaceID:  spaceID,
+				Step:     events.Postprocessingstep(step),
+				Finished: finished,
+			})
+			if err != nil {
+				return err
+			}
+
+			uploads := make([]service.UploadStatus, 0, len(pps))
+			for _, pp := range pps {
+				uploads = append(uploads, service.NewUploadStatus(pp))
+			}
+
+			if renderJSON {
+				return printJSON(uploads)
+			}
+
+			table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
+			table.Header([]string{"Upload Id", "Space", "Name", "Step", "Step Rule", "Retries", "Failure Reason", "Age"})
+			for _, u := range uploads {
+				table.Append([]string{
+					u.UploadID,
+					u.SpaceID,
+					u.Filename,
+					string(u.Step),
+					u.StepRule,
+					strconv.Itoa(u.Failures),
+					u.FailureReason,
+					u.Age,
+				})
+			}
+			return table.Render()
+		},
+	}
+
+	listCmd.Flags().String("space", "", "only list uploads to the given space id")
+	listCmd.Flags().StringP("step", "s", "", "only list uploads in the given postprocessing step")
+	listCmd.Flags().Bool("finished", false, "include uploads whose postprocessing is finished")
+	listCmd.Flags().Bool("json", false, "output as json")
+
+	return listCmd
+}
+
+// ShowUpload cli command to show the postprocessing state of an upload
+func ShowUpload(cfg *config.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <upload-id>",
+		Short: "show the postprocessing state of an upload",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pp, err := service.GetUpload(newStore(cfg), args[0])
+			if err != nil {
+				return fmt.Errorf("cannot get upload '%s': %w", args[0], err)
+			}
+			return printJSON(service.NewUploadStatus(pp))
+		},
+	}
+}
+
+// RetryUpload cli command to retry the postprocessing of an upload
+func RetryUpload(cfg *config.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <upload-id>",
+		Short: "retry the current postprocessing step of an upload",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pp, err := service.GetUpload(newStore(cfg), args[0])
+			if err != nil {
+				return fmt.Errorf("cannot get upload '%s': %w", args[0], err)
+			}
+
+			stream, err := eventsStream(cfg)
+			if err != nil {
+				return err
+			}
+			return service.RetryUpload(cmd.Context(), stream, pp)
+		},
+	}
+}
+
+// AbortUpload cli command to abort the postprocessing of an upload
+func AbortUpload(cfg *config.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "abort <upload-id>",
+		Short: "abort the postprocessing of an upload, the uploaded bytes are kept",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			st := newStore(cfg)
+			pp, err := service.GetUpload(st, args[0])
+			if err != nil {
+				return fmt.Errorf("cannot get upload '%s': %w", args[0], err)
+			}
+			if pp.Status.CurrentStep == events.PPStepFinished {
+				return fmt.Errorf("postprocessing of upload '%s' is already finished", args[0])
+			}
+
+			stream, err := eventsStream(cfg)
+			if err != nil {
+				return err
+			}
+			return service.AbortUpload(cmd.Context(), st, stream, pp)
+		},
+	}
+}
+
+func newStore(cfg *config.Config) microstore.Store {
+	return store.Create(
+		store.Store(cfg.Store.Store),
+		store.TTL(cfg.Store.TTL),
+		microstore.Nodes(cfg.Store.Nodes...),
+		microstore.Database(cfg.Store.Database),
+		microstore.Table(cfg.Store.Table),
+		store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+	)
+}
+
+func printJSON(v any) error {
+	j, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(j))
+	return nil
+}
@@ -3,6 +3,8 @@ package defaults
 import (
 	"time"
 
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/pkg/structs"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
 )
 
@@ -43,6 +45,22 @@ func DefaultConfig() *config.Config {
 			Database: "postprocessing",
 			Table:    "",
 		},
+		RevaGateway: shared.DefaultRevaConfig().Address,
+		GRPC: config.GRPCConfig{
+			Addr:      "127.0.0.1:9257",
+			Namespace: "eu.opencloud.api",
+		},
+		HTTP: config.HTTP{
+			Addr:      "127.0.0.1:9256",
+			Root:      "/",
+			Namespace: "eu.opencloud.web",
+			CORS: config.CORS{
+				AllowedOrigins:   []string{"*"},
+				AllowedMethods:   []string{"GET", "POST"},
+				AllowedHeaders:   []string{"Authorization", "Origin", "Content-Type", "Accept", "X-Requested-With", "X-Request-Id", "Ocs-Apirequest"},
+				AllowCredentials: true,
+			},
+		},
 	}
 }
 
@@ -51,6 +69,25 @@ func EnsureDefaults(cfg *config.Config) {
 	if cfg.LogLevel == "" {
 		cfg.LogLevel = "error"
 	}
+	if cfg.GRPCClientTLS == nil && cfg.Commons != nil {
+		cfg.GRPCClientTLS = structs.CopyOrZeroValue(cfg.Commons.GRPCClientTLS)
+	}
+
+	if cfg.GRPC.TLS == nil && cfg.Commons != nil {
+		cfg.GRPC.TLS = structs.CopyOrZeroValue(cfg.Commons.GRPCServiceTLS)
+	}
+
+	if cfg.TokenManager == nil && cfg.Commons != nil && cfg.Commons.TokenManager != nil {
+		cfg.TokenManager = &config.TokenManager{
+			JWTSecret: cfg.Commons.TokenManager.JWTSecret,
+		}
+	} else if cfg.TokenManager == nil {
+		cfg.TokenManager = &config.TokenManager{}
+	}
+
+	if cfg.Commons != nil {
+		cfg.HTTP.TLS = cfg.Commons.HTTPServiceTLS
+	}
 }
 
 // Sanitize does nothing atm
@@ -6,8 +6,10 @@ import (
 	"strings"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
+	"github.com/opencloud-eu/opencloud/pkg/shared"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/postprocessing"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/envdecode"
@@ -47,6 +49,28 @@ func Validate(cfg *config.Config) error {
 			cfg.Postprocessing.Steps = append(cfg.Postprocessing.Steps, string(events.PPStepDelay))
 		}
 	}
+
+	if cfg.TokenManager.JWTSecret == "" {
+		return shared.MissingJWTTokenError(cfg.Service.Name)
+	}
+
+	for i, r := range cfg.Postprocessing.StepRules {
+		if r.Name == "" {
+			return fmt.Errorf("postprocessing step rule %d has no name", i)
+		}
+		if r.MaxSize != 0 && r.MaxSize < r.MinSize {
+			return fmt.Errorf("postprocessing step rule '%s' has a max_size smaller than its min_size", r.Name)
+		}
+	}
+
+	if postprocessing.NeedsSpaceType(cfg.Postprocessing.StepRules) {
+		if cfg.ServiceAccount.ServiceAccountID == "" {
+			return shared.MissingServiceAccountID(cfg.Service.Name)
+		}
+		if cfg.ServiceAccount.ServiceAccountSecret == "" {
+			return shared.MissingServiceAccountSecret(cfg.Service.Name)
+		}
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package postprocessing
 
 import (
+	"fmt"
 	"math"
 	"time"
 
@@ -19,12 +20,15 @@ type Postprocessing struct {
 	Filename          string
 	Filesize          uint64
 	ResourceID        *provider.ResourceId
+	SpaceType         string
+	StepRule          string
 	Steps             []events.Postprocessingstep
 	Status            Status
 	Failures          int
 	InitiatorID       string
 	Finished          bool
 	StartTime         time.Time
+	FailureReason     string
 
 	config config.Postprocessing
 }
@@ -42,8 +46,10 @@ func New(config config.Postprocessing) *Postprocessing {
 	}
 }
 
-// Init is the first step of the postprocessing
+// Init is the first step of the postprocessing. It chooses the steps of the upload by the configured step rules.
 func (pp *Postprocessing) Init(_ events.BytesReceived) interface{} {
+	pp.applyStepRules()
+
 	if len(pp.Steps) == 0 {
 		return pp.finished(events.PPOutcomeContinue)
 	}
@@ -53,6 +59,10 @@ func (pp *Postprocessing) Init(_ events.BytesReceived) interface{} {
 
 // NextStep returns the next postprocessing step
 func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) interface{} {
+	if ev.Outcome != events.PPOutcomeContinue {
+		pp.FailureReason = failureReason(ev)
+	}
+
 	switch ev.Outcome {
 	case events.PPOutcomeContinue:
 		return pp.next(ev.FinishedStep)
@@ -67,6 +77,12 @@ func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) interfa
 	}
 }
 
+// Abort finishes the postprocessing with the outcome abort, regardless of the current step
+func (pp *Postprocessing) Abort(reason string) events.PostprocessingFinished {
+	pp.FailureReason = reason
+	return pp.finished(events.PPOutcomeAbort)
+}
+
 // CurrentStep returns the current postprocessing step
 func (pp *Postprocessing) CurrentStep() interface{} {
 	if pp.Status.CurrentStep == events.PPStepFinished {
@@ -135,3 +151,24 @@ func (pp *Postprocessing) retry() events.PostprocessingRetry {
 		BackoffDuration: pp.BackoffDuration(),
 	}
 }
+
+// failureReason describes why a step did not continue the postprocessing
+func failureReason(ev events.PostprocessingStepFinished) string {
+	switch res := ev.Result.(type) {
+	case events.VirusscanResult:
+		if res.ErrorMsg != "" {
+			return res.ErrorMsg
+		}
+		if res.Infected {
+			return fmt.Sprintf("infected: %s", res.Description)
+		}
+	case string:
+		if res != "" {
+			return res
+		}
+	}
+	if ev.Error != nil {
+		return ev.Error.Error()
+	}
+	return fmt.Sprintf("step '%s' finished with outcome '%s'", ev.FinishedStep, ev.Outcome)
+}
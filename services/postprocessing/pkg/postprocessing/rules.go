@@ -0,0 +1,75 @@
+package postprocessing
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/mime"
+)
+
+// NeedsSpaceType returns true if any of the step rules matches on the type of the space
+func NeedsSpaceType(rules []config.StepRule) bool {
+	for _, r := range rules {
+		if len(r.SpaceTypes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// applyStepRules replaces the steps of the postprocessing with the steps of the first matching rule.
+// The configured steps are kept if no rule matches.
+func (pp *Postprocessing) applyStepRules() {
+	for _, r := range pp.config.StepRules {
+		if !pp.matches(r) {
+			continue
+		}
+
+		steps := make([]events.Postprocessingstep, 0, len(r.Steps))
+		for _, s := range r.Steps {
+			steps = append(steps, events.Postprocessingstep(s))
+		}
+		pp.Steps = steps
+		pp.StepRule = r.Name
+		return
+	}
+}
+
+func (pp *Postprocessing) matches(r config.StepRule) bool {
+	if len(r.SpaceTypes) > 0 && !slices.Contains(r.SpaceTypes, pp.SpaceType) {
+		return false
+	}
+	if len(r.SpaceIDs) > 0 && !slices.Contains(r.SpaceIDs, pp.ResourceID.GetSpaceId()) {
+		return false
+	}
+	if pp.Filesize < r.MinSize || (r.MaxSize != 0 && pp.Filesize > r.MaxSize) {
+		return false
+	}
+	if len(r.MimeTypes) > 0 && !matchesMimeType(r.MimeTypes, mime.Detect(false, pp.Filename)) {
+		return false
+	}
+	if len(r.Groups) > 0 && !slices.ContainsFunc(pp.User.GetGroups(), func(g string) bool {
+		return slices.Contains(r.Groups, g)
+	}) {
+		return false
+	}
+	return true
+}
+
+// matchesMimeType supports exact mimetypes like `application/pdf` and wildcards like `image/*`
+func matchesMimeType(patterns []string, mimeType string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "/*"); ok {
+			if strings.HasPrefix(mimeType, prefix+"/") {
+				return true
+			}
+			continue
+		}
+		if p == mimeType {
+			return true
+		}
+	}
+	return false
+}
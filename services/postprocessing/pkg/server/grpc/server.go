@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"github.com/opencloud-eu/opencloud/pkg/service/grpc"
+	"github.com/opencloud-eu/opencloud/pkg/version"
+	ppsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/postprocessing/v0"
+	svc "github.com/opencloud-eu/opencloud/services/postprocessing/pkg/service"
+)
+
+// Server initializes the grpc service and server serving the status API.
+func Server(opts ...Option) (grpc.Service, error) {
+	options := newOptions(opts...)
+
+	service, err := grpc.NewServiceWithClient(
+		options.Config.GrpcClient,
+		grpc.TLSEnabled(options.Config.GRPC.TLS.Enabled),
+		grpc.TLSCert(
+			options.Config.GRPC.TLS.Cert,
+			options.Config.GRPC.TLS.Key,
+		),
+		grpc.Name(options.Config.Service.Name),
+		grpc.Context(options.Context),
+		grpc.Address(options.Config.GRPC.Addr),
+		grpc.Namespace(options.Config.GRPC.Namespace),
+		grpc.Logger(options.Logger),
+		grpc.Version(version.GetString()),
+		grpc.TraceProvider(options.TraceProvider),
+	)
+	if err != nil {
+		options.Logger.Error().
+			Err(err).
+			Msg("Error creating postprocessing service")
+		return grpc.Service{}, err
+	}
+
+	handle, err := svc.NewGRPCHandler(
+		svc.Logger(options.Logger),
+		svc.Store(options.Store),
+		svc.Publisher(options.Publisher),
+		svc.GatewaySelector(options.GatewaySelector),
+		svc.JWTSecret(options.Config.TokenManager.JWTSecret),
+		svc.Namespace(options.Config.GRPC.Namespace),
+	)
+	if err != nil {
+		return grpc.Service{}, err
+	}
+
+	if err := ppsvc.RegisterPostprocessingServiceHandler(service.Server(), handle); err != nil {
+		options.Logger.Error().
+			Err(err).
+			Msg("Error registering postprocessing service handler")
+		return grpc.Service{}, err
+	}
+
+	return service, nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	ppmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/postprocessing/v0"
+	ppsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/postprocessing/v0"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/postprocessing"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/token"
+	"github.com/opencloud-eu/reva/v2/pkg/token/manager/jwt"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"go-micro.dev/v4/store"
+	grpcmetadata "google.golang.org/grpc/metadata"
+)
+
+// GRPCHandler serves the status API via grpc, it offers the same operations as the StatusHandler
+type GRPCHandler struct {
+	id           string
+	log          log.Logger
+	store        store.Store
+	pub          events.Publisher
+	gws          pool.Selectable[gateway.GatewayAPIClient]
+	tokenManager token.Manager
+}
+
+// NewGRPCHandler returns the grpc handler of the status API
+func NewGRPCHandler(opts ...Option) (*GRPCHandler, error) {
+	o := &Options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.Store == nil {
+		return nil, errors.New("store is required")
+	}
+	if o.Publisher == nil {
+		return nil, errors.New("publisher is required")
+	}
+
+	tokenManager, err := jwt.New(map[string]interface{}{
+		"secret":  o.JWTSecret,
+		"expires": int64(24 * 60 * 60),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GRPCHandler{
+		id:           o.Namespace + ".postprocessing",
+		log:          o.Logger,
+		store:        o.Store,
+		pub:          o.Publisher,
+		gws:          o.GatewaySelector,
+		tokenManager: tokenManager,
+	}, nil
+}
+
+// ListUploads implements the PostprocessingServiceHandler interface
+func (g *GRPCHandler) ListUploads(ctx context.Context, in *ppsvc.ListUploadsRequest, out *ppsvc.ListUploadsResponse) error {
+	if err := g.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	pps, err := ListUploads(g.store, UploadFilter{
+		SpaceID:  in.GetSpaceId(),
+		Step:     events.Postprocessingstep(in.GetStep()),
+		Finished: in.GetFinished(),
+	})
+	if err != nil {
+		return merrors.InternalServerError(g.id, "could not list uploads: %s", err.Error())
+	}
+
+	out.Uploads = make([]*ppmsg.Upload, 0, len(pps))
+	for _, pp := range pps {
+		out.Uploads = append(out.Uploads, NewUpload(pp))
+	}
+	return nil
+}
+
+// GetUpload implements the PostprocessingServiceHandler interface
+func (g *GRPCHandler) GetUpload(ctx context.Context, in *ppsvc.GetUploadRequest, out *ppsvc.GetUploadResponse) error {
+	if err := g.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	pp, err := g.upload(in.GetUploadId())
+	if err != nil {
+		return err
+	}
+
+	out.Upload = NewUpload(pp)
+	return nil
+}
+
+// RetryUpload implements the PostprocessingServiceHandler interface
+func (g *GRPCHandler) RetryUpload(ctx context.Context, in *ppsvc.RetryUploadRequest, _ *ppsvc.RetryUploadResponse) error {
+	if err := g.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	pp, err := g.upload(in.GetUploadId())
+	if err != nil {
+		return err
+	}
+
+	if err := RetryUpload(ctx, g.pub, pp); err != nil {
+		return merrors.InternalServerError(g.id, "could not retry upload: %s", err.Error())
+	}
+	return nil
+}
+
+// AbortUpload implements the PostprocessingServiceHandler interface
+func (g *GRPCHandler) AbortUpload(ctx context.Context, in *ppsvc.AbortUploadRequest, out *ppsvc.AbortUploadResponse) error {
+	if err := g.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	pp, err := g.upload(in.GetUploadId())
+	if err != nil {
+		return err
+	}
+
+	err = AbortUpload(ctx, g.store, g.pub, pp)
+	switch {
+	case errors.Is(err, ErrFinished):
+		return merrors.Conflict(g.id, "%s", err.Error())
+	case err != nil:
+		return merrors.InternalServerError(g.id, "could not abort upload: %s", err.Error())
+	}
+
+	out.Upload = NewUpload(pp)
+	return nil
+}
+
+func (g *GRPCHandler) upload(uploadID string) (*postprocessing.Postprocessing, error) {
+	pp, err := GetUpload(g.store, uploadID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, merrors.NotFound(g.id, "upload '%s' not found", uploadID)
+	case err != nil:
+		return nil, merrors.InternalServerError(g.id, "could not read upload: %s", err.Error())
+	}
+	return pp, nil
+}
+
+// requireAdmin authenticates the request and checks that the user has the admin permission
+func (g *GRPCHandler) requireAdmin(ctx context.Context) error {
+	// get the token from the go-micro context and make it known to the reva client
+	t, ok := metadata.Get(ctx, revactx.TokenHeader)
+	if !ok {
+		return merrors.Unauthorized(g.id, "could not get token from context")
+	}
+	if _, _, err := g.tokenManager.DismantleToken(ctx, t); err != nil {
+		return merrors.Unauthorized(g.id, "%s", err.Error())
+	}
+
+	ctx = grpcmetadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, t)
+	if !isAdmin(ctx, g.gws, g.log) {
+		return merrors.Forbidden(g.id, "the status API is only available to admins")
+	}
+	return nil
+}
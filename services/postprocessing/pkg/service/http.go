@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/postprocessing"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"go-micro.dev/v4/store"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	_basePath = "/api/v0/postprocessing/uploads"
+
+	// only users with this permission can use the status API
+	_adminPermission = "Settings.ReadWrite"
+)
+
+// StatusHandler serves the status API of the postprocessing service
+type StatusHandler struct {
+	log   log.Logger
+	store store.Store
+	pub   events.Publisher
+	gws   pool.Selectable[gateway.GatewayAPIClient]
+	mux   *chi.Mux
+}
+
+// NewStatusHandler returns the handler of the status API
+func NewStatusHandler(opts ...Option) (*StatusHandler, error) {
+	o := &Options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.Store == nil {
+		return nil, errors.New("store is required")
+	}
+	if o.Publisher == nil {
+		return nil, errors.New("publisher is required")
+	}
+
+	h := &StatusHandler{
+		log:   o.Logger,
+		store: o.Store,
+		pub:   o.Publisher,
+		gws:   o.GatewaySelector,
+		mux:   o.Mux,
+	}
+
+	h.mux.Route(_basePath, func(r chi.Router) {
+		r.Use(h.requireAdmin)
+		r.Get("/", h.HandleListUploads)
+		r.Get("/{uploadID}", h.HandleGetUpload)
+		r.Post("/{uploadID}/retry", h.HandleRetryUpload)
+		r.Post("/{uploadID}/abort", h.HandleAbortUpload)
+	})
+	return h, nil
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *StatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// HandleListUploads lists the uploads in postprocessing, filtered by the query parameters
+// `spaceId`, `step` and `finished`
+func (h *StatusHandler) HandleListUploads(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pps, err := ListUploads(h.store, UploadFilter{
+		SpaceID:  q.Get("spaceId"),
+		Step:     events.Postprocessingstep(q.Get("step")),
+		Finished: q.Get("finished") == "true",
+	})
+	if err != nil {
+		h.log.Error().Err(err).Msg("could not list uploads")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	value := make([]UploadStatus, 0, len(pps))
+	for _, pp := range pps {
+		value = append(value, NewUploadStatus(pp))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": value})
+}
+
+// HandleGetUpload returns the status of an upload
+func (h *StatusHandler) HandleGetUpload(w http.ResponseWriter, r *http.Request) {
+	pp, ok := h.upload(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, NewUploadStatus(pp))
+}
+
+// HandleRetryUpload resumes the postprocessing of an upload
+func (h *StatusHandler) HandleRetryUpload(w http.ResponseWriter, r *http.Request) {
+	pp, ok := h.upload(w, r)
+	if !ok {
+		return
+	}
+	if err := RetryUpload(r.Context(), h.pub, pp); err != nil {
+		h.log.Error().Err(err).Str("uploadID", pp.ID).Msg("could not retry upload")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// HandleAbortUpload aborts the postprocessing of an upload
+func (h *StatusHandler) HandleAbortUpload(w http.ResponseWriter, r *http.Request) {
+	pp, ok := h.upload(w, r)
+	if !ok {
+		return
+	}
+	err := AbortUpload(r.Context(), h.store, h.pub, pp)
+	switch {
+	case errors.Is(err, ErrFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		h.log.Error().Err(err).Str("uploadID", pp.ID).Msg("could not abort upload")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *StatusHandler) upload(w http.ResponseWriter, r *http.Request) (*postprocessing.Postprocessing, bool) {
+	pp, err := GetUpload(h.store, chi.URLParam(r, "uploadID"))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	case err != nil:
+		h.log.Error().Err(err).Msg("could not read upload")
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+	return pp, true
+}
+
+// requireAdmin only lets users with the admin permission pass
+func (h *StatusHandler) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := metadata.AppendToOutgoingContext(r.Context(), revactx.TokenHeader, r.Header.Get(revactx.TokenHeader))
+		if _, ok := revactx.ContextGetUser(ctx); !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !h.isAdmin(ctx) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *StatusHandler) isAdmin(ctx context.Context) bool {
+	return isAdmin(ctx, h.gws, h.log)
+}
+
+// isAdmin checks if the user of the request has the admin permission, the context must carry the token
+func isAdmin(ctx context.Context, gws pool.Selectable[gateway.GatewayAPIClient], logger log.Logger) bool {
+	gwc, err := gws.Next()
+	if err != nil {
+		logger.Error().Err(err).Msg("could not get gateway client")
+		return false
+	}
+	ok, err := utils.CheckPermission(ctx, _adminPermission, gwc)
+	if err != nil {
+		logger.Error().Err(err).Msg("could not check permission")
+	}
+	return ok
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	microstore "go-micro.dev/v4/store"
+)
+
+// Option for the status handler
+type Option func(*Options)
+
+// Options for the status handler
+type Options struct {
+	Logger          log.Logger
+	Store           microstore.Store
+	Publisher       events.Publisher
+	GatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	Mux             *chi.Mux
+	JWTSecret       string
+	Namespace       string
+}
+
+// Logger configures a logger for the status handler
+func Logger(log log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = log
+	}
+}
+
+// Store configures the store holding the postprocessing state
+func Store(store microstore.Store) Option {
+	return func(o *Options) {
+		o.Store = store
+	}
+}
+
+// Publisher configures the publisher used to retry and abort uploads
+func Publisher(pub events.Publisher) Option {
+	return func(o *Options) {
+		o.Publisher = pub
+	}
+}
+
+// GatewaySelector adds a grpc client selector for the gateway service
+func GatewaySelector(gatewaySelector pool.Selectable[gateway.GatewayAPIClient]) Option {
+	return func(o *Options) {
+		o.GatewaySelector = gatewaySelector
+	}
+}
+
+// Mux defines the muxer for the status handler
+func Mux(m *chi.Mux) Option {
+	return func(o *Options) {
+		o.Mux = m
+	}
+}
+
+// JWTSecret provides the secret to validate the tokens of the grpc requests
+func JWTSecret(secret string) Option {
+	return func(o *Options) {
+		o.JWTSecret = secret
+	}
+}
+
+// Namespace provides the namespace of the grpc service, it is used as the id of the errors
+func Namespace(namespace string) Option {
+	return func(o *Options) {
+		o.Namespace = namespace
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/version"
@@ -19,6 +20,7 @@ import (
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/events/raw"
 	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
@@ -33,6 +35,8 @@ type PostprocessingService struct {
 	steps   []events.Postprocessingstep
 	store   store.Store
 	c       config.Postprocessing
+	gws     pool.Selectable[gateway.GatewayAPIClient]
+	sa      config.ServiceAccount
 	tp      trace.TracerProvider
 	metrics *metrics.Metrics
 	stopCh  chan struct{}
@@ -49,7 +53,7 @@ var (
 )
 
 // NewPostprocessingService returns a new instance of a postprocessing service
-func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.Store, tp trace.TracerProvider, cfg *config.Config) (*PostprocessingService, error) {
+func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.Store, gws pool.Selectable[gateway.GatewayAPIClient], tp trace.TracerProvider, cfg *config.Config) (*PostprocessingService, error) {
 	connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
 	pub, err := stream.NatsFromConfig(connName, false, stream.NatsConfig{
 		Endpoint:             cfg.Postprocessing.Events.Endpoint,
@@ -98,6 +102,8 @@ func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.
 		steps:   getSteps(cfg.Postprocessing),
 		store:   sto,
 		c:       cfg.Postprocessing,
+		gws:     gws,
+		sa:      cfg.ServiceAccount,
 		tp:      tp,
 		metrics: m,
 		stopCh:  make(chan struct{}, 1),
@@ -193,17 +199,25 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 
 	switch ev := e.Event.Event.(type) {
 	case events.BytesReceived:
-		pp = &postprocessing.Postprocessing{
-			ID:                ev.UploadID,
-			URL:               ev.URL,
-			User:              ev.ExecutingUser,
-			Filename:          ev.Filename,
-			Filesize:          ev.Filesize,
-			ResourceID:        ev.ResourceID,
-			Steps:             pps.steps,
-			InitiatorID:       e.InitiatorID,
-			ImpersonatingUser: ev.ImpersonatingUser,
-			StartTime:         time.Now(),
+		pp = postprocessing.New(pps.c)
+		pp.ID = ev.UploadID
+		pp.URL = ev.URL
+		pp.User = ev.ExecutingUser
+		pp.Filename = ev.Filename
+		pp.Filesize = ev.Filesize
+		pp.ResourceID = ev.ResourceID
+		pp.Steps = pps.steps
+		pp.InitiatorID = e.InitiatorID
+		pp.ImpersonatingUser = ev.ImpersonatingUser
+		pp.StartTime = time.Now()
+		if postprocessing.NeedsSpaceType(pps.c.StepRules) {
+			pp.SpaceType, err = pps.spaceType(ctx, ev.ResourceID.GetSpaceId())
+			if err != nil {
+				// without the space type the rules can't be evaluated, let the event be redelivered
+				ackEvent = false
+				pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get space type")
+				return fmt.Errorf("%w: cannot get space type", ErrEvent)
+			}
 		}
 		next = pp.Init(ev)
 	case events.PostprocessingStepFinished:
@@ -216,6 +230,11 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 			pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get upload")
 			return fmt.Errorf("%w: cannot get upload", ErrEvent)
 		}
+		if pp.Status.CurrentStep == events.PPStepFinished {
+			// the postprocessing was aborted while the step was running
+			pps.log.Info().Str("uploadID", ev.UploadID).Str("step", string(ev.FinishedStep)).Msg("ignoring step result of finished postprocessing")
+			return nil
+		}
 		next = pp.NextStep(ev)
 
 		switch pp.Status.Outcome {
@@ -329,10 +348,26 @@ func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*post
 	return pp, nil
 }
 
+// spaceType looks up the type of a space as the service user
+func (pps *PostprocessingService) spaceType(ctx context.Context, spaceID string) (string, error) {
+	gwc, err := pps.gws.Next()
+	if err != nil {
+		return "", err
+	}
+	ctx, err = utils.GetServiceUserContextWithContext(ctx, gwc, pps.sa.ServiceAccountID, pps.sa.ServiceAccountSecret)
+	if err != nil {
+		return "", err
+	}
+	space, err := utils.GetSpace(ctx, spaceID, gwc)
+	if err != nil {
+		return "", err
+	}
+	return space.GetSpaceType(), nil
+}
+
 func getSteps(c config.Postprocessing) []events.Postprocessingstep {
-	// NOTE: improved version only allows configuring order of postprocessing steps
-	// But we aim for a system where postprocessing steps can be configured per space, ideally by the spaceadmin itself
-	// We need to iterate over configuring PP service when we see fit
+	// NOTE: these are the default steps, step rules can choose other steps per upload (see Postprocessing.Init)
+	// We still aim for a system where postprocessing steps can be configured per space by the spaceadmin itself
 	steps := make([]events.Postprocessingstep, 0, len(c.Steps))
 	for _, s := range c.Steps {
 		steps = append(steps, events.Postprocessingstep(s))
@@ -391,37 +426,15 @@ func (pps *PostprocessingService) resumePP(ctx context.Context, uploadID string)
 }
 
 func (pps *PostprocessingService) findUploadsByStep(step events.Postprocessingstep) []string {
-	var ids []string
-
-	keys, err := pps.store.List()
+	uploads, err := ListUploads(pps.store, UploadFilter{Step: step, Finished: true})
 	if err != nil {
 		pps.log.Error().Err(err).Msg("cannot list uploads")
 	}
 
-	for _, k := range keys {
-		rec, err := pps.store.Read(k)
-		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot read upload")
-			continue
-		}
-
-		if len(rec) != 1 {
-			pps.log.Error().Err(err).Msg("expected only one result")
-			continue
-		}
-
-		pp := &postprocessing.Postprocessing{}
-		err = json.Unmarshal(rec[0].Value, pp)
-		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot unmarshal upload")
-			continue
-		}
-
-		if pp.Status.CurrentStep == step {
-			ids = append(ids, pp.ID)
-		}
+	ids := make([]string, 0, len(uploads))
+	for _, pp := range uploads {
+		ids = append(ids, pp.ID)
 	}
-
 	return ids
 }
 
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	ppmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/postprocessing/v0"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/postprocessing"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"go-micro.dev/v4/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrFinished is returned when a finished postprocessing is aborted
+var ErrFinished = errors.New("postprocessing is already finished")
+
+// UploadFilter selects the uploads returned by ListUploads, empty fields match all uploads
+type UploadFilter struct {
+	SpaceID  string
+	Step     events.Postprocessingstep
+	Finished bool
+}
+
+// UploadStatus is the postprocessing state of an upload as returned by the status API
+type UploadStatus struct {
+	UploadID      string                       `json:"uploadId"`
+	Filename      string                       `json:"filename"`
+	Filesize      uint64                       `json:"filesize"`
+	SpaceID       string                       `json:"spaceId,omitempty"`
+	SpaceType     string                       `json:"spaceType,omitempty"`
+	UserID        string                       `json:"userId,omitempty"`
+	Step          events.Postprocessingstep    `json:"step"`
+	Outcome       events.PostprocessingOutcome `json:"outcome,omitempty"`
+	Failures      int                          `json:"failures"`
+	FailureReason string                       `json:"failureReason,omitempty"`
+	StepRule      string                       `json:"stepRule,omitempty"`
+	Steps         []events.Postprocessingstep  `json:"steps"`
+	Finished      bool                         `json:"finished"`
+	StartTime     time.Time                    `json:"startTime,omitzero"`
+	Age           string                       `json:"age,omitempty"`
+}
+
+// NewUploadStatus returns the status of a postprocessing
+func NewUploadStatus(pp *postprocessing.Postprocessing) UploadStatus {
+	s := UploadStatus{
+		UploadID:      pp.ID,
+		Filename:      pp.Filename,
+		Filesize:      pp.Filesize,
+		SpaceID:       pp.ResourceID.GetSpaceId(),
+		SpaceType:     pp.SpaceType,
+		UserID:        pp.User.GetId().GetOpaqueId(),
+		Step:          pp.Status.CurrentStep,
+		Outcome:       pp.Status.Outcome,
+		Failures:      pp.Failures,
+		FailureReason: pp.FailureReason,
+		StepRule:      pp.StepRule,
+		Steps:         pp.Steps,
+		Finished:      pp.Finished,
+		StartTime:     pp.StartTime,
+	}
+	if !pp.StartTime.IsZero() {
+		s.Age = time.Since(pp.StartTime).Truncate(time.Second).String()
+	}
+	return s
+}
+
+// NewUpload returns the status of a postprocessing as returned by the grpc API
+func NewUpload(pp *postprocessing.Postprocessing) *ppmsg.Upload {
+	u := &ppmsg.Upload{
+		UploadId:      pp.ID,
+		Filename:      pp.Filename,
+		Filesize:      pp.Filesize,
+		SpaceId:       pp.ResourceID.GetSpaceId(),
+		SpaceType:     pp.SpaceType,
+		UserId:        pp.User.GetId().GetOpaqueId(),
+		Step:          string(pp.Status.CurrentStep),
+		Outcome:       string(pp.Status.Outcome),
+		Failures:      int32(pp.Failures),
+		FailureReason: pp.FailureReason,
+		StepRule:      pp.StepRule,
+		Steps:         make([]string, 0, len(pp.Steps)),
+		Finished:      pp.Finished,
+	}
+	for _, s := range pp.Steps {
+		u.Steps = append(u.Steps, string(s))
+	}
+	if !pp.StartTime.IsZero() {
+		u.StartTime = timestamppb.New(pp.StartTime)
+	}
+	return u
+}
+
+// ListUploads returns the postprocessings in the store matching the filter, the oldest first.
+// Finished uploads are only returned if the filter asks for them.
+func ListUploads(sto store.Store, filter UploadFilter) ([]*postprocessing.Postprocessing, error) {
+	keys, err := sto.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var pps []*postprocessing.Postprocessing
+	for _, k := range keys {
+		pp, err := GetUpload(sto, k)
+		if err != nil {
+			// the upload might have finished in the meantime
+			continue
+		}
+
+		switch {
+		case pp.Finished && !filter.Finished:
+		case filter.SpaceID != "" && pp.ResourceID.GetSpaceId() != filter.SpaceID:
+		case filter.Step != "" && pp.Status.CurrentStep != filter.Step:
+		default:
+			pps = append(pps, pp)
+		}
+	}
+
+	sort.Slice(pps, func(i, j int) bool {
+		return pps[i].StartTime.Before(pps[j].StartTime)
+	})
+	return pps, nil
+}
+
+// GetUpload reads a postprocessing from the store
+func GetUpload(sto store.Store, uploadID string) (*postprocessing.Postprocessing, error) {
+	recs, err := sto.Read(uploadID)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, err
+	case len(recs) != 1:
+		return nil, ErrNotFound
+	}
+
+	pp := &postprocessing.Postprocessing{}
+	if err := json.Unmarshal(recs[0].Value, pp); err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+// RetryUpload resumes the postprocessing of an upload at its current step. Finished uploads
+// are restarted by the storage provider.
+func RetryUpload(ctx context.Context, pub events.Publisher, pp *postprocessing.Postprocessing) error {
+	if pp.Finished {
+		return events.Publish(ctx, pub, events.RestartPostprocessing{
+			UploadID:  pp.ID,
+			Timestamp: utils.TSNow(),
+		})
+	}
+	return events.Publish(ctx, pub, events.ResumePostprocessing{
+		UploadID:  pp.ID,
+		Timestamp: utils.TSNow(),
+	})
+}
+
+// AbortUpload finishes the postprocessing of an upload with the outcome abort. The finished state is stored
+// before the storage provider is told, results of steps which are still running are ignored. The bytes of
+// the upload are kept.
+func AbortUpload(ctx context.Context, sto store.Store, pub events.Publisher, pp *postprocessing.Postprocessing) error {
+	if pp.Status.CurrentStep == events.PPStepFinished {
+		return ErrFinished
+	}
+
+	next := pp.Abort("aborted by an administrator")
+	if err := storePP(sto, pp); err != nil {
+		return err
+	}
+
+	ctx = ctxpkg.ContextSetInitiator(ctx, pp.InitiatorID)
+	return events.Publish(ctx, pub, next)
+}
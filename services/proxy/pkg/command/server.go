@@ -10,6 +10,8 @@ import (
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/justinas/alice"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
 	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/log"
@@ -75,6 +77,15 @@ func Server(cfg *config.Config) *cobra.Command {
 				store.Authentication(cfg.PreSignedURL.SigningKeys.AuthUsername, cfg.PreSignedURL.SigningKeys.AuthPassword),
 			)
 
+			var rateLimitBuckets middleware.RateLimitBuckets
+			if cfg.RateLimit.Enabled {
+				var err error
+				rateLimitBuckets, err = newRateLimitBuckets(cmd.Context(), cfg.RateLimit)
+				if err != nil {
+					return err
+				}
+			}
+
 			logger := log.Configure(cfg.Service.Name, cfg.Commons, cfg.LogLevel)
 			traceProvider, err := tracing.GetTraceProvider(cmd.Context(), cfg.Commons.TracesExporter, cfg.Service.Name)
 			if err != nil {
@@ -185,7 +196,7 @@ func Server(cfg *config.Config) *cobra.Command {
 
 			gr := runner.NewGroup()
 			{
-				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, traceProvider, *m, userProvider, publisher, gatewaySelector, serviceSelector)
+				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, rateLimitBuckets, traceProvider, *m, userProvider, publisher, gatewaySelector, serviceSelector)
 
 				server, err := proxyHTTP.Server(
 					proxyHTTP.Handler(lh.Handler()),
@@ -235,7 +246,7 @@ func Server(cfg *config.Config) *cobra.Command {
 }
 
 func loadMiddlewares(logger log.Logger, cfg *config.Config,
-	userInfoCache, signingKeyStore microstore.Store,
+	userInfoCache, signingKeyStore microstore.Store, rateLimitBuckets middleware.RateLimitBuckets,
 	traceProvider trace.TracerProvider, metrics metrics.Metrics,
 	userProvider backend.UserBackend, publisher events.Publisher,
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient], serviceSelector selector.Selector) alice.Chain {
@@ -283,11 +294,16 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		})
 	}
 
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.AuthMiddleware.TrustedProxies)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to parse the trusted proxies.")
+	}
 	if cfg.AuthMiddleware.AllowAppAuth {
 		authenticators = append(authenticators, middleware.AppAuthAuthenticator{
 			Logger:              logger,
 			RevaGatewaySelector: gatewaySelector,
 			UserRoleAssigner:    roleAssigner,
+			TrustedProxies:      trustedProxies,
 		})
 	}
 	authenticators = append(authenticators, middleware.NewOIDCAuthenticator(
@@ -331,6 +347,7 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 	}
 
 	return alice.New(
+		middleware.SocketAddr,
 		chimiddleware.RealIP,
 		chimiddleware.RequestID,
 		// first make sure we log all requests and redirect to https if necessary
@@ -348,6 +365,14 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		middleware.HTTPSRedirect,
 		middleware.Security(cspConfig),
 		router.Middleware(serviceSelector, cfg.PolicySelector, cfg.Policies, logger),
+		// limit the failed authentications per client ip, the client isn't known before the authentication
+		middleware.FailedAuthRateLimit(
+			cfg.RateLimit,
+			metrics,
+			middleware.Logger(logger),
+			middleware.WithRateLimitBuckets(rateLimitBuckets),
+			middleware.TrustedProxies(trustedProxies),
+		),
 		middleware.Authentication(
 			authenticators,
 			middleware.CredentialsByUserAgent(cfg.AuthMiddleware.CredentialsByUserAgent),
@@ -368,6 +393,14 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 			middleware.MultiTenantEnabled(cfg.Commons.MultiTenantEnabled),
 			middleware.EventsPublisher(publisher),
 		),
+		// limit the requests once the client is known
+		middleware.RateLimit(
+			cfg.RateLimit,
+			metrics,
+			middleware.Logger(logger),
+			middleware.WithRateLimitBuckets(rateLimitBuckets),
+			middleware.TrustedProxies(trustedProxies),
+		),
 		middleware.SelectorCookie(
 			middleware.Logger(logger),
 			middleware.TraceProvider(traceProvider),
@@ -379,6 +412,7 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 			middleware.TraceProvider(traceProvider),
 			middleware.WithRevaGatewaySelector(gatewaySelector),
 			middleware.PoliciesProviderService(policiesProviderClient),
+			middleware.TrustedProxies(trustedProxies),
 		),
 		// finally, trigger home creation when a user logs in
 		middleware.CreateHome(
@@ -389,3 +423,36 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		),
 	)
 }
+
+// newRateLimitBuckets returns the buckets of the rate limits. The nats-js-kv buckets are kept in
+// memory by the NATS server, the limits don't need to survive a restart.
+func newRateLimitBuckets(ctx context.Context, cfg config.RateLimit) (middleware.RateLimitBuckets, error) {
+	ttl := middleware.RateLimitTTL(cfg)
+	if cfg.Store.Store == "memory" {
+		return middleware.NewMemoryRateLimitBuckets(ttl), nil
+	}
+
+	natsOptions := nats.Options{
+		Servers:  cfg.Store.Nodes,
+		User:     cfg.Store.AuthUsername,
+		Password: cfg.Store.AuthPassword,
+	}
+	conn, err := natsOptions.Connect()
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the rate limit store: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+	bucket := cfg.Store.Database + "-" + cfg.Store.Table
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  bucket,
+		TTL:     ttl,
+		Storage: jetstream.MemoryStorage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket (%s): %w", bucket, err)
+	}
+	return middleware.NewKVRateLimitBuckets(kv), nil
+}
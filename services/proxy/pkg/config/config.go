@@ -45,6 +45,7 @@ type Config struct {
 	CSPConfigFileLocation         string              `yaml:"csp_config_file_location" env:"PROXY_CSP_CONFIG_FILE_LOCATION" desc:"The location of the CSP configuration file." introductionVersion:"1.0.0"`
 	CSPConfigFileOverrideLocation string              `yaml:"csp_config_file_override_location" env:"PROXY_CSP_CONFIG_FILE_OVERRIDE_LOCATION" desc:"The location of the CSP configuration file override." introductionVersion:"4.0.0"`
 	Events                        Events              `yaml:"events"`
+	RateLimit                     RateLimit           `yaml:"rate_limit"`
 
 	Context context.Context `json:"-" yaml:"-"`
 }
@@ -95,6 +96,7 @@ var (
 type AuthMiddleware struct {
 	CredentialsByUserAgent map[string]string `yaml:"credentials_by_user_agent"`
 	AllowAppAuth           bool              `yaml:"allow_app_auth" env:"PROXY_ENABLE_APP_AUTH" desc:"Allow app authentication. This can be used to authenticate 3rd party applications. Note that auth-app service must be running for this feature to work." introductionVersion:"1.0.0"`
+	TrustedProxies         []string          `yaml:"trusted_proxies" env:"PROXY_TRUSTED_PROXIES" desc:"A list of ip addresses or networks in CIDR notation of the reverse proxies in front of the proxy. The X-Forwarded-For and X-Real-IP headers are only used to check the ip restrictions of app tokens, to rate limit clients by their ip address and for the client ip passed to the policies when the request was received from one of them. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
 }
 
 // PoliciesMiddleware configures the proxy's policies middleware.
@@ -195,6 +197,39 @@ type SigningKeys struct {
 	AuthPassword       string        `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;PROXY_PRESIGNEDURL_SIGNING_KEYS_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"1.0.0"`
 }
 
+// RateLimit is the config for the rate limit middleware
+type RateLimit struct {
+	Enabled  bool            `yaml:"enabled" env:"PROXY_RATE_LIMIT_ENABLED" desc:"Enable rate limiting of requests. Requests are limited per authenticated user, app token, public link or client IP address." introductionVersion:"%%NEXT%%"`
+	Requests int             `yaml:"requests" env:"PROXY_RATE_LIMIT_REQUESTS" desc:"The number of requests a client is allowed to send in 'PROXY_RATE_LIMIT_PERIOD' for all routes without a rule of their own." introductionVersion:"%%NEXT%%"`
+	Period   time.Duration   `yaml:"period" env:"PROXY_RATE_LIMIT_PERIOD" desc:"The period 'PROXY_RATE_LIMIT_REQUESTS' applies to. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Burst    int             `yaml:"burst" env:"PROXY_RATE_LIMIT_BURST" desc:"The number of requests a client is allowed to send at once before being limited to 'PROXY_RATE_LIMIT_REQUESTS' per 'PROXY_RATE_LIMIT_PERIOD'. Defaults to 'PROXY_RATE_LIMIT_REQUESTS' when set to 0." introductionVersion:"%%NEXT%%"`
+	Rules    []RateLimitRule `yaml:"rules" desc:"A list of rules with their own limits for specific routes. The first matching rule is applied. This setting can only be configured in the configuration file and not via environment variables."`
+	Store    RateLimitStore  `yaml:"store"`
+
+	FailedAuthRequests int           `yaml:"failed_auth_requests" env:"PROXY_RATE_LIMIT_FAILED_AUTH_REQUESTS" desc:"The number of failed authentications a client IP address is allowed in 'PROXY_RATE_LIMIT_FAILED_AUTH_PERIOD'. Once exceeded, all requests of the address are rejected before they are authenticated until another attempt is allowed. Set to 0 to not limit failed authentications." introductionVersion:"%%NEXT%%"`
+	FailedAuthPeriod   time.Duration `yaml:"failed_auth_period" env:"PROXY_RATE_LIMIT_FAILED_AUTH_PERIOD" desc:"The period 'PROXY_RATE_LIMIT_FAILED_AUTH_REQUESTS' applies to. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// RateLimitRule defines the rate limit of a route
+type RateLimitRule struct {
+	// Method optionally limits the rule to this HTTP method
+	Method   string        `yaml:"method,omitempty" desc:"The HTTP method the rule applies to. The rule applies to all methods when empty."`
+	Endpoint string        `yaml:"endpoint" desc:"The path prefix of the requests the rule applies to."`
+	Requests int           `yaml:"requests" desc:"The number of requests a client is allowed to send in 'period'."`
+	Period   time.Duration `yaml:"period" desc:"The period 'requests' applies to."`
+	Burst    int           `yaml:"burst" desc:"The number of requests a client is allowed to send at once. Defaults to 'requests' when not set."`
+}
+
+// RateLimitStore is the store configuration of the rate limit middleware.
+type RateLimitStore struct {
+	Store        string   `yaml:"store" env:"PROXY_RATE_LIMIT_STORE" desc:"The type of the rate limit store. Supported values are: 'memory' and 'nats-js-kv'. Only 'nats-js-kv' shares the limits between multiple proxy instances. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string `yaml:"addresses" env:"OC_CACHE_STORE_NODES;PROXY_RATE_LIMIT_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string   `yaml:"database" env:"OC_CACHE_DATABASE" desc:"The database name the configured store should use. The 'nats-js-kv' store uses a key value bucket named after the database and the table." introductionVersion:"%%NEXT%%"`
+	Table        string   `yaml:"table" env:"PROXY_RATE_LIMIT_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	AuthUsername string   `yaml:"username" env:"OC_CACHE_AUTH_USERNAME;PROXY_RATE_LIMIT_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string   `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;PROXY_RATE_LIMIT_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
 // ClaimsSelectorConf is the config for the claims-selector
 type ClaimsSelectorConf struct {
 	DefaultPolicy         string `yaml:"default_policy"`
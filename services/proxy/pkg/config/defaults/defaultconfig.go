@@ -104,6 +104,21 @@ func DefaultConfig() *config.Config {
 		AuthMiddleware: config.AuthMiddleware{
 			AllowAppAuth: true,
 		},
+		RateLimit: config.RateLimit{
+			Enabled:  false,
+			Requests: 1200,
+			Period:   time.Minute,
+			Burst:    300,
+			// failed authentications are limited per client ip before the authentication
+			FailedAuthRequests: 20,
+			FailedAuthPeriod:   time.Minute,
+			Store: config.RateLimitStore{
+				Store:    "nats-js-kv", // the limits must be shared between all proxy instances
+				Nodes:    []string{"127.0.0.1:9233"},
+				Database: "proxy",
+				Table:    "rate-limits",
+			},
+		},
 	}
 }
 
@@ -269,6 +284,10 @@ func DefaultPolicies() []config.Policy {
 					Endpoint: "/graph/v1beta1/extensions/org.libregraph/activities",
 					Service:  "eu.opencloud.web.activitylog",
 				},
+				{
+					Endpoint: "/graph/v1beta1/extensions/org.libregraph/webhooks",
+					Service:  "eu.opencloud.web.webhooks",
+				},
 				{
 					Endpoint: "/graph/v1.0/invitations",
 					Service:  "eu.opencloud.web.invitations",
@@ -277,10 +296,23 @@ func DefaultPolicies() []config.Policy {
 					Endpoint: "/graph/",
 					Service:  "eu.opencloud.web.graph",
 				},
+				{
+					Endpoint:    "/scim/v2/",
+					Service:     "eu.opencloud.web.graph",
+					Unprotected: true,
+				},
+				{
+					Endpoint: "/api/v0/postprocessing",
+					Service:  "eu.opencloud.web.postprocessing",
+				},
 				{
 					Endpoint: "/api/v0/settings",
 					Service:  "eu.opencloud.web.settings",
 				},
+				{
+					Endpoint: "/api/v0/search",
+					Service:  "eu.opencloud.web.search",
+				},
 				{
 					Endpoint: "/auth-app/tokens",
 					Service:  "eu.opencloud.web.auth-app",
@@ -65,5 +65,29 @@ func Validate(cfg *config.Config) error {
 		return shared.MissingURLSigningSecret(cfg.Service.Name)
 	}
 
+	if cfg.RateLimit.Enabled {
+		if err := validateRateLimit(cfg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateRateLimit(cfg *config.Config) error {
+	if cfg.RateLimit.Store.Store != "memory" && cfg.RateLimit.Store.Store != "nats-js-kv" {
+		return fmt.Errorf("Invalid rate limit store '%s' in service %s. Possible values are: 'memory' or 'nats-js-kv'.", cfg.RateLimit.Store.Store, cfg.Service.Name)
+	}
+	if cfg.RateLimit.Requests <= 0 || cfg.RateLimit.Period <= 0 {
+		return fmt.Errorf("Invalid rate limit in service %s. 'requests' and 'period' must be greater than zero.", cfg.Service.Name)
+	}
+	for _, rule := range cfg.RateLimit.Rules {
+		if rule.Endpoint == "" || rule.Requests <= 0 || rule.Period <= 0 {
+			return fmt.Errorf(
+				"Invalid rate limit rule for endpoint '%s' in service %s. 'endpoint' must be set and 'requests' and 'period' must be greater than zero.",
+				rule.Endpoint, cfg.Service.Name,
+			)
+		}
+	}
 	return nil
 }
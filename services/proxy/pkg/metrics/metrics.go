@@ -18,6 +18,7 @@ type Metrics struct {
 	Errors    *prometheus.CounterVec
 	Duration  *prometheus.HistogramVec
 	BuildInfo *prometheus.GaugeVec
+	Throttled *prometheus.CounterVec
 }
 
 // New initializes the available metrics.
@@ -47,6 +48,12 @@ func New() *Metrics {
 			Name:      "build_info",
 			Help:      "Build Information",
 		}, []string{"version"}),
+		Throttled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "throttled_requests_total",
+			Help:      "How many requests were rejected by the rate limit",
+		}, []string{"client", "endpoint"}),
 	}
 
 	// Initialize the metrics with 0
@@ -57,5 +64,6 @@ func New() *Metrics {
 	_ = prometheus.Register(m.Errors)
 	_ = prometheus.Register(m.Duration)
 	_ = prometheus.Register(m.BuildInfo)
+	_ = prometheus.Register(m.Throttled)
 	return m
 }
@@ -1,11 +1,15 @@
 package middleware
 
 import (
+	gonet "net"
 	"net/http"
 
+	authpb "github.com/cs3org/go-cs3apis/cs3/auth/provider/v1beta1"
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	cs3rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/restrictions"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/userroles"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
@@ -16,6 +20,9 @@ type AppAuthAuthenticator struct {
 	Logger              log.Logger
 	RevaGatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 	UserRoleAssigner    userroles.UserRoleAssigner
+	// TrustedProxies are the proxies whose forwarded headers are used to check the ip
+	// restrictions of app tokens
+	TrustedProxies []*gonet.IPNet
 }
 
 // Authenticate implements the authenticator interface to authenticate requests via app auth.
@@ -49,6 +56,22 @@ func (m AppAuthAuthenticator) Authenticate(r *http.Request) (*http.Request, bool
 		return nil, false
 	}
 
+	// the restrictions of the app token are carried in the scopes of the reva token
+	scopes, err := tokenScopes(authenticateResponse.GetToken())
+	if err != nil {
+		m.Logger.Error().Err(err).Str("clientid", username).Msg("app auth: failed to read the token scopes")
+		return nil, false
+	}
+	restr, err := restrictions.FromScopes(scopes)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("clientid", username).Msg("app auth: failed to read the token restrictions")
+		return nil, false
+	}
+	if err := restr.Check(r, trustedClientIP(r, m.TrustedProxies)); err != nil {
+		m.Logger.Debug().Err(err).Str("clientid", username).Str("path", r.URL.Path).Msg("app auth: request not allowed")
+		return nil, false
+	}
+
 	user := authenticateResponse.GetUser()
 	if user, err = m.UserRoleAssigner.ApplyUserRole(r.Context(), user); err != nil {
 		m.Logger.Error().Err(err).Str("clientid", username).Msg("app auth: failed to load user roles")
@@ -57,8 +80,23 @@ func (m AppAuthAuthenticator) Authenticate(r *http.Request) (*http.Request, bool
 
 	ctx := revactx.ContextSetUser(r.Context(), user)
 	ctx = revactx.ContextSetToken(ctx, authenticateResponse.GetToken())
+	// every app token gets its own rate limit
+	ctx = withRateLimitClient(ctx, rateLimitClientAppToken, username+":"+password)
 
 	r = r.WithContext(ctx)
 
 	return r, true
 }
+
+// tokenScopes returns the scopes of a reva token. The token was just issued by the gateway,
+// so the signature doesn't need to be verified.
+func tokenScopes(token string) (map[string]*authpb.Scope, error) {
+	claims := &struct {
+		jwt.RegisteredClaims
+		Scope map[string]*authpb.Scope `json:"scope"`
+	}{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+	return claims.Scope, nil
+}
@@ -1,25 +1,57 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 
+	authpb "github.com/cs3org/go-cs3apis/cs3/auth/provider/v1beta1"
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/restrictions"
 	userRoleMocks "github.com/opencloud-eu/opencloud/services/proxy/pkg/userroles/mocks"
+	"github.com/opencloud-eu/reva/v2/pkg/auth/scope"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/token/manager/jwt"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
 )
 
+// mintAppAuthToken mints a reva token like the gateway does for app tokens with the given restrictions
+func mintAppAuthToken(r restrictions.Restrictions) string {
+	scopes, err := scope.AddOwnerScope(map[string]*authpb.Scope{})
+	Expect(err).ToNot(HaveOccurred())
+	scopes, err = restrictions.AddScope(r, scopes)
+	Expect(err).ToNot(HaveOccurred())
+
+	manager, err := jwt.New(map[string]interface{}{"secret": "loremipsum"})
+	Expect(err).ToNot(HaveOccurred())
+	token, err := manager.MintToken(context.Background(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "test-user"}}, scopes)
+	Expect(err).ToNot(HaveOccurred())
+	return token
+}
+
 var _ = Describe("Authenticating requests", Label("AppAuthAuthenticator"), func() {
-	var authenticator Authenticator
+	var (
+		authenticator Authenticator
+		revaToken     string
+		restricted    = restrictions.Restrictions{
+			Spaces:   []string{"storage$space1"},
+			Path:     "/Photos",
+			ReadOnly: true,
+			APIs:     []string{restrictions.APIWebDAV},
+			IPRanges: []string{"192.0.2.0/24"},
+		}
+		trustedProxies, _ = ParseTrustedProxies([]string{"10.0.0.0/8", "203.0.113.7"})
+	)
 	BeforeEach(func() {
+		revaToken = mintAppAuthToken(restrictions.Restrictions{})
 		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
 		ra := &userRoleMocks.UserRoleAssigner{}
 		ra.On("ApplyUserRole", mock.Anything, mock.Anything, mock.Anything).Return(&userv1beta1.User{}, nil)
@@ -36,7 +68,11 @@ var _ = Describe("Authenticating requests", Label("AppAuthAuthenticator"), func(
 							}
 
 							if clientID == "test-user" && clientSecret == "AppPassword" {
-								return "reva-token", rpcv1beta1.Code_CODE_OK
+								return revaToken, rpcv1beta1.Code_CODE_OK
+							}
+
+							if clientID == "test-user" && clientSecret == "RestrictedAppPassword" {
+								return mintAppAuthToken(restricted), rpcv1beta1.Code_CODE_OK
 							}
 
 							return "", rpcv1beta1.Code_CODE_NOT_FOUND
@@ -45,6 +81,7 @@ var _ = Describe("Authenticating requests", Label("AppAuthAuthenticator"), func(
 				},
 			),
 			UserRoleAssigner: ra,
+			TrustedProxies:   trustedProxies,
 		}
 	})
 
@@ -62,7 +99,7 @@ var _ = Describe("Authenticating requests", Label("AppAuthAuthenticator"), func(
 			Expect(user).ToNot(BeNil())
 			token, ok := revactx.ContextGetToken(req2.Context())
 			Expect(ok).To(BeTrue())
-			Expect(token).To(Equal("reva-token"))
+			Expect(token).To(Equal(revaToken))
 		})
 	})
 
@@ -77,4 +114,75 @@ var _ = Describe("Authenticating requests", Label("AppAuthAuthenticator"), func(
 			Expect(req2).To(BeNil())
 		})
 	})
+
+	When("the app token is restricted", func() {
+		restrictedRequest := func(method, target, remoteAddr string) *http.Request {
+			req := httptest.NewRequest(method, target, http.NoBody)
+			req.SetBasicAuth("test-user", "RestrictedAppPassword")
+			req.RemoteAddr = remoteAddr
+			return req
+		}
+
+		DescribeTable("should authenticate allowed requests",
+			func(method, target, remoteAddr string) {
+				req2, valid := authenticator.Authenticate(restrictedRequest(method, target, remoteAddr))
+				Expect(valid).To(BeTrue())
+				Expect(req2).ToNot(BeNil())
+			},
+			Entry("allowed path", "PROPFIND", "http://example.com/dav/spaces/storage$space1/Photos/2026", "192.0.2.10:4711"),
+			Entry("space root reference", "PROPFIND", "http://example.com/dav/spaces/storage$space1!space1/Photos/2026", "192.0.2.10:4711"),
+		)
+
+		DescribeTable("should not authenticate denied requests",
+			func(method, target, remoteAddr string) {
+				req2, valid := authenticator.Authenticate(restrictedRequest(method, target, remoteAddr))
+				Expect(valid).To(BeFalse())
+				Expect(req2).To(BeNil())
+			},
+			Entry("other ip", "PROPFIND", "http://example.com/dav/spaces/storage$space1/Photos", "198.51.100.1:4711"),
+			Entry("other api", http.MethodGet, "http://example.com/graph/v1.0/drives/storage$space1", "192.0.2.10:4711"),
+			Entry("write request", http.MethodPut, "http://example.com/dav/spaces/storage$space1/Photos/a.jpg", "192.0.2.10:4711"),
+			Entry("other space", "PROPFIND", "http://example.com/dav/spaces/storage$space2/Photos", "192.0.2.10:4711"),
+			Entry("search in an allowed space", "REPORT", "http://example.com/dav/spaces/storage$space1/Photos", "192.0.2.10:4711"),
+			Entry("search method in an allowed space", "SEARCH", "http://example.com/dav/spaces/storage$space1", "192.0.2.10:4711"),
+			Entry("other path", "PROPFIND", "http://example.com/dav/spaces/storage$space1/Documents", "192.0.2.10:4711"),
+			Entry("path traversal", "PROPFIND", "http://example.com/dav/spaces/storage$space1/Photos/../Documents", "192.0.2.10:4711"),
+			Entry("path without space", "PROPFIND", "http://example.com/remote.php/webdav/Photos", "192.0.2.10:4711"),
+			Entry("path relative to a node", "PROPFIND", "http://example.com/dav/spaces/storage$space1!secret-node/Photos", "192.0.2.10:4711"),
+			Entry("path relative to an escaped node", "PROPFIND", "http://example.com/dav/spaces/storage%24space1%21secret-node/Photos", "192.0.2.10:4711"),
+		)
+
+		It("should not allow a destination relative to a node", func() {
+			req := restrictedRequest("PROPFIND", "http://example.com/dav/spaces/storage$space1/Photos/a.jpg", "192.0.2.10:4711")
+			req.Header.Set("Destination", "http://example.com/dav/spaces/storage$space1!secret-node/Photos/a.jpg")
+			req2, valid := authenticator.Authenticate(req)
+			Expect(valid).To(BeFalse())
+			Expect(req2).To(BeNil())
+		})
+
+		DescribeTable("should only use the forwarded headers of trusted proxies",
+			func(remoteAddr string, header http.Header, allowed bool) {
+				req := restrictedRequest("PROPFIND", "http://example.com/dav/spaces/storage$space1/Photos", remoteAddr)
+				for k, v := range header {
+					req.Header[k] = v
+				}
+				// run the request through the middlewares in front of the authentication
+				var req2 *http.Request
+				var valid bool
+				SocketAddr(chimiddleware.RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					req2, valid = authenticator.Authenticate(r)
+				}))).ServeHTTP(httptest.NewRecorder(), req)
+				Expect(valid).To(Equal(allowed))
+				Expect(req2 != nil).To(Equal(allowed))
+			},
+			Entry("untrusted peer with forwarded for", "198.51.100.1:4711", http.Header{"X-Forwarded-For": {"192.0.2.10"}}, false),
+			Entry("untrusted peer with real ip", "198.51.100.1:4711", http.Header{"X-Real-Ip": {"192.0.2.10"}}, false),
+			Entry("trusted peer with forwarded for", "10.0.0.1:4711", http.Header{"X-Forwarded-For": {"192.0.2.10"}}, true),
+			Entry("trusted peer with real ip", "203.0.113.7:4711", http.Header{"X-Real-Ip": {"192.0.2.10"}}, true),
+			Entry("trusted peers with forwarded for", "10.0.0.1:4711", http.Header{"X-Forwarded-For": {"192.0.2.10, 10.0.0.2"}}, true),
+			Entry("spoofed forwarded for", "10.0.0.1:4711", http.Header{"X-Forwarded-For": {"192.0.2.10, 198.51.100.1"}}, false),
+			Entry("spoofed forwarded for header", "10.0.0.1:4711", http.Header{"X-Forwarded-For": {"192.0.2.10", "198.51.100.1"}}, false),
+			Entry("trusted peer without forwarded headers", "10.0.0.1:4711", http.Header{}, false),
+		)
+	})
 })
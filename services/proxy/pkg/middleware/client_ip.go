@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	gonet "net"
+	"net/http"
+	"strings"
+)
+
+type socketAddrKey struct{}
+
+// SocketAddr keeps the address of the peer the request was received from. It must run before
+// the RealIP middleware, which replaces the remote address with the value of the forwarded headers.
+func SocketAddr(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), socketAddrKey{}, r.RemoteAddr)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ParseTrustedProxies parses a list of ip addresses and networks in CIDR notation.
+func ParseTrustedProxies(proxies []string) ([]*gonet.IPNet, error) {
+	networks := make([]*gonet.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			ip := gonet.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s'", p)
+			}
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &gonet.IPNet{IP: ip, Mask: gonet.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := gonet.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", p, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// trustedClientIP returns the ip address of the client. The forwarded headers are only
+// honoured when the request was received from a trusted proxy, the X-Forwarded-For header
+// is followed from the right as long as the addresses are trusted proxies.
+func trustedClientIP(r *http.Request, trustedProxies []*gonet.IPNet) string {
+	addr, ok := r.Context().Value(socketAddrKey{}).(string)
+	if !ok {
+		addr = r.RemoteAddr
+	}
+	host, _, err := gonet.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip := gonet.ParseIP(host)
+	if ip == nil || !trusted(ip, trustedProxies) {
+		return host
+	}
+
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := gonet.ParseIP(strings.TrimSpace(hops[i]))
+			if hop == nil {
+				break
+			}
+			ip = hop
+			if !trusted(ip, trustedProxies) {
+				break
+			}
+		}
+		return ip.String()
+	}
+	if realIP := gonet.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+		return realIP.String()
+	}
+	return ip.String()
+}
+
+func trusted(ip gonet.IP, trustedProxies []*gonet.IPNet) bool {
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	gonet "net"
 	"net/http"
 	"time"
 
@@ -74,6 +75,10 @@ type Options struct {
 	// MultiTenantEnabled causes the account resolve middleware to reject users that don't have a tenant id assigned
 	MultiTenantEnabled bool
 	EventsPublisher    events.Publisher
+	// RateLimitBuckets keeps the state of the rate limits
+	RateLimitBuckets RateLimitBuckets
+	// TrustedProxies are the proxies whose forwarded headers are used to identify the client ip
+	TrustedProxies []*gonet.IPNet
 }
 
 // newOptions initializes the available default options.
@@ -254,3 +259,17 @@ func EventsPublisher(ep events.Publisher) Option {
 		o.EventsPublisher = ep
 	}
 }
+
+// WithRateLimitBuckets sets the buckets of the rate limit middleware.
+func WithRateLimitBuckets(val RateLimitBuckets) Option {
+	return func(o *Options) {
+		o.RateLimitBuckets = val
+	}
+}
+
+// TrustedProxies sets the proxies whose forwarded headers are trusted.
+func TrustedProxies(val []*gonet.IPNet) Option {
+	return func(o *Options) {
+		o.TrustedProxies = val
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,8 +15,12 @@ import (
 	tusd "github.com/tus/tusd/v2/pkg/handler"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/mime"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 
@@ -41,6 +46,10 @@ type (
 
 const DeniedMessage = "Operation denied due to security policies"
 
+// spaceTypeMethods are the methods the space type of the target is looked up for, looking it up for
+// every request would be too expensive
+var spaceTypeMethods = []string{http.MethodPut, http.MethodPost, http.MethodPatch, "MKCOL", "COPY", "MOVE"}
+
 // Policies verifies if a request is granted or not.
 func Policies(qs string, opts ...Option) func(next http.Handler) http.Handler {
 	options := newOptions(opts...)
@@ -48,6 +57,7 @@ func Policies(qs string, opts ...Option) func(next http.Handler) http.Handler {
 	tracer := getTraceProvider(options).Tracer("proxy.middleware.policies")
 	gatewaySelector := options.RevaGatewaySelector
 	policiesProviderClient := options.PoliciesProviderService
+	trustedProxies := options.TrustedProxies
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -64,10 +74,13 @@ func Policies(qs string, opts ...Option) func(next http.Handler) http.Handler {
 				Query: qs,
 				Environment: &pMessage.Environment{
 					Request: &pMessage.Request{
-						Method: r.Method,
-						Path:   r.URL.Path,
+						Method:    r.Method,
+						Path:      r.URL.Path,
+						ClientIp:  trustedClientIP(r, trustedProxies),
+						UserAgent: r.UserAgent(),
 					},
 					Stage: pMessage.Stage_STAGE_HTTP,
+					Time:  timestamppb.Now(),
 				},
 			}
 
@@ -116,6 +129,15 @@ func Policies(qs string, opts ...Option) func(next http.Handler) http.Handler {
 				resource.Name = sRes.GetInfo().GetName()
 			}
 
+			// the type is derived from the name, the filetype of the upload metadata is set by the client
+			if resource.Name != "" {
+				resource.MimeType = mime.Detect(false, resource.Name)
+			}
+
+			if slices.Contains(spaceTypeMethods, r.Method) {
+				resource.SpaceType = spaceType(r, gatewaySelector)
+			}
+
 			req.Environment.Resource = resource
 
 			if user, ok := revactx.ContextGetUser(r.Context()); ok {
@@ -173,3 +195,34 @@ func RenderError(w http.ResponseWriter, r *http.Request, evaluateReq *pService.E
 	render.Status(r, status)
 	render.JSON(w, r, resp)
 }
+
+// spaceType looks up the type of the space a webdav request targets
+func spaceType(r *http.Request, gatewaySelector pool.Selectable[gateway.GatewayAPIClient]) string {
+	var id string
+	for _, prefix := range []string{"/remote.php/dav/spaces/", "/dav/spaces/"} {
+		if rest, ok := strings.CutPrefix(r.URL.Path, prefix); ok {
+			id, _, _ = strings.Cut(rest, "/")
+			break
+		}
+	}
+	if id == "" {
+		return ""
+	}
+
+	storageID, spaceID, _, err := storagespace.SplitID(id)
+	if err != nil {
+		return ""
+	}
+
+	client, err := gatewaySelector.Next()
+	if err != nil {
+		return ""
+	}
+
+	ctx := metadata.AppendToOutgoingContext(r.Context(), revactx.TokenHeader, r.Header.Get(revactx.TokenHeader))
+	space, err := utils.GetSpace(ctx, storagespace.FormatStorageID(storageID, spaceID), client)
+	if err != nil {
+		return ""
+	}
+	return space.GetSpaceType()
+}
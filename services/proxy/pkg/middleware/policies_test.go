@@ -9,8 +9,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	. "github.com/onsi/gomega"
 	pMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/policies/v0"
 	policiesPG "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/policies/v0"
@@ -97,28 +101,41 @@ func TestPolicies_EvaluationEnvironment_Request(t *testing.T) {
 		func(_ context.Context, in *policiesPG.EvaluateRequest, _ ...client.CallOption) (*policiesPG.EvaluateResponse, error) {
 			g.Expect(in.Environment.Request.Method).To(Equal(http.MethodDelete))
 			g.Expect(in.Environment.Request.Path).To(Equal("/whatever"))
+			g.Expect(in.Environment.Request.ClientIp).To(Equal("192.0.2.1"))
+			g.Expect(in.Environment.Request.UserAgent).To(Equal("curl/8.0"))
+			g.Expect(in.Environment.Time.AsTime()).To(BeTemporally("~", time.Now(), time.Minute))
 
 			return &policiesPG.EvaluateResponse{Result: false}, nil
 		},
 	)
 
+	request := httptest.NewRequest(http.MethodDelete, "/whatever", nil)
+	request.Header.Set("User-Agent", "curl/8.0")
 	responseRecorder := httptest.NewRecorder()
-	policiesMiddleware.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodDelete, "/whatever", nil))
+	policiesMiddleware.ServeHTTP(responseRecorder, request)
 }
 
 func TestPolicies_EvaluationEnvironment_Resource(t *testing.T) {
 	var g = NewWithT(t)
 
-	policiesMiddleware, policiesProviderService, _ := prepare("any")
+	policiesMiddleware, policiesProviderService, gatewayClient := prepare("any")
+	gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&provider.ListStorageSpacesResponse{
+		Status:        &rpc.Status{Code: rpc.Code_CODE_OK},
+		StorageSpaces: []*provider.StorageSpace{{SpaceType: "project"}},
+	}, nil)
 
 	// tus metadata
 	{
 		responseRecorder := httptest.NewRecorder()
 		request := httptest.NewRequest(http.MethodPost, "/remote.php/dav/spaces", nil)
-		request.Header.Set(net.HeaderUploadMetadata, fmt.Sprintf("filename %v", base64.StdEncoding.EncodeToString([]byte("tus-file-name.png"))))
+		request.Header.Set(net.HeaderUploadMetadata, fmt.Sprintf("filename %v,filetype %v",
+			base64.StdEncoding.EncodeToString([]byte("tus-file-name.png")),
+			base64.StdEncoding.EncodeToString([]byte("text/plain")),
+		))
 		policiesProviderService.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(
 			func(_ context.Context, in *policiesPG.EvaluateRequest, _ ...client.CallOption) (*policiesPG.EvaluateResponse, error) {
 				g.Expect(in.Environment.Resource.Name).To(Equal("tus-file-name.png"))
+				g.Expect(in.Environment.Resource.MimeType).To(Equal("image/png"))
 
 				return &policiesPG.EvaluateResponse{Result: false}, nil
 			},
@@ -132,6 +149,8 @@ func TestPolicies_EvaluationEnvironment_Resource(t *testing.T) {
 		policiesProviderService.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(
 			func(_ context.Context, in *policiesPG.EvaluateRequest, _ ...client.CallOption) (*policiesPG.EvaluateResponse, error) {
 				g.Expect(in.Environment.Resource.Name).To(Equal("simple-file-name.png"))
+				g.Expect(in.Environment.Resource.MimeType).To(Equal("image/png"))
+				g.Expect(in.Environment.Resource.SpaceType).To(Equal("project"))
 
 				return &policiesPG.EvaluateResponse{Result: false}, nil
 			},
@@ -140,7 +159,39 @@ func TestPolicies_EvaluationEnvironment_Resource(t *testing.T) {
 	}
 }
 
-func prepare(q string) (http.Handler, *mocks.PoliciesProviderService, *cs3mocks.GatewayAPIClient) {
+func TestPolicies_EvaluationEnvironment_ForgedClientIP(t *testing.T) {
+	var g = NewWithT(t)
+
+	trustedProxies, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for remoteAddr, clientIP := range map[string]string{
+		// the forwarded headers of clients are ignored
+		"192.0.2.1:4711": "192.0.2.1",
+		"10.0.0.1:4711":  "198.51.100.1",
+	} {
+		policiesMiddleware, policiesProviderService, _ := prepare("any", middleware.TrustedProxies(trustedProxies))
+		policiesProviderService.On("Evaluate", mock.Anything, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, in *policiesPG.EvaluateRequest, _ ...client.CallOption) (*policiesPG.EvaluateResponse, error) {
+				g.Expect(in.Environment.Request.ClientIp).To(Equal(clientIP))
+
+				return &policiesPG.EvaluateResponse{Result: true}, nil
+			},
+		).Once()
+
+		request := httptest.NewRequest(http.MethodGet, "/whatever", nil)
+		request.RemoteAddr = remoteAddr
+		request.Header.Set("X-Forwarded-For", "198.51.100.1")
+		request.Header.Set("X-Real-IP", "198.51.100.1")
+		responseRecorder := httptest.NewRecorder()
+		middleware.SocketAddr(chimiddleware.RealIP(policiesMiddleware)).ServeHTTP(responseRecorder, request)
+
+		g.Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		policiesProviderService.AssertExpectations(t)
+	}
+}
+
+func prepare(q string, opts ...middleware.Option) (http.Handler, *mocks.PoliciesProviderService, *cs3mocks.GatewayAPIClient) {
 
 	// mocked gatewaySelector
 	gatewayClient := &cs3mocks.GatewayAPIClient{}
@@ -159,8 +210,10 @@ func prepare(q string) (http.Handler, *mocks.PoliciesProviderService, *cs3mocks.
 	// spin up middleware
 	policiesMiddleware := middleware.Policies(
 		q,
-		middleware.WithRevaGatewaySelector(gatewaySelector),
-		middleware.PoliciesProviderService(policiesProviderService),
+		append([]middleware.Option{
+			middleware.WithRevaGatewaySelector(gatewaySelector),
+			middleware.PoliciesProviderService(policiesProviderService),
+		}, opts...)...,
 	)(mockHandler{})
 
 	return policiesMiddleware, policiesProviderService, gatewayClient
@@ -120,6 +120,7 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 	}
 
 	r.Header.Add(headerRevaAccessToken, authResp.Token)
+	r = r.WithContext(withRateLimitClient(r.Context(), rateLimitClientPublicLink, shareToken))
 
 	a.Logger.Debug().
 		Str("authenticator", "public_share").
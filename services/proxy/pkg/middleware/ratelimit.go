@@ -0,0 +1,240 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	gonet "net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/metrics"
+)
+
+const (
+	// TooManyRequestsMessage is the message of the error returned to throttled clients
+	TooManyRequestsMessage = "Too many requests, please try again later"
+
+	rateLimitClientUser       = "user"
+	rateLimitClientAppToken   = "app_token"
+	rateLimitClientPublicLink = "public_link"
+	rateLimitClientIP         = "ip"
+
+	rateLimitDefaultEndpoint    = "default"
+	rateLimitFailedAuthEndpoint = "failed_authentication"
+)
+
+// rateLimitClientKey is the context key of the client set by the authenticators that don't
+// identify the client by the user alone
+type rateLimitClientKey struct{}
+
+type rateLimitClientID struct {
+	client string
+	id     string
+}
+
+// withRateLimitClient sets the client the rate limit of the request is applied to
+func withRateLimitClient(ctx context.Context, client, id string) context.Context {
+	return context.WithValue(ctx, rateLimitClientKey{}, rateLimitClientID{client: client, id: id})
+}
+
+// RateLimit limits the number of requests a client can send. Clients are identified by the
+// public link or the app token the request was authenticated with, the authenticated user or
+// the client ip, in this order. The state of the limits is kept in the configured buckets,
+// the NATS key value buckets are shared by all proxy instances.
+func RateLimit(cfg config.RateLimit, m metrics.Metrics, opts ...Option) func(next http.Handler) http.Handler {
+	options := newOptions(opts...)
+
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled {
+			return next
+		}
+		return &rateLimit{
+			next:           next,
+			logger:         options.Logger,
+			buckets:        options.RateLimitBuckets,
+			trustedProxies: options.TrustedProxies,
+			metrics:        m,
+			cfg:            cfg,
+			now:            time.Now,
+		}
+	}
+}
+
+type rateLimit struct {
+	next           http.Handler
+	logger         log.Logger
+	buckets        RateLimitBuckets
+	trustedProxies []*gonet.IPNet
+	metrics        metrics.Metrics
+	cfg            config.RateLimit
+	now            func() time.Time
+}
+
+func (m *rateLimit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rule := m.rule(r)
+	client, id := rateLimitClient(r, m.trustedProxies)
+
+	retryAfter, err := m.buckets.Take(r.Context(), rateLimitKey(client, id, rule.Endpoint), rule, m.now())
+	if err != nil {
+		// don't lock out everybody because the store is unavailable
+		m.logger.Error().Err(err).Str("client", client).Msg("could not apply the rate limit")
+		m.next.ServeHTTP(w, r)
+		return
+	}
+
+	if retryAfter > 0 {
+		m.metrics.Throttled.With(prometheus.Labels{"client": client, "endpoint": rule.Endpoint}).Inc()
+		m.logger.Debug().Str("client", client).Str("endpoint", rule.Endpoint).Str("path", r.URL.Path).Msg("request throttled")
+		tooManyRequests(w, r, retryAfter)
+		return
+	}
+
+	m.next.ServeHTTP(w, r)
+}
+
+// FailedAuthRateLimit limits the number of failed authentications per client ip. It has to be
+// added before the authentication, once a client exhausted its failed authentications all of its
+// requests are rejected before they are authenticated, until the limit allows another attempt.
+// Every response with the status 401 counts as a failed authentication.
+func FailedAuthRateLimit(cfg config.RateLimit, m metrics.Metrics, opts ...Option) func(next http.Handler) http.Handler {
+	options := newOptions(opts...)
+
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled || cfg.FailedAuthRequests <= 0 {
+			return next
+		}
+		return &failedAuthRateLimit{
+			next:           next,
+			logger:         options.Logger,
+			buckets:        options.RateLimitBuckets,
+			trustedProxies: options.TrustedProxies,
+			metrics:        m,
+			rule:           failedAuthRule(cfg),
+			now:            time.Now,
+		}
+	}
+}
+
+type failedAuthRateLimit struct {
+	next           http.Handler
+	logger         log.Logger
+	buckets        RateLimitBuckets
+	trustedProxies []*gonet.IPNet
+	metrics        metrics.Metrics
+	rule           config.RateLimitRule
+	now            func() time.Time
+}
+
+func (m *failedAuthRateLimit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := rateLimitKey(rateLimitClientIP, trustedClientIP(r, m.trustedProxies), m.rule.Endpoint)
+
+	retryAfter, err := m.buckets.Wait(r.Context(), key, m.rule, m.now())
+	if err != nil {
+		// don't lock out everybody because the store is unavailable
+		m.logger.Error().Err(err).Msg("could not apply the failed authentication rate limit")
+	}
+	if retryAfter > 0 {
+		m.metrics.Throttled.With(prometheus.Labels{"client": rateLimitClientIP, "endpoint": m.rule.Endpoint}).Inc()
+		m.logger.Debug().Str("path", r.URL.Path).Msg("request throttled after failed authentications")
+		tooManyRequests(w, r, retryAfter)
+		return
+	}
+
+	ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+	m.next.ServeHTTP(ww, r)
+
+	if ww.Status() == http.StatusUnauthorized {
+		if _, err := m.buckets.Take(r.Context(), key, m.rule, m.now()); err != nil {
+			m.logger.Error().Err(err).Msg("could not count the failed authentication")
+		}
+	}
+}
+
+// failedAuthRule returns the rule of the failed authentications of a client ip
+func failedAuthRule(cfg config.RateLimit) config.RateLimitRule {
+	return config.RateLimitRule{
+		Endpoint: rateLimitFailedAuthEndpoint,
+		Requests: cfg.FailedAuthRequests,
+		Period:   cfg.FailedAuthPeriod,
+	}
+}
+
+// tooManyRequests renders the error returned to throttled clients
+func tooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	render.Status(r, http.StatusTooManyRequests)
+	render.JSON(w, r, &RequestDenied{
+		Error: RequestDeniedError{
+			Code:    "tooManyRequests",
+			Message: TooManyRequestsMessage,
+			Innererror: map[string]interface{}{
+				"date":       time.Now().UTC().Format(time.RFC3339),
+				"request-id": middleware.GetReqID(r.Context()),
+			},
+		},
+	})
+}
+
+// rule returns the first rule matching the request or the default limit
+func (m *rateLimit) rule(r *http.Request) config.RateLimitRule {
+	for _, rule := range m.cfg.Rules {
+		if (rule.Method == "" || strings.EqualFold(rule.Method, r.Method)) && strings.HasPrefix(r.URL.Path, rule.Endpoint) {
+			return rule
+		}
+	}
+	return config.RateLimitRule{
+		Endpoint: rateLimitDefaultEndpoint,
+		Requests: m.cfg.Requests,
+		Period:   m.cfg.Period,
+		Burst:    m.cfg.Burst,
+	}
+}
+
+// rateLimitClient identifies the client sending the request. Public link and app tokens are
+// only used when the request was authenticated with them, so clients can't pick the bucket
+// they are limited by.
+func rateLimitClient(r *http.Request, trustedProxies []*gonet.IPNet) (string, string) {
+	if c, ok := r.Context().Value(rateLimitClientKey{}).(rateLimitClientID); ok {
+		return c.client, c.id
+	}
+	if u, ok := revactx.ContextGetUser(r.Context()); ok {
+		return rateLimitClientUser, u.GetId().GetOpaqueId()
+	}
+	return rateLimitClientIP, trustedClientIP(r, trustedProxies)
+}
+
+// rateLimitKey returns the store key of the bucket. The key is hashed to not leak tokens to the store
+// and to only use characters all stores support.
+func rateLimitKey(client, id, endpoint string) string {
+	sum := sha256.Sum256([]byte(client + "\x00" + id + "\x00" + endpoint))
+	return hex.EncodeToString(sum[:])
+}
+
+// RateLimitTTL returns the longest time a bucket needs to be refilled completely. Buckets which
+// were not used for this long can be removed from the store.
+func RateLimitTTL(cfg config.RateLimit) time.Duration {
+	var ttl time.Duration
+	rules := append([]config.RateLimitRule{{Requests: cfg.Requests, Period: cfg.Period, Burst: cfg.Burst}, failedAuthRule(cfg)}, cfg.Rules...)
+	for _, rule := range rules {
+		if rule.Requests <= 0 {
+			continue
+		}
+		refill := rule.Period
+		if rule.Burst > rule.Requests {
+			refill = rule.Period * time.Duration(rule.Burst) / time.Duration(rule.Requests)
+		}
+		ttl = max(ttl, refill)
+	}
+	return ttl
+}
@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+)
+
+// _rateLimitMaxConflicts is the number of times a bucket is read again when it was changed
+// by another request while it was updated
+const _rateLimitMaxConflicts = 5
+
+// RateLimitBuckets keeps the token buckets of the rate limits
+type RateLimitBuckets interface {
+	// Take takes a token from the bucket stored under key. It returns how long the client has to
+	// wait for the next token when the bucket is empty.
+	Take(ctx context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error)
+
+	// Wait returns how long the client has to wait for the next token of the bucket stored under key
+	// without taking a token.
+	Wait(ctx context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error)
+}
+
+// tokenBucket is the state of a rate limit
+type tokenBucket struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// bucketRate returns the capacity of the buckets of a rule and the tokens added per second
+func bucketRate(rule config.RateLimitRule) (float64, float64) {
+	capacity := float64(rule.Burst)
+	if rule.Burst <= 0 {
+		capacity = float64(rule.Requests)
+	}
+	return capacity, float64(rule.Requests) / rule.Period.Seconds()
+}
+
+// take refills the bucket and takes a token from it. It returns how long the client has to wait
+// for the next token when the bucket is empty, the bucket is left unchanged in that case.
+func (b *tokenBucket) take(rule config.RateLimitRule, now time.Time) time.Duration {
+	tokens, wait := b.tokens(rule, now)
+	if wait > 0 {
+		return wait
+	}
+	b.Tokens, b.Updated = tokens-1, now
+	return 0
+}
+
+// tokens returns the tokens of the refilled bucket and how long the client has to wait for
+// the next token when the bucket is empty
+func (b *tokenBucket) tokens(rule config.RateLimitRule, now time.Time) (float64, time.Duration) {
+	capacity, rate := bucketRate(rule)
+
+	tokens := capacity
+	if !b.Updated.IsZero() {
+		tokens = math.Min(capacity, b.Tokens+now.Sub(b.Updated).Seconds()*rate)
+	}
+	if tokens < 1 {
+		return tokens, time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+	return tokens, 0
+}
+
+// memoryBuckets keeps the buckets in memory, the limits only apply to a single proxy instance
+type memoryBuckets struct {
+	ttl time.Duration
+
+	// mu guards the map, every bucket has a lock of its own
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	swept   time.Time
+}
+
+type memoryBucket struct {
+	mu sync.Mutex
+	tokenBucket
+}
+
+// NewMemoryRateLimitBuckets returns buckets kept in memory. Buckets that weren't used for the ttl
+// are removed.
+func NewMemoryRateLimitBuckets(ttl time.Duration) RateLimitBuckets {
+	return &memoryBuckets{
+		ttl:     ttl,
+		buckets: map[string]*memoryBucket{},
+	}
+}
+
+// Take implements the RateLimitBuckets interface
+func (m *memoryBuckets) Take(_ context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error) {
+	m.mu.Lock()
+	if now.Sub(m.swept) > m.ttl {
+		m.sweep(now)
+	}
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{}
+		m.buckets[key] = bucket
+	}
+	m.mu.Unlock()
+
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	return bucket.take(rule, now), nil
+}
+
+// Wait implements the RateLimitBuckets interface
+func (m *memoryBuckets) Wait(_ context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error) {
+	m.mu.Lock()
+	bucket, ok := m.buckets[key]
+	m.mu.Unlock()
+	if !ok {
+		return 0, nil
+	}
+
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	_, wait := bucket.tokens(rule, now)
+	return wait, nil
+}
+
+// sweep removes the buckets that are full again, the caller must hold the lock
+func (m *memoryBuckets) sweep(now time.Time) {
+	for key, bucket := range m.buckets {
+		bucket.mu.Lock()
+		if now.Sub(bucket.Updated) > m.ttl {
+			delete(m.buckets, key)
+		}
+		bucket.mu.Unlock()
+	}
+	m.swept = now
+}
+
+// kvBuckets keeps the buckets in a NATS key value bucket, so the limits hold across all proxy
+// instances using it. Buckets are only written when their revision didn't change since they were
+// read, concurrent requests of a client can't take the same token.
+type kvBuckets struct {
+	kv jetstream.KeyValue
+}
+
+// NewKVRateLimitBuckets returns buckets kept in a NATS key value bucket. The key value bucket
+// should expire entries after the RateLimitTTL.
+func NewKVRateLimitBuckets(kv jetstream.KeyValue) RateLimitBuckets {
+	return kvBuckets{kv: kv}
+}
+
+// Take implements the RateLimitBuckets interface
+func (k kvBuckets) Take(ctx context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error) {
+	for range _rateLimitMaxConflicts {
+		var bucket tokenBucket
+		var revision uint64
+		entry, err := k.kv.Get(ctx, key)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(entry.Value(), &bucket); err != nil {
+				return 0, err
+			}
+			revision = entry.Revision()
+		case !errors.Is(err, jetstream.ErrKeyNotFound):
+			return 0, err
+		}
+
+		if retryAfter := bucket.take(rule, now); retryAfter > 0 {
+			return retryAfter, nil
+		}
+
+		value, err := json.Marshal(bucket)
+		if err != nil {
+			return 0, err
+		}
+		if revision == 0 {
+			_, err = k.kv.Create(ctx, key, value)
+		} else {
+			_, err = k.kv.Update(ctx, key, value, revision)
+		}
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			// another request took a token in the meantime
+			continue
+		}
+		return 0, err
+	}
+
+	// the client keeps sending requests at the same time, let it wait for the next token
+	_, rate := bucketRate(rule)
+	return time.Duration(float64(time.Second) / rate), nil
+}
+
+// Wait implements the RateLimitBuckets interface
+func (k kvBuckets) Wait(ctx context.Context, key string, rule config.RateLimitRule, now time.Time) (time.Duration, error) {
+	entry, err := k.kv.Get(ctx, key)
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	var bucket tokenBucket
+	if err := json.Unmarshal(entry.Value(), &bucket); err != nil {
+		return 0, err
+	}
+	_, wait := bucket.tokens(rule, now)
+	return wait, nil
+}
@@ -0,0 +1,286 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/metrics"
+)
+
+func newTestRateLimit(cfg config.RateLimit, now *time.Time) *rateLimit {
+	cfg.Enabled = true
+	m := metrics.Metrics{
+		Throttled: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "throttled_requests_total"}, []string{"client", "endpoint"}),
+	}
+	handler := RateLimit(cfg, m, Logger(log.NopLogger()), WithRateLimitBuckets(NewMemoryRateLimitBuckets(time.Hour)))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	rl := handler.(*rateLimit)
+	rl.now = func() time.Time { return *now }
+	return rl
+}
+
+func throttled(t *testing.T, rl *rateLimit, client, endpoint string) float64 {
+	registry := prometheus.NewPedanticRegistry()
+	assert.NoError(t, registry.Register(rl.metrics.Throttled))
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["client"] == client && labels["endpoint"] == endpoint {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func serveRateLimited(rl *rateLimit, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	rl.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestRateLimitThrottlesClients(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimit(config.RateLimit{Requests: 2, Period: time.Minute}, &now)
+
+	req := httptest.NewRequest(http.MethodGet, "/graph/v1.0/me", nil)
+	req.RemoteAddr = "10.0.0.1:4711"
+
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, req).Code)
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, req).Code)
+
+	rr := serveRateLimited(rl, req)
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "30", rr.Header().Get("Retry-After"))
+	assert.Equal(t, float64(1), throttled(t, rl, rateLimitClientIP, rateLimitDefaultEndpoint))
+
+	// other clients have their own limit
+	other := httptest.NewRequest(http.MethodGet, "/graph/v1.0/me", nil)
+	other.RemoteAddr = "10.0.0.2:4711"
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, other).Code)
+
+	// a token is added every 30 seconds
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, req).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(rl, req).Code)
+}
+
+func TestRateLimitRules(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimit(config.RateLimit{
+		Requests: 10,
+		Period:   time.Minute,
+		Rules: []config.RateLimitRule{
+			{Method: http.MethodPut, Endpoint: "/remote.php/dav/", Requests: 1, Period: time.Minute},
+		},
+	}, &now)
+
+	ctx := revactx.ContextSetUser(t.Context(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "einstein"}})
+	put := httptest.NewRequest(http.MethodPut, "/remote.php/dav/spaces/1/file.txt", nil).WithContext(ctx)
+	get := httptest.NewRequest(http.MethodGet, "/remote.php/dav/spaces/1/file.txt", nil).WithContext(ctx)
+
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, put).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(rl, put).Code)
+	assert.Equal(t, http.StatusOK, serveRateLimited(rl, get).Code)
+	assert.Equal(t, float64(1), throttled(t, rl, rateLimitClientUser, "/remote.php/dav/"))
+}
+
+func TestRateLimitClient(t *testing.T) {
+	user := &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "einstein"}}
+
+	// public link tokens are only used once the request was authenticated with them
+	req := httptest.NewRequest(http.MethodGet, "/remote.php/dav/public-files/abc?public-token=abc", nil)
+	req.RemoteAddr = "10.0.0.1:4711"
+	req.Header.Set(headerShareToken, "abc")
+	client, id := rateLimitClient(req, nil)
+	assert.Equal(t, rateLimitClientIP, client)
+	assert.Equal(t, "10.0.0.1", id)
+
+	req = req.WithContext(withRateLimitClient(req.Context(), rateLimitClientPublicLink, "abc"))
+	client, id = rateLimitClient(req, nil)
+	assert.Equal(t, rateLimitClientPublicLink, client)
+	assert.Equal(t, "abc", id)
+
+	// basic auth with the password of the user
+	req = httptest.NewRequest(http.MethodGet, "/graph/v1.0/me", nil)
+	req.SetBasicAuth("einstein", "relativity")
+	req = req.WithContext(revactx.ContextSetUser(req.Context(), user))
+	client, id = rateLimitClient(req, nil)
+	assert.Equal(t, rateLimitClientUser, client)
+	assert.Equal(t, "einstein", id)
+
+	req = req.WithContext(withRateLimitClient(req.Context(), rateLimitClientAppToken, "einstein:app-token"))
+	client, _ = rateLimitClient(req, nil)
+	assert.Equal(t, rateLimitClientAppToken, client)
+
+	// forwarded headers are only used when they were set by a trusted proxy
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	assert.NoError(t, err)
+	var ip string
+	handler := SocketAddr(chimiddleware.RealIP(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		_, ip = rateLimitClient(r, trustedProxies)
+	})))
+
+	req = httptest.NewRequest(http.MethodGet, "/graph/v1.0/me", nil)
+	req.RemoteAddr = "192.0.2.1:4711"
+	req.Header.Set("X-Forwarded-For", "203.0.113.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "192.0.2.1", ip)
+
+	req.RemoteAddr = "10.0.0.1:4711"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "203.0.113.1", ip)
+}
+
+func TestRateLimitKVBuckets(t *testing.T) {
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: natsserver.RANDOM_PORT, JetStream: true, StoreDir: t.TempDir()})
+	assert.NoError(t, err)
+	go ns.Start()
+	defer ns.Shutdown()
+	if !ns.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+
+	conn, err := nats.Connect(ns.ClientURL())
+	assert.NoError(t, err)
+	defer conn.Close()
+	js, err := jetstream.New(conn)
+	assert.NoError(t, err)
+	kv, err := js.CreateKeyValue(t.Context(), jetstream.KeyValueConfig{Bucket: "proxy-rate-limits", Storage: jetstream.MemoryStorage})
+	assert.NoError(t, err)
+
+	// two proxy instances sharing the bucket
+	buckets := []RateLimitBuckets{NewKVRateLimitBuckets(kv), NewKVRateLimitBuckets(kv)}
+	rule := config.RateLimitRule{Requests: 10, Period: time.Hour}
+	now := time.Now()
+
+	var wg sync.WaitGroup
+	var taken atomic.Int32
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			retryAfter, err := buckets[i%2].Take(t.Context(), rateLimitKey(rateLimitClientUser, "einstein", "default"), rule, now)
+			assert.NoError(t, err)
+			if retryAfter == 0 {
+				taken.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// no token is taken twice, requests losing too many races are throttled as well
+	assert.LessOrEqual(t, taken.Load(), int32(10))
+	assert.Positive(t, taken.Load())
+
+	// waiting doesn't take a token
+	retryAfter, err := buckets[1].Wait(t.Context(), rateLimitKey(rateLimitClientUser, "einstein", "default"), rule, now)
+	assert.NoError(t, err)
+	if taken.Load() == 10 {
+		assert.Positive(t, retryAfter)
+	}
+	retryAfter, err = buckets[1].Wait(t.Context(), rateLimitKey(rateLimitClientUser, "unknown", "default"), rule, now)
+	assert.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	retryAfter, err = buckets[0].Take(t.Context(), rateLimitKey(rateLimitClientUser, "einstein", "default"), rule, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Zero(t, retryAfter)
+}
+
+func TestFailedAuthRateLimit(t *testing.T) {
+	now := time.Now()
+	m := metrics.Metrics{
+		Throttled: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "throttled_requests_total"}, []string{"client", "endpoint"}),
+	}
+	var authenticated atomic.Int32
+	handler := FailedAuthRateLimit(
+		config.RateLimit{Enabled: true, FailedAuthRequests: 2, FailedAuthPeriod: time.Minute},
+		m,
+		Logger(log.NopLogger()),
+		WithRateLimitBuckets(NewMemoryRateLimitBuckets(time.Hour)),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// stands in for the authentication middleware
+		authenticated.Add(1)
+		if r.Header.Get("Authorization") != "Basic valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	handler.(*failedAuthRateLimit).now = func() time.Time { return now }
+
+	request := func(remoteAddr, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("PROPFIND", "/remote.php/dav/files/einstein", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Authorization", authorization)
+		// forwarded headers of untrusted clients don't change the client ip
+		req.Header.Set("X-Forwarded-For", fmt.Sprintf("192.0.2.%d", authenticated.Load()))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// successful authentications don't count
+	for range 5 {
+		assert.Equal(t, http.StatusOK, request("10.0.0.1:4711", "Basic valid").Code)
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, request("10.0.0.1:4711", "Basic wrong").Code)
+	assert.Equal(t, http.StatusUnauthorized, request("10.0.0.1:4712", "Basic wrong").Code)
+
+	// the client ip is rejected before it is authenticated, even with valid credentials
+	attempts := authenticated.Load()
+	rr := request("10.0.0.1:4713", "Basic wrong")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "30", rr.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.1:4714", "Basic valid").Code)
+	assert.Equal(t, attempts, authenticated.Load())
+
+	// other client ips have their own limit
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:4711", "Basic valid").Code)
+	assert.Equal(t, http.StatusUnauthorized, request("10.0.0.2:4711", "Basic wrong").Code)
+
+	// a failed authentication is allowed every 30 seconds
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, http.StatusUnauthorized, request("10.0.0.1:4711", "Basic wrong").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.1:4711", "Basic wrong").Code)
+}
+
+func TestRateLimitTTL(t *testing.T) {
+	assert.Equal(t, 2*time.Minute, RateLimitTTL(config.RateLimit{
+		Requests: 100,
+		Period:   time.Minute,
+		Rules: []config.RateLimitRule{
+			{Endpoint: "/graph/v1.0/users", Requests: 10, Period: time.Minute, Burst: 20},
+		},
+	}))
+	assert.Equal(t, time.Hour, RateLimitTTL(config.RateLimit{
+		Requests:           100,
+		Period:             time.Minute,
+		FailedAuthRequests: 10,
+		FailedAuthPeriod:   time.Hour,
+	}))
+}
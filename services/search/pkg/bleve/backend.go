@@ -74,10 +74,22 @@ func (b *Backend) Search(_ context.Context, sir *searchService.SearchIndexReques
 				),
 			},
 		)
+
+		// restrict the query to the requested path, the facets are computed from all matching documents
+		if requestedPath := utils.MakeRelativePath(sir.Ref.Path); requestedPath != "." {
+			q.Conjuncts = append(
+				q.Conjuncts,
+				bleve.NewDisjunctionQuery(
+					&query.TermQuery{FieldVal: "Path", Term: requestedPath},
+					&query.PrefixQuery{FieldVal: "Path", Prefix: requestedPath + "/"},
+				),
+			)
+		}
 	}
 
 	bleveReq := bleve.NewSearchRequest(q)
 	bleveReq.Highlight = bleve.NewHighlight()
+	addFacets(bleveReq, sir.Facets)
 
 	switch {
 	case sir.PageSize == -1:
@@ -152,6 +164,7 @@ func (b *Backend) Search(_ context.Context, sir *searchService.SearchIndexReques
 	return &searchService.SearchIndexResponse{
 		Matches:      matches,
 		TotalMatches: int32(totalMatches),
+		Facets:       getFacets(sir.Facets, res.Facets),
 	}, nil
 }
 
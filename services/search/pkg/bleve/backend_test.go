@@ -3,6 +3,7 @@ package bleve_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	bleveSearch "github.com/blevesearch/bleve/v2"
 	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -52,6 +53,8 @@ var _ = Describe("Bleve", func() {
 			return res.Matches
 		}
 
+		doFacetSearch func(query, path string, facets ...*searchmsg.FacetRequest) []*searchmsg.Facet
+
 		rootResource   search.Resource
 		parentResource search.Resource
 		childResource  search.Resource
@@ -285,6 +288,65 @@ var _ = Describe("Bleve", func() {
 
 		})
 
+		Context("with KQL", func() {
+			BeforeEach(func() {
+				parentResource.Document.Name = "quarterly-report.pdf"
+				parentResource.Document.Size = 12345
+				parentResource.Document.Content = "The quarterly reports of the year"
+				Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+
+				childResource.Document.Tags = []string{"finance"}
+				Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+			})
+
+			It("finds files by prefix and suffix wildcards", func() {
+				assertDocCount(rootResource.ID, "quarterly*", 1)
+				assertDocCount(rootResource.ID, "*report.pdf", 1)
+				assertDocCount(rootResource.ID, "*.pdf", 2)
+				assertDocCount(rootResource.ID, "*.docx", 0)
+			})
+
+			It("finds files by numeric ranges", func() {
+				assertDocCount(rootResource.ID, "size>10KB", 1)
+				assertDocCount(rootResource.ID, "size>=12345", 1)
+				assertDocCount(rootResource.ID, "size<12345", 1)
+				assertDocCount(rootResource.ID, "size:10KB..20KB", 1)
+				assertDocCount(rootResource.ID, "size:1MB..5MB", 0)
+			})
+
+			It("excludes files with the not equal operator", func() {
+				assertDocCount(rootResource.ID, "*.pdf name<>child.pdf", 1)
+				assertDocCount(rootResource.ID, "*.pdf tag<>finance", 1)
+			})
+
+			It("matches quoted phrases", func() {
+				assertDocCount(rootResource.ID, `content:"quarterly reports"`, 1)
+				assertDocCount(rootResource.ID, `content:"quarterly report"`, 1)
+				assertDocCount(rootResource.ID, `content:"reports quarterly"`, 0)
+			})
+
+			It("finds terms near to each other", func() {
+				assertDocCount(rootResource.ID, "quarterly NEAR(3) year", 1)
+				assertDocCount(rootResource.ID, "year NEAR(3) quarterly", 1)
+				assertDocCount(rootResource.ID, "quarterly NEAR(2) year", 0)
+				assertDocCount(rootResource.ID, "quarterly ONEAR(3) year", 1)
+				assertDocCount(rootResource.ID, "year ONEAR(3) quarterly", 0)
+				assertDocCount(rootResource.ID, `"quarterly reports" ONEAR year`, 1)
+				assertDocCount(rootResource.ID, `"quarterly reports" ONEAR(2) year`, 1)
+				assertDocCount(rootResource.ID, `"quarterly reports" ONEAR(1) year`, 0)
+				assertDocCount(rootResource.ID, `year NEAR(2) "quarterly reports"`, 1)
+				assertDocCount(rootResource.ID, `year ONEAR(100) "quarterly reports"`, 0)
+			})
+
+			It("ranks the matches with XRANK", func() {
+				matches := assertDocCount(rootResource.ID, "*.pdf XRANK(cb=100) tag:finance", 2)
+				Expect(matches[0].Entity.Name).To(Equal("child.pdf"))
+
+				matches = assertDocCount(rootResource.ID, "*.pdf XRANK(cb=100) content:year", 2)
+				Expect(matches[0].Entity.Name).To(Equal("quarterly-report.pdf"))
+			})
+		})
+
 		Context("with a file in the root of the space and folder with a file. all of them have the same name", func() {
 			BeforeEach(func() {
 				parentResource := search.Resource{
@@ -366,6 +428,86 @@ var _ = Describe("Bleve", func() {
 			})
 		})
 
+		Context("Facets", func() {
+			doFacetSearch = func(query, path string, facets ...*searchmsg.FacetRequest) []*searchmsg.Facet {
+				res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+					Query: query,
+					Ref: &searchmsg.Reference{
+						ResourceId: &searchmsg.ResourceID{StorageId: "1", SpaceId: "2", OpaqueId: "2"},
+						Path:       path,
+					},
+					Facets: facets,
+				})
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				return res.Facets
+			}
+
+			BeforeEach(func() {
+				parentResource.Document.MimeType = "httpd/unix-directory"
+				parentResource.Document.Mtime = time.Now().Format(time.RFC3339)
+				childResource.Document.MimeType = "application/pdf"
+				childResource.Document.Tags = []string{"Tax", "2024"}
+				childResource.Document.Size = 2 << 20
+				childResource.Document.Mtime = time.Now().Format(time.RFC3339)
+				childResource2.Document.MimeType = "text/plain"
+				childResource2.Document.Tags = []string{"tax"}
+				childResource2.Document.Size = 1 << 10
+				childResource2.Document.Mtime = time.Now().AddDate(-2, 0, 0).Format(time.RFC3339)
+
+				for _, r := range []search.Resource{rootResource, parentResource, childResource, childResource2} {
+					Expect(eng.Upsert(r.ID, r)).To(Succeed())
+				}
+			})
+
+			It("counts the media types", func() {
+				facets := doFacetSearch("name:*", "", &searchmsg.FacetRequest{Field: "mediatype"})
+				Expect(facets).To(HaveLen(1))
+				Expect(facets[0].Field).To(Equal("mediatype"))
+				Expect(facets[0].Values).To(Equal([]*searchmsg.FacetValue{
+					{Value: "file", Count: 2},
+					{Value: "folder", Count: 1},
+					{Value: "document", Count: 1},
+					{Value: "pdf", Count: 1},
+				}))
+			})
+
+			It("counts the mime types and tags", func() {
+				facets := doFacetSearch("name:*", "", &searchmsg.FacetRequest{Field: "mimetype", Size: 1}, &searchmsg.FacetRequest{Field: "tags"})
+				Expect(facets).To(HaveLen(2))
+				Expect(facets[0].Values).To(HaveLen(1))
+				Expect(facets[1].Values).To(Equal([]*searchmsg.FacetValue{
+					{Value: "tax", Count: 2},
+					{Value: "2024", Count: 1},
+				}))
+			})
+
+			It("counts the sizes and modification dates", func() {
+				facets := doFacetSearch("name:*.pdf", "", &searchmsg.FacetRequest{Field: "size"}, &searchmsg.FacetRequest{Field: "mtime"})
+				Expect(facets[0].Values).To(Equal([]*searchmsg.FacetValue{
+					{Value: "0-100KB", Count: 1},
+					{Value: "1MB-10MB", Count: 1},
+				}))
+				Expect(facets[1].Values).To(ContainElement(&searchmsg.FacetValue{Value: "today", Count: 1}))
+				Expect(facets[1].Values).To(ContainElement(&searchmsg.FacetValue{Value: "this year", Count: 1}))
+				Expect(facets[1].Values).ToNot(ContainElement(HaveField("Value", "last year")))
+			})
+
+			It("only counts the matches in the requested path", func() {
+				Expect(eng.Upsert("1$2!6", search.Resource{
+					ID:       "1$2!6",
+					ParentID: rootResource.ID,
+					RootID:   rootResource.ID,
+					Path:     "./other.pdf",
+					Document: content.Document{Name: "other.pdf", MimeType: "application/pdf"},
+				})).To(Succeed())
+
+				facets := doFacetSearch("name:*.pdf", "./parent d!r", &searchmsg.FacetRequest{Field: "mimetype"})
+				Expect(facets[0].Values).To(ConsistOf(
+					&searchmsg.FacetValue{Value: "application/pdf", Count: 1},
+					&searchmsg.FacetValue{Value: "text/plain", Count: 1},
+				))
+			})
+		})
 	})
 
 	Describe("Upsert", func() {
@@ -0,0 +1,88 @@
+package bleve
+
+import (
+	"github.com/blevesearch/bleve/v2"
+	bleveSearch "github.com/blevesearch/bleve/v2/search"
+
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+// addFacets adds the requested facets to the search request, the space facet is computed by the search service
+func addFacets(req *bleve.SearchRequest, facets []*searchMessage.FacetRequest) {
+	for _, f := range facets {
+		switch f.GetField() {
+		case search.FacetMediaType:
+			req.AddFacet(f.GetField(), bleve.NewFacetRequest("MimeType", search.MediaTypeFacetSize))
+		case search.FacetMimeType:
+			// one more, the space roots don't have a mime type
+			req.AddFacet(f.GetField(), bleve.NewFacetRequest("MimeType", search.FacetLimit(f)+1))
+		case search.FacetTags:
+			req.AddFacet(f.GetField(), bleve.NewFacetRequest("Tags", search.FacetLimit(f)))
+		case search.FacetMtime:
+			buckets := search.MtimeBuckets()
+			fr := bleve.NewFacetRequest("Mtime", len(buckets))
+			for _, b := range buckets {
+				fr.AddDateTimeRange(b.Name, b.From, b.To)
+			}
+			req.AddFacet(f.GetField(), fr)
+		case search.FacetSize:
+			fr := bleve.NewFacetRequest("Size", len(search.SizeBuckets))
+			for _, b := range search.SizeBuckets {
+				var max *float64
+				if b.Max > 0 {
+					max = &b.Max
+				}
+				fr.AddNumericRange(b.Name, &b.Min, max)
+			}
+			req.AddFacet(f.GetField(), fr)
+		}
+	}
+}
+
+// getFacets converts the facet results of bleve, the values of a facet keep the order of its buckets
+func getFacets(facets []*searchMessage.FacetRequest, results bleveSearch.FacetResults) []*searchMessage.Facet {
+	out := make([]*searchMessage.Facet, 0, len(facets))
+	for _, f := range facets {
+		result, ok := results[f.GetField()]
+		if !ok {
+			continue
+		}
+
+		facet := &searchMessage.Facet{Field: f.GetField()}
+		switch f.GetField() {
+		case search.FacetMediaType:
+			counts := make(map[string]int64, result.Terms.Len())
+			for _, t := range result.Terms.Terms() {
+				counts[t.Term] = int64(t.Count)
+			}
+			facet = search.MediaTypeFacet(counts)
+		case search.FacetMimeType, search.FacetTags:
+			for _, t := range result.Terms.Terms() {
+				if t.Term == "" {
+					continue
+				}
+				facet.Values = append(facet.Values, &searchMessage.FacetValue{Value: t.Term, Count: int64(t.Count)})
+			}
+			facet.Values = facet.Values[:min(len(facet.Values), search.FacetLimit(f))]
+		case search.FacetMtime:
+			for _, b := range search.MtimeBuckets() {
+				for _, r := range result.DateRanges {
+					if r.Name == b.Name && r.Count > 0 {
+						facet.Values = append(facet.Values, &searchMessage.FacetValue{Value: r.Name, Count: int64(r.Count)})
+					}
+				}
+			}
+		case search.FacetSize:
+			for _, b := range search.SizeBuckets {
+				for _, r := range result.NumericRanges {
+					if r.Name == b.Name && r.Count > 0 {
+						facet.Values = append(facet.Values, &searchMessage.FacetValue{Value: r.Name, Count: int64(r.Count)})
+					}
+				}
+			}
+		}
+		out = append(out, facet)
+	}
+	return out
+}
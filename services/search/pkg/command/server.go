@@ -4,7 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"net/http"
+	stdhttp "net/http"
 	"os"
 	"os/signal"
 
@@ -20,15 +20,24 @@ import (
 	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/config/parser"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/content"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/embedding"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch"
 	bleveQuery "github.com/opencloud-eu/opencloud/services/search/pkg/query/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch/alert"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/server/grpc"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/server/http"
 	svcEvent "github.com/opencloud-eu/opencloud/services/search/pkg/service/event"
+	svc "github.com/opencloud-eu/opencloud/services/search/pkg/service/grpc/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/vector"
 
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/opencloud-eu/reva/v2/pkg/events/raw"
+	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	opensearchgo "github.com/opensearch-project/opensearch-go/v4"
 	opensearchgoAPI "github.com/opensearch-project/opensearch-go/v4/opensearchapi"
@@ -98,7 +107,7 @@ func Server(cfg *config.Config) *cobra.Command {
 					DiscoverNodesInterval: cfg.Engine.OpenSearch.Client.DiscoverNodesInterval,
 					EnableMetrics:         cfg.Engine.OpenSearch.Client.EnableMetrics,
 					EnableDebugLogger:     cfg.Engine.OpenSearch.Client.EnableDebugLogger,
-					Transport: &http.Transport{
+					Transport: &stdhttp.Transport{
 						TLSClientConfig: &tls.Config{
 							MinVersion:         tls.VersionTLS12,
 							InsecureSkipVerify: cfg.Engine.OpenSearch.Client.Insecure,
@@ -129,6 +138,31 @@ func Server(cfg *config.Config) *cobra.Command {
 				return fmt.Errorf("unknown search engine: %s", cfg.Engine.Type)
 			}
 
+			if cfg.Engine.Vector.Enabled {
+				var provider embedding.Provider
+				switch cfg.Engine.Vector.Provider {
+				case "ollama":
+					provider = embedding.NewOllama(cfg.Engine.Vector.Ollama.URL, cfg.Engine.Vector.Ollama.Model, cfg.Engine.Vector.Ollama.Timeout)
+				case "hash":
+					provider = embedding.NewHash(256)
+				default:
+					return fmt.Errorf("unknown embedding provider: %s", cfg.Engine.Vector.Provider)
+				}
+
+				store, err := vector.NewStore(cfg.Engine.Vector.Datapath)
+				if err != nil {
+					return err
+				}
+
+				defer func() {
+					if err = store.Close(); err != nil {
+						logger.Error().Err(err).Msg("could not close vector store")
+					}
+				}()
+
+				eng = vector.NewBackend(eng, store, provider, cfg.Engine.Vector, logger)
+			}
+
 			// initialize gateway selector
 			selector, err := pool.GatewaySelector(cfg.Reva.Address, pool.WithRegistry(registry.GetRegistry()), pool.WithTracerProvider(traceProvider))
 			if err != nil {
@@ -151,8 +185,19 @@ func Server(cfg *config.Config) *cobra.Command {
 				return fmt.Errorf("unknown search extractor: %s", cfg.Extractor.Type)
 			}
 
+			if cfg.Extractor.OCR.Enabled {
+				if extractor, err = content.NewOCRExtractor(extractor, selector, logger, cfg); err != nil {
+					return err
+				}
+			}
+
 			ss := search.NewService(selector, eng, extractor, mtrcs, logger, cfg)
 
+			savedSearches, err := newSavedSearchStore(ctx, cfg.Store)
+			if err != nil {
+				return err
+			}
+
 			// setup the servers
 			gr := runner.NewGroup()
 
@@ -167,6 +212,7 @@ func Server(cfg *config.Config) *cobra.Command {
 					grpc.TraceProvider(traceProvider),
 					grpc.GatewaySelector(selector),
 					grpc.Searcher(ss),
+					grpc.SavedSearches(savedSearches),
 				)
 				if err != nil {
 					logger.Error().Err(err).Str("transport", "grpc").Msg("Failed to initialize server")
@@ -174,6 +220,34 @@ func Server(cfg *config.Config) *cobra.Command {
 				}
 
 				gr.Add(runner.NewGoMicroGrpcServerRunner(cfg.Service.Name+".grpc", grpcServer))
+
+				handle, err := svc.NewHandler(
+					svc.Config(cfg),
+					svc.Logger(logger),
+					svc.JWTSecret(cfg.TokenManager.JWTSecret),
+					svc.TracerProvider(traceProvider),
+					svc.Metrics(mtrcs),
+					svc.GatewaySelector(selector),
+					svc.Searcher(ss),
+					svc.SavedSearches(savedSearches),
+				)
+				if err != nil {
+					return err
+				}
+
+				httpServer, err := http.Server(
+					http.Logger(logger),
+					http.Context(ctx),
+					http.Config(cfg),
+					http.TraceProvider(traceProvider),
+					http.Handler(handle),
+				)
+				if err != nil {
+					logger.Error().Err(err).Str("transport", "http").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(runner.NewGoMicroHttpServerRunner(cfg.Service.Name+".http", httpServer))
 			} else {
 				logger.Info().Msg("gRPC server disabled, not starting gRPC service")
 			}
@@ -196,6 +270,27 @@ func Server(cfg *config.Config) *cobra.Command {
 					return err
 				}
 
+				publisher, err := stream.NatsFromConfig(connName, false, stream.NatsConfig{
+					Endpoint:             cfg.Events.Endpoint,
+					Cluster:              cfg.Events.Cluster,
+					EnableTLS:            cfg.Events.EnableTLS,
+					TLSInsecure:          cfg.Events.TLSInsecure,
+					TLSRootCACertificate: cfg.Events.TLSRootCACertificate,
+					AuthUsername:         cfg.Events.AuthUsername,
+					AuthPassword:         cfg.Events.AuthPassword,
+				})
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to create event publisher")
+					return err
+				}
+
+				alerter, err := alert.New(ctx, savedSearches, eng, selector, publisher, logger)
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to watch the saved search alerts")
+					return err
+				}
+				ss.Observe(alerter)
+
 				eventSvc, err := svcEvent.New(ctx, bus, logger, traceProvider, mtrcs, ss, cfg.Events.DebounceDuration, cfg.Events.NumConsumers, cfg.Events.AsyncUploads)
 				if err != nil {
 					logger.Error().Err(err).Str("transport", "event").Msg("Failed to initialize server")
@@ -238,3 +333,26 @@ func Server(cfg *config.Config) *cobra.Command {
 		},
 	}
 }
+
+// newSavedSearchStore returns the store of the saved searches, they are kept in a nats key value bucket
+func newSavedSearchStore(ctx context.Context, cfg config.Store) (*savedsearch.Store, error) {
+	natsOptions := nats.Options{
+		Servers:  cfg.Nodes,
+		User:     cfg.AuthUsername,
+		Password: cfg.AuthPassword,
+	}
+	conn, err := natsOptions.Connect()
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the saved search store: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+	bucket := cfg.Database + "-" + cfg.Table
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: bucket})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket (%s): %w", bucket, err)
+	}
+	return savedsearch.NewStore(kv), nil
+}
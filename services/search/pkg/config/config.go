@@ -17,6 +17,7 @@ type Config struct {
 	Debug    Debug  `yaml:"debug"`
 
 	GRPC       GRPCConfig    `yaml:"grpc"`
+	HTTP       HTTP          `yaml:"http"`
 	GrpcClient client.Client `yaml:"-"`
 
 	TokenManager *TokenManager `yaml:"token_manager"`
@@ -28,6 +29,7 @@ type Config struct {
 	Extractor                  Extractor             `yaml:"extractor"`
 	ContentExtractionSizeLimit uint64                `yaml:"content_extraction_size_limit" env:"SEARCH_CONTENT_EXTRACTION_SIZE_LIMIT" desc:"Maximum file size in bytes that is allowed for content extraction." introductionVersion:"1.0.0"`
 	BatchSize                  int                   `yaml:"batch_size" env:"SEARCH_BATCH_SIZE" desc:"The number of documents to process in a single batch. Defaults to 500." introductionVersion:"1.0.0"`
+	Store                      Store                 `yaml:"store"`
 
 	ServiceAccount ServiceAccount `yaml:"service_account"`
 
@@ -39,3 +41,12 @@ type ServiceAccount struct {
 	ServiceAccountID     string `yaml:"service_account_id" env:"OC_SERVICE_ACCOUNT_ID;SEARCH_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use. See the 'auth-service' service description for more details." introductionVersion:"1.0.0"`
 	ServiceAccountSecret string `yaml:"service_account_secret" env:"OC_SERVICE_ACCOUNT_SECRET;SEARCH_SERVICE_ACCOUNT_SECRET" desc:"The service account secret." introductionVersion:"1.0.0"`
 }
+
+// Store configures the nats-js-kv store the saved searches of the users are kept in
+type Store struct {
+	Nodes        []string `yaml:"nodes" env:"OC_PERSISTENT_STORE_NODES;SEARCH_STORE_NODES" desc:"A list of nodes to access the nats-js-kv store the saved searches are kept in. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string   `yaml:"database" env:"SEARCH_STORE_DATABASE" desc:"The database name the store should use. The saved searches are kept in a key value bucket named after the database and the table." introductionVersion:"%%NEXT%%"`
+	Table        string   `yaml:"table" env:"SEARCH_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	AuthUsername string   `yaml:"username" env:"OC_PERSISTENT_STORE_AUTH_USERNAME;SEARCH_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store." introductionVersion:"%%NEXT%%"`
+	AuthPassword string   `yaml:"password" env:"OC_PERSISTENT_STORE_AUTH_PASSWORD;SEARCH_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store." introductionVersion:"%%NEXT%%"`
+}
@@ -1,10 +1,13 @@
 package config
 
+import "time"
+
 // Extractor defines which extractor to use
 type Extractor struct {
 	Type             string        `yaml:"type" env:"SEARCH_EXTRACTOR_TYPE" desc:"Defines the content extraction engine. Defaults to 'basic'. Supported values are: 'basic' and 'tika'." introductionVersion:"1.0.0"`
 	CS3AllowInsecure bool          `yaml:"cs3_allow_insecure" env:"OC_INSECURE;SEARCH_EXTRACTOR_CS3SOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the CS3 source." introductionVersion:"1.0.0"`
 	Tika             ExtractorTika `yaml:"tika"`
+	OCR              ExtractorOCR  `yaml:"ocr"`
 }
 
 // ExtractorTika configures the Tika extractor
@@ -12,3 +15,16 @@ type ExtractorTika struct {
 	TikaURL        string `yaml:"tika_url" env:"SEARCH_EXTRACTOR_TIKA_TIKA_URL" desc:"URL of the tika server." introductionVersion:"1.0.0"`
 	CleanStopWords bool   `yaml:"clean_stop_words" env:"SEARCH_EXTRACTOR_TIKA_CLEAN_STOP_WORDS" desc:"Defines if stop words should be cleaned or not. See the documentation for more details." introductionVersion:"1.0.0"`
 }
+
+// ExtractorOCR configures the text recognition added to the configured extractor
+type ExtractorOCR struct {
+	Enabled          bool          `yaml:"enabled" env:"SEARCH_EXTRACTOR_OCR_ENABLED" desc:"Recognize the text of images and of PDF documents the configured extractor found no text in. Needs tesseract and poppler to be installed." introductionVersion:"%%NEXT%%"`
+	TesseractCommand string        `yaml:"tesseract_command" env:"SEARCH_EXTRACTOR_OCR_TESSERACT_COMMAND" desc:"Path to the tesseract executable used to recognize the text of images." introductionVersion:"%%NEXT%%"`
+	Languages        string        `yaml:"languages" env:"SEARCH_EXTRACTOR_OCR_LANGUAGES" desc:"The languages tesseract recognizes, multiple languages are separated by a '+', e.g. 'eng+deu'. The language data must be installed." introductionVersion:"%%NEXT%%"`
+	PDFToTextCommand string        `yaml:"pdftotext_command" env:"SEARCH_EXTRACTOR_OCR_PDFTOTEXT_COMMAND" desc:"Path to the pdftotext executable used to read the text of PDF pages." introductionVersion:"%%NEXT%%"`
+	PDFToPPMCommand  string        `yaml:"pdftoppm_command" env:"SEARCH_EXTRACTOR_OCR_PDFTOPPM_COMMAND" desc:"Path to the pdftoppm executable used to render PDF pages without text." introductionVersion:"%%NEXT%%"`
+	Resolution       int           `yaml:"resolution" env:"SEARCH_EXTRACTOR_OCR_RESOLUTION" desc:"The resolution in DPI PDF pages are rendered with before their text is recognized." introductionVersion:"%%NEXT%%"`
+	Timeout          time.Duration `yaml:"timeout" env:"SEARCH_EXTRACTOR_OCR_TIMEOUT" desc:"The maximum time the text recognition of a single file may take. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Workers          int           `yaml:"workers" env:"SEARCH_EXTRACTOR_OCR_WORKERS" desc:"The number of files the text is recognized in concurrently." introductionVersion:"%%NEXT%%"`
+	QueueSize        int           `yaml:"queue_size" env:"SEARCH_EXTRACTOR_OCR_QUEUE_SIZE" desc:"The maximum number of files waiting for the text recognition. Files that don't fit into the queue are indexed without their content." introductionVersion:"%%NEXT%%"`
+}
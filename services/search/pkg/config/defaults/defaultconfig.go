@@ -2,6 +2,7 @@ package defaults
 
 import (
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
@@ -30,6 +31,17 @@ func DefaultConfig() *config.Config {
 			Addr:      "127.0.0.1:9220",
 			Namespace: "eu.opencloud.api",
 		},
+		HTTP: config.HTTP{
+			Addr:      "127.0.0.1:9225",
+			Namespace: "eu.opencloud.web",
+			Root:      "/",
+			CORS: config.CORS{
+				AllowedOrigins:   []string{"*"},
+				AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+				AllowedHeaders:   []string{"Authorization", "Origin", "Content-Type", "Accept", "X-Requested-With", "X-Request-Id"},
+				AllowCredentials: true,
+			},
+		},
 		Service: config.Service{
 			Name: "search",
 		},
@@ -44,6 +56,18 @@ func DefaultConfig() *config.Config {
 					Name: "opencloud-resource",
 				},
 			},
+			Vector: config.EngineVector{
+				Datapath:  filepath.Join(defaults.BaseDataPath(), "search"),
+				Provider:  "ollama",
+				ChunkSize: 200,
+				MaxChunks: 50,
+				MinScore:  0.5,
+				Ollama: config.EngineVectorOllama{
+					URL:     "http://127.0.0.1:11434",
+					Model:   "nomic-embed-text",
+					Timeout: 30 * time.Second,
+				},
+			},
 		},
 		Extractor: config.Extractor{
 			Type:             "basic",
@@ -52,6 +76,16 @@ func DefaultConfig() *config.Config {
 				TikaURL:        "http://127.0.0.1:9998",
 				CleanStopWords: true,
 			},
+			OCR: config.ExtractorOCR{
+				TesseractCommand: "tesseract",
+				Languages:        "eng",
+				PDFToTextCommand: "pdftotext",
+				PDFToPPMCommand:  "pdftoppm",
+				Resolution:       300,
+				Timeout:          5 * time.Minute,
+				Workers:          1,
+				QueueSize:        1000,
+			},
 		},
 		Events: config.Events{
 			Endpoint:         "127.0.0.1:9233",
@@ -65,6 +99,11 @@ func DefaultConfig() *config.Config {
 		},
 		ContentExtractionSizeLimit: 20 * 1024 * 1024, // Limit content extraction to <20MB files by default
 		BatchSize:                  500,
+		Store: config.Store{
+			Nodes:    []string{"127.0.0.1:9233"},
+			Database: "search",
+			Table:    "saved-searches",
+		},
 	}
 }
 
@@ -91,9 +130,16 @@ func EnsureDefaults(cfg *config.Config) {
 	if cfg.GRPC.TLS == nil && cfg.Commons != nil {
 		cfg.GRPC.TLS = structs.CopyOrZeroValue(cfg.Commons.GRPCServiceTLS)
 	}
+
+	if cfg.Commons != nil {
+		cfg.HTTP.TLS = cfg.Commons.HTTPServiceTLS
+	}
 }
 
 // Sanitize sanitizes the configuration
 func Sanitize(cfg *config.Config) {
-	// no http endpoint to be sanitized
+	// sanitize config
+	if cfg.HTTP.Root != "/" {
+		cfg.HTTP.Root = strings.TrimSuffix(cfg.HTTP.Root, "/")
+	}
 }
@@ -10,6 +10,7 @@ type Engine struct {
 	Type       string           `yaml:"type" env:"SEARCH_ENGINE_TYPE" desc:"Defines which search engine to use. Defaults to 'bleve'. Supported values are: 'bleve'." introductionVersion:"1.0.0"`
 	Bleve      EngineBleve      `yaml:"bleve"`
 	OpenSearch EngineOpenSearch `yaml:"open_search"`
+	Vector     EngineVector     `yaml:"vector"`
 }
 
 // EngineBleve configures the bleve engine
@@ -17,6 +18,24 @@ type EngineBleve struct {
 	Datapath string `yaml:"data_path" env:"SEARCH_ENGINE_BLEVE_DATA_PATH" desc:"The directory where the filesystem will store search data. If not defined, the root directory derives from $OC_BASE_DATA_PATH/search." introductionVersion:"1.0.0"`
 }
 
+// EngineVector configures the semantic search on top of the search engine
+type EngineVector struct {
+	Enabled   bool               `yaml:"enabled" env:"SEARCH_ENGINE_VECTOR_ENABLED" desc:"Store embeddings of the indexed content to support 'similar:' queries, which rank the results by their similarity to a text. Works with all search engines. Existing resources get embeddings when they are indexed again." introductionVersion:"%%NEXT%%"`
+	Datapath  string             `yaml:"data_path" env:"SEARCH_ENGINE_VECTOR_DATA_PATH" desc:"The directory where the embeddings are stored. If not defined, the root directory derives from $OC_BASE_DATA_PATH/search." introductionVersion:"%%NEXT%%"`
+	Provider  string             `yaml:"provider" env:"SEARCH_ENGINE_VECTOR_PROVIDER" desc:"The embedding provider computing the embeddings. Supported values are 'ollama' and 'hash'. The 'hash' provider only compares words and is meant for testing." introductionVersion:"%%NEXT%%"`
+	ChunkSize int                `yaml:"chunk_size" env:"SEARCH_ENGINE_VECTOR_CHUNK_SIZE" desc:"The number of words of the content chunks that are embedded separately." introductionVersion:"%%NEXT%%"`
+	MaxChunks int                `yaml:"max_chunks" env:"SEARCH_ENGINE_VECTOR_MAX_CHUNKS" desc:"The maximum number of chunks embedded per resource, including the one for the name. The remaining content is not taken into account." introductionVersion:"%%NEXT%%"`
+	MinScore  float64            `yaml:"min_score" env:"SEARCH_ENGINE_VECTOR_MIN_SCORE" desc:"The minimum cosine similarity between -1 and 1 a resource needs to be returned by a 'similar:' query. Suitable values depend on the embedding model." introductionVersion:"%%NEXT%%"`
+	Ollama    EngineVectorOllama `yaml:"ollama"`
+}
+
+// EngineVectorOllama configures the ollama embedding provider
+type EngineVectorOllama struct {
+	URL     string        `yaml:"url" env:"SEARCH_ENGINE_VECTOR_OLLAMA_URL" desc:"The URL of the ollama server." introductionVersion:"%%NEXT%%"`
+	Model   string        `yaml:"model" env:"SEARCH_ENGINE_VECTOR_OLLAMA_MODEL" desc:"The embedding model to use. Changing the model requires reindexing all spaces." introductionVersion:"%%NEXT%%"`
+	Timeout time.Duration `yaml:"timeout" env:"SEARCH_ENGINE_VECTOR_OLLAMA_TIMEOUT" desc:"The timeout of requests to the ollama server. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
 // EngineOpenSearch configures the OpenSearch engine
 type EngineOpenSearch struct {
 	Client        EngineOpenSearchClient        `yaml:"client"`
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
@@ -45,5 +46,20 @@ func Validate(cfg *config.Config) error {
 		return shared.MissingServiceAccountSecret(cfg.Service.Name)
 	}
 
+	if cfg.Engine.Vector.Enabled {
+		switch cfg.Engine.Vector.Provider {
+		case "ollama":
+			if cfg.Engine.Vector.Ollama.URL == "" || cfg.Engine.Vector.Ollama.Model == "" {
+				return errors.New("the ollama embedding provider needs a url and a model")
+			}
+		case "hash":
+		default:
+			return fmt.Errorf("unknown embedding provider: %s", cfg.Engine.Vector.Provider)
+		}
+		if cfg.Engine.Vector.ChunkSize <= 0 || cfg.Engine.Vector.MaxChunks <= 0 {
+			return errors.New("the chunk size and the maximum number of chunks must be greater than 0")
+		}
+	}
+
 	return nil
 }
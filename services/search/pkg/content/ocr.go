@@ -0,0 +1,261 @@
+package content
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+)
+
+// OCRImageMimeTypes are the mime types of the images the OCR extractor recognizes text in
+var OCRImageMimeTypes = map[string]struct{}{
+	"image/png":  {},
+	"image/jpeg": {},
+	"image/tiff": {},
+	"image/bmp":  {},
+	"image/gif":  {},
+	"image/webp": {},
+}
+
+// AsyncExtractor is implemented by extractors that extract the content of some resources in the background.
+// Once the content of a resource is available, the registered function is called
+// and the next Extract call of the unchanged resource returns it.
+type AsyncExtractor interface {
+	Extractor
+	OnExtracted(func(ri *provider.ResourceInfo))
+}
+
+type ocrJob struct {
+	ri   *provider.ResourceInfo
+	key  string
+	path string
+}
+
+// ocrResult is the recognized text of a resource in the version identified by the etag
+type ocrResult struct {
+	etag string
+	text string
+}
+
+// OCR wraps another Extractor and adds the text of images and of PDF documents the wrapped
+// Extractor found no text in, the text is recognized in the background by an OCREngine.
+type OCR struct {
+	Extractor
+	Retriever
+	Engine                     OCREngine
+	ContentExtractionSizeLimit uint64
+
+	logger      log.Logger
+	jobs        chan ocrJob
+	onExtracted func(ri *provider.ResourceInfo)
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+	// the results are stored per resource, a result of another version of the resource replaces the previous one
+	results map[string]ocrResult
+}
+
+// NewOCRExtractor creates a new OCR instance wrapping the given Extractor and starts its workers.
+func NewOCRExtractor(extractor Extractor, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], logger log.Logger, cfg *config.Config) (*OCR, error) {
+	o := &OCR{
+		Extractor:                  extractor,
+		Retriever:                  newCS3Retriever(gatewaySelector, logger, cfg.Extractor.CS3AllowInsecure),
+		Engine:                     NewProcessOCREngine(cfg.Extractor.OCR),
+		ContentExtractionSizeLimit: cfg.ContentExtractionSizeLimit,
+		logger:                     logger,
+		jobs:                       make(chan ocrJob, max(cfg.Extractor.OCR.QueueSize, 1)),
+		pending:                    map[string]struct{}{},
+		results:                    map[string]ocrResult{},
+	}
+
+	for range max(cfg.Extractor.OCR.Workers, 1) {
+		go o.work(cfg.Extractor.OCR.Timeout)
+	}
+
+	return o, nil
+}
+
+// OnExtracted registers the function that is called once the text of a resource is recognized.
+func (o *OCR) OnExtracted(f func(ri *provider.ResourceInfo)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.onExtracted = f
+}
+
+// Extract returns the document of the wrapped Extractor. The recognized text of an image or a PDF document
+// without text is added if it is available, otherwise the resource is downloaded and queued for recognition.
+func (o *OCR) Extract(ctx context.Context, ri *provider.ResourceInfo) (Document, error) {
+	doc, err := o.Extractor.Extract(ctx, ri)
+	if err != nil {
+		return doc, err
+	}
+
+	if ri.Type != provider.ResourceType_RESOURCE_TYPE_FILE || ri.Size == 0 || !needsOCR(ri.MimeType, doc.Content) {
+		return doc, nil
+	}
+
+	if ri.Size > o.ContentExtractionSizeLimit {
+		o.logger.Info().Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("file exceeds content extraction size limit. skipping.")
+		return doc, nil
+	}
+
+	id := storagespace.FormatResourceID(ri.Id)
+	key := id + ":" + ri.Etag
+
+	o.mu.Lock()
+	// the result is removed even if it belongs to another version, it is outdated then
+	result, ok := o.results[id]
+	delete(o.results, id)
+	_, queued := o.pending[key]
+	o.mu.Unlock()
+
+	switch {
+	case ok && result.etag == ri.Etag:
+		doc.Content = strings.TrimSpace(doc.Content + "\n" + result.text)
+		return doc, nil
+	case queued:
+		return doc, nil
+	}
+
+	path, err := o.download(ctx, ri)
+	if err != nil {
+		return doc, err
+	}
+
+	o.mu.Lock()
+	o.pending[key] = struct{}{}
+	o.mu.Unlock()
+
+	select {
+	case o.jobs <- ocrJob{ri: ri, key: key, path: path}:
+	default:
+		o.logger.Warn().Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("ocr queue is full. skipping.")
+		o.finish(ocrJob{key: key, path: path})
+	}
+
+	return doc, nil
+}
+
+// download stores the resource in a temporary file, the recognition doesn't depend on the request context
+func (o *OCR) download(ctx context.Context, ri *provider.ResourceInfo) (string, error) {
+	data, err := o.Retrieve(ctx, ri.Id)
+	if err != nil {
+		return "", err
+	}
+	defer data.Close()
+
+	f, err := os.CreateTemp("", "opencloud-search-ocr-*"+filepath.Ext(ri.Name))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, io.LimitReader(data, int64(o.ContentExtractionSizeLimit))); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+func (o *OCR) work(timeout time.Duration) {
+	for job := range o.jobs {
+		ctx, cancel := context.Background(), context.CancelFunc(func() {})
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+
+		text, err := o.recognize(ctx, job.ri.MimeType, job.path)
+		cancel()
+		if err != nil {
+			o.logger.Error().Err(err).Interface("ResourceID", job.ri.Id).Str("Name", job.ri.Name).Msg("failed to recognize text")
+			o.finish(job)
+			continue
+		}
+
+		if text == "" {
+			o.finish(job)
+			continue
+		}
+
+		o.mu.Lock()
+		o.results[storagespace.FormatResourceID(job.ri.Id)] = ocrResult{etag: job.ri.Etag, text: text}
+		onExtracted := o.onExtracted
+		o.mu.Unlock()
+		o.finish(job)
+
+		if onExtracted != nil {
+			onExtracted(job.ri)
+		}
+	}
+}
+
+// finish removes the temporary file and the pending state of a job
+func (o *OCR) finish(job ocrJob) {
+	if err := os.Remove(job.path); err != nil && !os.IsNotExist(err) {
+		o.logger.Error().Err(err).Str("path", job.path).Msg("failed to remove temporary file")
+	}
+
+	o.mu.Lock()
+	delete(o.pending, job.key)
+	o.mu.Unlock()
+}
+
+// recognize returns the text of an image or a PDF document, PDF pages that contain text aren't recognized
+func (o *OCR) recognize(ctx context.Context, mimeType, path string) (string, error) {
+	if _, ok := OCRImageMimeTypes[mimeType]; ok {
+		text, err := o.Engine.RecognizeImage(ctx, path)
+		return strings.TrimSpace(text), err
+	}
+
+	pages, err := o.Engine.PDFPages(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp("", "opencloud-search-ocr-pages-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	texts := make([]string, 0, len(pages))
+	for i, page := range pages {
+		if strings.TrimSpace(page) == "" {
+			image, err := o.Engine.RenderPDFPage(ctx, path, i+1, dir)
+			if err != nil {
+				return "", err
+			}
+
+			if page, err = o.Engine.RecognizeImage(ctx, image); err != nil {
+				return "", err
+			}
+		}
+
+		if page = strings.TrimSpace(page); page != "" {
+			texts = append(texts, page)
+		}
+	}
+
+	return strings.Join(texts, "\n"), nil
+}
+
+// needsOCR reports whether the text of a resource is recognized, the text of images is always recognized
+// while PDF documents are only recognized if no text was extracted from them
+func needsOCR(mimeType, content string) bool {
+	if _, ok := OCRImageMimeTypes[mimeType]; ok {
+		return true
+	}
+	return mimeType == "application/pdf" && strings.TrimSpace(content) == ""
+}
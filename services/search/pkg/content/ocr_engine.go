@@ -0,0 +1,82 @@
+package content
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+)
+
+// OCREngine recognizes the text of images and PDF documents.
+type OCREngine interface {
+	// RecognizeImage returns the text of the image at the given path.
+	RecognizeImage(ctx context.Context, path string) (string, error)
+	// PDFPages returns the text of every page of the PDF document at the given path,
+	// pages without text, e.g. scanned pages, are returned as empty strings.
+	PDFPages(ctx context.Context, path string) ([]string, error)
+	// RenderPDFPage renders the page of the PDF document to an image in the given directory and returns its path,
+	// the first page is 1.
+	RenderPDFPage(ctx context.Context, path string, page int, dir string) (string, error)
+}
+
+// ProcessOCREngine is an OCREngine that runs local tesseract and poppler processes.
+type ProcessOCREngine struct {
+	cfg config.ExtractorOCR
+}
+
+// NewProcessOCREngine creates a new ProcessOCREngine instance.
+func NewProcessOCREngine(cfg config.ExtractorOCR) ProcessOCREngine {
+	return ProcessOCREngine{cfg: cfg}
+}
+
+// RecognizeImage runs tesseract on the image.
+func (e ProcessOCREngine) RecognizeImage(ctx context.Context, path string) (string, error) {
+	args := []string{path, "stdout"}
+	if e.cfg.Languages != "" {
+		args = append(args, "-l", e.cfg.Languages)
+	}
+
+	return run(ctx, e.cfg.TesseractCommand, args...)
+}
+
+// PDFPages runs pdftotext on the document and splits its output at the page breaks.
+func (e ProcessOCREngine) PDFPages(ctx context.Context, path string) ([]string, error) {
+	out, err := run(ctx, e.cfg.PDFToTextCommand, "-layout", path, "-")
+	if err != nil {
+		return nil, err
+	}
+
+	// pdftotext terminates every page with a form feed
+	return strings.Split(strings.TrimSuffix(out, "\f"), "\f"), nil
+}
+
+// RenderPDFPage runs pdftoppm to render the page as png.
+func (e ProcessOCREngine) RenderPDFPage(ctx context.Context, path string, page int, dir string) (string, error) {
+	n := strconv.Itoa(page)
+	prefix := filepath.Join(dir, "page-"+n)
+
+	if _, err := run(ctx, e.cfg.PDFToPPMCommand, "-f", n, "-l", n, "-r", strconv.Itoa(e.cfg.Resolution), "-png", "-singlefile", path, prefix); err != nil {
+		return "", err
+	}
+
+	return prefix + ".png", nil
+}
+
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
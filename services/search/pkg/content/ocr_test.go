@@ -0,0 +1,264 @@
+package content_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	conf "github.com/opencloud-eu/opencloud/services/search/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/content"
+	contentMocks "github.com/opencloud-eu/opencloud/services/search/pkg/content/mocks"
+)
+
+// fakeOCREngine recognizes the content of the files as text,
+// PDF pages are separated by form feeds and pages starting with "scan:" have no text.
+type fakeOCREngine struct {
+	mu         sync.Mutex
+	recognized []string
+}
+
+func (e *fakeOCREngine) RecognizeImage(_ context.Context, path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.recognized = append(e.recognized, filepath.Base(path))
+
+	return strings.TrimPrefix(string(b), "scan:"), nil
+}
+
+func (e *fakeOCREngine) PDFPages(_ context.Context, path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := strings.Split(string(b), "\f")
+	for i, page := range pages {
+		if strings.HasPrefix(page, "scan:") {
+			pages[i] = ""
+		}
+	}
+
+	return pages, nil
+}
+
+func (e *fakeOCREngine) RenderPDFPage(_ context.Context, path string, page int, dir string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	image := filepath.Join(dir, "page.png")
+	return image, os.WriteFile(image, []byte(strings.Split(string(b), "\f")[page-1]), 0600)
+}
+
+func (e *fakeOCREngine) calls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.recognized)
+}
+
+var _ = Describe("OCR", func() {
+	Describe("extract", func() {
+		var (
+			body      string
+			text      string
+			ocr       *content.OCR
+			engine    *fakeOCREngine
+			extracted chan *provider.ResourceInfo
+			ri        *provider.ResourceInfo
+		)
+
+		BeforeEach(func() {
+			body = ""
+			text = ""
+			engine = &fakeOCREngine{}
+			extracted = make(chan *provider.ResourceInfo, 1)
+			ri = &provider.ResourceInfo{
+				Id:       &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "opaque"},
+				Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+				Name:     "scan.png",
+				MimeType: "image/png",
+				Etag:     "etag",
+				Size:     1,
+			}
+
+			basic, err := content.NewBasicExtractor(log.NewLogger())
+			Expect(err).ToNot(HaveOccurred())
+
+			// the wrapped extractor returns the text of the resource
+			extractor := &contentMocks.Extractor{}
+			extractor.On("Extract", mock.Anything, mock.Anything).Return(func(ctx context.Context, ri *provider.ResourceInfo) content.Document {
+				doc, _ := basic.Extract(ctx, ri)
+				doc.Content = text
+				return doc
+			}, nil)
+
+			ocr, err = content.NewOCRExtractor(extractor, nil, log.NewLogger(), conf.DefaultConfig())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ocr).ToNot(BeNil())
+
+			retriever := &contentMocks.Retriever{}
+			retriever.On("Retrieve", mock.Anything, mock.Anything).Return(func(context.Context, *provider.ResourceId) io.ReadCloser {
+				return io.NopCloser(strings.NewReader(body))
+			}, nil)
+
+			ocr.Retriever = retriever
+			ocr.Engine = engine
+			ocr.OnExtracted(func(ri *provider.ResourceInfo) {
+				extracted <- ri
+			})
+		})
+
+		It("skips non file resources", func() {
+			ri.Type = provider.ResourceType_RESOURCE_TYPE_CONTAINER
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			Consistently(extracted).ShouldNot(Receive())
+		})
+
+		It("skips unsupported mime types", func() {
+			body = "text"
+			ri.Name = "text.txt"
+			ri.MimeType = "text/plain"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			Consistently(extracted).ShouldNot(Receive())
+		})
+
+		It("skips files exceeding the size limit", func() {
+			body = "scan:text"
+			ri.Size = ocr.ContentExtractionSizeLimit + 1
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			Consistently(extracted).ShouldNot(Receive())
+			Expect(engine.calls()).To(Equal(0))
+		})
+
+		It("recognizes the text of images in the background", func() {
+			body = "scan:recognized text"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Name).To(Equal("scan.png"))
+			Expect(doc.Content).To(Equal(""))
+
+			Eventually(extracted).Should(Receive(Equal(ri)))
+
+			doc, err = ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("recognized text"))
+		})
+
+		It("recognizes the text of changed images again", func() {
+			body = "scan:first"
+
+			_, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(extracted).Should(Receive())
+
+			body = "scan:second"
+			ri.Etag = "changed"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			Eventually(extracted).Should(Receive())
+
+			doc, err = ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("second"))
+		})
+
+		It("doesn't return the text of previous versions", func() {
+			body = "scan:first"
+
+			_, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(extracted).Should(Receive())
+
+			body = "scan:second"
+			ri.Etag = "changed"
+
+			_, err = ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(extracted).Should(Receive())
+
+			ri.Etag = "etag"
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+		})
+
+		It("adds the recognized text of images to the extracted text", func() {
+			body = "scan:recognized text"
+			text = "extracted text"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("extracted text"))
+			Eventually(extracted).Should(Receive())
+
+			doc, err = ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("extracted text\nrecognized text"))
+		})
+
+		It("skips PDF documents the wrapped extractor found text in", func() {
+			body = "scan:page"
+			text = "extracted text"
+			ri.Name = "document.pdf"
+			ri.MimeType = "application/pdf"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("extracted text"))
+			Consistently(extracted).ShouldNot(Receive())
+			Expect(engine.calls()).To(Equal(0))
+		})
+
+		It("only recognizes the image only pages of PDF documents", func() {
+			body = "first page\fscan:second page\fthird page"
+			ri.Name = "document.pdf"
+			ri.MimeType = "application/pdf"
+
+			_, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(extracted).Should(Receive())
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("first page\nsecond page\nthird page"))
+			Expect(engine.calls()).To(Equal(1))
+		})
+
+		It("doesn't call back for files without text", func() {
+			body = "scan:"
+
+			doc, err := ocr.Extract(context.TODO(), ri)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			Eventually(engine.calls).Should(Equal(1))
+			Consistently(extracted).ShouldNot(Receive())
+		})
+	})
+})
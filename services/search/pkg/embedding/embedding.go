@@ -0,0 +1,42 @@
+// Package embedding provides the embedding models used for semantic search.
+package embedding
+
+import (
+	"context"
+	"math"
+)
+
+// Provider computes embeddings for texts. All embeddings of a provider have the same dimension.
+type Provider interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Normalize scales the vector to unit length, so the similarity of two vectors is their dot product
+func Normalize(v []float32) []float32 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return v
+	}
+
+	norm := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= norm
+	}
+	return v
+}
+
+// Similarity returns the cosine similarity of two normalized vectors
+func Similarity(a, b []float32) float32 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot float32
+	for i := range a {
+		dot += a[i] * b[i]
+	}
+	return dot
+}
@@ -0,0 +1,44 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+// Hash is a deterministic Provider which hashes the words of a text into a vector. It doesn't
+// understand the meaning of words, texts are similar if they share words. It is meant for tests.
+type Hash struct {
+	dimensions int
+}
+
+// NewHash returns a Hash provider creating vectors of the given dimension
+func NewHash(dimensions int) *Hash {
+	return &Hash{dimensions: dimensions}
+}
+
+// Embed implements the Provider interface
+func (h *Hash) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		v := make([]float32, h.dimensions)
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		for _, w := range words {
+			f := fnv.New64a()
+			_, _ = f.Write([]byte(w))
+			sum := f.Sum64()
+
+			// the sign bit reduces collisions of unrelated words
+			sign := float32(1)
+			if sum&(1<<63) != 0 {
+				sign = -1
+			}
+			v[sum%uint64(h.dimensions)] += sign
+		}
+		vectors = append(vectors, Normalize(v))
+	}
+	return vectors, nil
+}
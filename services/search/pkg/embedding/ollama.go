@@ -0,0 +1,73 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Ollama computes embeddings with a locally running ollama server, see https://ollama.com
+type Ollama struct {
+	url    string
+	model  string
+	client *http.Client
+}
+
+// NewOllama returns a Provider using the given embedding model of an ollama server
+func NewOllama(url, model string, timeout time.Duration) *Ollama {
+	return &Ollama{
+		url:    strings.TrimSuffix(url, "/"),
+		model:  model,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type ollamaEmbedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type ollamaEmbedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+// Embed implements the Provider interface
+func (o *Ollama) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(ollamaEmbedRequest{Model: o.model, Input: texts})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url+"/api/embed", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from ollama: %s", res.Status)
+	}
+
+	var er ollamaEmbedResponse
+	if err := json.NewDecoder(res.Body).Decode(&er); err != nil {
+		return nil, err
+	}
+	if len(er.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("ollama returned %d embeddings for %d texts", len(er.Embeddings), len(texts))
+	}
+
+	for _, v := range er.Embeddings {
+		Normalize(v)
+	}
+	return er.Embeddings, nil
+}
@@ -90,6 +90,13 @@ func (b *Backend) Search(ctx context.Context, sir *searchService.SearchIndexRequ
 				),
 			),
 		)
+
+		// restrict the query to the requested path, the facets are computed from all matching documents
+		if requestedPath := utils.MakeRelativePath(sir.Ref.Path); requestedPath != "." {
+			boolQuery.Filter(
+				osu.NewTermQuery[string]("Path").Value(strings.ToLower(requestedPath)),
+			)
+		}
 	}
 
 	searchParams := opensearchgoAPI.SearchParams{}
@@ -116,6 +123,7 @@ func (b *Backend) Search(ctx context.Context, sir *searchService.SearchIndexRequ
 					"Content": {},
 				},
 			},
+			Aggregations: facetAggregations(sir.Facets),
 		},
 	)
 	if err != nil {
@@ -149,9 +157,15 @@ func (b *Backend) Search(ctx context.Context, sir *searchService.SearchIndexRequ
 		matches = append(matches, match)
 	}
 
+	facets, err := getFacets(sir.Facets, resp.Aggregations)
+	if err != nil {
+		return nil, err
+	}
+
 	return &searchService.SearchIndexResponse{
 		Matches:      matches,
 		TotalMatches: int32(totalMatches),
+		Facets:       facets,
 	}, nil
 }
 
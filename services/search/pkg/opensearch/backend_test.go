@@ -9,6 +9,7 @@ import (
 	opensearchgoAPI "github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 	"github.com/stretchr/testify/require"
 
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
 	searchService "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/test"
@@ -71,6 +72,24 @@ func TestEngine_Search(t *testing.T) {
 		require.Equal(t, int32(1), resp.TotalMatches)
 		require.Equal(t, document.ID, fmt.Sprintf("%s$%s!%s", resp.Matches[0].Entity.Id.StorageId, resp.Matches[0].Entity.Id.SpaceId, resp.Matches[0].Entity.Id.OpaqueId))
 	})
+
+	t.Run("computes the requested facets", func(t *testing.T) {
+		resp, err := backend.Search(t.Context(), &searchService.SearchIndexRequest{
+			Query: fmt.Sprintf(`"%s"`, document.Name),
+			Facets: []*searchMessage.FacetRequest{
+				{Field: "mediatype"},
+				{Field: "mimetype"},
+				{Field: "tags"},
+				{Field: "size"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Facets, 4)
+		require.Equal(t, []*searchMessage.FacetValue{{Value: "file", Count: 1}, {Value: "image", Count: 1}}, resp.Facets[0].Values)
+		require.Equal(t, []*searchMessage.FacetValue{{Value: document.MimeType, Count: 1}}, resp.Facets[1].Values)
+		require.Equal(t, []*searchMessage.FacetValue{{Value: document.Tags[0], Count: 1}}, resp.Facets[2].Values)
+		require.Equal(t, []*searchMessage.FacetValue{{Value: "0-100KB", Count: 1}}, resp.Facets[3].Values)
+	})
 }
 
 func TestEngine_Upsert(t *testing.T) {
@@ -0,0 +1,99 @@
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/osu"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+// facetAggregations returns the aggregations of the requested facets, the space facet is computed by the search service
+func facetAggregations(facets []*searchMessage.FacetRequest) map[string]osu.BodyParamAggregation {
+	aggregations := make(map[string]osu.BodyParamAggregation, len(facets))
+	for _, f := range facets {
+		switch f.GetField() {
+		case search.FacetMediaType:
+			aggregations[f.GetField()] = osu.BodyParamAggregation{
+				Terms: &osu.BodyParamTermsAggregation{Field: "MimeType", Size: search.MediaTypeFacetSize},
+			}
+		case search.FacetMimeType:
+			aggregations[f.GetField()] = osu.BodyParamAggregation{
+				// one more, the space roots don't have a mime type
+				Terms: &osu.BodyParamTermsAggregation{Field: "MimeType", Size: search.FacetLimit(f) + 1},
+			}
+		case search.FacetTags:
+			aggregations[f.GetField()] = osu.BodyParamAggregation{
+				Terms: &osu.BodyParamTermsAggregation{Field: "Tags.keyword", Size: search.FacetLimit(f)},
+			}
+		case search.FacetMtime:
+			buckets := search.MtimeBuckets()
+			ranges := make([]osu.BodyParamAggregationRange, 0, len(buckets))
+			for _, b := range buckets {
+				ranges = append(ranges, osu.BodyParamAggregationRange{
+					Key:  b.Name,
+					From: b.From.Format(time.RFC3339Nano),
+					To:   b.To.Format(time.RFC3339Nano),
+				})
+			}
+			aggregations[f.GetField()] = osu.BodyParamAggregation{
+				DateRange: &osu.BodyParamRangeAggregation{Field: "Mtime", Ranges: ranges},
+			}
+		case search.FacetSize:
+			ranges := make([]osu.BodyParamAggregationRange, 0, len(search.SizeBuckets))
+			for _, b := range search.SizeBuckets {
+				r := osu.BodyParamAggregationRange{Key: b.Name, From: b.Min}
+				if b.Max > 0 {
+					r.To = b.Max
+				}
+				ranges = append(ranges, r)
+			}
+			aggregations[f.GetField()] = osu.BodyParamAggregation{
+				Range: &osu.BodyParamRangeAggregation{Field: "Size", Ranges: ranges},
+			}
+		}
+	}
+	return aggregations
+}
+
+// getFacets converts the aggregations of a search response, the values of a facet keep the order of its buckets
+func getFacets(facets []*searchMessage.FacetRequest, raw json.RawMessage) ([]*searchMessage.Facet, error) {
+	if len(facets) == 0 || len(raw) == 0 {
+		return nil, nil
+	}
+
+	aggregations := map[string]osu.AggregationBuckets{}
+	if err := json.Unmarshal(raw, &aggregations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal aggregations: %w", err)
+	}
+
+	out := make([]*searchMessage.Facet, 0, len(facets))
+	for _, f := range facets {
+		aggregation, ok := aggregations[f.GetField()]
+		if !ok {
+			continue
+		}
+
+		facet := &searchMessage.Facet{Field: f.GetField()}
+		for _, b := range aggregation.Buckets {
+			if key := fmt.Sprint(b.Key); key != "" && b.DocCount > 0 {
+				facet.Values = append(facet.Values, &searchMessage.FacetValue{Value: key, Count: b.DocCount})
+			}
+		}
+
+		switch f.GetField() {
+		case search.FacetMediaType:
+			counts := make(map[string]int64, len(facet.Values))
+			for _, v := range facet.Values {
+				counts[v.GetValue()] = v.GetCount()
+			}
+			facet = search.MediaTypeFacet(counts)
+		case search.FacetMimeType, search.FacetTags:
+			facet.Values = facet.Values[:min(len(facet.Values), search.FacetLimit(f))]
+		}
+		out = append(out, facet)
+	}
+	return out, nil
+}
@@ -53,6 +53,27 @@ func (e kqlExpander) expand(nodes []ast.Node, defaultKey string) ([]ast.Node, er
 			cnode.Key = e.remapKey(cnode.Key, defaultKey)
 		case *ast.BooleanNode:
 			cnode.Key = e.remapKey(cnode.Key, defaultKey)
+		case *ast.NumericNode:
+			cnode.Key = e.remapKey(cnode.Key, defaultKey)
+		case *ast.ProximityNode:
+			// names are single terms, free-text proximity searches the content
+			if cnode.Key == "" && defaultKey == "" {
+				cnode.Key = "Content"
+			} else {
+				cnode.Key = e.remapKey(cnode.Key, defaultKey)
+			}
+		case *ast.RankNode:
+			matchNodes, err := e.expand(cnode.Match, defaultKey)
+			if err != nil {
+				return nil, err
+			}
+			cnode.Match = matchNodes
+
+			rankNodes, err := e.expand(cnode.Rank, defaultKey)
+			if err != nil {
+				return nil, err
+			}
+			cnode.Rank = rankNodes
 		}
 
 		if unfoldedNodes != nil {
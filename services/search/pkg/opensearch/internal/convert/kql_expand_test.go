@@ -520,6 +520,38 @@ func TestExpandKQLAST(t *testing.T) {
 					&ast.StringNode{Key: "Name", Value: `some-name`},
 				},
 			},
+			{
+				Name: "search the content for proximity nodes without a key",
+				Got: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"a", "b"}},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.GroupNode{Key: "name", Nodes: []ast.Node{
+						&ast.ProximityNode{Terms: []string{"a", "b"}},
+					}},
+				},
+				Want: []ast.Node{
+					&ast.ProximityNode{Key: "Content", Terms: []string{"a", "b"}},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.GroupNode{Key: "Name", Nodes: []ast.Node{
+						&ast.ProximityNode{Key: "Name", Terms: []string{"a", "b"}},
+					}},
+				},
+			},
+			{
+				Name: "expand the nodes of rank nodes",
+				Got: []ast.Node{
+					&ast.RankNode{
+						Match: []ast.Node{&ast.NumericNode{Key: "size"}},
+						Rank:  []ast.Node{&ast.StringNode{Key: "mediatype", Value: "folder"}},
+					},
+				},
+				Want: []ast.Node{
+					&ast.RankNode{
+						Match: []ast.Node{&ast.NumericNode{Key: "Size"}},
+						Rank:  []ast.Node{&ast.StringNode{Key: "MimeType", Value: "httpd/unix-directory"}},
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -3,12 +3,14 @@ package convert
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/ast"
 	"github.com/opencloud-eu/opencloud/pkg/kql"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/osu"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/query"
 )
 
 func TranspileKQLToOpenSearch(nodes []ast.Node) (osu.Builder, error) {
@@ -98,6 +100,8 @@ func (t kqlOpensearchTranspiler) toBuilder(node ast.Node) (osu.Builder, error) {
 	switch node := node.(type) {
 	case *ast.BooleanNode:
 		return osu.NewTermQuery[bool](node.Key).Value(node.Value), nil
+	case *ast.SimilarNode:
+		return nil, &query.UnsupportedSimilarityError{Node: node}
 	case *ast.StringNode:
 		isWildcard := strings.Contains(node.Value, "*")
 		if isWildcard {
@@ -134,6 +138,43 @@ func (t kqlOpensearchTranspiler) toBuilder(node ast.Node) (osu.Builder, error) {
 		}
 
 		return nil, fmt.Errorf("unsupported operator %s for date time node: %w", node.Operator.Value, ErrUnsupportedNodeType)
+	case *ast.NumericNode:
+		if node.Operator == nil {
+			return builder, fmt.Errorf("numeric node without operator: %w", ErrUnsupportedNodeType)
+		}
+
+		query := osu.NewRangeQuery[string](node.Key)
+		value := strconv.FormatFloat(node.Value, 'f', -1, 64)
+
+		switch node.Operator.Value {
+		case ">":
+			return query.Gt(value), nil
+		case ">=":
+			return query.Gte(value), nil
+		case "<":
+			return query.Lt(value), nil
+		case "<=":
+			return query.Lte(value), nil
+		}
+
+		return nil, fmt.Errorf("unsupported operator %s for numeric node: %w", node.Operator.Value, ErrUnsupportedNodeType)
+	case *ast.ProximityNode:
+		return osu.NewIntervalsQuery(node.Key).Phrases(node.Terms...).MaxGaps(node.Distance).Ordered(node.Ordered), nil
+	case *ast.RankNode:
+		match, err := t.transpile(node.Match)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build match expression: %w", err)
+		}
+
+		rank, err := t.transpile(node.Rank)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build rank expression: %w", err)
+		}
+
+		// the rank expression is optional and only boosts the matches
+		return osu.NewBoolQuery().Must(match).Should(
+			osu.NewBoolQuery().Params(&osu.BoolQueryParams{Boost: float32(node.Boost)}).Must(rank),
+		), nil
 	case *ast.GroupNode:
 		group, err := t.transpile(node.Nodes)
 		if err != nil {
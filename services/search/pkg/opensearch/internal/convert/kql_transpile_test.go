@@ -361,6 +361,48 @@ func TestTranspileKQLToOpenSearch(t *testing.T) {
 				osu.NewTermQuery[string]("d").Value("d"),
 			),
 		},
+		{
+			Name: "range query - numeric node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "Size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1048576},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.NumericNode{Key: "Size", Operator: &ast.OperatorNode{Value: "<"}, Value: 1.5},
+				},
+			},
+			Want: osu.NewBoolQuery().Must(
+				osu.NewRangeQuery[string]("Size").Gte("1048576"),
+				osu.NewRangeQuery[string]("Size").Lt("1.5"),
+			),
+		},
+		{
+			Name: "intervals query - proximity node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Key: "Content", Terms: []string{"tax return", "2023"}, Distance: 3, Ordered: true},
+				},
+			},
+			Want: osu.NewIntervalsQuery("Content").Phrases("tax return", "2023").MaxGaps(3).Ordered(true),
+		},
+		{
+			Name: "bool query - rank node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Boost: 100,
+						Match: []ast.Node{&ast.StringNode{Key: "Name", Value: "a"}},
+						Rank:  []ast.Node{&ast.StringNode{Key: "Tags", Value: "b"}},
+					},
+				},
+			},
+			Want: osu.NewBoolQuery().
+				Must(osu.NewTermQuery[string]("Name").Value("a")).
+				Should(
+					osu.NewBoolQuery().
+						Params(&osu.BoolQueryParams{Boost: 100}).
+						Must(osu.NewTermQuery[string]("Tags").Value("b")),
+				),
+		},
 	}
 
 	for _, test := range tests {
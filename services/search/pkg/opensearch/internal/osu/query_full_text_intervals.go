@@ -0,0 +1,70 @@
+package osu
+
+import (
+	"encoding/json"
+)
+
+// IntervalsQuery matches phrases that are at most maxGaps terms apart
+type IntervalsQuery struct {
+	field   string
+	phrases []string
+	maxGaps int
+	ordered bool
+}
+
+func NewIntervalsQuery(field string) *IntervalsQuery {
+	return &IntervalsQuery{field: field}
+}
+
+func (q *IntervalsQuery) Phrases(v ...string) *IntervalsQuery {
+	q.phrases = append(q.phrases, v...)
+	return q
+}
+
+func (q *IntervalsQuery) MaxGaps(v int) *IntervalsQuery {
+	q.maxGaps = v
+	return q
+}
+
+func (q *IntervalsQuery) Ordered(v bool) *IntervalsQuery {
+	q.ordered = v
+	return q
+}
+
+func (q *IntervalsQuery) Map() (map[string]any, error) {
+	if len(q.phrases) == 0 {
+		return nil, nil
+	}
+
+	// max_gaps is set explicitly, opensearch treats a missing value as unlimited
+	intervals := make([]map[string]any, 0, len(q.phrases))
+	for _, phrase := range q.phrases {
+		intervals = append(intervals, map[string]any{
+			"match": map[string]any{
+				"query":    phrase,
+				"max_gaps": 0,
+				"ordered":  true,
+			},
+		})
+	}
+
+	return map[string]any{
+		"intervals": map[string]any{
+			q.field: map[string]any{
+				"all_of": map[string]any{
+					"intervals": intervals,
+					"max_gaps":  q.maxGaps,
+					"ordered":   q.ordered,
+				},
+			},
+		},
+	}, nil
+}
+
+func (q *IntervalsQuery) MarshalJSON() ([]byte, error) {
+	data, err := q.Map()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
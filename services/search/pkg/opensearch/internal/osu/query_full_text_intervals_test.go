@@ -0,0 +1,62 @@
+package osu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/osu"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/test"
+)
+
+func TestNewIntervalsQuery(t *testing.T) {
+	tests := []opensearchtest.TableTest[osu.Builder, map[string]any]{
+		{
+			Name: "empty",
+			Got:  osu.NewIntervalsQuery("empty"),
+			Want: nil,
+		},
+		{
+			Name: "phrases",
+			Got:  osu.NewIntervalsQuery("Content").Phrases("tax return", "2023").MaxGaps(3).Ordered(true),
+			Want: map[string]any{
+				"intervals": map[string]any{
+					"Content": map[string]any{
+						"all_of": map[string]any{
+							"intervals": []map[string]any{
+								{"match": map[string]any{"query": "tax return", "max_gaps": 0, "ordered": true}},
+								{"match": map[string]any{"query": "2023", "max_gaps": 0, "ordered": true}},
+							},
+							"max_gaps": 3,
+							"ordered":  true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "unordered",
+			Got:  osu.NewIntervalsQuery("Content").Phrases("cat", "dog"),
+			Want: map[string]any{
+				"intervals": map[string]any{
+					"Content": map[string]any{
+						"all_of": map[string]any{
+							"intervals": []map[string]any{
+								{"match": map[string]any{"query": "cat", "max_gaps": 0, "ordered": true}},
+								{"match": map[string]any{"query": "dog", "max_gaps": 0, "ordered": true}},
+							},
+							"max_gaps": 0,
+							"ordered":  false,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.JSONEq(t, opensearchtest.JSONMustMarshal(t, test.Want), opensearchtest.JSONMustMarshal(t, test.Got))
+		})
+	}
+}
@@ -64,7 +64,38 @@ func BuildSearchReq(req *opensearchgoAPI.SearchReq, q Builder, p ...SearchBodyPa
 }
 
 type SearchBodyParams struct {
-	Highlight *BodyParamHighlight `json:"highlight,omitempty"`
+	Highlight    *BodyParamHighlight             `json:"highlight,omitempty"`
+	Aggregations map[string]BodyParamAggregation `json:"aggs,omitempty"`
+}
+
+type BodyParamAggregation struct {
+	Terms     *BodyParamTermsAggregation `json:"terms,omitempty"`
+	Range     *BodyParamRangeAggregation `json:"range,omitempty"`
+	DateRange *BodyParamRangeAggregation `json:"date_range,omitempty"`
+}
+
+type BodyParamTermsAggregation struct {
+	Field string `json:"field"`
+	Size  int    `json:"size,omitempty"`
+}
+
+type BodyParamRangeAggregation struct {
+	Field  string                      `json:"field"`
+	Ranges []BodyParamAggregationRange `json:"ranges"`
+}
+
+type BodyParamAggregationRange struct {
+	Key  string `json:"key,omitempty"`
+	From any    `json:"from,omitempty"`
+	To   any    `json:"to,omitempty"`
+}
+
+// AggregationBuckets is the result of a bucket aggregation
+type AggregationBuckets struct {
+	Buckets []struct {
+		Key      any   `json:"key"`
+		DocCount int64 `json:"doc_count"`
+	} `json:"buckets"`
 }
 
 //----------------------------------------------------------------------------//
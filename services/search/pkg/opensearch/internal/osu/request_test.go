@@ -74,6 +74,59 @@ func TestBuildSearchReq(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "aggregations",
+			Got: func() io.Reader {
+				req, _ := osu.BuildSearchReq(
+					&opensearchgoAPI.SearchReq{},
+					osu.NewTermQuery[string]("content").Value("content"),
+					osu.SearchBodyParams{
+						Aggregations: map[string]osu.BodyParamAggregation{
+							"tags": {
+								Terms: &osu.BodyParamTermsAggregation{Field: "Tags.keyword", Size: 5},
+							},
+							"size": {
+								Range: &osu.BodyParamRangeAggregation{
+									Field: "Size",
+									Ranges: []osu.BodyParamAggregationRange{
+										{Key: "small", To: 1024},
+										{Key: "large", From: 1024},
+									},
+								},
+							},
+						},
+					},
+				)
+
+				return req.Body
+			}(),
+			Want: map[string]any{
+				"query": map[string]any{
+					"term": map[string]any{
+						"content": map[string]any{
+							"value": "content",
+						},
+					},
+				},
+				"aggs": map[string]any{
+					"tags": map[string]any{
+						"terms": map[string]any{
+							"field": "Tags.keyword",
+							"size":  5,
+						},
+					},
+					"size": map[string]any{
+						"range": map[string]any{
+							"field": "Size",
+							"ranges": []map[string]any{
+								{"key": "small", "to": 1024},
+								{"key": "large", "from": 1024},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -2,12 +2,18 @@ package bleve
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/token/porter"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
 	bleveQuery "github.com/blevesearch/bleve/v2/search/query"
 	"github.com/opencloud-eu/opencloud/pkg/ast"
 	"github.com/opencloud-eu/opencloud/pkg/kql"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/query"
 )
 
 var _fields = map[string]string{
@@ -83,6 +89,8 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 	var isGroup bool
 	for i := offset; i < len(nodes); i++ {
 		switch n := nodes[i].(type) {
+		case *ast.SimilarNode:
+			return nil, 0, &query.UnsupportedSimilarityError{Node: n}
 		case *ast.StringNode:
 			k := getField(n.Key)
 			v := n.Value
@@ -96,12 +104,17 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 
 			var q bleveQuery.Query
 			var group bool
-			switch k {
-			case "MimeType":
+			switch {
+			case k == "MimeType":
 				q, group = mimeType(k, v)
 				if prev == nil {
 					isGroup = group
 				}
+			case k == "Content" && strings.ContainsAny(n.Value, " \t") && !strings.ContainsAny(n.Value, "*?"):
+				// quoted phrases have to match in the given order
+				pq := bleveQuery.NewMatchPhraseQuery(strings.ToLower(n.Value))
+				pq.SetField(k)
+				q = pq
 			default:
 				q = bleveQuery.NewQueryStringQuery(k + ":" + v)
 			}
@@ -141,6 +154,51 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 				continue
 			}
 
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.NumericNode:
+			if n.Operator == nil {
+				continue
+			}
+
+			var minV, maxV *float64
+			var minInclusive, maxInclusive *bool
+			switch n.Operator.Value {
+			case ">":
+				minV, minInclusive = &n.Value, &[]bool{false}[0]
+			case ">=":
+				minV, minInclusive = &n.Value, &[]bool{true}[0]
+			case "<":
+				maxV, maxInclusive = &n.Value, &[]bool{false}[0]
+			case "<=":
+				maxV, maxInclusive = &n.Value, &[]bool{true}[0]
+			default:
+				continue
+			}
+
+			q := bleveQuery.NewNumericRangeInclusiveQuery(minV, maxV, minInclusive, maxInclusive)
+			q.SetField(getField(n.Key))
+
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.ProximityNode:
+			q := proximity(n)
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.RankNode:
+			q, err := rank(n)
+			if err != nil {
+				return nil, 0, err
+			}
 			if prev == nil {
 				prev = q
 			} else {
@@ -277,70 +335,99 @@ func getField(name string) string {
 
 func normalizeGroupingProperty(group *ast.GroupNode) *ast.GroupNode {
 	for _, n := range group.Nodes {
-		if onode, ok := n.(*ast.StringNode); ok {
+		switch onode := n.(type) {
+		case *ast.StringNode:
+			onode.Key = group.Key
+		case *ast.ProximityNode:
 			onode.Key = group.Key
 		}
 	}
 	return group
 }
 
+// proximity matches the terms of a proximity node as phrases with up to Distance unknown terms in between,
+// the terms are near to each other in the content by default because names are not tokenized
+func proximity(n *ast.ProximityNode) bleveQuery.Query {
+	field := "Content"
+	if n.Key != "" {
+		field = getField(n.Key)
+	}
+
+	terms := make([][]string, 0, len(n.Terms))
+	for _, t := range n.Terms {
+		if analyzed := analyze(field, t); len(analyzed) > 0 {
+			terms = append(terms, analyzed)
+		}
+	}
+	// terms without any token don't restrict the proximity
+	if len(terms) < 2 {
+		return bleveQuery.NewPhraseQuery(slices.Concat(terms...), field)
+	}
+
+	return &proximityQuery{
+		Terms:    terms,
+		Field:    field,
+		Distance: n.Distance,
+		Ordered:  n.Ordered,
+	}
+}
+
+// analyze splits a text into the terms of a field like the index does,
+// it mirrors the fulltext analyzer of the content and lowercases the terms of all other fields.
+func analyze(field, text string) []string {
+	var tokens analysis.TokenStream
+	switch field {
+	case "Content":
+		tokens = unicode.NewUnicodeTokenizer().Tokenize([]byte(text))
+		tokens = lowercase.NewLowerCaseFilter().Filter(tokens)
+		tokens = porter.NewPorterStemmer().Filter(tokens)
+	default:
+		tokens = analysis.TokenStream{{Term: []byte(strings.ToLower(text))}}
+	}
+
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		terms = append(terms, string(t.Term))
+	}
+	return terms
+}
+
+// rank boosts the matches of a rank node that also match its rank expression
+func rank(n *ast.RankNode) (bleveQuery.Query, error) {
+	match, _, err := walk(0, n.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _, err := walk(0, n.Rank)
+	if err != nil {
+		return nil, err
+	}
+
+	if b, ok := r.(bleveQuery.BoostableQuery); ok {
+		b.SetBoost(n.Boost)
+	}
+
+	return bleveQuery.NewBooleanQuery([]bleveQuery.Query{match}, []bleveQuery.Query{r}, nil), nil
+}
+
 func mimeType(k, v string) (bleveQuery.Query, bool) {
 	switch v {
 	case "file":
 		q := bleve.NewBooleanQuery()
-		q.AddMustNot(bleveQuery.NewQueryStringQuery(k + ":httpd/unix-directory"))
+		q.AddMustNot(bleveQuery.NewQueryStringQuery(k + ":" + query.FolderMimeType))
 		return q, false
 	case "folder":
-		return bleveQuery.NewQueryStringQuery(k + ":httpd/unix-directory"), false
-	case "document":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/msword",
-			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
-			"application/vnd.openxmlformats-officedocument.wordprocessingml.form",
-			"application/vnd.oasis.opendocument.text",
-			"text/plain",
-			"text/markdown",
-			"application/rtf",
-			"application/vnd.apple.pages",
-		)), true
-	case "spreadsheet":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/vnd.ms-excel",
-			"application/vnd.oasis.opendocument.spreadsheet",
-			"text/csv",
-			"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
-			"application/vnd.oasis.opendocument.spreadsheet",
-			"application/vnd.apple.numbers",
-		)), true
-	case "presentation":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/vnd.openxmlformats-officedocument.presentationml.presentation",
-			"application/vnd.oasis.opendocument.presentation",
-			"application/vnd.ms-powerpoint",
-			"application/vnd.apple.keynote",
-		)), true
-	case "pdf":
-		return bleveQuery.NewQueryStringQuery(k + ":application/pdf"), false
-	case "image":
-		return bleveQuery.NewQueryStringQuery(k + ":image/*"), false
-	case "video":
-		return bleveQuery.NewQueryStringQuery(k + ":video/*"), false
-	case "audio":
-		return bleveQuery.NewQueryStringQuery(k + ":audio/*"), false
-	case "archive":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/zip",
-			"application/gzip",
-			"application/x-gzip",
-			"application/x-7z-compressed",
-			"application/x-rar-compressed",
-			"application/x-tar",
-			"application/x-bzip2",
-			"application/x-bzip",
-			"application/x-tgz",
-		)), true
-	default:
+		return bleveQuery.NewQueryStringQuery(k + ":" + query.FolderMimeType), false
+	}
+
+	switch mimeTypes := query.MediaTypeMimeTypes[v]; len(mimeTypes) {
+	case 0:
 		return bleveQuery.NewQueryStringQuery(k + ":" + v), false
+	case 1:
+		return bleveQuery.NewQueryStringQuery(k + ":" + mimeTypes[0]), false
+	default:
+		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k, mimeTypes...)), true
 	}
 }
 
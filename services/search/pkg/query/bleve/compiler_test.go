@@ -525,6 +525,93 @@ func Test_compile(t *testing.T) {
 			}),
 			wantErr: false,
 		},
+		{
+			name: `size>10 size<=20`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">"}, Value: 10},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<="}, Value: 20},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					q := query.NewNumericRangeInclusiveQuery(&[]float64{10}[0], nil, &[]bool{false}[0], nil)
+					q.SetField("Size")
+					return q
+				}(),
+				func() query.Query {
+					q := query.NewNumericRangeInclusiveQuery(nil, &[]float64{20}[0], nil, &[]bool{true}[0])
+					q.SetField("Size")
+					return q
+				}(),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `content:"Quarterly Reports"`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "Quarterly Reports"},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					q := query.NewMatchPhraseQuery("quarterly reports")
+					q.SetField("Content")
+					return q
+				}(),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `Cats ONEAR(1) dog`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"Cats", "dog"}, Distance: 1, Ordered: true},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				&proximityQuery{Terms: [][]string{{"cat"}, {"dog"}}, Field: "Content", Distance: 1, Ordered: true},
+			}),
+			wantErr: false,
+		},
+		{
+			name: `cat NEAR(0) dog`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"cat", "dog"}, Distance: 0},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				&proximityQuery{Terms: [][]string{{"cat"}, {"dog"}}, Field: "Content"},
+			}),
+			wantErr: false,
+		},
+		{
+			name: `cat XRANK(cb=10) dog`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Boost: 10,
+						Match: []ast.Node{&ast.StringNode{Value: "cat"}},
+						Rank:  []ast.Node{&ast.StringNode{Value: "dog"}},
+					},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				query.NewBooleanQuery(
+					[]query.Query{query.NewQueryStringQuery(`Name:cat`)},
+					[]query.Query{func() query.Query {
+						q := query.NewQueryStringQuery(`Name:dog`)
+						q.SetBoost(10)
+						return q
+					}()},
+					nil,
+				),
+			}),
+			wantErr: false,
+		},
 	}
 
 	assert := tAssert.New(t)
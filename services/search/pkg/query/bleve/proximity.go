@@ -0,0 +1,91 @@
+package bleve
+
+import (
+	"context"
+	"slices"
+
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/searcher"
+	index "github.com/blevesearch/bleve_index_api"
+)
+
+// proximityQuery matches documents which contain the phrases of Terms at most Distance terms apart,
+// in the given order if Ordered is set. Bleve has no sloppy phrase or span queries, the documents
+// containing all phrases are filtered by the positions of the phrases instead.
+type proximityQuery struct {
+	Terms    [][]string
+	Field    string
+	Distance int
+	Ordered  bool
+}
+
+// Searcher implements the bleve query.Query interface
+func (q *proximityQuery) Searcher(ctx context.Context, i index.IndexReader, _ mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	options.IncludeTermVectors = true
+
+	phrases := make([]search.Searcher, 0, len(q.Terms))
+	for _, terms := range q.Terms {
+		s, err := searcher.NewPhraseSearcher(ctx, i, terms, 0, false, q.Field, 1.0, options)
+		if err != nil {
+			for _, p := range phrases {
+				_ = p.Close()
+			}
+			return nil, err
+		}
+		phrases = append(phrases, s)
+	}
+
+	s, err := searcher.NewConjunctionSearcher(ctx, i, phrases, options)
+	if err != nil {
+		for _, p := range phrases {
+			_ = p.Close()
+		}
+		return nil, err
+	}
+
+	return searcher.NewFilteringSearcher(ctx, s, q.near), nil
+}
+
+// near checks the positions of the phrases in the document
+func (q *proximityQuery) near(_ *search.SearchContext, d *search.DocumentMatch) bool {
+	starts := make([][]int, len(q.Terms))
+	for _, ftl := range d.FieldTermLocations {
+		if ftl.Field != q.Field {
+			continue
+		}
+		for i, terms := range q.Terms {
+			if ftl.Term == terms[0] {
+				starts[i] = append(starts[i], int(ftl.Location.Pos))
+			}
+		}
+	}
+	for _, s := range starts {
+		slices.Sort(s)
+	}
+
+	for i := range q.Terms {
+		for j := range q.Terms {
+			if i == j || (q.Ordered && i > j) {
+				continue
+			}
+			if follows(starts[i], len(q.Terms[i]), starts[j], q.Distance) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// follows returns true if a phrase of the given length starting at one of the left positions is followed by
+// one of the right positions with at most distance terms in between, the positions must be sorted
+func follows(left []int, length int, right []int, distance int) bool {
+	for _, l := range left {
+		first := l + length
+		k, _ := slices.BinarySearch(right, first)
+		if k < len(right) && right[k] <= first+distance {
+			return true
+		}
+	}
+	return false
+}
@@ -38,9 +38,37 @@ func (e UnsupportedTimeRangeError) Error() string {
 	return fmt.Sprintf("unable to convert '%v' to a time range", e.Value)
 }
 
+// UnsupportedSimilarityError records a similarity clause that can't be handled by a lexical query.
+type UnsupportedSimilarityError struct {
+	Node *ast.SimilarNode
+}
+
+func (e UnsupportedSimilarityError) Error() string {
+	return "'similar:" + e.Node.Value + "' requires the vector search engine and can only be combined with other clauses by AND"
+}
+
+// InvalidProximityDistanceError records a NEAR or ONEAR operator with a distance larger than allowed.
+type InvalidProximityDistanceError struct {
+	Distance float64
+	Max      int
+}
+
+func (e InvalidProximityDistanceError) Error() string {
+	return fmt.Sprintf("the proximity distance %v exceeds the maximum of %d", e.Distance, e.Max)
+}
+
+// IncompleteRankError records a XRANK operator without a match or a rank expression.
+type IncompleteRankError struct {
+	Node *ast.RankNode
+}
+
+func (e IncompleteRankError) Error() string {
+	return "the XRANK operator needs an expression on both sides"
+}
+
 func IsValidationError(err error) bool {
 	switch err.(type) {
-	case *StartsWithBinaryOperatorError, *NamedGroupInvalidNodesError, *UnsupportedTimeRangeError:
+	case *StartsWithBinaryOperatorError, *NamedGroupInvalidNodesError, *UnsupportedTimeRangeError, *UnsupportedSimilarityError, *InvalidProximityDistanceError, *IncompleteRankError:
 		return true
 	}
 	return false
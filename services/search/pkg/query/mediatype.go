@@ -0,0 +1,78 @@
+package query
+
+import (
+	"slices"
+	"strings"
+)
+
+// FolderMimeType is the mime type of folders
+const FolderMimeType = "httpd/unix-directory"
+
+// MediaTypes are the values of the `mediatype` key in the order they are presented.
+// `file` matches everything but folders, `folder` only matches folders.
+var MediaTypes = []string{"file", "folder", "document", "spreadsheet", "presentation", "pdf", "image", "video", "audio", "archive"}
+
+// MediaTypeMimeTypes maps the remaining media types to the mime types they match,
+// a mime type ending with `*` matches all mime types with that prefix.
+var MediaTypeMimeTypes = map[string][]string{
+	"document": {
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.form",
+		"application/vnd.oasis.opendocument.text",
+		"text/plain",
+		"text/markdown",
+		"application/rtf",
+		"application/vnd.apple.pages",
+	},
+	"spreadsheet": {
+		"application/vnd.ms-excel",
+		"application/vnd.oasis.opendocument.spreadsheet",
+		"text/csv",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.apple.numbers",
+	},
+	"presentation": {
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/vnd.oasis.opendocument.presentation",
+		"application/vnd.ms-powerpoint",
+		"application/vnd.apple.keynote",
+	},
+	"pdf":   {"application/pdf"},
+	"image": {"image/*"},
+	"video": {"video/*"},
+	"audio": {"audio/*"},
+	"archive": {
+		"application/zip",
+		"application/gzip",
+		"application/x-gzip",
+		"application/x-7z-compressed",
+		"application/x-rar-compressed",
+		"application/x-tar",
+		"application/x-bzip2",
+		"application/x-bzip",
+		"application/x-tgz",
+	},
+}
+
+// MediaTypesOf returns the media types matching a mime type
+func MediaTypesOf(mimeType string) []string {
+	mimeType = strings.ToLower(mimeType)
+	if mimeType == FolderMimeType {
+		return []string{"folder"}
+	}
+
+	mediaTypes := []string{"file"}
+	for _, mediaType := range MediaTypes {
+		matches := slices.ContainsFunc(MediaTypeMimeTypes[mediaType], func(m string) bool {
+			if prefix, ok := strings.CutSuffix(m, "*"); ok {
+				return strings.HasPrefix(mimeType, prefix)
+			}
+			return m == mimeType
+		})
+		if matches {
+			mediaTypes = append(mediaTypes, mediaType)
+		}
+	}
+	return mediaTypes
+}
@@ -0,0 +1,315 @@
+// Package alert notifies users about resources that newly match their saved searches.
+package alert
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	bleveSearch "github.com/blevesearch/bleve/v2"
+	bQuery "github.com/blevesearch/bleve/v2/search/query"
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	savedsearchEvent "github.com/opencloud-eu/opencloud/pkg/savedsearch"
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchService "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/bleve"
+	bleveQuery "github.com/opencloud-eu/opencloud/services/search/pkg/query/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+// Alerter evaluates the saved searches with enabled alerts against the resources UpsertItem indexes.
+// A resource matches a saved search if the new version matches the query while the indexed version didn't,
+// and the owner of the saved search is a member of the space of the resource.
+type Alerter struct {
+	store           *savedsearch.Store
+	engine          search.Engine
+	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	publisher       events.Publisher
+	logger          log.Logger
+
+	// alerts keeps the compiled saved searches with enabled alerts keyed by the user id, it is updated
+	// by watching the saved search store
+	alerts   map[string][]alert
+	alertsMu sync.RWMutex
+
+	// index evaluates the new versions of the resources, which aren't indexed yet
+	index   bleveSearch.Index
+	indexMu sync.Mutex
+}
+
+// alert is a saved search with its compiled query
+type alert struct {
+	savedSearch *searchMessage.SavedSearch
+	query       bQuery.Query
+}
+
+// New creates a new Alerter instance, it keeps watching the saved searches until the context is done.
+func New(ctx context.Context, store *savedsearch.Store, engine search.Engine, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], publisher events.Publisher, logger log.Logger) (*Alerter, error) {
+	index, err := newMemoryIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Alerter{
+		store:           store,
+		engine:          engine,
+		gatewaySelector: gatewaySelector,
+		publisher:       publisher,
+		logger:          logger,
+		alerts:          make(map[string][]alert),
+		index:           index,
+	}
+
+	if err := store.WatchAlerts(ctx, a.setAlerts); err != nil {
+		_ = index.Close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Upserting evaluates the saved searches before the resource is indexed
+// and publishes the matches once it was indexed.
+func (a *Alerter) Upserting(ctx context.Context, r search.Resource) func() {
+	matches, err := a.matches(ctx, r)
+	if err != nil {
+		a.logger.Error().Err(err).Str("id", r.ID).Msg("failed to evaluate saved search alerts")
+	}
+
+	return func() {
+		for _, m := range matches {
+			if err := events.Publish(ctx, a.publisher, m); err != nil {
+				a.logger.Error().Err(err).Str("id", r.ID).Str("savedsearch", m.SavedSearchID).Msg("failed to publish saved search match")
+			}
+		}
+	}
+}
+
+// setAlerts compiles the saved searches with enabled alerts of the user
+func (a *Alerter) setAlerts(userID string, searches []*searchMessage.SavedSearch) {
+	alerts := make([]alert, 0, len(searches))
+	for _, ss := range searches {
+		q, err := bleveQuery.DefaultCreator.Create(ss.GetQuery())
+		if err != nil {
+			a.logger.Error().Err(err).Str("savedsearch", ss.GetId()).Msg("failed to compile saved search query")
+			continue
+		}
+		alerts = append(alerts, alert{savedSearch: ss, query: q})
+	}
+
+	a.alertsMu.Lock()
+	defer a.alertsMu.Unlock()
+	if len(alerts) == 0 {
+		delete(a.alerts, userID)
+		return
+	}
+	a.alerts[userID] = alerts
+}
+
+// candidate is a saved search of a member of the space which covers the resource
+type candidate struct {
+	userID string
+	alert  alert
+	ref    *searchMessage.Reference
+}
+
+func (a *Alerter) matches(ctx context.Context, r search.Resource) ([]savedsearchEvent.Matched, error) {
+	a.alertsMu.RLock()
+	empty := len(a.alerts) == 0
+	a.alertsMu.RUnlock()
+	if empty {
+		return nil, nil
+	}
+
+	rid, err := storagespace.ParseID(r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gwc, err := a.gatewaySelector.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := utils.GetSpaceMembers(ctx, storagespace.FormatStorageID(rid.GetStorageId(), rid.GetSpaceId()), gwc, utils.ViewerRole)
+	if err != nil {
+		return nil, err
+	}
+
+	memberAlerts := make(map[string][]alert, len(members))
+	a.alertsMu.RLock()
+	for _, userID := range members {
+		if alerts := a.alerts[userID]; len(alerts) > 0 {
+			memberAlerts[userID] = alerts
+		}
+	}
+	a.alertsMu.RUnlock()
+	if len(memberAlerts) == 0 {
+		return nil, nil
+	}
+
+	var (
+		candidates []candidate
+		scopePaths = map[string]string{}
+	)
+	for _, userID := range members {
+		for _, al := range memberAlerts[userID] {
+			ref, err := a.reference(ctx, gwc, &rid, al.savedSearch.GetScope(), scopePaths)
+			if err != nil {
+				a.logger.Debug().Err(err).Str("savedsearch", al.savedSearch.GetId()).Msg("failed to resolve saved search scope")
+				continue
+			}
+			if ref == nil || !inScope(r.Path, ref) {
+				continue
+			}
+			candidates = append(candidates, candidate{userID: userID, alert: al, ref: ref})
+		}
+	}
+
+	candidates, err = a.matching(r, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []savedsearchEvent.Matched
+	for _, c := range candidates {
+		ss := c.alert.savedSearch
+
+		// only resources that didn't match before are new matches
+		if ok, err := hasMatch(ctx, a.engine, "("+ss.GetQuery()+`) AND id:"`+r.ID+`"`, c.ref); err != nil || ok {
+			continue
+		}
+
+		matches = append(matches, savedsearchEvent.Matched{
+			UserID:          &user.UserId{OpaqueId: c.userID},
+			SavedSearchID:   ss.GetId(),
+			SavedSearchName: ss.GetName(),
+			ResourceID:      &rid,
+			ResourceName:    r.Name,
+			Email:           ss.GetEmail(),
+			Timestamp:       time.Now(),
+		})
+	}
+
+	return matches, nil
+}
+
+// matching returns the candidates whose query matches the new version of the resource, the resource
+// is added to the in memory index of the alerter while the compiled queries are evaluated
+func (a *Alerter) matching(r search.Resource, candidates []candidate) ([]candidate, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	a.indexMu.Lock()
+	defer a.indexMu.Unlock()
+
+	if err := a.index.Index(r.ID, r); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = a.index.Delete(r.ID)
+	}()
+
+	var matching []candidate
+	for _, c := range candidates {
+		req := bleveSearch.NewSearchRequestOptions(bleveSearch.NewConjunctionQuery(
+			bleveSearch.NewDocIDQuery([]string{r.ID}),
+			&bQuery.BoolFieldQuery{Bool: false, FieldVal: "Deleted"},
+			c.alert.query,
+		), 1, 0, false)
+
+		res, err := a.index.Search(req)
+		if err != nil {
+			a.logger.Debug().Err(err).Str("savedsearch", c.alert.savedSearch.GetId()).Msg("failed to evaluate saved search query")
+			continue
+		}
+		if res.Total > 0 {
+			matching = append(matching, c)
+		}
+	}
+
+	return matching, nil
+}
+
+// reference returns the reference the saved search is limited to, it is nil if the resource is outside of the scope
+func (a *Alerter) reference(ctx context.Context, gwc gateway.GatewayAPIClient, rid *provider.ResourceId, scope string, scopePaths map[string]string) (*searchMessage.Reference, error) {
+	ref := &searchMessage.Reference{
+		ResourceId: &searchMessage.ResourceID{
+			StorageId: rid.GetStorageId(),
+			SpaceId:   rid.GetSpaceId(),
+			OpaqueId:  rid.GetSpaceId(),
+		},
+	}
+
+	if scope == "" {
+		return ref, nil
+	}
+
+	scopeID, err := storagespace.ParseID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if scopeID.GetStorageId() != rid.GetStorageId() || scopeID.GetSpaceId() != rid.GetSpaceId() {
+		return nil, nil
+	}
+
+	path, ok := scopePaths[scope]
+	if !ok {
+		res, err := gwc.GetPath(ctx, &provider.GetPathRequest{ResourceId: &scopeID})
+		if err != nil {
+			return nil, err
+		}
+		if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+			return nil, errors.New(res.GetStatus().GetMessage())
+		}
+
+		path = res.GetPath()
+		scopePaths[scope] = path
+	}
+
+	ref.Path = path
+	return ref, nil
+}
+
+func hasMatch(ctx context.Context, engine search.Engine, query string, ref *searchMessage.Reference) (bool, error) {
+	res, err := engine.Search(ctx, &searchService.SearchIndexRequest{
+		Query:    query,
+		Ref:      ref,
+		PageSize: 1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return res.GetTotalMatches() > 0, nil
+}
+
+// inScope returns true if the path of the resource is within the path of the reference
+func inScope(path string, ref *searchMessage.Reference) bool {
+	scope := utils.MakeRelativePath(ref.GetPath())
+	path = strings.TrimSuffix(path, "/")
+	return scope == "." || path == scope || strings.HasPrefix(path, scope+"/")
+}
+
+func newMemoryIndex() (bleveSearch.Index, error) {
+	mapping, err := bleve.NewMapping()
+	if err != nil {
+		return nil, err
+	}
+
+	return bleveSearch.NewMemOnly(mapping)
+}
@@ -0,0 +1,44 @@
+package alert_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlert(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alert Suite")
+}
+
+var js jetstream.JetStream
+
+var _ = BeforeSuite(func() {
+	ns, err := natsserver.NewServer(&natsserver.Options{Port: natsserver.RANDOM_PORT, JetStream: true, StoreDir: GinkgoT().TempDir()})
+	Expect(err).ToNot(HaveOccurred())
+	go ns.Start()
+	DeferCleanup(ns.Shutdown)
+	Expect(ns.ReadyForConnections(5 * time.Second)).To(BeTrue())
+
+	conn, err := nats.Connect(ns.ClientURL())
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	js, err = jetstream.New(conn)
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// newKeyValue returns an empty key value bucket
+func newKeyValue() jetstream.KeyValue {
+	ctx := context.Background()
+	_ = js.DeleteKeyValue(ctx, "savedsearches")
+	kv, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: "savedsearches"})
+	Expect(err).ToNot(HaveOccurred())
+	return kv
+}
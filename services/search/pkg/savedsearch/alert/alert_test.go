@@ -0,0 +1,223 @@
+package alert_test
+
+import (
+	"context"
+
+	bleveSearch "github.com/blevesearch/bleve/v2"
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/mock"
+	microevents "go-micro.dev/v4/events"
+	"google.golang.org/grpc"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	savedsearchEvent "github.com/opencloud-eu/opencloud/pkg/savedsearch"
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/content"
+	bleveQuery "github.com/opencloud-eu/opencloud/services/search/pkg/query/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch/alert"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+// testPublisher records the published events
+type testPublisher struct {
+	events []interface{}
+}
+
+func (p *testPublisher) Publish(_ string, ev interface{}, _ ...microevents.PublishOption) error {
+	p.events = append(p.events, ev)
+	return nil
+}
+
+var _ = Describe("Alerter", func() {
+	var (
+		newAlerter    func() *alert.Alerter
+		savedSearches *savedsearch.Store
+		engine        *bleve.Backend
+		publisher     *testPublisher
+		gatewayClient *cs3mocks.GatewayAPIClient
+		ctx           = context.Background()
+
+		resource = search.Resource{
+			ID:       "storageid$spaceid!opaqueid",
+			RootID:   "storageid$spaceid!spaceid",
+			Path:     "./invoices/invoice.pdf",
+			Document: content.Document{Name: "invoice.pdf", Tags: []string{"unpaid"}},
+		}
+
+		// upsert creates the alerter, which loads the current saved searches, and upserts the resource
+		upsert = func(r search.Resource) {
+			published := newAlerter().Upserting(ctx, r)
+			Expect(engine.Upsert(r.ID, r)).To(Succeed())
+			published()
+		}
+
+		matched = func() []savedsearchEvent.Matched {
+			var matches []savedsearchEvent.Matched
+			for _, ev := range publisher.events {
+				matches = append(matches, ev.(savedsearchEvent.Matched))
+			}
+			return matches
+		}
+	)
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+		gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+			Status: status.NewOK(ctx),
+			StorageSpaces: []*sprovider.StorageSpace{{
+				Id:        &sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"},
+				Root:      &sprovider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "spaceid"},
+				Owner:     &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "user"}},
+				SpaceType: "personal",
+			}},
+		}, nil)
+		gatewayClient.On("GetPath", mock.Anything, mock.MatchedBy(func(req *sprovider.GetPathRequest) bool {
+			return req.GetResourceId().GetOpaqueId() == "invoices"
+		})).Return(&sprovider.GetPathResponse{
+			Status: status.NewOK(ctx),
+			Path:   "/invoices",
+		}, nil)
+		gatewayClient.On("GetPath", mock.Anything, mock.Anything).Return(&sprovider.GetPathResponse{
+			Status: status.NewOK(ctx),
+			Path:   "/archive",
+		}, nil)
+
+		mapping, err := bleve.NewMapping()
+		Expect(err).ToNot(HaveOccurred())
+		idx, err := bleveSearch.NewMemOnly(mapping)
+		Expect(err).ToNot(HaveOccurred())
+		engine = bleve.NewBackend(idx, bleveQuery.DefaultCreator, log.NewLogger())
+
+		savedSearches = savedsearch.NewStore(newKeyValue())
+		publisher = &testPublisher{}
+		newAlerter = func() *alert.Alerter {
+			alertCtx, cancel := context.WithCancel(ctx)
+			DeferCleanup(cancel)
+			a, err := alert.New(alertCtx, savedSearches, engine, gatewaySelector, publisher, log.NewLogger())
+			Expect(err).ToNot(HaveOccurred())
+			return a
+		}
+	})
+
+	It("publishes new matches of saved searches with alerts", func() {
+		ss, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid", Alert: true, Email: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		matches := matched()
+		Expect(matches).To(HaveLen(1))
+		Expect(matches[0].UserID.GetOpaqueId()).To(Equal("user"))
+		Expect(matches[0].SavedSearchID).To(Equal(ss.GetId()))
+		Expect(matches[0].SavedSearchName).To(Equal("unpaid"))
+		Expect(matches[0].ResourceID.GetOpaqueId()).To(Equal("opaqueid"))
+		Expect(matches[0].ResourceName).To(Equal("invoice.pdf"))
+		Expect(matches[0].Email).To(BeTrue())
+	})
+
+	It("ignores saved searches without alerts", func() {
+		_, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid"})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		Expect(matched()).To(BeEmpty())
+	})
+
+	It("ignores resources that don't match", func() {
+		_, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "paid", Query: "tag:paid", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		Expect(matched()).To(BeEmpty())
+	})
+
+	It("ignores resources that already matched", func() {
+		_, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(engine.Upsert(resource.ID, resource)).To(Succeed())
+
+		upsert(resource)
+
+		Expect(matched()).To(BeEmpty())
+	})
+
+	It("ignores the saved searches of users that aren't members of the space", func() {
+		_, err := savedSearches.Create(ctx, "otheruser", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		Expect(matched()).To(BeEmpty())
+	})
+
+	It("limits the saved searches to their scope", func() {
+		_, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "invoices", Query: "tag:unpaid", Scope: "storageid$spaceid!invoices", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "archive", Query: "tag:unpaid", Scope: "storageid$spaceid!archive", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "other space", Query: "tag:unpaid", Scope: "storageid$otherspace!invoices", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		matches := matched()
+		Expect(matches).To(HaveLen(1))
+		Expect(matches[0].SavedSearchName).To(Equal("invoices"))
+	})
+
+	It("doesn't look up the space members without saved searches with alerts", func() {
+		_, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid"})
+		Expect(err).ToNot(HaveOccurred())
+
+		upsert(resource)
+
+		Expect(matched()).To(BeEmpty())
+		gatewayClient.AssertNotCalled(GinkgoT(), "ListStorageSpaces", mock.Anything, mock.Anything)
+	})
+
+	It("keeps the saved searches up to date", func() {
+		alerter := newAlerter()
+		evaluate := func() int {
+			publisher.events = nil
+			alerter.Upserting(ctx, resource)()
+			return len(matched())
+		}
+		Expect(evaluate()).To(Equal(0))
+
+		ss, err := savedSearches.Create(ctx, "user", &searchmsg.SavedSearch{Name: "unpaid", Query: "tag:unpaid", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(evaluate).Should(Equal(1))
+
+		ss.Query = "tag:paid"
+		_, err = savedSearches.Update(ctx, "user", ss)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(evaluate).Should(Equal(0))
+
+		ss.Query = "tag:unpaid"
+		_, err = savedSearches.Update(ctx, "user", ss)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(evaluate).Should(Equal(1))
+
+		Expect(savedSearches.Delete(ctx, "user", ss.GetId())).To(Succeed())
+		Eventually(evaluate).Should(Equal(0))
+	})
+})
@@ -0,0 +1,289 @@
+// Package savedsearch persists the named search queries of users.
+package savedsearch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+
+	"github.com/opencloud-eu/opencloud/pkg/kql"
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+)
+
+// _maxConflicts is the number of times the saved searches of a user are read again when they were
+// changed by another request while they were updated
+const _maxConflicts = 5
+
+var (
+	// ErrNotFound is returned if the saved search doesn't exist
+	ErrNotFound = errors.New("saved search not found")
+
+	// ErrConflict is returned if the saved searches of a user kept changing while they were updated
+	ErrConflict = errors.New("saved searches were changed concurrently")
+)
+
+// Store keeps the saved searches of every user in one key value entry keyed by the user id.
+// Entries are only written when their revision didn't change since they were read, so
+// concurrent changes on multiple search instances don't overwrite each other.
+type Store struct {
+	kv jetstream.KeyValue
+}
+
+// NewStore creates a new Store instance.
+func NewStore(kv jetstream.KeyValue) *Store {
+	return &Store{kv: kv}
+}
+
+// List returns the saved searches of the user.
+func (s *Store) List(ctx context.Context, userID string) ([]*searchMessage.SavedSearch, error) {
+	searches, _, err := s.read(ctx, userID)
+	return searches, err
+}
+
+// Get returns the saved search of the user with the given id.
+func (s *Store) Get(ctx context.Context, userID, id string) (*searchMessage.SavedSearch, error) {
+	searches, _, err := s.read(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ss := range searches {
+		if ss.GetId() == id {
+			return ss, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// Create validates the saved search, assigns a new id and stores it for the user.
+func (s *Store) Create(ctx context.Context, userID string, ss *searchMessage.SavedSearch) (*searchMessage.SavedSearch, error) {
+	if err := Validate(ss); err != nil {
+		return nil, err
+	}
+
+	created := &searchMessage.SavedSearch{
+		Id:    uuid.New().String(),
+		Name:  strings.TrimSpace(ss.GetName()),
+		Query: ss.GetQuery(),
+		Scope: ss.GetScope(),
+		Alert: ss.GetAlert(),
+		Email: ss.GetEmail(),
+	}
+
+	err := s.alter(ctx, userID, func(searches []*searchMessage.SavedSearch) ([]*searchMessage.SavedSearch, error) {
+		return append(searches, created), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Update validates the saved search and replaces the stored saved search with the same id.
+func (s *Store) Update(ctx context.Context, userID string, ss *searchMessage.SavedSearch) (*searchMessage.SavedSearch, error) {
+	if err := Validate(ss); err != nil {
+		return nil, err
+	}
+
+	updated := &searchMessage.SavedSearch{
+		Id:    ss.GetId(),
+		Name:  strings.TrimSpace(ss.GetName()),
+		Query: ss.GetQuery(),
+		Scope: ss.GetScope(),
+		Alert: ss.GetAlert(),
+		Email: ss.GetEmail(),
+	}
+
+	err := s.alter(ctx, userID, func(searches []*searchMessage.SavedSearch) ([]*searchMessage.SavedSearch, error) {
+		for i, existing := range searches {
+			if existing.GetId() == updated.GetId() {
+				searches[i] = updated
+				return searches, nil
+			}
+		}
+		return nil, ErrNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Delete removes the saved search of the user with the given id.
+func (s *Store) Delete(ctx context.Context, userID, id string) error {
+	return s.alter(ctx, userID, func(searches []*searchMessage.SavedSearch) ([]*searchMessage.SavedSearch, error) {
+		for i, existing := range searches {
+			if existing.GetId() == id {
+				return append(searches[:i], searches[i+1:]...), nil
+			}
+		}
+		return nil, ErrNotFound
+	})
+}
+
+// WatchAlerts passes the saved searches with enabled alerts of a user to update whenever the saved searches
+// of the user change. It returns once the current saved searches of all users were passed and keeps watching
+// until the context is done.
+func (s *Store) WatchAlerts(ctx context.Context, update func(userID string, alerts []*searchMessage.SavedSearch)) error {
+	watcher, err := s.kv.WatchAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for entry := range watcher.Updates() {
+		if entry == nil {
+			// all current entries were passed
+			break
+		}
+		updateAlerts(entry, update)
+	}
+
+	go func() {
+		defer func() {
+			_ = watcher.Stop()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case entry, ok := <-watcher.Updates():
+				if !ok {
+					return
+				}
+				if entry != nil {
+					updateAlerts(entry, update)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Validate checks that the saved search has a name, a valid KQL query and a valid scope.
+func Validate(ss *searchMessage.SavedSearch) error {
+	if strings.TrimSpace(ss.GetName()) == "" {
+		return errtypes.BadRequest("saved search name must not be empty")
+	}
+
+	if strings.TrimSpace(ss.GetQuery()) == "" {
+		return errtypes.BadRequest("saved search query must not be empty")
+	}
+
+	if _, err := (kql.Builder{}).Build(ss.GetQuery()); err != nil {
+		return errtypes.BadRequest("invalid saved search query: " + err.Error())
+	}
+
+	if ss.GetScope() != "" {
+		scope, err := storagespace.ParseID(ss.GetScope())
+		if err != nil {
+			return errtypes.BadRequest("invalid saved search scope: " + err.Error())
+		}
+		if scope.GetStorageId() == "" || scope.GetSpaceId() == "" {
+			return errtypes.BadRequest("invalid saved search scope: " + ss.GetScope())
+		}
+	}
+
+	return nil
+}
+
+// read returns the saved searches of the user and the revision of their entry, the revision
+// is 0 if the user has no saved searches
+func (s *Store) read(ctx context.Context, userID string) ([]*searchMessage.SavedSearch, uint64, error) {
+	entry, err := s.kv.Get(ctx, encodeKey(userID))
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		return nil, 0, nil
+	case err != nil:
+		return nil, 0, err
+	}
+
+	var searches []*searchMessage.SavedSearch
+	if err := json.Unmarshal(entry.Value(), &searches); err != nil {
+		return nil, 0, err
+	}
+
+	return searches, entry.Revision(), nil
+}
+
+func (s *Store) alter(ctx context.Context, userID string, alter func([]*searchMessage.SavedSearch) ([]*searchMessage.SavedSearch, error)) error {
+	key := encodeKey(userID)
+	for range _maxConflicts {
+		searches, revision, err := s.read(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		searches, err = alter(searches)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case len(searches) == 0 && revision == 0:
+			return nil
+		case len(searches) == 0:
+			err = s.kv.Delete(ctx, key, jetstream.LastRevision(revision))
+		default:
+			b, merr := json.Marshal(searches)
+			if merr != nil {
+				return merr
+			}
+			if revision == 0 {
+				_, err = s.kv.Create(ctx, key, b)
+			} else {
+				_, err = s.kv.Update(ctx, key, b, revision)
+			}
+		}
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			// the saved searches were changed in the meantime
+			continue
+		}
+		return err
+	}
+
+	return ErrConflict
+}
+
+func updateAlerts(entry jetstream.KeyValueEntry, update func(userID string, alerts []*searchMessage.SavedSearch)) {
+	userID, err := decodeKey(entry.Key())
+	if err != nil {
+		return
+	}
+
+	var searches []*searchMessage.SavedSearch
+	if entry.Operation() == jetstream.KeyValuePut {
+		if err := json.Unmarshal(entry.Value(), &searches); err != nil {
+			return
+		}
+	}
+
+	var alerts []*searchMessage.SavedSearch
+	for _, ss := range searches {
+		if ss.GetAlert() {
+			alerts = append(alerts, ss)
+		}
+	}
+	update(userID, alerts)
+}
+
+// encodeKey returns the key of the saved searches of the user, user ids can contain
+// characters which aren't allowed in keys
+func encodeKey(userID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(userID))
+}
+
+func decodeKey(key string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(key)
+	return string(b), err
+}
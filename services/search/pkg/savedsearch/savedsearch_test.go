@@ -0,0 +1,162 @@
+package savedsearch_test
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
+
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		s       *savedsearch.Store
+		ctx     = context.Background()
+		invoice = &searchMessage.SavedSearch{
+			Name:  "unpaid invoices",
+			Query: "tag:unpaid AND name:*invoice*",
+			Scope: "storage$space!space",
+			Alert: true,
+		}
+	)
+
+	BeforeEach(func() {
+		s = savedsearch.NewStore(newKeyValue())
+	})
+
+	It("creates saved searches", func() {
+		created, err := s.Create(ctx, "user", invoice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.GetId()).ToNot(BeEmpty())
+		Expect(created.GetName()).To(Equal(invoice.GetName()))
+		Expect(created.GetQuery()).To(Equal(invoice.GetQuery()))
+		Expect(created.GetScope()).To(Equal(invoice.GetScope()))
+
+		searches, err := s.List(ctx, "user")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(HaveLen(1))
+		Expect(searches[0].GetId()).To(Equal(created.GetId()))
+
+		searches, err = s.List(ctx, "otheruser")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(BeEmpty())
+	})
+
+	DescribeTable("rejects invalid saved searches",
+		func(ss *searchMessage.SavedSearch) {
+			_, err := s.Create(ctx, "user", ss)
+			Expect(err).To(BeAssignableToTypeOf(errtypes.BadRequest("")))
+		},
+		Entry("without name", &searchMessage.SavedSearch{Query: "name:foo"}),
+		Entry("without query", &searchMessage.SavedSearch{Name: "foo"}),
+		Entry("with an invalid query", &searchMessage.SavedSearch{Name: "foo", Query: "AND"}),
+		Entry("with an invalid scope", &searchMessage.SavedSearch{Name: "foo", Query: "name:foo", Scope: "space"}),
+	)
+
+	It("gets saved searches", func() {
+		created, err := s.Create(ctx, "user", invoice)
+		Expect(err).ToNot(HaveOccurred())
+
+		ss, err := s.Get(ctx, "user", created.GetId())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ss.GetName()).To(Equal(invoice.GetName()))
+
+		_, err = s.Get(ctx, "otheruser", created.GetId())
+		Expect(err).To(MatchError(savedsearch.ErrNotFound))
+	})
+
+	It("updates saved searches", func() {
+		created, err := s.Create(ctx, "user", invoice)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated, err := s.Update(ctx, "user", &searchMessage.SavedSearch{Id: created.GetId(), Name: "renamed", Query: "tag:paid"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.GetName()).To(Equal("renamed"))
+
+		ss, err := s.Get(ctx, "user", created.GetId())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ss.GetQuery()).To(Equal("tag:paid"))
+		Expect(ss.GetAlert()).To(BeFalse())
+
+		_, err = s.Update(ctx, "user", &searchMessage.SavedSearch{Id: "unknown", Name: "renamed", Query: "tag:paid"})
+		Expect(err).To(MatchError(savedsearch.ErrNotFound))
+	})
+
+	It("deletes saved searches", func() {
+		created, err := s.Create(ctx, "user", invoice)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(s.Delete(ctx, "user", created.GetId())).To(Succeed())
+		Expect(s.Delete(ctx, "user", created.GetId())).To(MatchError(savedsearch.ErrNotFound))
+
+		searches, err := s.List(ctx, "user")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(BeEmpty())
+	})
+
+	It("passes the saved searches with alerts of all users", func() {
+		_, err := s.Create(ctx, "user", invoice)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = s.Create(ctx, "user", &searchMessage.SavedSearch{Name: "no alert", Query: "name:foo"})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = s.Create(ctx, "otheruser", invoice)
+		Expect(err).ToNot(HaveOccurred())
+
+		var (
+			mu     sync.Mutex
+			alerts = map[string][]*searchMessage.SavedSearch{}
+		)
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		Expect(s.WatchAlerts(watchCtx, func(userID string, ss []*searchMessage.SavedSearch) {
+			mu.Lock()
+			defer mu.Unlock()
+			alerts[userID] = ss
+		})).To(Succeed())
+
+		mu.Lock()
+		Expect(alerts).To(HaveLen(2))
+		Expect(alerts["user"]).To(HaveLen(1))
+		Expect(alerts["user"][0].GetName()).To(Equal(invoice.GetName()))
+		Expect(alerts["otheruser"]).To(HaveLen(1))
+		id := alerts["user"][0].GetId()
+		mu.Unlock()
+
+		userAlerts := func(userID string) func() int {
+			return func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(alerts[userID])
+			}
+		}
+
+		_, err = s.Create(ctx, "newuser", invoice)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(userAlerts("newuser")).Should(Equal(1))
+
+		Expect(s.Delete(ctx, "user", id)).To(Succeed())
+		Eventually(userAlerts("user")).Should(Equal(0))
+	})
+
+	It("keeps concurrent changes", func() {
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := s.Create(ctx, "user", invoice)
+				Expect(err).ToNot(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+
+		searches, err := s.List(ctx, "user")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(HaveLen(5))
+	})
+})
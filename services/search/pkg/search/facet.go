@@ -0,0 +1,230 @@
+package search
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
+
+	"github.com/opencloud-eu/opencloud/pkg/kql"
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/query"
+)
+
+// The fields facets can be requested for
+const (
+	FacetMediaType = "mediatype"
+	FacetMimeType  = "mimetype"
+	FacetTags      = "tags"
+	FacetMtime     = "mtime"
+	FacetSize      = "size"
+	FacetSpace     = "space"
+)
+
+const (
+	// DefaultFacetSize is the number of values of the mimetype, tags and space facets if the request doesn't set a size
+	DefaultFacetSize = 10
+	// MediaTypeFacetSize is the number of mime types the engines count to compute the mediatype facet
+	MediaTypeFacetSize = 1000
+)
+
+// SizeBucket is a bucket of the size facet, Max is exclusive and 0 if the bucket is unbounded
+type SizeBucket struct {
+	Name     string
+	Min, Max float64
+}
+
+// SizeBuckets are the buckets of the size facet
+var SizeBuckets = []SizeBucket{
+	{Name: "0-100KB", Min: 0, Max: 100 << 10},
+	{Name: "100KB-1MB", Min: 100 << 10, Max: 1 << 20},
+	{Name: "1MB-10MB", Min: 1 << 20, Max: 10 << 20},
+	{Name: "10MB-100MB", Min: 10 << 20, Max: 100 << 20},
+	{Name: "100MB+", Min: 100 << 20},
+}
+
+// MtimeBucket is a bucket of the mtime facet
+type MtimeBucket struct {
+	Name     string
+	From, To time.Time
+}
+
+// _mtimeBuckets are the natural language dates of the mtime key that are used as buckets of the mtime facet
+var _mtimeBuckets = []string{"today", "yesterday", "this week", "last week", "this month", "last month", "this year", "last year"}
+
+// MtimeBuckets returns the buckets of the mtime facet relative to the current time, the buckets overlap
+func MtimeBuckets() []MtimeBucket {
+	buckets := make([]MtimeBucket, 0, len(_mtimeBuckets))
+	for _, name := range _mtimeBuckets {
+		from, to, err := kql.TimeRange(name)
+		if err != nil {
+			continue
+		}
+		buckets = append(buckets, MtimeBucket{Name: name, From: from, To: to})
+	}
+	return buckets
+}
+
+// ValidateFacets returns an error if a requested facet is unknown
+func ValidateFacets(facets []*searchmsg.FacetRequest) error {
+	for _, f := range facets {
+		switch f.GetField() {
+		case FacetMediaType, FacetMimeType, FacetTags, FacetMtime, FacetSize, FacetSpace:
+		default:
+			return errtypes.BadRequest("unsupported facet: " + f.GetField())
+		}
+	}
+	return nil
+}
+
+// FacetLimit returns the number of values of the mimetype, tags and space facets
+func FacetLimit(f *searchmsg.FacetRequest) int {
+	if f.GetSize() > 0 {
+		return int(f.GetSize())
+	}
+	return DefaultFacetSize
+}
+
+// MediaTypeFacet computes the mediatype facet from the number of matches per mime type
+func MediaTypeFacet(mimeTypes map[string]int64) *searchmsg.Facet {
+	counts := map[string]int64{}
+	for mimeType, count := range mimeTypes {
+		if mimeType == "" {
+			// the space roots
+			continue
+		}
+		for _, mediaType := range query.MediaTypesOf(mimeType) {
+			counts[mediaType] += count
+		}
+	}
+
+	facet := &searchmsg.Facet{Field: FacetMediaType}
+	for _, mediaType := range query.MediaTypes {
+		if counts[mediaType] > 0 {
+			facet.Values = append(facet.Values, &searchmsg.FacetValue{Value: mediaType, Count: counts[mediaType]})
+		}
+	}
+	return facet
+}
+
+// sortBuckets sorts the values of a facet in the order of its buckets
+func sortBuckets(values []*searchmsg.FacetValue, buckets []string) {
+	slices.SortStableFunc(values, func(a, b *searchmsg.FacetValue) int {
+		return cmp.Compare(slices.Index(buckets, a.GetValue()), slices.Index(buckets, b.GetValue()))
+	})
+}
+
+// MergeFacets adds up the facets of several responses. The values of the mimetype, tags and
+// space facets are sorted by their count and limited to the requested size, buckets keep their order.
+func MergeFacets(requests []*searchmsg.FacetRequest, responses ...[]*searchmsg.Facet) []*searchmsg.Facet {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	facets := make([]*searchmsg.Facet, 0, len(requests))
+	for _, r := range requests {
+		var (
+			order  []string
+			counts = map[string]int64{}
+		)
+		for _, response := range responses {
+			for _, f := range response {
+				if f.GetField() != r.GetField() {
+					continue
+				}
+				for _, v := range f.GetValues() {
+					if _, ok := counts[v.GetValue()]; !ok {
+						order = append(order, v.GetValue())
+					}
+					counts[v.GetValue()] += v.GetCount()
+				}
+			}
+		}
+
+		facet := &searchmsg.Facet{Field: r.GetField()}
+		for _, v := range order {
+			if counts[v] > 0 {
+				facet.Values = append(facet.Values, &searchmsg.FacetValue{Value: v, Count: counts[v]})
+			}
+		}
+
+		switch r.GetField() {
+		case FacetMimeType, FacetTags, FacetSpace:
+			slices.SortStableFunc(facet.Values, func(a, b *searchmsg.FacetValue) int {
+				return cmp.Or(cmp.Compare(b.GetCount(), a.GetCount()), cmp.Compare(a.GetValue(), b.GetValue()))
+			})
+			facet.Values = facet.Values[:min(len(facet.Values), FacetLimit(r))]
+		case FacetMediaType:
+			sortBuckets(facet.Values, query.MediaTypes)
+		case FacetMtime:
+			sortBuckets(facet.Values, _mtimeBuckets)
+		case FacetSize:
+			names := make([]string, 0, len(SizeBuckets))
+			for _, b := range SizeBuckets {
+				names = append(names, b.Name)
+			}
+			sortBuckets(facet.Values, names)
+		}
+
+		facets = append(facets, facet)
+	}
+	return facets
+}
+
+// CountFacets computes the facets of a list of matches. It is used if the matches of an engine
+// are filtered afterwards, which makes the facets computed by the engine useless.
+func CountFacets(requests []*searchmsg.FacetRequest, matches []*searchmsg.Match) []*searchmsg.Facet {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	mtimeBuckets := MtimeBuckets()
+	counts := map[string]map[string]int64{}
+	count := func(field, value string) {
+		if counts[field] == nil {
+			counts[field] = map[string]int64{}
+		}
+		counts[field][value]++
+	}
+	for _, match := range matches {
+		entity := match.GetEntity()
+		if entity.GetMimeType() != "" {
+			count(FacetMimeType, entity.GetMimeType())
+		}
+		for _, tag := range entity.GetTags() {
+			count(FacetTags, tag)
+		}
+		if entity.GetLastModifiedTime() != nil {
+			mtime := entity.GetLastModifiedTime().AsTime()
+			for _, b := range mtimeBuckets {
+				if !mtime.Before(b.From) && !mtime.After(b.To) {
+					count(FacetMtime, b.Name)
+				}
+			}
+		}
+		for _, b := range SizeBuckets {
+			size := float64(entity.GetSize())
+			if size >= b.Min && (b.Max == 0 || size < b.Max) {
+				count(FacetSize, b.Name)
+			}
+		}
+	}
+
+	facets := make([]*searchmsg.Facet, 0, len(requests))
+	for _, r := range requests {
+		if r.GetField() == FacetMediaType {
+			facets = append(facets, MediaTypeFacet(counts[FacetMimeType]))
+			continue
+		}
+
+		facet := &searchmsg.Facet{Field: r.GetField()}
+		for value, c := range counts[r.GetField()] {
+			facet.Values = append(facet.Values, &searchmsg.FacetValue{Value: value, Count: c})
+		}
+		facets = append(facets, facet)
+	}
+
+	// sort and limit the values
+	return MergeFacets(requests, facets)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
@@ -15,6 +16,8 @@ import (
 	"github.com/opencloud-eu/reva/v2/pkg/storage/utils/grants"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 
+	"github.com/opencloud-eu/opencloud/pkg/ast"
+	"github.com/opencloud-eu/opencloud/pkg/kql"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
 	searchService "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
@@ -37,6 +40,19 @@ type Engine interface {
 	NewBatch(batchSize int) (BatchOperator, error)
 }
 
+// IndexChecker is implemented by engines that keep data next to the index, which might be missing for
+// resources indexed before. IndexSpace doesn't skip unchanged resources if their data is incomplete.
+type IndexChecker interface {
+	IsIndexed(id string) bool
+}
+
+// UpsertObserver is informed about the resources UpsertItem indexes.
+type UpsertObserver interface {
+	// Upserting is called before the resource is indexed,
+	// the returned function is called once the resource was indexed successfully.
+	Upserting(ctx context.Context, r Resource) func()
+}
+
 type BatchOperator interface {
 	Upsert(id string, r Resource) error
 	Move(rootID, parentID, location string) error
@@ -196,3 +212,67 @@ func ParseScope(query string) (string, string) {
 	}
 	return query, ""
 }
+
+// ParseSimilar extracts the top level similarity clauses from the query string and returns the remaining
+// query and the text the results should be similar to. Only clauses combined with the remaining query by AND
+// are extracted, the others are left in the query and rejected by the engine.
+func ParseSimilar(query string) (string, string) {
+	q, err := kql.Builder{}.Build(query)
+	if err != nil {
+		// the engine reports the error
+		return query, ""
+	}
+
+	var similar []string
+	cut := map[ast.Node]bool{}
+	for i, n := range q.Nodes {
+		sn, ok := n.(*ast.SimilarNode)
+		if !ok {
+			continue
+		}
+
+		prev, next := operatorAt(q.Nodes, i-1), operatorAt(q.Nodes, i+1)
+		if (prev != nil && prev.Value != kql.BoolAND) || (next != nil && next.Value != kql.BoolAND) {
+			continue
+		}
+
+		similar = append(similar, sn.Value)
+		cut[sn] = true
+		// the operator connecting the clause is removed as well
+		switch {
+		case prev != nil && !cut[prev]:
+			cut[prev] = true
+		case next != nil:
+			cut[next] = true
+		}
+	}
+	if len(similar) == 0 {
+		return query, ""
+	}
+
+	runes := []rune(query)
+	var rest strings.Builder
+	start := 0
+	for _, n := range q.Nodes {
+		loc := n.Location()
+		// implicit operators aren't part of the query
+		if !cut[n] || loc.Start.Column == 0 {
+			continue
+		}
+
+		rest.WriteString(string(runes[start : loc.Start.Column-1]))
+		start = loc.Start.Column - 1 + utf8.RuneCountInString(*loc.Source)
+	}
+	rest.WriteString(string(runes[start:]))
+
+	return strings.TrimSpace(rest.String()), strings.TrimSpace(strings.Join(similar, " "))
+}
+
+// operatorAt returns the operator node at the given index if there is one
+func operatorAt(nodes []ast.Node, i int) *ast.OperatorNode {
+	if i < 0 || i >= len(nodes) {
+		return nil
+	}
+	op, _ := nodes[i].(*ast.OperatorNode)
+	return op
+}
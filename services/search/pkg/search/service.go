@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -64,6 +65,7 @@ type Service struct {
 	engine          Engine
 	extractor       content.Extractor
 	metrics         *metrics.Metrics
+	observer        UpsertObserver
 
 	serviceAccountID     string
 	serviceAccountSecret string
@@ -88,9 +90,21 @@ func NewService(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], eng E
 		batchSize: cfg.BatchSize,
 	}
 
+	// index the content of resources again once it was extracted in the background
+	if ae, ok := extractor.(content.AsyncExtractor); ok {
+		ae.OnExtracted(func(ri *provider.ResourceInfo) {
+			s.UpsertItem(&provider.Reference{ResourceId: ri.GetId(), Path: "."})
+		})
+	}
+
 	return s
 }
 
+// Observe registers the observer that is informed about the resources UpsertItem indexes.
+func (s *Service) Observe(o UpsertObserver) {
+	s.observer = o
+}
+
 // Search processes a search request and passes it down to the engine.
 func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*searchsvc.SearchResponse, error) {
 	s.logger.Debug().Str("query", req.Query).Msg("performing a search")
@@ -122,6 +136,9 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 		return nil, errtypes.BadRequest("empty query provided")
 	}
 	req.Query = query
+	if err := ValidateFacets(req.Facets); err != nil {
+		return nil, err
+	}
 	if len(scope) > 0 {
 		scopedID, err := storagespace.ParseID(scope)
 		if err != nil {
@@ -260,6 +277,7 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 		return nil, err
 	}
 
+	facets := make([][]*searchmsg.Facet, 0, len(responses))
 	for _, res := range responses {
 		if res == nil {
 			continue
@@ -268,6 +286,7 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 		for _, match := range res.Matches {
 			matches = append(matches, match)
 		}
+		facets = append(facets, res.Facets)
 	}
 
 	// compile one sorted list of matches from all spaces and apply the limit if needed
@@ -284,6 +303,7 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 	return &searchsvc.SearchResponse{
 		Matches:      matches,
 		TotalMatches: total,
+		Facets:       MergeFacets(req.Facets, facets...),
 	}, nil
 }
 
@@ -390,6 +410,7 @@ func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest,
 			Path:       searchPathPrefix,
 		},
 		PageSize: req.PageSize,
+		Facets:   req.Facets,
 	}
 	start := time.Now()
 	res, err := s.engine.Search(ctx, searchRequest)
@@ -430,6 +451,18 @@ func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest,
 
 	res.Matches = matches
 
+	if res.TotalMatches > 0 && slices.ContainsFunc(req.Facets, func(f *searchmsg.FacetRequest) bool { return f.GetField() == FacetSpace }) {
+		// the engines search one space at a time, the matches of a share are counted for its mountpoint
+		spaceID := space.GetId().GetOpaqueId()
+		if mountpointID != "" {
+			spaceID = mountpointID
+		}
+		res.Facets = append(res.Facets, &searchmsg.Facet{
+			Field:  FacetSpace,
+			Values: []*searchmsg.FacetValue{{Value: spaceID, Count: int64(res.TotalMatches)}},
+		})
+	}
+
 	return res, nil
 }
 
@@ -496,7 +529,12 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 			Query: "id:" + storagespace.FormatResourceID(info.Id) + ` mtime>=` + utils.TSToTime(info.Mtime).Format(time.RFC3339Nano),
 		})
 
-		if err == nil && len(searchRes.Matches) >= 1 {
+		unchanged := err == nil && len(searchRes.Matches) >= 1
+		if ic, ok := s.engine.(IndexChecker); ok && !ic.IsIndexed(storagespace.FormatResourceID(info.Id)) {
+			unchanged = false
+		}
+
+		if unchanged {
 			if info.Type == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
 				s.logger.Debug().Str("path", ref.Path).Msg("subtree hasn't changed. Skipping.")
 				return filepath.SkipDir
@@ -601,6 +639,12 @@ func (s *Service) doUpsertItem(ref *provider.Reference, batch BatchOperator) {
 		r.ParentID = storagespace.FormatResourceID(parentID)
 	}
 
+	// resources indexed in batches are (re)indexed spaces, only changed resources are observed
+	upserted := func() {}
+	if batch == nil && s.observer != nil {
+		upserted = s.observer.Upserting(ctx, r)
+	}
+
 	if batch != nil {
 		err = batch.Upsert(r.ID, r)
 	} else {
@@ -610,6 +654,7 @@ func (s *Service) doUpsertItem(ref *provider.Reference, batch BatchOperator) {
 		s.logger.Error().Err(err).Msg("error adding updating the resource in the index")
 	} else {
 		logDocCount(s.engine, s.logger)
+		upserted()
 	}
 
 	// determine if metadata needs to be stored in storage as well
@@ -16,6 +16,7 @@ import (
 	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
@@ -417,6 +418,9 @@ var _ = Describe("Searchprovider", func() {
 								},
 							},
 						},
+						Facets: []*searchmsg.Facet{
+							{Field: "mimetype", Values: []*searchmsg.FacetValue{{Value: "application/pdf", Count: 2}}},
+						},
 					}, nil)
 					indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
 						return req.Ref.ResourceId.OpaqueId == personalSpace.Root.OpaqueId &&
@@ -443,6 +447,9 @@ var _ = Describe("Searchprovider", func() {
 								},
 							},
 						},
+						Facets: []*searchmsg.Facet{
+							{Field: "mimetype", Values: []*searchmsg.FacetValue{{Value: "application/pdf", Count: 1}}},
+						},
 					}, nil)
 				})
 
@@ -485,6 +492,28 @@ var _ = Describe("Searchprovider", func() {
 					ids := []string{res.Matches[0].Entity.Id.OpaqueId, res.Matches[1].Entity.Id.OpaqueId}
 					Expect(ids).To(Equal([]string{"grant-shared-id", "foo-id"}))
 				})
+
+				It("adds up the facets of all spaces", func() {
+					res, err := s.Search(ctx, &searchsvc.SearchRequest{
+						Query:  "foo",
+						Facets: []*searchmsg.FacetRequest{{Field: "mimetype"}, {Field: "space"}},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res.Facets).To(HaveLen(2))
+					Expect(res.Facets[0].Values).To(Equal([]*searchmsg.FacetValue{{Value: "application/pdf", Count: 3}}))
+					Expect(res.Facets[1].Values).To(Equal([]*searchmsg.FacetValue{
+						{Value: mountpointSpace.Id.OpaqueId, Count: 2},
+						{Value: personalSpace.Id.OpaqueId, Count: 1},
+					}))
+				})
+
+				It("rejects unknown facets", func() {
+					_, err := s.Search(ctx, &searchsvc.SearchRequest{
+						Query:  "foo",
+						Facets: []*searchmsg.FacetRequest{{Field: "color"}},
+					})
+					Expect(err).To(HaveOccurred())
+				})
 			})
 		})
 	})
@@ -537,3 +566,91 @@ var _ = DescribeTable("Parse Scope",
 		``,
 	),
 )
+
+var _ = DescribeTable("Parse Similar",
+	func(pattern, wantSearch, wantSimilar string) {
+		gotSearch, gotSimilar := search.ParseSimilar(pattern)
+		Expect(gotSearch).To(Equal(wantSearch))
+		Expect(gotSimilar).To(Equal(wantSimilar))
+	},
+	Entry("When similar is the only clause",
+		`similar:"tax returns"`,
+		``,
+		`tax returns`,
+	),
+	Entry("When similar is combined with other clauses",
+		`Name:*file* +similar:invoices +Tags:foo`,
+		`Name:*file*  +Tags:foo`,
+		`invoices`,
+	),
+	Entry("When similar is combined implicitly",
+		`similar:"tax returns" Name:*ä*`,
+		`Name:*ä*`,
+		`tax returns`,
+	),
+	Entry("When there are multiple similar clauses",
+		`similar:tax AND similar:refund AND Name:foo`,
+		`Name:foo`,
+		`tax refund`,
+	),
+	Entry("When similar is connected by OR",
+		`similar:invoices OR Name:foo`,
+		`similar:invoices OR Name:foo`,
+		``,
+	),
+	Entry("When similar is negated",
+		`Name:foo NOT similar:invoices`,
+		`Name:foo NOT similar:invoices`,
+		``,
+	),
+	Entry("When similar is connected by AND",
+		`similar:"tax returns" AND mediatype:document`,
+		`mediatype:document`,
+		`tax returns`,
+	),
+	Entry("When similar is part of a group",
+		`(similar:invoices OR Name:foo)`,
+		`(similar:invoices OR Name:foo)`,
+		``,
+	),
+	Entry("When similar is a value",
+		`Name:similar`,
+		`Name:similar`,
+		``,
+	),
+	Entry("When no similar",
+		`+Name:*file* +Tags:foo`,
+		`+Name:*file* +Tags:foo`,
+		``,
+	),
+)
+
+var _ = Describe("Count Facets", func() {
+	It("counts the facets of matches", func() {
+		matches := []*searchmsg.Match{
+			{Entity: &searchmsg.Entity{MimeType: "application/pdf", Size: 2 << 20, Tags: []string{"tax"}, LastModifiedTime: timestamppb.Now()}},
+			{Entity: &searchmsg.Entity{MimeType: "text/plain", Size: 10, Tags: []string{"tax", "2024"}}},
+			{Entity: &searchmsg.Entity{MimeType: "httpd/unix-directory"}},
+		}
+
+		facets := search.CountFacets([]*searchmsg.FacetRequest{
+			{Field: "mediatype"},
+			{Field: "tags", Size: 1},
+			{Field: "size"},
+			{Field: "mtime"},
+		}, matches)
+		Expect(facets).To(HaveLen(4))
+		Expect(facets[0].Values).To(Equal([]*searchmsg.FacetValue{
+			{Value: "file", Count: 2},
+			{Value: "folder", Count: 1},
+			{Value: "document", Count: 1},
+			{Value: "pdf", Count: 1},
+		}))
+		Expect(facets[1].Values).To(Equal([]*searchmsg.FacetValue{{Value: "tax", Count: 2}}))
+		Expect(facets[2].Values).To(Equal([]*searchmsg.FacetValue{
+			{Value: "0-100KB", Count: 2},
+			{Value: "1MB-10MB", Count: 1},
+		}))
+		Expect(facets[3].Values[0]).To(Equal(&searchmsg.FacetValue{Value: "today", Count: 1}))
+	})
+})
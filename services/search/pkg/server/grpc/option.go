@@ -10,6 +10,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/metrics"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 	svc "github.com/opencloud-eu/opencloud/services/search/pkg/service/grpc/v0"
 )
@@ -29,6 +30,7 @@ type Options struct {
 	TraceProvider   trace.TracerProvider
 	GatewaySelector *pool.Selector[gateway.GatewayAPIClient]
 	Searcher        search.Searcher
+	SavedSearches   *savedsearch.Store
 }
 
 // newOptions initializes the available default options.
@@ -111,3 +113,10 @@ func Searcher(val search.Searcher) Option {
 		o.Searcher = val
 	}
 }
+
+// SavedSearches provides a function to set the SavedSearches option.
+func SavedSearches(val *savedsearch.Store) Option {
+	return func(o *Options) {
+		o.SavedSearches = val
+	}
+}
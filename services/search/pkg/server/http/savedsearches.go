@@ -0,0 +1,152 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+)
+
+// RegisterSavedSearches registers the routes to manage the saved searches of the current user:
+//
+//	GET    /api/v0/search/saved-searches       lists the saved searches
+//	POST   /api/v0/search/saved-searches       creates a saved search
+//	GET    /api/v0/search/saved-searches/{id}  returns a saved search
+//	PUT    /api/v0/search/saved-searches/{id}  updates a saved search
+//	DELETE /api/v0/search/saved-searches/{id}  deletes a saved search
+func RegisterSavedSearches(r chi.Router, h searchsvc.SearchProviderHandler) {
+	s := savedSearches{h: h}
+	r.Route("/api/v0/search/saved-searches", func(r chi.Router) {
+		r.Use(accessToken)
+		r.Get("/", s.list)
+		r.Post("/", s.create)
+		r.Get("/{id}", s.get)
+		r.Put("/{id}", s.update)
+		r.Delete("/{id}", s.delete)
+	})
+}
+
+type savedSearches struct {
+	h searchsvc.SearchProviderHandler
+}
+
+func (s savedSearches) list(w http.ResponseWriter, r *http.Request) {
+	out := &searchsvc.ListSavedSearchesResponse{}
+	if err := s.h.ListSavedSearches(r.Context(), &searchsvc.ListSavedSearchesRequest{}, out); err != nil {
+		renderError(w, err)
+		return
+	}
+	render(w, http.StatusOK, out)
+}
+
+func (s savedSearches) get(w http.ResponseWriter, r *http.Request) {
+	out := &searchsvc.GetSavedSearchResponse{}
+	if err := s.h.GetSavedSearch(r.Context(), &searchsvc.GetSavedSearchRequest{Id: chi.URLParam(r, "id")}, out); err != nil {
+		renderError(w, err)
+		return
+	}
+	render(w, http.StatusOK, out.GetSavedSearch())
+}
+
+func (s savedSearches) create(w http.ResponseWriter, r *http.Request) {
+	ss, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	out := &searchsvc.CreateSavedSearchResponse{}
+	if err := s.h.CreateSavedSearch(r.Context(), &searchsvc.CreateSavedSearchRequest{SavedSearch: ss}, out); err != nil {
+		renderError(w, err)
+		return
+	}
+	render(w, http.StatusCreated, out.GetSavedSearch())
+}
+
+func (s savedSearches) update(w http.ResponseWriter, r *http.Request) {
+	ss, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	ss.Id = chi.URLParam(r, "id")
+
+	out := &searchsvc.UpdateSavedSearchResponse{}
+	if err := s.h.UpdateSavedSearch(r.Context(), &searchsvc.UpdateSavedSearchRequest{SavedSearch: ss}, out); err != nil {
+		renderError(w, err)
+		return
+	}
+	render(w, http.StatusOK, out.GetSavedSearch())
+}
+
+func (s savedSearches) delete(w http.ResponseWriter, r *http.Request) {
+	if err := s.h.DeleteSavedSearch(r.Context(), &searchsvc.DeleteSavedSearchRequest{Id: chi.URLParam(r, "id")}, &searchsvc.DeleteSavedSearchResponse{}); err != nil {
+		renderError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// accessToken passes the access token of the request on to the handler, which expects it
+// in the metadata like for grpc requests
+func accessToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t := r.Header.Get(revactx.TokenHeader)
+		if t == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		ctx := metadata.Set(r.Context(), revactx.TokenHeader, t)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func decode(w http.ResponseWriter, r *http.Request) (*searchMessage.SavedSearch, bool) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	ss := &searchMessage.SavedSearch{}
+	if err := protojson.Unmarshal(b, ss); err != nil {
+		http.Error(w, "invalid saved search: "+err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	return ss, true
+}
+
+func render(w http.ResponseWriter, status int, m proto.Message) {
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// renderError renders the errors of the handler with their status code
+func renderError(w http.ResponseWriter, err error) {
+	merr, ok := merrors.As(err)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	switch merr.Code {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound, http.StatusConflict:
+		http.Error(w, merr.Detail, int(merr.Code))
+	default:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
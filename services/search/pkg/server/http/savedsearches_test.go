@@ -0,0 +1,89 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+	searchHTTP "github.com/opencloud-eu/opencloud/services/search/pkg/server/http"
+)
+
+// handler implements the saved search endpoints of the search service handler
+type handler struct {
+	searchsvc.SearchProviderHandler
+	created *searchMessage.SavedSearch
+}
+
+func (h *handler) CreateSavedSearch(ctx context.Context, in *searchsvc.CreateSavedSearchRequest, out *searchsvc.CreateSavedSearchResponse) error {
+	if t, _ := metadata.Get(ctx, revactx.TokenHeader); t != "token" {
+		return merrors.Unauthorized("search", "invalid token")
+	}
+	h.created = in.GetSavedSearch()
+	out.SavedSearch = &searchMessage.SavedSearch{Id: "1", Name: in.GetSavedSearch().GetName()}
+	return nil
+}
+
+func (h *handler) GetSavedSearch(_ context.Context, in *searchsvc.GetSavedSearchRequest, _ *searchsvc.GetSavedSearchResponse) error {
+	return merrors.NotFound("search", "saved search %s not found", in.GetId())
+}
+
+func (h *handler) DeleteSavedSearch(_ context.Context, _ *searchsvc.DeleteSavedSearchRequest, _ *searchsvc.DeleteSavedSearchResponse) error {
+	return nil
+}
+
+var _ = Describe("SavedSearches", func() {
+	var (
+		h   *handler
+		mux *chi.Mux
+
+		serve = func(method, path, body, token string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest(method, path, strings.NewReader(body))
+			if token != "" {
+				r.Header.Set(revactx.TokenHeader, token)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			return w
+		}
+	)
+
+	BeforeEach(func() {
+		h = &handler{}
+		mux = chi.NewMux()
+		searchHTTP.RegisterSavedSearches(mux, h)
+	})
+
+	It("creates saved searches", func() {
+		w := serve(http.MethodPost, "/api/v0/search/saved-searches", `{"name":"invoices","query":"tag:unpaid"}`, "token")
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		Expect(w.Body.String()).To(ContainSubstring(`"id":"1"`))
+		Expect(h.created.GetQuery()).To(Equal("tag:unpaid"))
+	})
+
+	It("rejects requests without a valid token", func() {
+		Expect(serve(http.MethodPost, "/api/v0/search/saved-searches", `{"name":"invoices"}`, "").Code).To(Equal(http.StatusUnauthorized))
+		Expect(serve(http.MethodPost, "/api/v0/search/saved-searches", `{"name":"invoices"}`, "other").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects invalid saved searches", func() {
+		Expect(serve(http.MethodPost, "/api/v0/search/saved-searches", `{"name":`, "token").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns the status of the errors", func() {
+		Expect(serve(http.MethodGet, "/api/v0/search/saved-searches/2", "", "token").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes saved searches", func() {
+		Expect(serve(http.MethodDelete, "/api/v0/search/saved-searches/1", "", "token").Code).To(Equal(http.StatusNoContent))
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/metrics"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 )
 
@@ -23,6 +24,7 @@ type Options struct {
 	Metrics         *metrics.Metrics
 	GatewaySelector *pool.Selector[gateway.GatewayAPIClient]
 	Searcher        search.Searcher
+	SavedSearches   *savedsearch.Store
 }
 
 func newOptions(opts ...Option) Options {
@@ -85,3 +87,10 @@ func Searcher(val search.Searcher) Option {
 		o.Searcher = val
 	}
 }
+
+// SavedSearches provides a function to set the SavedSearches option.
+func SavedSearches(val *savedsearch.Store) Option {
+	return func(o *Options) {
+		o.SavedSearches = val
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
@@ -25,6 +26,7 @@ import (
 	v0 "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
 	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/savedsearch"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 )
 
@@ -38,6 +40,9 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, error) {
 	if options.Searcher == nil {
 		return nil, errors.New("no Searcher provided")
 	}
+	if options.SavedSearches == nil {
+		return nil, errors.New("no SavedSearches provided")
+	}
 
 	cache := ttlcache.NewCache()
 	if err := cache.SetTTL(time.Second); err != nil {
@@ -56,6 +61,7 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, error) {
 		id:           cfg.GRPC.Namespace + "." + cfg.Service.Name,
 		log:          &options.Logger,
 		searcher:     options.Searcher,
+		searches:     options.SavedSearches,
 		cache:        cache,
 		tokenManager: tokenManager,
 		gws:          options.GatewaySelector,
@@ -68,6 +74,7 @@ type Service struct {
 	id           string
 	log          *log.Logger
 	searcher     search.Searcher
+	searches     *savedsearch.Store
 	cache        *ttlcache.Cache
 	tokenManager token.Manager
 	gws          *pool.Selector[gateway.GatewayAPIClient]
@@ -76,22 +83,12 @@ type Service struct {
 
 // Search handles the search
 func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *searchsvc.SearchResponse) error {
-	// Get token from the context (go-micro) and make it known to the reva client too (grpc)
-	t, ok := metadata.Get(ctx, revactx.TokenHeader)
-	if !ok {
-		s.log.Error().Msg("Could not get token from context")
-		return errors.New("could not get token from context")
-	}
-	ctx = grpcmetadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, t)
-
-	// unpack user
-	u, _, err := s.tokenManager.DismantleToken(ctx, t)
+	ctx, u, err := s.authenticate(ctx)
 	if err != nil {
 		return err
 	}
-	ctx = revactx.ContextSetUser(ctx, u)
 
-	key := cacheKey(in.Query, in.PageSize, in.Ref, u)
+	key := cacheKey(in.Query, in.PageSize, in.Ref, in.Facets, u)
 	res, ok := s.FromCache(key)
 	if !ok {
 		var err error
@@ -99,6 +96,7 @@ func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *s
 			Query:    in.Query,
 			PageSize: in.PageSize,
 			Ref:      in.Ref,
+			Facets:   in.Facets,
 		})
 		if err != nil {
 			switch err.(type) {
@@ -115,6 +113,7 @@ func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *s
 	out.Matches = res.Matches
 	out.TotalMatches = res.TotalMatches
 	out.NextPageToken = res.NextPageToken
+	out.Facets = res.Facets
 	return nil
 }
 
@@ -153,6 +152,116 @@ func (s Service) IndexSpace(_ context.Context, in *searchsvc.IndexSpaceRequest,
 	return nil
 }
 
+// ListSavedSearches lists the saved searches of the current user
+func (s Service) ListSavedSearches(ctx context.Context, _ *searchsvc.ListSavedSearchesRequest, out *searchsvc.ListSavedSearchesResponse) error {
+	_, u, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	searches, err := s.searches.List(ctx, u.GetId().GetOpaqueId())
+	if err != nil {
+		return s.savedSearchError(err)
+	}
+
+	out.SavedSearches = searches
+	return nil
+}
+
+// GetSavedSearch returns a saved search of the current user
+func (s Service) GetSavedSearch(ctx context.Context, in *searchsvc.GetSavedSearchRequest, out *searchsvc.GetSavedSearchResponse) error {
+	_, u, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ss, err := s.searches.Get(ctx, u.GetId().GetOpaqueId(), in.GetId())
+	if err != nil {
+		return s.savedSearchError(err)
+	}
+
+	out.SavedSearch = ss
+	return nil
+}
+
+// CreateSavedSearch saves a search for the current user
+func (s Service) CreateSavedSearch(ctx context.Context, in *searchsvc.CreateSavedSearchRequest, out *searchsvc.CreateSavedSearchResponse) error {
+	_, u, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ss, err := s.searches.Create(ctx, u.GetId().GetOpaqueId(), in.GetSavedSearch())
+	if err != nil {
+		return s.savedSearchError(err)
+	}
+
+	out.SavedSearch = ss
+	return nil
+}
+
+// UpdateSavedSearch updates a saved search of the current user
+func (s Service) UpdateSavedSearch(ctx context.Context, in *searchsvc.UpdateSavedSearchRequest, out *searchsvc.UpdateSavedSearchResponse) error {
+	_, u, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ss, err := s.searches.Update(ctx, u.GetId().GetOpaqueId(), in.GetSavedSearch())
+	if err != nil {
+		return s.savedSearchError(err)
+	}
+
+	out.SavedSearch = ss
+	return nil
+}
+
+// DeleteSavedSearch deletes a saved search of the current user
+func (s Service) DeleteSavedSearch(ctx context.Context, in *searchsvc.DeleteSavedSearchRequest, _ *searchsvc.DeleteSavedSearchResponse) error {
+	_, u, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.searches.Delete(ctx, u.GetId().GetOpaqueId(), in.GetId()); err != nil {
+		return s.savedSearchError(err)
+	}
+
+	return nil
+}
+
+// authenticate returns the user of the request and a context that makes the token known to the reva client
+func (s Service) authenticate(ctx context.Context) (context.Context, *user.User, error) {
+	// Get token from the context (go-micro) and make it known to the reva client too (grpc)
+	t, ok := metadata.Get(ctx, revactx.TokenHeader)
+	if !ok {
+		s.log.Error().Msg("Could not get token from context")
+		return nil, nil, merrors.Unauthorized(s.id, "could not get token from context")
+	}
+	ctx = grpcmetadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, t)
+
+	// unpack user
+	u, _, err := s.tokenManager.DismantleToken(ctx, t)
+	if err != nil {
+		return nil, nil, merrors.Unauthorized(s.id, "%s", err.Error())
+	}
+
+	return revactx.ContextSetUser(ctx, u), u, nil
+}
+
+func (s Service) savedSearchError(err error) error {
+	switch {
+	case errors.Is(err, savedsearch.ErrNotFound):
+		return merrors.NotFound(s.id, "%s", err.Error())
+	case errors.Is(err, savedsearch.ErrConflict):
+		return merrors.Conflict(s.id, "%s", err.Error())
+	case errors.As(err, new(errtypes.BadRequest)):
+		return merrors.BadRequest(s.id, "%s", err.Error())
+	default:
+		return merrors.InternalServerError(s.id, "%s", err.Error())
+	}
+}
+
 // FromCache pulls a search result from cache
 func (s Service) FromCache(key string) (*searchsvc.SearchResponse, bool) {
 	v, err := s.cache.Get(key)
@@ -170,6 +279,10 @@ func (s Service) Cache(key string, res *searchsvc.SearchResponse) {
 	_ = s.cache.Set(key, res)
 }
 
-func cacheKey(query string, pagesize int32, ref *v0.Reference, user *user.User) string {
-	return fmt.Sprintf("%s|%d|%s$%s!%s/%s|%s", query, pagesize, ref.GetResourceId().GetStorageId(), ref.GetResourceId().GetSpaceId(), ref.GetResourceId().GetOpaqueId(), ref.GetPath(), user.GetId().GetOpaqueId())
+func cacheKey(query string, pagesize int32, ref *v0.Reference, facets []*v0.FacetRequest, user *user.User) string {
+	f := make([]string, 0, len(facets))
+	for _, facet := range facets {
+		f = append(f, fmt.Sprintf("%s:%d", facet.GetField(), facet.GetSize()))
+	}
+	return fmt.Sprintf("%s|%d|%s$%s!%s/%s|%s|%s", query, pagesize, ref.GetResourceId().GetStorageId(), ref.GetResourceId().GetSpaceId(), ref.GetResourceId().GetOpaqueId(), ref.GetPath(), strings.Join(f, ","), user.GetId().GetOpaqueId())
 }
@@ -0,0 +1,263 @@
+// Package vector provides semantic search on top of a lexical search engine.
+package vector
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	searchMessage "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchService "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/embedding"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+// _matchAll is the lexical query used if a query only consists of similarity clauses
+const _matchAll = "name:*"
+
+var (
+	_ search.Engine       = (*Backend)(nil) // ensure Backend implements Engine
+	_ search.IndexChecker = (*Backend)(nil) // ensure Backend implements IndexChecker
+)
+
+// Backend wraps a lexical search engine and stores embeddings of the indexed resources.
+// Queries with a `similar:` clause are passed to the wrapped engine without the clause,
+// the hits are ranked by the similarity of their embeddings to the clause.
+type Backend struct {
+	engine    search.Engine
+	store     *Store
+	provider  embedding.Provider
+	chunkSize int
+	maxChunks int
+	minScore  float32
+	log       log.Logger
+}
+
+// NewBackend returns a new vector Backend
+func NewBackend(engine search.Engine, store *Store, provider embedding.Provider, cfg config.EngineVector, log log.Logger) *Backend {
+	return &Backend{
+		engine:    engine,
+		store:     store,
+		provider:  provider,
+		chunkSize: cfg.ChunkSize,
+		maxChunks: cfg.MaxChunks,
+		minScore:  float32(cfg.MinScore),
+		log:       log,
+	}
+}
+
+// Search executes a search request, hits are ranked by similarity if the query contains a `similar:` clause.
+// The scope of the request is applied by the wrapped engine, only its hits are ranked.
+func (b *Backend) Search(ctx context.Context, sir *searchService.SearchIndexRequest) (*searchService.SearchIndexResponse, error) {
+	query, similar := search.ParseSimilar(sir.Query)
+	if similar == "" {
+		return b.engine.Search(ctx, sir)
+	}
+	if query == "" {
+		query = _matchAll
+	}
+
+	vectors, err := b.provider.Embed(ctx, []string{similar})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.engine.Search(ctx, &searchService.SearchIndexRequest{
+		Query:    query,
+		Ref:      sir.Ref,
+		PageSize: -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(res.Matches))
+	for _, match := range res.Matches {
+		ids = append(ids, matchID(match))
+	}
+	root := storagespace.FormatResourceID(&storageProvider.ResourceId{
+		StorageId: sir.GetRef().GetResourceId().GetStorageId(),
+		SpaceId:   sir.GetRef().GetResourceId().GetSpaceId(),
+		OpaqueId:  sir.GetRef().GetResourceId().GetSpaceId(),
+	})
+	embeddings, err := b.store.vectors(root, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*searchMessage.Match, 0, len(res.Matches))
+	for _, match := range res.Matches {
+		var score float32
+		for _, v := range embeddings[matchID(match)] {
+			score = max(score, embedding.Similarity(vectors[0], v))
+		}
+		if score <= 0 || score < b.minScore {
+			continue
+		}
+		match.Score = score
+		matches = append(matches, match)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].GetScore() > matches[j].GetScore()
+	})
+
+	// the facets of the wrapped engine include the hits that have been left out
+	facets := search.CountFacets(sir.Facets, matches)
+
+	total := len(matches)
+	switch {
+	case sir.PageSize == -1:
+	case sir.PageSize == 0:
+		matches = matches[:min(len(matches), 200)]
+	default:
+		matches = matches[:min(len(matches), int(sir.PageSize))]
+	}
+
+	return &searchService.SearchIndexResponse{
+		Matches:      matches,
+		TotalMatches: int32(total),
+		Facets:       facets,
+	}, nil
+}
+
+func matchID(match *searchMessage.Match) string {
+	return storagespace.FormatResourceID(&storageProvider.ResourceId{
+		StorageId: match.GetEntity().GetId().GetStorageId(),
+		SpaceId:   match.GetEntity().GetId().GetSpaceId(),
+		OpaqueId:  match.GetEntity().GetId().GetOpaqueId(),
+	})
+}
+
+// DocCount returns the number of documents of the wrapped engine
+func (b *Backend) DocCount() (uint64, error) {
+	return b.engine.DocCount()
+}
+
+// IsIndexed returns true if the embeddings of the resource have been computed
+func (b *Backend) IsIndexed(id string) bool {
+	root, err := rootID(id)
+	if err != nil {
+		return false
+	}
+	h, err := b.store.hash(root, id)
+	return err == nil && h != ""
+}
+
+func (b *Backend) Upsert(id string, r search.Resource) error {
+	b.embed(id, r)
+	return b.engine.Upsert(id, r)
+}
+
+func (b *Backend) Move(id, parentID, target string) error {
+	b.move(id, target)
+	return b.engine.Move(id, parentID, target)
+}
+
+func (b *Backend) Delete(id string) error {
+	b.setDeleted(id, true)
+	return b.engine.Delete(id)
+}
+
+func (b *Backend) Restore(id string) error {
+	b.setDeleted(id, false)
+	return b.engine.Restore(id)
+}
+
+func (b *Backend) Purge(id string, onlyDeleted bool) error {
+	b.purge(id, onlyDeleted)
+	return b.engine.Purge(id, onlyDeleted)
+}
+
+func (b *Backend) NewBatch(size int) (search.BatchOperator, error) {
+	batch, err := b.engine.NewBatch(size)
+	if err != nil {
+		return nil, err
+	}
+	return &Batch{batch: batch, backend: b}, nil
+}
+
+// embed computes and stores the embeddings of a resource. Errors are logged only, the resource
+// is still added to the lexical index if its embeddings can't be computed.
+func (b *Backend) embed(id string, r search.Resource) {
+	chunks := b.chunks(r)
+	sum := sha256.Sum256([]byte(strings.Join(chunks, "\x00")))
+	h := hex.EncodeToString(sum[:])
+
+	logger := b.log.With().Str("id", id).Logger()
+	if current, err := b.store.hash(r.RootID, id); err != nil {
+		logger.Error().Err(err).Msg("could not read embeddings")
+	} else if current == h {
+		// moves and deletions are tracked separately, there is nothing to do
+		return
+	}
+
+	var vectors [][]float32
+	if len(chunks) > 0 {
+		var err error
+		if vectors, err = b.provider.Embed(context.Background(), chunks); err != nil {
+			logger.Error().Err(err).Msg("could not compute embeddings")
+			return
+		}
+	}
+
+	if err := b.store.put(r.RootID, id, resource{Path: r.Path, Deleted: r.Deleted, Hash: h}, vectors); err != nil {
+		logger.Error().Err(err).Msg("could not store embeddings")
+	}
+}
+
+// chunks splits the name and content of a resource into the texts that are embedded.
+// Consecutive chunks overlap by a tenth of the chunk size to keep context across their borders.
+func (b *Backend) chunks(r search.Resource) []string {
+	var chunks []string
+	if name := strings.TrimSpace(r.Title + " " + r.Name); name != "" {
+		chunks = append(chunks, name)
+	}
+
+	words := strings.Fields(r.Content)
+	step := max(b.chunkSize-b.chunkSize/10, 1)
+	for i := 0; i < len(words) && len(chunks) < b.maxChunks; i += step {
+		chunks = append(chunks, strings.Join(words[i:min(i+b.chunkSize, len(words))], " "))
+		if i+b.chunkSize >= len(words) {
+			break
+		}
+	}
+	return chunks
+}
+
+func (b *Backend) move(id, location string) {
+	target := utils.MakeRelativePath(location)
+	err := b.store.update(id, func(r *resource, path string) bool {
+		r.Path = strings.Replace(r.Path, path, target, 1)
+		return true
+	})
+	if err != nil {
+		b.log.Error().Err(err).Str("id", id).Msg("could not move embeddings")
+	}
+}
+
+func (b *Backend) setDeleted(id string, deleted bool) {
+	err := b.store.update(id, func(r *resource, _ string) bool {
+		r.Deleted = deleted
+		return true
+	})
+	if err != nil {
+		b.log.Error().Err(err).Str("id", id).Msg("could not update embeddings")
+	}
+}
+
+func (b *Backend) purge(id string, onlyDeleted bool) {
+	err := b.store.update(id, func(r *resource, _ string) bool {
+		return onlyDeleted && !r.Deleted
+	})
+	if err != nil {
+		b.log.Error().Err(err).Str("id", id).Msg("could not purge embeddings")
+	}
+}
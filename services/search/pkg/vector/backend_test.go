@@ -0,0 +1,162 @@
+package vector_test
+
+import (
+	"context"
+
+	bleveSearch "github.com/blevesearch/bleve/v2"
+	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/content"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/embedding"
+	bleveQuery "github.com/opencloud-eu/opencloud/services/search/pkg/query/bleve"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/vector"
+)
+
+var _ = Describe("Vector", func() {
+	var (
+		eng     *vector.Backend
+		lexical *bleve.Backend
+		store   *vector.Store
+
+		doSearch = func(query, path string) []string {
+			res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+				Query: query,
+				Ref: &searchmsg.Reference{
+					ResourceId: &searchmsg.ResourceID{StorageId: "1", SpaceId: "2", OpaqueId: "2"},
+					Path:       path,
+				},
+			})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+			names := make([]string, 0, len(res.Matches))
+			for _, m := range res.Matches {
+				names = append(names, m.GetEntity().GetName())
+			}
+			return names
+		}
+
+		root = search.Resource{
+			ID:     "1$2!2",
+			RootID: "1$2!2",
+			Path:   ".",
+			Type:   uint64(sprovider.ResourceType_RESOURCE_TYPE_CONTAINER),
+		}
+		folder = search.Resource{
+			ID:       "1$2!3",
+			RootID:   "1$2!2",
+			Path:     "./reports",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_CONTAINER),
+			Document: content.Document{Name: "reports"},
+		}
+		taxes = search.Resource{
+			ID:       "1$2!4",
+			ParentID: "1$2!3",
+			RootID:   "1$2!2",
+			Path:     "./reports/2024.pdf",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_FILE),
+			Document: content.Document{Name: "2024.pdf", MimeType: "application/pdf", Content: "annual tax return income deductions refund"},
+		}
+		recipe = search.Resource{
+			ID:       "1$2!5",
+			ParentID: "1$2!2",
+			RootID:   "1$2!2",
+			Path:     "./cake.txt",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_FILE),
+			Document: content.Document{Name: "cake.txt", MimeType: "text/plain", Content: "chocolate cake recipe with flour sugar and eggs"},
+		}
+		invoice = search.Resource{
+			ID:       "1$2!6",
+			ParentID: "1$2!3",
+			RootID:   "1$2!2",
+			Path:     "./reports/invoice.txt",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_FILE),
+			Document: content.Document{Name: "invoice.txt", MimeType: "text/plain", Content: "invoice for tax consulting services"},
+		}
+	)
+
+	BeforeEach(func() {
+		mapping, err := bleve.NewMapping()
+		Expect(err).ToNot(HaveOccurred())
+		idx, err := bleveSearch.NewMemOnly(mapping)
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = vector.NewStore(GinkgoT().TempDir())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(store.Close)
+
+		lexical = bleve.NewBackend(idx, bleveQuery.DefaultCreator, log.NopLogger())
+		eng = vector.NewBackend(
+			lexical,
+			store,
+			embedding.NewHash(256),
+			config.EngineVector{ChunkSize: 200, MaxChunks: 10, MinScore: 0.1},
+			log.NopLogger(),
+		)
+
+		for _, r := range []search.Resource{root, folder, taxes, recipe, invoice} {
+			Expect(eng.Upsert(r.ID, r)).To(Succeed())
+		}
+	})
+
+	Describe("Search", func() {
+		It("ranks the results by similarity", func() {
+			Expect(doSearch(`similar:"tax refund"`, "")).To(Equal([]string{"2024.pdf", "invoice.txt"}))
+			Expect(doSearch(`similar:"cake recipe"`, "")).To(Equal([]string{"cake.txt"}))
+		})
+
+		It("combines similarity with other clauses", func() {
+			Expect(doSearch(`similar:"tax refund" AND mediatype:text/plain`, "")).To(Equal([]string{"invoice.txt"}))
+		})
+
+		It("honors the path scope", func() {
+			Expect(doSearch(`similar:"cake recipe tax"`, "./reports")).To(ConsistOf("2024.pdf", "invoice.txt"))
+		})
+
+		It("passes lexical queries to the wrapped engine", func() {
+			Expect(doSearch(`cake.txt`, "")).To(Equal([]string{"cake.txt"}))
+		})
+
+		It("rejects nested similarity clauses", func() {
+			_, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: `(similar:cake OR name:foo)`})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Delete and Restore", func() {
+		It("skips deleted resources", func() {
+			Expect(eng.Delete(folder.ID)).To(Succeed())
+			Expect(doSearch(`similar:"tax refund"`, "")).To(BeEmpty())
+
+			Expect(eng.Restore(folder.ID)).To(Succeed())
+			Expect(doSearch(`similar:"tax refund"`, "")).To(Equal([]string{"2024.pdf", "invoice.txt"}))
+		})
+	})
+
+	Describe("Move", func() {
+		It("keeps the embeddings of moved folders", func() {
+			Expect(eng.Move(folder.ID, folder.ParentID, "/archive")).To(Succeed())
+			Expect(doSearch(`similar:"tax refund"`, "./archive")).To(Equal([]string{"2024.pdf", "invoice.txt"}))
+		})
+	})
+
+	Describe("Purge", func() {
+		It("removes the embeddings of purged resources", func() {
+			Expect(eng.Delete(folder.ID)).To(Succeed())
+			Expect(eng.Purge(root.ID, true)).To(Succeed())
+
+			// resources only known to the lexical index are not part of the results
+			Expect(lexical.Upsert(taxes.ID, taxes)).To(Succeed())
+			Expect(doSearch(`2024.pdf`, "")).To(Equal([]string{"2024.pdf"}))
+			Expect(doSearch(`similar:"tax refund"`, "")).To(BeEmpty())
+			Expect(doSearch(`similar:"cake recipe"`, "")).To(Equal([]string{"cake.txt"}))
+		})
+	})
+})
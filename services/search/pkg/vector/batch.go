@@ -0,0 +1,42 @@
+package vector
+
+import (
+	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
+)
+
+var _ search.BatchOperator = (*Batch)(nil) // ensure Batch implements BatchOperator
+
+// Batch wraps a batch of the lexical engine, the embeddings are updated immediately
+type Batch struct {
+	batch   search.BatchOperator
+	backend *Backend
+}
+
+func (b *Batch) Upsert(id string, r search.Resource) error {
+	b.backend.embed(id, r)
+	return b.batch.Upsert(id, r)
+}
+
+func (b *Batch) Move(id, parentID, location string) error {
+	b.backend.move(id, location)
+	return b.batch.Move(id, parentID, location)
+}
+
+func (b *Batch) Delete(id string) error {
+	b.backend.setDeleted(id, true)
+	return b.batch.Delete(id)
+}
+
+func (b *Batch) Restore(id string) error {
+	b.backend.setDeleted(id, false)
+	return b.batch.Restore(id)
+}
+
+func (b *Batch) Purge(id string, onlyDeleted bool) error {
+	b.backend.purge(id, onlyDeleted)
+	return b.batch.Purge(id, onlyDeleted)
+}
+
+func (b *Batch) Push() error {
+	return b.batch.Push()
+}
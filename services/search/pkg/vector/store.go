@@ -0,0 +1,214 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	_resourcesBucket = []byte("resources")
+	_vectorsBucket   = []byte("vectors")
+)
+
+// resource mirrors the path and deletion state of a resource in the lexical index, they are needed
+// to find the embeddings of descendants when a folder is moved or purged.
+type resource struct {
+	Path    string
+	Deleted bool
+	// Hash identifies the embedded text, unchanged resources are not embedded again
+	Hash string
+}
+
+// Store persists the embeddings in a bolt database. Every space has its own bucket, which contains
+// the resources and their embeddings in separate buckets to keep folder operations cheap.
+type Store struct {
+	db *bbolt.DB
+}
+
+// NewStore opens or creates the embedding store in the given directory
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(filepath.Join(root, "vectors.db"), 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the store
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// rootID returns the id of the space root of a resource
+func rootID(id string) (string, error) {
+	rid, err := storagespace.ParseID(id)
+	if err != nil {
+		return "", err
+	}
+	return storagespace.FormatResourceID(&provider.ResourceId{
+		StorageId: rid.GetStorageId(),
+		SpaceId:   rid.GetSpaceId(),
+		OpaqueId:  rid.GetSpaceId(),
+	}), nil
+}
+
+func decode(v []byte, out any) error {
+	return gob.NewDecoder(bytes.NewReader(v)).Decode(out)
+}
+
+func encode(in any) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(in)
+	return buf.Bytes(), err
+}
+
+// hash returns the hash of the embedded text of a resource, an empty string if it is unknown
+func (s *Store) hash(root, id string) (string, error) {
+	var r resource
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(root))
+		if b == nil {
+			return nil
+		}
+		v := b.Bucket(_resourcesBucket).Get([]byte(id))
+		if v == nil {
+			return nil
+		}
+		return decode(v, &r)
+	})
+	return r.Hash, err
+}
+
+// vectors returns the embeddings of resources, resources without embeddings are left out
+func (s *Store) vectors(root string, ids []string) (map[string][][]float32, error) {
+	vectors := make(map[string][][]float32, len(ids))
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(root))
+		if b == nil {
+			return nil
+		}
+		vb := b.Bucket(_vectorsBucket)
+		for _, id := range ids {
+			v := vb.Get([]byte(id))
+			if v == nil {
+				continue
+			}
+			var vs [][]float32
+			if err := decode(v, &vs); err != nil {
+				return err
+			}
+			vectors[id] = vs
+		}
+		return nil
+	})
+	return vectors, err
+}
+
+func (s *Store) put(root, id string, r resource, vectors [][]float32) error {
+	rv, err := encode(r)
+	if err != nil {
+		return err
+	}
+	vv, err := encode(vectors)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(root))
+		if err != nil {
+			return err
+		}
+		rb, err := b.CreateBucketIfNotExists(_resourcesBucket)
+		if err != nil {
+			return err
+		}
+		vb, err := b.CreateBucketIfNotExists(_vectorsBucket)
+		if err != nil {
+			return err
+		}
+		if err := rb.Put([]byte(id), rv); err != nil {
+			return err
+		}
+		return vb.Put([]byte(id), vv)
+	})
+}
+
+// update calls fn with the resource and all of its descendants and the original path of the resource.
+// A resource is written back if fn returns true, it is deleted together with its embeddings otherwise.
+func (s *Store) update(id string, fn func(r *resource, path string) bool) error {
+	root, err := rootID(id)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(root))
+		if b == nil {
+			return nil
+		}
+		rb, vb := b.Bucket(_resourcesBucket), b.Bucket(_vectorsBucket)
+
+		v := rb.Get([]byte(id))
+		if v == nil {
+			return nil
+		}
+		parent := &resource{}
+		if err := decode(v, parent); err != nil {
+			return err
+		}
+		path := parent.Path
+
+		// collect the changes first, the bucket must not be modified while iterating it
+		changes := map[string]*resource{id: parent}
+		err := rb.ForEach(func(k, v []byte) error {
+			if string(k) == id {
+				return nil
+			}
+			r := &resource{}
+			if err := decode(v, r); err != nil {
+				return err
+			}
+			if strings.HasPrefix(r.Path, path+"/") {
+				changes[string(k)] = r
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for k, r := range changes {
+			if !fn(r, path) {
+				if err := rb.Delete([]byte(k)); err != nil {
+					return err
+				}
+				if err := vb.Delete([]byte(k)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			v, err := encode(r)
+			if err != nil {
+				return err
+			}
+			if err := rb.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
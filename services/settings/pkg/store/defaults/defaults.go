@@ -18,6 +18,8 @@ const (
 	BundleUUIDProfile = "2a506de7-99bd-4f0d-994e-c38e72c28fd9"
 	// BundleUUIDServiceAccount represents the service account role.
 	BundleUUIDServiceAccount = "bcceed81-c610-49cc-ab77-39a024e8da12"
+	// BundleUUIDUnifiedRoles holds the custom sharing roles, it is managed by the graph service.
+	BundleUUIDUnifiedRoles = "1348ec56-41da-48c2-8304-d94c9d1e062a"
 	// SettingUUIDProfileLanguage is the hardcoded setting UUID for the user profile language
 	SettingUUIDProfileLanguage = "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"
 	// SettingUUIDProfileDisableNotifications is the hardcoded setting UUID for the disable notifications setting
@@ -27,6 +29,14 @@ const (
 
 	// SettingUUIDProfileEmailSendingInterval is the hardcoded setting UUID for the email sending interval setting
 	SettingUUIDProfileEmailSendingInterval = "08dec2fe-3f97-42a9-9d1b-500855e92f25"
+	// SettingUUIDProfileNotificationChannels is the hardcoded setting UUID for the notification channels setting
+	SettingUUIDProfileNotificationChannels = "b2757cf5-cd1b-4501-beeb-f83eb419c1c6"
+	// SettingUUIDProfileMatrixRoom is the hardcoded setting UUID for the matrix room notifications are sent to
+	SettingUUIDProfileMatrixRoom = "39060e84-ebdd-440f-b7e4-16a1b1933b7d"
+	// SettingUUIDProfileWebhookURL is the hardcoded setting UUID for the webhook url notifications are sent to
+	SettingUUIDProfileWebhookURL = "d71f514d-6127-4333-8ef2-15ab645231dc"
+	// SettingUUIDProfileWebPushSubscription is the hardcoded setting UUID for the web push subscription notifications are sent to
+	SettingUUIDProfileWebPushSubscription = "bbe12769-7909-4ba2-ae92-3503021cf86a"
 	// SettingUUIDProfileEventShareCreated it the hardcoded setting UUID for the send in app setting
 	SettingUUIDProfileEventShareCreated = "872d8ef6-6f2a-42ab-af7d-f53cc81d7046"
 	// SettingUUIDProfileEventShareRemoved is the hardcoded setting UUID for the send in app setting
@@ -93,6 +103,7 @@ func ServiceAccountBundle() *settingsmsg.Bundle {
 			SetProjectSpaceQuotaPermission(All),
 			SettingsManagementPermission(All),
 			SpaceAbilityPermission(All),
+			UnifiedRolesReadPermission(All),
 			WriteFavoritesPermission(All),
 			// TODO: add more permissions? remove some?
 		},
@@ -121,6 +132,10 @@ func generateBundleAdminRole() *settingsmsg.Bundle {
 			DeleteReadOnlyPublicLinkPasswordPermission(All),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileMatrixRoomPermission(Own),
+			ProfileWebhookURLPermission(Own),
+			ProfileWebPushSubscriptionPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -165,6 +180,10 @@ func generateBundleSpaceAdminRole() *settingsmsg.Bundle {
 			DeleteReadOnlyPublicLinkPasswordPermission(All),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileMatrixRoomPermission(Own),
+			ProfileWebhookURLPermission(Own),
+			ProfileWebPushSubscriptionPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -203,6 +222,10 @@ func generateBundleUserRole() *settingsmsg.Bundle {
 			CreateSpacesPermission(Own),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileMatrixRoomPermission(Own),
+			ProfileWebhookURLPermission(Own),
+			ProfileWebPushSubscriptionPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -234,6 +257,10 @@ func generateBundleUserLightRole() *settingsmsg.Bundle {
 			AutoAcceptSharesPermission(Own),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileMatrixRoomPermission(Own),
+			ProfileWebhookURLPermission(Own),
+			ProfileWebPushSubscriptionPermission(Own),
 			LanguageManagementPermission(Own),
 		},
 	}
@@ -290,6 +317,54 @@ func generateBundleProfileRequest() *settingsmsg.Bundle {
 				},
 				Value: &sendEmailOptions,
 			},
+			{
+				Id:          SettingUUIDProfileNotificationChannels,
+				Name:        "notification-channels-options",
+				DisplayName: TemplateNotificationChannels,
+				Description: TemplateNotificationChannelsDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_MultiChoiceCollectionValue{
+					MultiChoiceCollectionValue: &settingsmsg.MultiChoiceCollection{
+						Options: []*settingsmsg.MultiChoiceCollectionOption{
+							&optionMatrixFalse,
+							&optionWebhookFalse,
+							&optionPushFalse,
+						},
+					},
+				},
+			},
+			{
+				Id:          SettingUUIDProfileMatrixRoom,
+				Name:        "matrix-room",
+				DisplayName: TemplateMatrixRoom,
+				Description: TemplateMatrixRoomDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{Placeholder: "!room:example.org"}},
+			},
+			{
+				Id:          SettingUUIDProfileWebhookURL,
+				Name:        "webhook-url",
+				DisplayName: TemplateWebhookURL,
+				Description: TemplateWebhookURLDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{Placeholder: "https://hooks.example.org/..."}},
+			},
+			{
+				Id:          SettingUUIDProfileWebPushSubscription,
+				Name:        "web-push-subscription",
+				DisplayName: "Web Push Subscription",
+				Description: "The push subscription of the browser as JSON",
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{}},
+			},
 			{
 				Id:          SettingUUIDProfileEventShareCreated,
 				Name:        "event-share-created-options",
@@ -524,6 +599,42 @@ var optionMailFalseDisabled = settingsmsg.MultiChoiceCollectionOption{
 	},
 }
 
+var optionMatrixFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "matrix",
+	DisplayValue: "Matrix",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
+var optionWebhookFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "webhook",
+	DisplayValue: "Webhook",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
+var optionPushFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "push",
+	DisplayValue: "Push",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
 // TODO: languageSetting needed?
 var languageSetting = settingsmsg.Setting_SingleChoiceValue{
 	SingleChoiceValue: &settingsmsg.SingleChoiceList{
@@ -219,6 +219,82 @@ func ProfileEmailSendingIntervalPermission(c settingsmsg.Permission_Constraint)
 	}
 }
 
+// ProfileNotificationChannelsPermission is the permission to choose the notification channels
+func ProfileNotificationChannelsPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "11a078ce-6021-409a-b00e-33874072b9be",
+		Name:        "NotificationChannels.ReadWrite",
+		DisplayName: "Notification Channels",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileNotificationChannels,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
+// ProfileMatrixRoomPermission is the permission to set the matrix room for notifications
+func ProfileMatrixRoomPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "af1db0d2-0cce-41c2-b10f-ce542fd68854",
+		Name:        "MatrixRoom.ReadWrite",
+		DisplayName: "Matrix Room",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileMatrixRoom,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
+// ProfileWebhookURLPermission is the permission to set the webhook url for notifications
+func ProfileWebhookURLPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "b751c50d-523c-4a6c-9d3b-360f4880b08c",
+		Name:        "WebhookURL.ReadWrite",
+		DisplayName: "Webhook URL",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileWebhookURL,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
+// ProfileWebPushSubscriptionPermission is the permission to set the web push subscription for notifications
+func ProfileWebPushSubscriptionPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "686b6187-951c-4159-84e3-de2be702d8d4",
+		Name:        "WebPushSubscription.ReadWrite",
+		DisplayName: "Web Push Subscription",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileWebPushSubscription,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
 // ProfileEventShareCreatedPermission is
 func ProfileEventShareCreatedPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
 	return &settingsmsg.Setting{
@@ -603,6 +679,26 @@ func SpaceAbilityPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Se
 	}
 }
 
+// UnifiedRolesReadPermission is the permission to read the custom sharing roles
+func UnifiedRolesReadPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "9c52c8d3-2f6b-4fea-8faf-782961922b1d",
+		Name:        "UnifiedRoles.Read",
+		DisplayName: "Read Custom Sharing Roles",
+		Description: "This permission allows reading the custom sharing roles defined by the admins.",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_BUNDLE,
+			Id:   BundleUUIDUnifiedRoles,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READ,
+				Constraint: c,
+			},
+		},
+	}
+}
+
 // WriteFavoritesPermission is the permission to mark/unmark files as favorites
 func WriteFavoritesPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
 	return &settingsmsg.Setting{
@@ -44,6 +44,18 @@ var (
 	TemplateEmailSendingInterval = l10n.Template("Email sending interval")
 	// description of the notification option 'Email Interval'
 	TemplateEmailSendingIntervalDescription = l10n.Template("Selected value:")
+	// name of the notification option 'Notification Channels'
+	TemplateNotificationChannels = l10n.Template("Notification channels")
+	// description of the notification option 'Notification Channels'
+	TemplateNotificationChannelsDescription = l10n.Template("Send notifications to these channels in addition to email")
+	// name of the notification option 'Matrix Room'
+	TemplateMatrixRoom = l10n.Template("Matrix room")
+	// description of the notification option 'Matrix Room'
+	TemplateMatrixRoomDescription = l10n.Template("The id or alias of the Matrix room notifications are sent to. Invite the notification bot to the room.")
+	// name of the notification option 'Webhook URL'
+	TemplateWebhookURL = l10n.Template("Webhook URL")
+	// description of the notification option 'Webhook URL'
+	TemplateWebhookURLDescription = l10n.Template("The URL of a Slack, Microsoft Teams or Mattermost compatible incoming webhook notifications are sent to")
 	// translation for the 'instant' email interval option
 	TemplateIntervalInstant = l10n.Template("Instant")
 	// translation for the 'daily' email interval option
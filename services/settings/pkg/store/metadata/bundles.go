@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
@@ -156,8 +157,22 @@ func (s *Store) AddSettingToBundle(bundleID string, setting *settingsmsg.Setting
 
 // RemoveSettingFromBundle removes the setting from the bundle with the given ids.
 func (s *Store) RemoveSettingFromBundle(bundleID string, settingID string) error {
-	fmt.Println("RemoveSettingFromBundle not implemented")
-	return errors.New("not implemented")
+	s.Init()
+	b, err := s.ReadBundle(bundleID)
+	if err != nil {
+		return err
+	}
+
+	settingsCount := len(b.Settings)
+	b.Settings = slices.DeleteFunc(b.Settings, func(setting *settingsmsg.Setting) bool {
+		return setting.GetId() == settingID
+	})
+	if len(b.Settings) == settingsCount {
+		return fmt.Errorf("settingID '%s' %w", settingID, settings.ErrNotFound)
+	}
+
+	_, err = s.WriteBundle(b)
+	return err
 }
 
 func bundlePath(id string) string {
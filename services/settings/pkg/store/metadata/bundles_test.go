@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/settings"
 	"github.com/stretchr/testify/require"
 )
 
@@ -198,3 +199,26 @@ func TestAppendSetting(t *testing.T) {
 	require.Len(t, b.Settings, 2)
 
 }
+
+func TestRemoveSetting(t *testing.T) {
+	s := initStore()
+	setupRoles(s)
+
+	bundleID := uuid.NewString()
+	_, err := s.AddSettingToBundle(bundleID, appendTestSetting1)
+	require.NoError(t, err)
+	_, err = s.AddSettingToBundle(bundleID, appendTestSetting2)
+	require.NoError(t, err)
+
+	err = s.RemoveSettingFromBundle(bundleID, appendTestSetting1.Id)
+	require.NoError(t, err)
+
+	b, err := s.ReadBundle(bundleID)
+	require.NoError(t, err)
+	require.Len(t, b.Settings, 1)
+	require.Equal(t, appendTestSetting2.Id, b.Settings[0].Id)
+
+	// removing an unknown setting fails
+	err = s.RemoveSettingFromBundle(bundleID, appendTestSetting1.Id)
+	require.ErrorIs(t, err, settings.ErrNotFound)
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/opencloud-eu/opencloud/services/sse/pkg/server/http"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
 
 	"github.com/spf13/cobra"
+	microstore "go-micro.dev/v4/store"
 )
 
 // all events we care about
@@ -56,12 +58,22 @@ func Server(cfg *config.Config) *cobra.Command {
 					return err
 				}
 
+				st := store.Create(
+					store.Store(cfg.Store.Store),
+					store.TTL(cfg.Store.TTL),
+					microstore.Nodes(cfg.Store.Nodes...),
+					microstore.Database(cfg.Store.Database),
+					microstore.Table(cfg.Store.Table),
+					store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+				)
+
 				server, err := http.Server(
 					http.Logger(logger),
 					http.Context(ctx),
 					http.Config(cfg),
 					http.Consumer(natsStream),
 					http.RegisteredEvents(_registeredEvents),
+					http.Store(st),
 					http.TracerProvider(tracerProvider),
 				)
 				if err != nil {
@@ -15,8 +15,10 @@ type Config struct {
 
 	Service           Service       `yaml:"-"`
 	KeepAliveInterval time.Duration `yaml:"keepalive_interval" env:"SSE_KEEPALIVE_INTERVAL" desc:"To prevent intermediate proxies from closing the SSE connection, send periodic SSE comments to keep it open." introductionVersion:"1.0.0"`
+	ReplayBufferSize  int           `yaml:"replay_buffer_size" env:"SSE_REPLAY_BUFFER_SIZE" desc:"The maximum number of events kept per user to be replayed to clients reconnecting with a 'Last-Event-ID' header. Set to 0 to disable replaying." introductionVersion:"%%NEXT%%"`
 
 	Events       Events
+	Store        Store         `yaml:"store"`
 	HTTP         HTTP          `yaml:"http"`
 	TokenManager *TokenManager `yaml:"token_manager"`
 
@@ -47,6 +49,17 @@ type Events struct {
 	AuthPassword         string `yaml:"password" env:"OC_EVENTS_AUTH_PASSWORD;SSE_EVENTS_AUTH_PASSWORD" desc:"The password to authenticate with the events broker. The events broker is the OpenCloud service which receives and delivers events between the services." introductionVersion:"1.0.0"`
 }
 
+// Store configures the store used for the replay buffer
+type Store struct {
+	Store        string        `yaml:"store" env:"OC_PERSISTENT_STORE;SSE_STORE" desc:"The type of the store. Supported values are: 'memory', 'nats-js-kv', 'redis-sentinel', 'noop'. Use 'nats-js-kv' when running multiple sse instances so they share the replay buffer. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string      `yaml:"nodes" env:"OC_PERSISTENT_STORE_NODES;SSE_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string        `yaml:"database" env:"SSE_STORE_DATABASE" desc:"The database name the configured store should use." introductionVersion:"%%NEXT%%"`
+	Table        string        `yaml:"table" env:"SSE_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	TTL          time.Duration `yaml:"ttl" env:"SSE_STORE_TTL" desc:"Time to live for events in the replay buffer. Defaults to '1h'. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AuthUsername string        `yaml:"username" env:"OC_PERSISTENT_STORE_AUTH_USERNAME;SSE_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string        `yaml:"password" env:"OC_PERSISTENT_STORE_AUTH_PASSWORD;SSE_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
 // CORS defines the available cors configuration.
 type CORS struct {
 	AllowedOrigins   []string `yaml:"allow_origins" env:"OC_CORS_ALLOW_ORIGINS;SSE_CORS_ALLOW_ORIGINS" desc:"A list of allowed CORS origins. See following chapter for more details: *Access-Control-Allow-Origin* at https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Allow-Origin. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
@@ -2,6 +2,7 @@ package defaults
 
 import (
 	"strings"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/services/sse/pkg/config"
 )
@@ -28,6 +29,13 @@ func DefaultConfig() *config.Config {
 			Endpoint: "127.0.0.1:9233",
 			Cluster:  "opencloud-cluster",
 		},
+		Store: config.Store{
+			Store:    "memory",
+			Database: "sse",
+			Table:    "replay",
+			TTL:      time.Hour,
+		},
+		ReplayBufferSize: 100,
 		HTTP: config.HTTP{
 			Addr:      "127.0.0.1:9135",
 			Root:      "/",
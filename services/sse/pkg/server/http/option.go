@@ -6,6 +6,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/sse/pkg/config"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -19,6 +20,7 @@ type Options struct {
 	Config           *config.Config
 	Consumer         events.Consumer
 	RegisteredEvents []events.Unmarshaller
+	Store            store.Store
 	TracerProvider   trace.TracerProvider
 }
 
@@ -68,6 +70,13 @@ func RegisteredEvents(evs []events.Unmarshaller) Option {
 	}
 }
 
+// Store provides a function to set the store option
+func Store(val store.Store) Option {
+	return func(o *Options) {
+		o.Store = val
+	}
+}
+
 // TracerProvider provides a function to set the TracerProvider option
 func TracerProvider(val trace.TracerProvider) Option {
 	return func(o *Options) {
@@ -83,7 +83,7 @@ func Server(opts ...Option) (http.Service, error) {
 		return http.Service{}, err
 	}
 
-	handle, err := svc.NewSSE(options.Config, options.Logger, ch, mux)
+	handle, err := svc.NewSSE(options.Config, options.Logger, ch, options.Store, mux)
 	if err != nil {
 		return http.Service{}, err
 	}
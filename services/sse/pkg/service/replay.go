@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go-micro.dev/v4/store"
+)
+
+// bufferedEvent is an event kept in the replay buffer of a user
+type bufferedEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data []byte `json:"data"`
+}
+
+// replayBuffer keeps the last events of each user in a store so they can be
+// replayed to reconnecting clients. Keys have the form <userid>/<timestamp>/<eventid>.
+// All sse instances write every event they receive, the event id is used to
+// deduplicate so the buffer stays consistent when instances share a store.
+type replayBuffer struct {
+	store store.Store
+	size  int
+}
+
+func newReplayBuffer(s store.Store, size int) *replayBuffer {
+	return &replayBuffer{store: s, size: size}
+}
+
+// persist adds an event to the replay buffer of the user and drops the oldest
+// events exceeding the buffer size.
+func (b *replayBuffer) persist(uid string, ev bufferedEvent) error {
+	keys, err := b.keys(uid)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if eventID(k) == ev.ID {
+			// another instance already buffered this event
+			return nil
+		}
+	}
+
+	value, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s/%020d/%s", uid, time.Now().UnixNano(), ev.ID)
+	if err := b.store.Write(&store.Record{Key: key, Value: value}); err != nil {
+		return err
+	}
+
+	keys = append(keys, key)
+	for len(keys) > b.size {
+		if err := b.store.Delete(keys[0]); err != nil && err != store.ErrNotFound {
+			return err
+		}
+		keys = keys[1:]
+	}
+	return nil
+}
+
+// since returns the buffered events of the user sent after the event with the given id.
+// If the id is not known (anymore) all buffered events are returned.
+func (b *replayBuffer) since(uid string, lastID string) ([]bufferedEvent, error) {
+	keys, err := b.keys(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		if eventID(keys[i]) == lastID {
+			keys = keys[i+1:]
+			break
+		}
+	}
+
+	seen := make(map[string]struct{}, len(keys))
+	evs := make([]bufferedEvent, 0, len(keys))
+	for _, k := range keys {
+		id := eventID(k)
+		if _, ok := seen[id]; ok || id == lastID {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		records, err := b.store.Read(k)
+		switch {
+		case err == store.ErrNotFound || len(records) == 0:
+			// expired or trimmed in the meantime
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		var ev bufferedEvent
+		if err := json.Unmarshal(records[0].Value, &ev); err != nil {
+			return nil, err
+		}
+		evs = append(evs, ev)
+	}
+	return evs, nil
+}
+
+// keys returns the buffer keys of a user, oldest first
+func (b *replayBuffer) keys(uid string) ([]string, error) {
+	keys, err := b.store.List(store.ListPrefix(uid + "/"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func eventID(key string) string {
+	return key[strings.LastIndex(key, "/")+1:]
+}
+
+// replayWriter sends replayed events to a reconnecting client before the live
+// events. The sse server flushes once after registering the subscriber, so
+// replaying on the first flush does not miss events published in between.
+// Live events that were already replayed are dropped.
+type replayWriter struct {
+	http.ResponseWriter
+	flusher  http.Flusher
+	replay   func() []bufferedEvent
+	replayed map[string]struct{}
+	started  bool
+	frame    bytes.Buffer
+}
+
+func newReplayWriter(w http.ResponseWriter, replay func() []bufferedEvent) (*replayWriter, bool) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	return &replayWriter{
+		ResponseWriter: w,
+		flusher:        f,
+		replay:         replay,
+		replayed:       make(map[string]struct{}),
+	}, true
+}
+
+// Write buffers the current frame until it is flushed
+func (w *replayWriter) Write(p []byte) (int, error) {
+	return w.frame.Write(p)
+}
+
+// Flush fulfills the http.Flusher interface
+func (w *replayWriter) Flush() {
+	if !w.started {
+		w.started = true
+		for _, ev := range w.replay() {
+			w.replayed[ev.ID] = struct{}{}
+			fmt.Fprintf(w.ResponseWriter, "id: %s\ndata: %s\nevent: %s\n\n", ev.ID, ev.Data, ev.Type)
+		}
+	}
+
+	frame := w.frame.Bytes()
+	if id, ok := frameID(frame); ok {
+		if _, ok := w.replayed[id]; ok {
+			delete(w.replayed, id)
+			frame = nil
+		}
+	}
+
+	if len(frame) > 0 {
+		_, _ = w.ResponseWriter.Write(frame)
+	}
+	w.frame.Reset()
+	w.flusher.Flush()
+}
+
+func frameID(frame []byte) (string, bool) {
+	line, _, _ := bytes.Cut(frame, []byte("\n"))
+	id, ok := bytes.CutPrefix(line, []byte("id: "))
+	return string(id), ok
+}
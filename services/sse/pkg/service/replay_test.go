@@ -0,0 +1,62 @@
+package service
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/store"
+)
+
+func TestReplayBuffer(t *testing.T) {
+	b := newReplayBuffer(store.NewMemoryStore(), 3)
+
+	for _, id := range []string{"a", "b", "c", "c", "d"} {
+		require.NoError(t, b.persist("einstein", bufferedEvent{ID: id, Type: "t", Data: []byte(id)}))
+	}
+	require.NoError(t, b.persist("marie", bufferedEvent{ID: "x", Type: "t", Data: []byte("x")}))
+
+	evs, err := b.since("einstein", "b")
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, ids(evs))
+
+	evs, err = b.since("einstein", "d")
+	require.NoError(t, err)
+	require.Empty(t, evs)
+
+	// "a" was trimmed, everything still buffered is replayed
+	evs, err = b.since("einstein", "a")
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c", "d"}, ids(evs))
+}
+
+func TestReplayWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w, ok := newReplayWriter(rec, func() []bufferedEvent {
+		return []bufferedEvent{{ID: "a", Type: "t", Data: []byte("1")}}
+	})
+	require.True(t, ok)
+
+	w.WriteHeader(200)
+	w.Flush()
+
+	// already replayed
+	_, _ = w.Write([]byte("id: a\ndata: 1\nevent: t\n\n"))
+	w.Flush()
+
+	_, _ = w.Write([]byte("id: b\ndata: 2\nevent: t\n\n"))
+	w.Flush()
+
+	body := rec.Body.String()
+	require.Equal(t, 1, strings.Count(body, "id: a\n"))
+	require.Equal(t, "id: a\ndata: 1\nevent: t\n\nid: b\ndata: 2\nevent: t\n\n", body)
+}
+
+func ids(evs []bufferedEvent) []string {
+	out := make([]string, 0, len(evs))
+	for _, ev := range evs {
+		out = append(out, ev.ID)
+	}
+	return out
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/r3labs/sse/v2"
+	"go-micro.dev/v4/store"
 
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
@@ -21,17 +23,25 @@ type SSE struct {
 	m         *chi.Mux
 	sse       *sse.Server
 	evChannel <-chan events.Event
+	replay    *replayBuffer
 }
 
 // NewSSE returns a service implementation for Service.
-func NewSSE(c *config.Config, l log.Logger, ch <-chan events.Event, mux *chi.Mux) (SSE, error) {
+func NewSSE(c *config.Config, l log.Logger, ch <-chan events.Event, st store.Store, mux *chi.Mux) (SSE, error) {
+	server := sse.New()
+	// event ids are set by us so they are stable across instances
+	server.AutoReplay = false
+
 	s := SSE{
 		c:         c,
 		l:         l,
 		m:         mux,
-		sse:       sse.New(),
+		sse:       server,
 		evChannel: ch,
 	}
+	if c.ReplayBufferSize > 0 && st != nil {
+		s.replay = newReplayBuffer(st, c.ReplayBufferSize)
+	}
 	mux.Route("/ocs/v2.php/apps/notifications/api/v1/notifications", func(r chi.Router) {
 		r.Get("/sse", s.HandleSSE)
 	})
@@ -53,8 +63,21 @@ func (s SSE) ListenForEvents() {
 		default:
 			s.l.Error().Interface("event", ev).Msg("unhandled event")
 		case events.SendSSE:
+			id := e.ID
+			if id == "" {
+				id = uuid.New().String()
+			}
 			for _, uid := range ev.UserIDs {
+				// persist before publishing so a client connecting in between
+				// gets the event either replayed or live
+				if s.replay != nil {
+					err := s.replay.persist(uid, bufferedEvent{ID: id, Type: ev.Type, Data: ev.Message})
+					if err != nil {
+						s.l.Error().Err(err).Str("userid", uid).Str("eventid", id).Msg("sse: could not buffer event")
+					}
+				}
 				s.sse.Publish(uid, &sse.Event{
+					ID:    []byte(id),
 					Event: []byte(ev.Type),
 					Data:  ev.Message,
 				})
@@ -99,5 +122,25 @@ func (s SSE) HandleSSE(w http.ResponseWriter, r *http.Request) {
 	q.Set("stream", uid)
 	r.URL.RawQuery = q.Encode()
 
-	s.sse.ServeHTTP(w, r)
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" || s.replay == nil {
+		s.sse.ServeHTTP(w, r)
+		return
+	}
+
+	// the sse server only understands numeric ids, replaying is done by us
+	r.Header.Del("Last-Event-ID")
+	rw, ok := newReplayWriter(w, func() []bufferedEvent {
+		evs, err := s.replay.since(uid, lastID)
+		if err != nil {
+			s.l.Error().Err(err).Str("userid", uid).Str("lastEventID", lastID).Msg("sse: could not read replay buffer")
+		}
+		return evs
+	})
+	if !ok {
+		s.sse.ServeHTTP(w, r)
+		return
+	}
+
+	s.sse.ServeHTTP(rw, r)
 }
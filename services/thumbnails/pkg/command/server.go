@@ -17,6 +17,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/grpc"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/http"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 
 	"github.com/spf13/cobra"
 )
@@ -95,6 +96,23 @@ func Server(cfg *config.Config) *cobra.Command {
 			}
 			gr.Add(runner.NewGoMicroHttpServerRunner(cfg.Service.Name+".http", httpServer))
 
+			thumbnailStorage, err := storage.New(cfg.Thumbnail, logger)
+			if err != nil {
+				return err
+			}
+			evictor, err := storage.NewEvictor(thumbnailStorage, cfg.Thumbnail.Eviction, logger)
+			if err != nil {
+				return err
+			}
+			if evictor.Enabled() {
+				evictionCtx, cancelEviction := context.WithCancel(ctx)
+				gr.Add(runner.New(cfg.Service.Name+".eviction", func() error {
+					return evictor.Run(evictionCtx)
+				}, func() {
+					cancelEviction()
+				}))
+			}
+
 			grResults := gr.Run(ctx)
 
 			// return the first non-nil error found in the results
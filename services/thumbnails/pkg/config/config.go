@@ -3,6 +3,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 	"go-micro.dev/v4/client"
@@ -28,6 +29,23 @@ type Config struct {
 	Context context.Context `yaml:"-"`
 }
 
+// S3Storage defines the available S3 storage configuration.
+type S3Storage struct {
+	Endpoint  string `yaml:"endpoint" env:"THUMBNAILS_S3STORAGE_ENDPOINT" desc:"The endpoint of the S3 compatible storage, e.g. 'https://s3.example.com'." introductionVersion:"%%NEXT%%"`
+	Region    string `yaml:"region" env:"THUMBNAILS_S3STORAGE_REGION" desc:"The region of the S3 bucket." introductionVersion:"%%NEXT%%"`
+	AccessKey string `yaml:"access_key" env:"THUMBNAILS_S3STORAGE_ACCESS_KEY" desc:"The access key for the S3 bucket." introductionVersion:"%%NEXT%%"`
+	SecretKey string `yaml:"secret_key" env:"THUMBNAILS_S3STORAGE_SECRET_KEY" desc:"The secret key for the S3 bucket." introductionVersion:"%%NEXT%%"`
+	Bucket    string `yaml:"bucket" env:"THUMBNAILS_S3STORAGE_BUCKET" desc:"The name of the S3 bucket. The bucket must exist." introductionVersion:"%%NEXT%%"`
+	Prefix    string `yaml:"prefix" env:"THUMBNAILS_S3STORAGE_PREFIX" desc:"An optional prefix for the keys of the thumbnails in the bucket, which allows sharing the bucket with other data." introductionVersion:"%%NEXT%%"`
+}
+
+// Eviction defines the available configuration for the eviction of thumbnails.
+type Eviction struct {
+	MaxSize  string        `yaml:"max_size" env:"THUMBNAILS_EVICTION_MAX_SIZE" desc:"The maximum total size of the stored thumbnails. If it is exceeded, the least recently used thumbnails are deleted. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB. If not set, the size is not limited." introductionVersion:"%%NEXT%%"`
+	MaxAge   time.Duration `yaml:"max_age" env:"THUMBNAILS_EVICTION_MAX_AGE" desc:"Thumbnails which were not used for longer than this duration are deleted. If not set, thumbnails are not deleted because of their age. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Interval time.Duration `yaml:"interval" env:"THUMBNAILS_EVICTION_INTERVAL" desc:"The interval in which the stored thumbnails are checked for eviction. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
 // FileSystemStorage defines the available filesystem storage configuration.
 type FileSystemStorage struct {
 	RootDirectory string `yaml:"root_directory" env:"THUMBNAILS_FILESYSTEMSTORAGE_ROOT" desc:"The directory where the filesystem storage will store the thumbnails. If not defined, the root directory derives from $OC_BASE_DATA_PATH/thumbnails." introductionVersion:"1.0.0"`
@@ -36,7 +54,10 @@ type FileSystemStorage struct {
 // Thumbnail defines the available thumbnail related configuration.
 type Thumbnail struct {
 	Resolutions           []string          `yaml:"resolutions" env:"THUMBNAILS_RESOLUTIONS" desc:"The supported list of target resolutions in the format WidthxHeight like 32x32. You can define any resolution as required. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
+	Storage               string            `yaml:"storage" env:"THUMBNAILS_STORAGE" desc:"The storage for the generated thumbnails. Supported values are 'filesystem' and 's3'. Multiple instances of the thumbnails service can share the thumbnails stored in S3." introductionVersion:"%%NEXT%%"`
 	FileSystemStorage     FileSystemStorage `yaml:"filesystem_storage"`
+	S3Storage             S3Storage         `yaml:"s3_storage"`
+	Eviction              Eviction          `yaml:"eviction"`
 	WebdavAllowInsecure   bool              `yaml:"webdav_allow_insecure" env:"OC_INSECURE;THUMBNAILS_WEBDAVSOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the webdav source." introductionVersion:"1.0.0"`
 	CS3AllowInsecure      bool              `yaml:"cs3_allow_insecure" env:"OC_INSECURE;THUMBNAILS_CS3SOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the CS3 source." introductionVersion:"1.0.0"`
 	RevaGateway           string            `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to look up user metadata" introductionVersion:"1.0.0"`
@@ -46,4 +67,7 @@ type Thumbnail struct {
 	MaxInputWidth         int               `yaml:"max_input_width" env:"THUMBNAILS_MAX_INPUT_WIDTH" desc:"The maximum width of an input image which is being processed." introductionVersion:"1.0.0"`
 	MaxInputHeight        int               `yaml:"max_input_height" env:"THUMBNAILS_MAX_INPUT_HEIGHT" desc:"The maximum height of an input image which is being processed." introductionVersion:"1.0.0"`
 	MaxInputImageFileSize string            `yaml:"max_input_image_file_size" env:"THUMBNAILS_MAX_INPUT_IMAGE_FILE_SIZE" desc:"The maximum file size of an input image which is being processed. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB." introductionVersion:"1.0.0"`
+	PDFToPPMCommand       string            `yaml:"pdftoppm_command" env:"THUMBNAILS_PDFTOPPM_COMMAND" desc:"The path of the poppler pdftoppm executable used to render the first page of PDF documents." introductionVersion:"%%NEXT%%"`
+	FFmpegCommand         string            `yaml:"ffmpeg_command" env:"THUMBNAILS_FFMPEG_COMMAND" desc:"The path of the ffmpeg executable used to extract a poster frame of videos." introductionVersion:"%%NEXT%%"`
+	CommandTimeout        time.Duration     `yaml:"command_timeout" env:"THUMBNAILS_COMMAND_TIMEOUT" desc:"The maximum time rendering a PDF document or extracting a video frame may take. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
 }
@@ -3,6 +3,7 @@ package defaults
 import (
 	"path"
 	"strings"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/defaults"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
@@ -48,9 +49,13 @@ func DefaultConfig() *config.Config {
 		},
 		Thumbnail: config.Thumbnail{
 			Resolutions: []string{"16x16", "32x32", "64x64", "128x128", "1080x1920", "1920x1080", "2160x3840", "3840x2160", "4320x7680", "7680x4320"},
+			Storage:     "filesystem",
 			FileSystemStorage: config.FileSystemStorage{
 				RootDirectory: path.Join(defaults.BaseDataPath(), "thumbnails"),
 			},
+			Eviction: config.Eviction{
+				Interval: time.Hour,
+			},
 			WebdavAllowInsecure:   false,
 			RevaGateway:           shared.DefaultRevaConfig().Address,
 			CS3AllowInsecure:      false,
@@ -58,6 +63,9 @@ func DefaultConfig() *config.Config {
 			MaxInputWidth:         7680,
 			MaxInputHeight:        7680,
 			MaxInputImageFileSize: "50MB",
+			PDFToPPMCommand:       "pdftoppm",
+			FFmpegCommand:         "ffmpeg",
+			CommandTimeout:        30 * time.Second,
 		},
 	}
 }
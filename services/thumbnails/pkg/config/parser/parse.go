@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
@@ -34,6 +35,19 @@ func ParseConfig(cfg *config.Config) error {
 }
 
 // Validate can validate the configuration
-func Validate(_ *config.Config) error {
+func Validate(cfg *config.Config) error {
+	switch cfg.Thumbnail.Storage {
+	case "filesystem":
+	case "s3":
+		if cfg.Thumbnail.S3Storage.Endpoint == "" || cfg.Thumbnail.S3Storage.Bucket == "" {
+			return errors.New("the S3 storage requires an endpoint and a bucket")
+		}
+	default:
+		return fmt.Errorf("unknown thumbnail storage '%s'", cfg.Thumbnail.Storage)
+	}
+
+	if cfg.Thumbnail.Eviction.Interval <= 0 {
+		return errors.New("the eviction interval must be greater than 0")
+	}
 	return nil
 }
@@ -13,10 +13,16 @@ var (
 	ErrNoGeneratorForType = errors.New("thumbnails: no generator for this type found")
 	// ErrNoImageFromAudioFile defines an error when an image cannot be extracted from an audio file
 	ErrNoImageFromAudioFile = errors.New("thumbnails: could not extract image from audio file")
+	// ErrNoImageFromOfficeFile defines an error when an image cannot be extracted from an office file
+	ErrNoImageFromOfficeFile = errors.New("thumbnails: could not extract image from office file")
 	// ErrNoConverterForExtractedImageFromGgsFile defines an error when the extracted image from an ggs file could not be converted
 	ErrNoConverterForExtractedImageFromGgsFile = errors.New("thumbnails: could not find converter for image extracted from ggs file")
 	// ErrNoConverterForExtractedImageFromAudioFile defines an error when the extracted image from an audio file could not be converted
 	ErrNoConverterForExtractedImageFromAudioFile = errors.New("thumbnails: could not find converter for image extracted from audio file")
+	// ErrNoConverterForExtractedImageFromOfficeFile defines an error when the extracted image from an office file could not be converted
+	ErrNoConverterForExtractedImageFromOfficeFile = errors.New("thumbnails: could not find converter for image extracted from office file")
+	// ErrNoConverterForRenderedImage defines an error when the image rendered by an external command could not be converted
+	ErrNoConverterForRenderedImage = errors.New("thumbnails: could not find converter for rendered image")
 	// ErrCS3AuthorizationMissing defines an error when the CS3 authorization is missing
 	ErrCS3AuthorizationMissing = errors.New("thumbnails: cs3source - authorization missing")
 )
@@ -0,0 +1,115 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	thumbnailerErrors "github.com/opencloud-eu/opencloud/services/thumbnails/pkg/errors"
+)
+
+// defaultRenderSize is the maximum width and height of rendered images if no limits are configured
+const defaultRenderSize = 1920
+
+// commandOpts holds the options for converters which render the thumbnail with an external command
+type commandOpts struct {
+	command   string
+	maxWidth  int
+	maxHeight int
+	timeout   time.Duration
+}
+
+func commandOptsFromMap(commandKey, defaultCommand string, opts map[string]interface{}) commandOpts {
+	c := commandOpts{
+		command:   defaultCommand,
+		maxWidth:  defaultRenderSize,
+		maxHeight: defaultRenderSize,
+	}
+
+	if command, ok := opts[commandKey].(string); ok && command != "" {
+		c.command = command
+	}
+	if maxWidth, ok := opts["maxWidth"].(int); ok && maxWidth > 0 {
+		c.maxWidth = maxWidth
+	}
+	if maxHeight, ok := opts["maxHeight"].(int); ok && maxHeight > 0 {
+		c.maxHeight = maxHeight
+	}
+	if timeout, ok := opts["timeout"].(time.Duration); ok {
+		c.timeout = timeout
+	}
+
+	return c
+}
+
+// run executes the command with r as stdin and converts the png image it writes to stdout
+func (c commandOpts) run(r io.Reader, args ...string) (interface{}, error) {
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.command, args...)
+	cmd.Stdin = r
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", c.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	converter := ForType("image/png", nil)
+	if converter == nil {
+		return nil, thumbnailerErrors.ErrNoConverterForRenderedImage
+	}
+	img, err := converter.Convert(&stdout)
+	if err != nil {
+		return nil, errors.Wrap(err, `could not decode the rendered image`)
+	}
+	return img, nil
+}
+
+// PdfConverter is a converter for the pdf file
+type PdfConverter struct{ commandOpts }
+
+// Convert renders the first page of the pdf file with pdftoppm
+func (p PdfConverter) Convert(r io.Reader) (interface{}, error) {
+	// pdftoppm scales the longer side of the page to the given size
+	size := min(p.maxWidth, p.maxHeight)
+	return p.run(r, "-f", "1", "-l", "1", "-singlefile", "-png", "-scale-to", strconv.Itoa(size), "-")
+}
+
+// VideoConverter is a converter for the video file
+type VideoConverter struct{ commandOpts }
+
+// Convert extracts a poster frame of the video file with ffmpeg
+func (v VideoConverter) Convert(r io.Reader) (interface{}, error) {
+	// most containers can't be read from a pipe because their index is located at the end of the file
+	f, err := os.CreateTemp("", "thumbnail-video-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return nil, err
+	}
+
+	// the thumbnail filter picks the most representative of the first frames which avoids black intro frames
+	filter := fmt.Sprintf("thumbnail,scale=w='min(%d,iw)':h='min(%d,ih)':force_original_aspect_ratio=decrease", v.maxWidth, v.maxHeight)
+	return v.run(nil, "-v", "error", "-nostdin", "-i", f.Name(), "-an", "-vf", filter, "-frames:v", "1", "-f", "image2pipe", "-c:v", "png", "pipe:1")
+}
@@ -75,6 +75,46 @@ func (g GgsDecoder) Convert(r io.Reader) (interface{}, error) {
 	return nil, errors.Errorf("%s not found", g.thumbnailpath)
 }
 
+// OfficeDecoder is a converter for office documents which embed a thumbnail image, like OOXML and ODF files
+type OfficeDecoder struct{ thumbnailpaths []string }
+
+// Convert reads the office file and returns the first embedded thumbnail image found
+func (o OfficeDecoder) Convert(r io.Reader) (interface{}, error) {
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, r)
+	if err != nil {
+		return nil, err
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		return nil, err
+	}
+	for _, thumbnailpath := range o.thumbnailpaths {
+		for _, file := range zipReader.File {
+			if file.Name != thumbnailpath {
+				continue
+			}
+			thumbnail, err := file.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer thumbnail.Close()
+
+			// the image decoder detects the actual format of the thumbnail
+			converter := ForType("image/png", nil)
+			if converter == nil {
+				return nil, thumbnailerErrors.ErrNoConverterForExtractedImageFromOfficeFile
+			}
+			img, err := converter.Convert(thumbnail)
+			if err != nil {
+				return nil, errors.Wrap(err, `could not decode the image`)
+			}
+			return img, nil
+		}
+	}
+	return nil, thumbnailerErrors.ErrNoImageFromOfficeFile
+}
+
 // AudioDecoder is a converter for the audio file
 type AudioDecoder struct{}
 
@@ -336,6 +376,25 @@ func ForType(mimeType string, opts map[string]interface{}) FileConverter {
 		return GgsDecoder{"_slide0/geogebra_thumbnail.png"}
 	case "application/vnd.geogebra.pinboard":
 		return GgpDecoder{}
+	case "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation":
+		return OfficeDecoder{[]string{"docProps/thumbnail.jpeg", "docProps/thumbnail.jpg", "docProps/thumbnail.png"}}
+	case "application/vnd.oasis.opendocument.text",
+		"application/vnd.oasis.opendocument.spreadsheet",
+		"application/vnd.oasis.opendocument.presentation",
+		"application/vnd.oasis.opendocument.graphics":
+		return OfficeDecoder{[]string{"Thumbnails/thumbnail.png"}}
+	case "application/pdf":
+		return PdfConverter{commandOptsFromMap("pdfToPPMCommand", "pdftoppm", opts)}
+	case "video/mp4",
+		"video/quicktime",
+		"video/webm",
+		"video/x-matroska",
+		"video/x-msvideo",
+		"video/mpeg",
+		"video/ogg":
+		return VideoConverter{commandOptsFromMap("ffmpegCommand", "ffmpeg", opts)}
 	case "image/gif":
 		return GifDecoder{}
 	case "audio/flac":
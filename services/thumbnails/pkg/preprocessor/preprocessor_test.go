@@ -1,10 +1,13 @@
 package preprocessor
 
 import (
+	"archive/zip"
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/opentype"
@@ -127,6 +130,135 @@ var _ = Describe("ImageDecoder", func() {
 		})
 	})
 
+	Describe("OfficeDecoder", func() {
+		var officeFile = func(name string) io.Reader {
+			thumbnail, err := os.ReadFile("test_assets/noise.png")
+			if err != nil {
+				panic(err)
+			}
+			buf := &bytes.Buffer{}
+			w := zip.NewWriter(buf)
+			f, err := w.Create(name)
+			if err != nil {
+				panic(err)
+			}
+			if _, err := f.Write(thumbnail); err != nil {
+				panic(err)
+			}
+			if err := w.Close(); err != nil {
+				panic(err)
+			}
+			return buf
+		}
+
+		It("should decode an odf file", func() {
+			decoder := ForType("application/vnd.oasis.opendocument.text", nil)
+			img, err := decoder.Convert(officeFile("Thumbnails/thumbnail.png"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+		})
+
+		It("should decode an ooxml file", func() {
+			decoder := ForType("application/vnd.openxmlformats-officedocument.wordprocessingml.document", nil)
+			img, err := decoder.Convert(officeFile("docProps/thumbnail.jpeg"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+		})
+
+		It("should return an error if the office file has no thumbnail", func() {
+			decoder := ForType("application/vnd.oasis.opendocument.text", nil)
+			img, err := decoder.Convert(officeFile("content.xml"))
+			Expect(err).To(HaveOccurred())
+			Expect(img).To(BeNil())
+		})
+
+		It("should return an error if the office file is invalid", func() {
+			decoder := ForType("application/vnd.oasis.opendocument.text", nil)
+			img, err := decoder.Convert(bytes.NewReader([]byte("not an office file")))
+			Expect(err).To(HaveOccurred())
+			Expect(img).To(BeNil())
+		})
+	})
+
+	Describe("command converters", func() {
+		var (
+			dir    string
+			args   string
+			script = func(body string) string {
+				command := filepath.Join(dir, "command")
+				err := os.WriteFile(command, []byte("#!/bin/sh\necho \"$@\" > "+args+"\n"+body), 0700)
+				if err != nil {
+					panic(err)
+				}
+				return command
+			}
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "thumbnails-command")
+			if err != nil {
+				panic(err)
+			}
+			args = filepath.Join(dir, "args")
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		It("should render the first page of a pdf", func() {
+			asset, err := filepath.Abs("test_assets/noise.png")
+			Expect(err).ToNot(HaveOccurred())
+			decoder := ForType("application/pdf", map[string]interface{}{
+				"pdfToPPMCommand": script("cat > /dev/null\ncat " + asset),
+				"maxWidth":        640,
+				"maxHeight":       480,
+			})
+			img, err := decoder.Convert(bytes.NewReader([]byte("%PDF")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+
+			b, err := os.ReadFile(args)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(b)).To(Equal("-f 1 -l 1 -singlefile -png -scale-to 480 -\n"))
+		})
+
+		It("should extract a poster frame of a video", func() {
+			asset, err := filepath.Abs("test_assets/noise.png")
+			Expect(err).ToNot(HaveOccurred())
+			decoder := ForType("video/mp4", map[string]interface{}{
+				"ffmpegCommand": script("cat " + asset),
+			})
+			img, err := decoder.Convert(bytes.NewReader([]byte("video")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+
+			b, err := os.ReadFile(args)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("scale=w='min(1920,iw)':h='min(1920,ih)'"))
+		})
+
+		It("should return an error if the command fails", func() {
+			decoder := ForType("application/pdf", map[string]interface{}{
+				"pdfToPPMCommand": script("exit 1"),
+			})
+			img, err := decoder.Convert(bytes.NewReader([]byte("%PDF")))
+			Expect(err).To(HaveOccurred())
+			Expect(img).To(BeNil())
+		})
+
+		It("should return an error if the command times out", func() {
+			decoder := ForType("video/mp4", map[string]interface{}{
+				"ffmpegCommand": script("exec sleep 5"),
+				"timeout":       100 * time.Millisecond,
+			})
+			img, err := decoder.Convert(bytes.NewReader([]byte("video")))
+			Expect(err).To(HaveOccurred())
+			Expect(img).To(BeNil())
+		})
+	})
+
 	Describe("should decode text", func() {
 		var decoder TxtToImageConverter
 		BeforeEach(func() {
@@ -184,6 +316,21 @@ var _ = Describe("ImageDecoder", func() {
 			Expect(decoder).To(BeAssignableToTypeOf(AudioDecoder{}))
 		})
 
+		It("should return an OfficeDecoder for office types", func() {
+			decoder := ForType("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", nil)
+			Expect(decoder).To(BeAssignableToTypeOf(OfficeDecoder{}))
+		})
+
+		It("should return a PdfConverter for pdf types", func() {
+			decoder := ForType("application/pdf", nil)
+			Expect(decoder).To(BeAssignableToTypeOf(PdfConverter{}))
+		})
+
+		It("should return a VideoConverter for video types", func() {
+			decoder := ForType("video/webm", nil)
+			Expect(decoder).To(BeAssignableToTypeOf(VideoConverter{}))
+		})
+
 		It("should return an TxtToImageConverter for text types", func() {
 			decoder := ForType("text/plain", nil)
 			Expect(decoder).To(BeAssignableToTypeOf(TxtToImageConverter{}))
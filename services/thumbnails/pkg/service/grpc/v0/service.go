@@ -53,7 +53,12 @@ func NewService(opts ...Option) decorators.DecoratedService {
 		logger:       logger,
 		selector:     options.GatewaySelector,
 		preprocessorOpts: PreprocessorOpts{
-			TxtFontFileMap: options.Config.Thumbnail.FontMapFile,
+			TxtFontFileMap:  options.Config.Thumbnail.FontMapFile,
+			PDFToPPMCommand: options.Config.Thumbnail.PDFToPPMCommand,
+			FFmpegCommand:   options.Config.Thumbnail.FFmpegCommand,
+			CommandTimeout:  options.Config.Thumbnail.CommandTimeout,
+			MaxInputWidth:   options.Config.Thumbnail.MaxInputWidth,
+			MaxInputHeight:  options.Config.Thumbnail.MaxInputHeight,
 		},
 		dataEndpoint:   options.Config.Thumbnail.DataEndpoint,
 		transferSecret: options.Config.Thumbnail.TransferSecret,
@@ -77,7 +82,32 @@ type Thumbnail struct {
 
 // PreprocessorOpts holds the options for the preprocessor
 type PreprocessorOpts struct {
-	TxtFontFileMap string
+	TxtFontFileMap  string
+	PDFToPPMCommand string
+	FFmpegCommand   string
+	CommandTimeout  time.Duration
+	MaxInputWidth   int
+	MaxInputHeight  int
+}
+
+// forRequest returns the preprocessor options for the thumbnail request
+func (o PreprocessorOpts) forRequest(req *thumbnailssvc.GetThumbnailRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"fontFileMap":     o.TxtFontFileMap,
+		"pdfToPPMCommand": o.PDFToPPMCommand,
+		"ffmpegCommand":   o.FFmpegCommand,
+		"timeout":         o.CommandTimeout,
+		// rendered documents and videos are limited to the requested size and the maximum input dimensions
+		"maxWidth":  renderLimit(int(req.GetWidth()), o.MaxInputWidth),
+		"maxHeight": renderLimit(int(req.GetHeight()), o.MaxInputHeight),
+	}
+}
+
+func renderLimit(requested, maxInput int) int {
+	if requested <= 0 || requested > maxInput {
+		return maxInput
+	}
+	return requested
 }
 
 // GetThumbnail retrieves a thumbnail for an image
@@ -165,10 +195,7 @@ func (g Thumbnail) handleCS3Source(ctx context.Context, req *thumbnailssvc.GetTh
 	}
 
 	defer r.Close()
-	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
-	}
-	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
+	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), g.preprocessorOpts.forRequest(req))
 	img, err := pp.Convert(r)
 	if err != nil {
 		g.logger.Error().Err(err).Msg("failed to convert image")
@@ -261,10 +288,7 @@ func (g Thumbnail) handleWebdavSource(ctx context.Context, req *thumbnailssvc.Ge
 		return "", merrors.InternalServerError(g.serviceID, "could not get image from source: %s", err.Error())
 	}
 	defer r.Close()
-	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
-	}
-	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
+	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), g.preprocessorOpts.forRequest(req))
 	img, err := pp.Convert(r)
 	if img == nil || err != nil {
 		return "", merrors.NotFound(g.serviceID, "could not get image")
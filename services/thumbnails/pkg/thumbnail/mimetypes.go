@@ -18,5 +18,20 @@ var (
 		"audio/ogg":                         {},
 		"application/vnd.geogebra.slides":   {},
 		"application/vnd.geogebra.pinboard": {},
+		"application/pdf":                   {},
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   {},
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         {},
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation": {},
+		"application/vnd.oasis.opendocument.text":                                   {},
+		"application/vnd.oasis.opendocument.spreadsheet":                            {},
+		"application/vnd.oasis.opendocument.presentation":                           {},
+		"application/vnd.oasis.opendocument.graphics":                               {},
+		"video/mp4":        {},
+		"video/quicktime":  {},
+		"video/webm":       {},
+		"video/x-matroska": {},
+		"video/x-msvideo":  {},
+		"video/mpeg":       {},
+		"video/ogg":        {},
 	}
 )
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
+	"github.com/pkg/errors"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+)
+
+// Evictor deletes thumbnails to keep the storage within the configured limits.
+type Evictor struct {
+	storage  Storage
+	maxSize  uint64
+	maxAge   time.Duration
+	interval time.Duration
+	logger   log.Logger
+}
+
+// NewEvictor creates a new instance of Evictor
+func NewEvictor(storage Storage, cfg config.Eviction, logger log.Logger) (*Evictor, error) {
+	e := &Evictor{
+		storage:  storage,
+		maxAge:   cfg.MaxAge,
+		interval: cfg.Interval,
+		logger:   logger,
+	}
+
+	if cfg.MaxSize != "" {
+		b, err := bytesize.Parse(cfg.MaxSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse the maximum size of the thumbnail storage")
+		}
+		e.maxSize = b.Bytes()
+	}
+
+	return e, nil
+}
+
+// Enabled returns if a maximum size or age is configured
+func (e *Evictor) Enabled() bool {
+	return e.maxSize > 0 || e.maxAge > 0
+}
+
+// Evict deletes the thumbnails which weren't used for longer than the maximum age
+// and the least recently used thumbnails until the total size is within the maximum size.
+// It returns the number of deleted thumbnails.
+func (e *Evictor) Evict() (int, error) {
+	entries, err := e.storage.Usage()
+	if err != nil {
+		return 0, err
+	}
+
+	// least recently used first
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LastAccess.Before(entries[j].LastAccess)
+	})
+
+	var total uint64
+	for _, entry := range entries {
+		total += uint64(entry.Size)
+	}
+
+	deleted := 0
+	expired := time.Now().Add(-e.maxAge)
+	for _, entry := range entries {
+		tooOld := e.maxAge > 0 && entry.LastAccess.Before(expired)
+		tooLarge := e.maxSize > 0 && total > e.maxSize
+		if !tooOld && !tooLarge {
+			// the remaining thumbnails were used more recently
+			break
+		}
+
+		if err := e.storage.Delete(entry.Key); err != nil {
+			return deleted, err
+		}
+		total -= uint64(entry.Size)
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// Run evicts thumbnails in the configured interval until the context is done.
+func (e *Evictor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			deleted, err := e.Evict()
+			if err != nil {
+				e.logger.Error().Err(err).Int("deleted", deleted).Msg("could not evict thumbnails")
+				continue
+			}
+			e.logger.Debug().Int("deleted", deleted).Msg("evicted thumbnails")
+		}
+	}
+}
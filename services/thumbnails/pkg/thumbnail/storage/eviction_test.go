@@ -0,0 +1,101 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	tAssert "github.com/stretchr/testify/assert"
+	tRequire "github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// memoryStorage keeps the thumbnails in memory with a fixed last access
+type memoryStorage struct {
+	storage.FileSystem
+	entries map[string]storage.Entry
+}
+
+func (m *memoryStorage) Delete(key string) error {
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *memoryStorage) Usage() ([]storage.Entry, error) {
+	entries := make([]storage.Entry, 0, len(m.entries))
+	for _, e := range m.entries {
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func newMemoryStorage(ages ...time.Duration) *memoryStorage {
+	m := &memoryStorage{entries: map[string]storage.Entry{}}
+	for i, age := range ages {
+		key := string(rune('a' + i))
+		m.entries[key] = storage.Entry{Key: key, Size: 100, LastAccess: time.Now().Add(-age)}
+	}
+	return m
+}
+
+func TestEvictor(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.Eviction
+		remaining []string
+	}{
+		{
+			name:      "disabled",
+			cfg:       config.Eviction{},
+			remaining: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:      "max size",
+			cfg:       config.Eviction{MaxSize: "250"},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name:      "max age",
+			cfg:       config.Eviction{MaxAge: 90 * time.Minute},
+			remaining: []string{"a", "b"},
+		},
+		{
+			name:      "max size and age",
+			cfg:       config.Eviction{MaxSize: "150", MaxAge: 90 * time.Minute},
+			remaining: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newMemoryStorage(0, time.Hour, 2*time.Hour, 3*time.Hour)
+			e, err := storage.NewEvictor(s, tt.cfg, log.NopLogger())
+			tRequire.NoError(t, err)
+
+			deleted, err := e.Evict()
+			tAssert.NoError(t, err)
+			tAssert.Equal(t, 4-len(tt.remaining), deleted)
+
+			var remaining []string
+			for key := range s.entries {
+				remaining = append(remaining, key)
+			}
+			tAssert.ElementsMatch(t, tt.remaining, remaining)
+		})
+	}
+}
+
+func TestNewEvictor(t *testing.T) {
+	_, err := storage.NewEvictor(newMemoryStorage(), config.Eviction{MaxSize: "lots"}, log.NopLogger())
+	tAssert.Error(t, err)
+
+	e, err := storage.NewEvictor(newMemoryStorage(), config.Eviction{}, log.NopLogger())
+	tAssert.NoError(t, err)
+	tAssert.False(t, e.Enabled())
+
+	e, err = storage.NewEvictor(newMemoryStorage(), config.Eviction{MaxAge: time.Hour}, log.NopLogger())
+	tAssert.NoError(t, err)
+	tAssert.True(t, e.Enabled())
+}
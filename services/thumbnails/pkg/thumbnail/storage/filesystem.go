@@ -4,8 +4,8 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -15,6 +15,7 @@ import (
 
 const (
 	filesDir = "files"
+	tmpFile  = "tmpthumb"
 )
 
 // NewFileSystemStorage creates a new instance of FileSystem
@@ -50,6 +51,12 @@ func (s FileSystem) Get(key string) ([]byte, error) {
 		}
 		return nil, err
 	}
+
+	// the modification time tracks the last access for the eviction of the least recently used thumbnails
+	now := time.Now()
+	if err := os.Chtimes(img, now, now); err != nil {
+		s.logger.Debug().Err(err).Str("key", key).Msg("could not update the access time of thumbnail")
+	}
 	return content, nil
 }
 
@@ -62,7 +69,7 @@ func (s FileSystem) Put(key string, img []byte) error {
 	}
 
 	if _, err := os.Stat(imgPath); os.IsNotExist(err) {
-		f, err := os.CreateTemp(dir, "tmpthumb")
+		f, err := os.CreateTemp(dir, tmpFile)
 		if err != nil {
 			return errors.Wrapf(err, "could not create temporary file for \"%s\"", key)
 		}
@@ -94,6 +101,65 @@ func (s FileSystem) Put(key string, img []byte) error {
 	return nil
 }
 
+// Delete removes the file for the given key and its empty parent directories
+func (s FileSystem) Delete(key string) error {
+	img := filepath.Join(s.root, filesDir, key)
+	if err := os.Remove(img); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrapf(err, "could not delete thumbnail \"%s\"", key)
+	}
+
+	// removing a directory fails as long as it isn't empty
+	files := filepath.Join(s.root, filesDir)
+	for dir := filepath.Dir(img); dir != files && strings.HasPrefix(dir, files); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+	return nil
+}
+
+// Usage lists all thumbnails stored in the file system
+func (s FileSystem) Usage() ([]Entry, error) {
+	files := filepath.Join(s.root, filesDir)
+
+	var entries []Entry
+	err := filepath.WalkDir(files, func(path string, d fs.DirEntry, err error) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return nil
+		case err != nil:
+			return err
+		case d.IsDir(), strings.HasPrefix(d.Name(), tmpFile):
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// the thumbnail was deleted in the meantime
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		key, err := filepath.Rel(files, path)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, Entry{
+			Key:        key,
+			Size:       info.Size(),
+			LastAccess: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list thumbnails")
+	}
+	return entries, nil
+}
+
 // BuildKey generate the unique key for a thumbnail.
 // The key is structure as follows:
 //
@@ -103,16 +169,5 @@ func (s FileSystem) Put(key string, img []byte) error {
 //
 // The key also represents the path to the thumbnail in the filesystem under the configured root directory.
 func (s FileSystem) BuildKey(r Request) string {
-	checksum := r.Checksum
-	filetype := r.Types[0]
-
-	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
-
-	if r.Characteristic != "" {
-		parts = append(parts, "-", r.Characteristic)
-	}
-
-	parts = append(parts, ".", filetype)
-
-	return filepath.Join(checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, ""))
+	return filepath.Join(keyParts(r)...)
 }
@@ -2,10 +2,16 @@ package storage_test
 
 import (
 	"image"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	tAssert "github.com/stretchr/testify/assert"
+	tRequire "github.com/stretchr/testify/require"
 
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 )
 
@@ -63,3 +69,52 @@ func TestFileSystem_BuildKey(t *testing.T) {
 	}
 
 }
+
+func TestFileSystem_Usage(t *testing.T) {
+	root := t.TempDir()
+	s := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+	assert := tAssert.New(t)
+
+	entries, err := s.Usage()
+	assert.NoError(err)
+	assert.Empty(entries)
+
+	key := "12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"
+	tRequire.NoError(t, s.Put(key, []byte("thumbnail")))
+	tRequire.NoError(t, s.Put("12/0E/B8A25E5D487BF68B5F7096440019/2x2.png", []byte("other")))
+
+	entries, err = s.Usage()
+	assert.NoError(err)
+	tRequire.Len(t, entries, 2)
+	assert.Equal(key, entries[0].Key)
+	assert.Equal(int64(len("thumbnail")), entries[0].Size)
+
+	// reading a thumbnail updates its last access
+	past := time.Now().Add(-time.Hour)
+	tRequire.NoError(t, os.Chtimes(filepath.Join(root, "files", key), past, past))
+	_, err = s.Get(key)
+	assert.NoError(err)
+	entries, err = s.Usage()
+	assert.NoError(err)
+	assert.WithinDuration(time.Now(), entries[0].LastAccess, time.Minute)
+}
+
+func TestFileSystem_Delete(t *testing.T) {
+	root := t.TempDir()
+	s := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+	assert := tAssert.New(t)
+
+	key := "12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"
+	tRequire.NoError(t, s.Put(key, []byte("thumbnail")))
+	assert.True(s.Stat(key))
+
+	assert.NoError(s.Delete(key))
+	assert.False(s.Stat(key))
+	assert.NoError(s.Delete(key))
+
+	// the empty directories are removed as well
+	_, err := os.Stat(filepath.Join(root, "files", "12"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(root, "files"))
+	assert.NoError(err)
+}
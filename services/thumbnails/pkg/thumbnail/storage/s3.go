@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+)
+
+// NewS3Storage creates a new instance of S3
+func NewS3Storage(cfg config.S3Storage, logger log.Logger) (S3, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return S3{}, errors.Wrapf(err, "invalid S3 endpoint \"%s\"", cfg.Endpoint)
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: endpoint.Scheme == "https",
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return S3{}, errors.Wrap(err, "could not create S3 client")
+	}
+
+	return S3{
+		client: client,
+		bucket: cfg.Bucket,
+		prefix: strings.Trim(cfg.Prefix, "/"),
+		logger: logger,
+	}, nil
+}
+
+// S3 represents a storage for the thumbnails using an S3 compatible object storage.
+// The thumbnails are shared by all instances using the same bucket.
+type S3 struct {
+	client *minio.Client
+	bucket string
+	prefix string
+	logger log.Logger
+}
+
+// Stat returns if an object for the given key exists in the bucket
+func (s S3) Stat(key string) bool {
+	_, err := s.client.StatObject(context.Background(), s.bucket, s.objectName(key), minio.StatObjectOptions{})
+	return err == nil
+}
+
+// Get returns the object content for the given key
+func (s S3) Get(key string) ([]byte, error) {
+	obj, err := s.client.GetObject(context.Background(), s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fs.ErrNotExist
+		}
+		s.logger.Debug().Str("err", err.Error()).Str("key", key).Msg("could not load thumbnail from store")
+		return nil, err
+	}
+	return content, nil
+}
+
+// Put stores image data in the bucket for the given key
+func (s S3) Put(key string, img []byte) error {
+	_, err := s.client.PutObject(context.Background(), s.bucket, s.objectName(key), bytes.NewReader(img), int64(len(img)), minio.PutObjectOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "could not upload thumbnail \"%s\"", key)
+	}
+	return nil
+}
+
+// Delete removes the object for the given key
+func (s S3) Delete(key string) error {
+	// S3 doesn't report an error for objects which don't exist
+	if err := s.client.RemoveObject(context.Background(), s.bucket, s.objectName(key), minio.RemoveObjectOptions{}); err != nil {
+		return errors.Wrapf(err, "could not delete thumbnail \"%s\"", key)
+	}
+	return nil
+}
+
+// Usage lists all thumbnails stored in the bucket.
+// S3 doesn't track reads, so the last access is the time the thumbnail was stored.
+func (s S3) Usage() ([]Entry, error) {
+	prefix := ""
+	if s.prefix != "" {
+		prefix = s.prefix + "/"
+	}
+
+	var entries []Entry
+	for obj := range s.client.ListObjects(context.Background(), s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, errors.Wrap(obj.Err, "could not list thumbnails")
+		}
+		entries = append(entries, Entry{
+			Key:        strings.TrimPrefix(obj.Key, prefix),
+			Size:       obj.Size,
+			LastAccess: obj.LastModified,
+		})
+	}
+	return entries, nil
+}
+
+// BuildKey generate the unique key for a thumbnail.
+// The key has the same structure as the keys of the FileSystem storage.
+func (s S3) BuildKey(r Request) string {
+	return path.Join(keyParts(r)...)
+}
+
+func (s S3) objectName(key string) string {
+	return path.Join(s.prefix, key)
+}
@@ -0,0 +1,187 @@
+package storage_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tAssert "github.com/stretchr/testify/assert"
+	tRequire "github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// fakeS3 is a minimal S3 stand-in which keeps the objects of a single bucket in memory
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	KeyCount    int
+	MaxKeys     int
+	IsTruncated bool
+	Contents    []listEntry
+}
+
+type listEntry struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+	StorageClass string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		f.list(w, r.URL.Query().Get("prefix"))
+	case r.Method == http.MethodPut:
+		body, err := readBody(r)
+		if err != nil {
+			f.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		f.objects[key] = body
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodHead, r.Method == http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, prefix string) {
+	res := listBucketResult{Name: f.bucket, Prefix: prefix, MaxKeys: 1000}
+	for key, obj := range f.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		res.Contents = append(res.Contents, listEntry{
+			Key:          key,
+			LastModified: time.Now().UTC().Format(time.RFC3339),
+			ETag:         `"etag"`,
+			Size:         len(obj),
+			StorageClass: "STANDARD",
+		})
+	}
+	sort.Slice(res.Contents, func(i, j int) bool { return res.Contents[i].Key < res.Contents[j].Key })
+	res.KeyCount = len(res.Contents)
+
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(res)
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, "<Error><Code>"+code+"</Code><Message>"+code+"</Message></Error>")
+}
+
+// readBody reads the request body and decodes the aws-chunked encoding used for streaming uploads
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	br := bufio.NewReader(r.Body)
+	for {
+		header, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.Split(strings.TrimSpace(header), ";")[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+		if _, err := io.CopyN(&body, br, size); err != nil {
+			return nil, err
+		}
+		if _, err := br.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func TestS3(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{bucket: "thumbnails", objects: map[string][]byte{"other/data": []byte("data")}})
+	defer server.Close()
+
+	s, err := storage.NewS3Storage(config.S3Storage{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "thumbnails",
+		Prefix:    "/cache/",
+	}, log.NopLogger())
+	tRequire.NoError(t, err)
+
+	assert := tAssert.New(t)
+	key := "12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"
+
+	assert.False(s.Stat(key))
+	_, err = s.Get(key)
+	assert.Error(err)
+
+	tRequire.NoError(t, s.Put(key, []byte("thumbnail")))
+	assert.True(s.Stat(key))
+	content, err := s.Get(key)
+	assert.NoError(err)
+	assert.Equal([]byte("thumbnail"), content)
+
+	entries, err := s.Usage()
+	assert.NoError(err)
+	tRequire.Len(t, entries, 1)
+	assert.Equal(key, entries[0].Key)
+	assert.Equal(int64(len("thumbnail")), entries[0].Size)
+
+	assert.NoError(s.Delete(key))
+	assert.False(s.Stat(key))
+	assert.NoError(s.Delete(key))
+
+	entries, err = s.Usage()
+	assert.NoError(err)
+	assert.Empty(entries)
+}
@@ -2,6 +2,12 @@ package storage
 
 import (
 	"image"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 )
 
 // Request combines different attributes needed for storage operations.
@@ -23,10 +29,49 @@ type Request struct {
 	Characteristic string
 }
 
+// Entry describes a stored thumbnail.
+type Entry struct {
+	Key  string
+	Size int64
+	// LastAccess is the time the thumbnail was last used.
+	// Storages which can't track reads use the time the thumbnail was stored.
+	LastAccess time.Time
+}
+
 // Storage defines the interface for a thumbnail store.
 type Storage interface {
 	Stat(key string) bool
 	Get(key string) ([]byte, error)
 	Put(key string, img []byte) error
+	// Delete removes the thumbnail, deleting a thumbnail which doesn't exist is not an error.
+	Delete(key string) error
+	// Usage lists all stored thumbnails.
+	Usage() ([]Entry, error)
 	BuildKey(r Request) string
 }
+
+// keyParts returns the path elements of the unique key for a thumbnail.
+func keyParts(r Request) []string {
+	checksum := r.Checksum
+	filetype := r.Types[0]
+
+	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
+
+	if r.Characteristic != "" {
+		parts = append(parts, "-", r.Characteristic)
+	}
+
+	parts = append(parts, ".", filetype)
+
+	return []string{checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, "")}
+}
+
+// New creates the thumbnail storage configured in cfg.
+func New(cfg config.Thumbnail, logger log.Logger) (Storage, error) {
+	switch cfg.Storage {
+	case "s3":
+		return NewS3Storage(cfg.S3Storage, logger)
+	default:
+		return NewFileSystemStorage(cfg.FileSystemStorage, logger), nil
+	}
+}
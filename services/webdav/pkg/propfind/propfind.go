@@ -67,6 +67,24 @@ type MultiStatusResponseXML struct {
 	XmlnsOC string   `xml:"xmlns:oc,attr,omitempty"`
 
 	Responses []*ResponseXML `xml:"d:response"`
+	Facets    *FacetsXML     `xml:"oc:facets,omitempty"`
+}
+
+// FacetsXML holds the xml representation of the facets of a search response
+type FacetsXML struct {
+	Facets []FacetXML `xml:"oc:facet"`
+}
+
+// FacetXML holds the xml representation of a facet
+type FacetXML struct {
+	Name   string          `xml:"name,attr"`
+	Values []FacetValueXML `xml:"oc:value"`
+}
+
+// FacetValueXML holds the xml representation of a value of a facet and its number of matches
+type FacetValueXML struct {
+	Count int64  `xml:"count,attr"`
+	Value string `xml:",chardata"`
 }
 
 // ResponseUnmarshalXML is a workaround for https://github.com/golang/go/issues/13400
@@ -58,6 +58,12 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 		Query:    rep.SearchFiles.Search.Pattern,
 		PageSize: int32(rep.SearchFiles.Search.Limit),
 	}
+	for _, f := range rep.SearchFiles.Search.Facets {
+		req.Facets = append(req.Facets, &searchmsg.FacetRequest{
+			Field: strings.TrimSpace(f.Field),
+			Size:  int32(f.Size),
+		})
+	}
 
 	// Limit search to the according space when searching /dav/spaces/
 	if strings.HasPrefix(r.URL.Path, "/dav/spaces") {
@@ -94,7 +100,7 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 
 func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.ResponseWriter, r *http.Request) {
 	logger := g.log.SubloggerWithRequestID(r.Context())
-	responsesXML, err := multistatusResponse(r.Context(), g.config.OpenCloudPublicURL, rsp.Matches)
+	responsesXML, err := multistatusResponse(r.Context(), g.config.OpenCloudPublicURL, rsp.Matches, rsp.Facets)
 	if err != nil {
 		logger.Error().Err(err).Msg("error formatting propfind")
 		w.WriteHeader(http.StatusInternalServerError)
@@ -111,8 +117,8 @@ func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.Respons
 	}
 }
 
-// multistatusResponse converts a list of matches into a multistatus response string
-func multistatusResponse(ctx context.Context, publicURL string, matches []*searchmsg.Match) ([]byte, error) {
+// multistatusResponse converts a list of matches and their facets into a multistatus response string
+func multistatusResponse(ctx context.Context, publicURL string, matches []*searchmsg.Match, facets []*searchmsg.Facet) ([]byte, error) {
 	responses := make([]*propfind.ResponseXML, 0, len(matches))
 	for i := range matches {
 		res, err := matchToPropResponse(ctx, publicURL, matches[i])
@@ -124,6 +130,16 @@ func multistatusResponse(ctx context.Context, publicURL string, matches []*searc
 
 	msr := propfind.NewMultiStatusResponseXML()
 	msr.Responses = responses
+	if len(facets) > 0 {
+		msr.Facets = &propfind.FacetsXML{}
+		for _, f := range facets {
+			facet := propfind.FacetXML{Name: f.GetField()}
+			for _, v := range f.GetValues() {
+				facet.Values = append(facet.Values, propfind.FacetValueXML{Count: v.GetCount(), Value: v.GetValue()})
+			}
+			msr.Facets.Facets = append(msr.Facets.Facets, facet)
+		}
+	}
 	msg, err := xml.Marshal(msr)
 	if err != nil {
 		return nil, err
@@ -262,9 +278,16 @@ type reportSearchFiles struct {
 	Search  reportSearchFilesSearch `xml:"search"`
 }
 type reportSearchFilesSearch struct {
-	Pattern string `xml:"pattern"`
-	Limit   int    `xml:"limit"`
-	Offset  int    `xml:"offset"`
+	Pattern string              `xml:"pattern"`
+	Limit   int                 `xml:"limit"`
+	Offset  int                 `xml:"offset"`
+	Facets  []reportSearchFacet `xml:"facet"`
+}
+
+// reportSearchFacet requests the facet of a field, e.g. <oc:facet size="5">tags</oc:facet>
+type reportSearchFacet struct {
+	Field string `xml:",chardata"`
+	Size  int    `xml:"size,attr,omitempty"`
 }
 
 type reportFilterFiles struct {
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oklog/run"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/spf13/cobra"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/generators"
+	"github.com/opencloud-eu/opencloud/pkg/registry"
+	"github.com/opencloud-eu/opencloud/pkg/tracing"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/server/debug"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/server/http"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/service"
+)
+
+// Server is the entrypoint for the server command.
+func Server(cfg *config.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "server",
+		Short: fmt.Sprintf("start the %s service without runtime (unsupervised mode)", cfg.Service.Name),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.Configure(cfg.Service.Name, cfg.Commons, cfg.LogLevel)
+			tracerProvider, err := tracing.GetTraceProvider(cmd.Context(), cfg.Commons.TracesExporter, cfg.Service.Name)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to initialize tracer")
+				return err
+			}
+
+			gr := run.Group{}
+			ctx, cancel := context.WithCancel(cmd.Context())
+
+			defer cancel()
+
+			connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+			evStream, err := stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to initialize event stream")
+				return err
+			}
+
+			st := store.Create(
+				store.Store(cfg.Store.Store),
+				store.TTL(cfg.Store.TTL),
+				microstore.Nodes(cfg.Store.Nodes...),
+				microstore.Database(cfg.Store.Database),
+				microstore.Table(cfg.Store.Table),
+				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+			)
+
+			tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to parse tls mode")
+				return err
+			}
+			gatewaySelector, err := pool.GatewaySelector(
+				cfg.RevaGateway,
+				pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+				pool.WithTLSMode(tm),
+				pool.WithRegistry(registry.GetRegistry()),
+				pool.WithTracerProvider(tracerProvider),
+			)
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to initialize gateway selector")
+				return fmt.Errorf("could not get reva client selector: %s", err)
+			}
+
+			{
+				svc, err := http.Server(
+					http.Logger(logger),
+					http.Config(cfg),
+					http.Context(ctx), // NOTE: not passing this "option" leads to a panic in go-micro
+					http.TraceProvider(tracerProvider),
+					http.Stream(evStream),
+					http.Store(st),
+					http.GatewaySelector(gatewaySelector),
+					http.RegisteredEvents(service.SupportedEvents),
+				)
+
+				if err != nil {
+					logger.Error().Err(err).Str("transport", "http").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(func() error {
+					return svc.Run()
+				}, func(err error) {
+					if err == nil {
+						logger.Info().
+							Str("transport", "http").
+							Str("server", cfg.Service.Name).
+							Msg("Shutting down server")
+					} else {
+						logger.Error().Err(err).
+							Str("transport", "http").
+							Str("server", cfg.Service.Name).
+							Msg("Shutting down server")
+					}
+
+					cancel()
+				})
+			}
+
+			{
+				debugServer, err := debug.Server(
+					debug.Logger(logger),
+					debug.Context(ctx),
+					debug.Config(cfg),
+				)
+				if err != nil {
+					logger.Info().Err(err).Str("server", "debug").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(debugServer.ListenAndServe, func(_ error) {
+					_ = debugServer.Shutdown(ctx)
+					cancel()
+				})
+			}
+
+			return gr.Run()
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+)
+
+// Config combines all available configuration parts.
+type Config struct {
+	Commons *shared.Commons `yaml:"-"` // don't use this directly as configuration for a service
+
+	Service Service `yaml:"-"`
+
+	LogLevel string `yaml:"loglevel" env:"OC_LOG_LEVEL;WEBHOOKS_LOG_LEVEL" desc:"The log level. Valid values are: 'panic', 'fatal', 'error', 'warn', 'info', 'debug', 'trace'." introductionVersion:"%%NEXT%%"`
+
+	Debug Debug `yaml:"debug"`
+
+	Events Events `yaml:"events"`
+	Store  Store  `yaml:"store"`
+
+	RevaGateway   string                `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to look up user metadata" introductionVersion:"%%NEXT%%"`
+	GRPCClientTLS *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
+
+	HTTP         HTTP          `yaml:"http"`
+	TokenManager *TokenManager `yaml:"token_manager"`
+
+	MachineAuthAPIKey string `yaml:"machine_auth_api_key" env:"OC_MACHINE_AUTH_API_KEY;WEBHOOKS_MACHINE_AUTH_API_KEY" desc:"The machine auth API key used to check the permissions of subscribers before an event is delivered to them." introductionVersion:"%%NEXT%%" mask:"password"`
+
+	Delivery Delivery `yaml:"delivery"`
+
+	Context context.Context `yaml:"-"`
+}
+
+// Events combines the configuration options for the event bus.
+type Events struct {
+	Endpoint             string `yaml:"endpoint" env:"OC_EVENTS_ENDPOINT" desc:"The address of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture." introductionVersion:"%%NEXT%%"`
+	Cluster              string `yaml:"cluster" env:"OC_EVENTS_CLUSTER" desc:"The clusterID of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture. Mandatory when using NATS as event system." introductionVersion:"%%NEXT%%"`
+	TLSInsecure          bool   `yaml:"tls_insecure" env:"OC_INSECURE;OC_EVENTS_TLS_INSECURE" desc:"Whether to verify the server TLS certificates." introductionVersion:"%%NEXT%%"`
+	TLSRootCACertificate string `yaml:"tls_root_ca_certificate" env:"OC_EVENTS_TLS_ROOT_CA_CERTIFICATE" desc:"The root CA certificate used to validate the server's TLS certificate. If provided OC_EVENTS_TLS_INSECURE will be seen as false." introductionVersion:"%%NEXT%%"`
+	EnableTLS            bool   `yaml:"enable_tls" env:"OC_EVENTS_ENABLE_TLS" desc:"Enable TLS for the connection to the events broker. The events broker is the OpenCloud service which receives and delivers events between the services." introductionVersion:"%%NEXT%%"`
+	AuthUsername         string `yaml:"username" env:"OC_EVENTS_AUTH_USERNAME" desc:"The username to authenticate with the events broker. The events broker is the OpenCloud service which receives and delivers events between the services." introductionVersion:"%%NEXT%%"`
+	AuthPassword         string `yaml:"password" env:"OC_EVENTS_AUTH_PASSWORD" desc:"The password to authenticate with the events broker. The events broker is the OpenCloud service which receives and delivers events between the services." introductionVersion:"%%NEXT%%"`
+}
+
+// Store configures the store to use
+type Store struct {
+	Store        string        `yaml:"store" env:"OC_PERSISTENT_STORE;WEBHOOKS_STORE" desc:"The type of the store. Supported values are: 'memory', 'nats-js-kv', 'redis-sentinel', 'noop'. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string      `yaml:"nodes" env:"OC_PERSISTENT_STORE_NODES;WEBHOOKS_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string        `yaml:"database" env:"WEBHOOKS_STORE_DATABASE" desc:"The database name the configured store should use." introductionVersion:"%%NEXT%%"`
+	Table        string        `yaml:"table" env:"WEBHOOKS_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	TTL          time.Duration `yaml:"ttl" env:"OC_PERSISTENT_STORE_TTL;WEBHOOKS_STORE_TTL" desc:"Time to live for subscriptions in the store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AuthUsername string        `yaml:"username" env:"OC_PERSISTENT_STORE_AUTH_USERNAME;WEBHOOKS_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string        `yaml:"password" env:"OC_PERSISTENT_STORE_AUTH_PASSWORD;WEBHOOKS_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
+// Delivery configures how events are delivered to the subscribed endpoints
+type Delivery struct {
+	Workers                int           `yaml:"workers" env:"WEBHOOKS_DELIVERY_WORKERS" desc:"The number of deliveries that are sent concurrently." introductionVersion:"%%NEXT%%"`
+	Timeout                time.Duration `yaml:"timeout" env:"WEBHOOKS_DELIVERY_TIMEOUT" desc:"The time to wait for an endpoint to answer a delivery. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	MaxRetries             int           `yaml:"max_retries" env:"WEBHOOKS_DELIVERY_MAX_RETRIES" desc:"The number of times a failed delivery is retried. The delay between the attempts doubles with every retry, starting at WEBHOOKS_DELIVERY_RETRY_DELAY." introductionVersion:"%%NEXT%%"`
+	RetryDelay             time.Duration `yaml:"retry_delay" env:"WEBHOOKS_DELIVERY_RETRY_DELAY" desc:"The delay before the first retry of a failed delivery. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	MaxConsecutiveFailures int           `yaml:"max_consecutive_failures" env:"WEBHOOKS_DELIVERY_MAX_CONSECUTIVE_FAILURES" desc:"The number of deliveries in a row that may fail after all retries before a subscription is disabled. Set to 0 to never disable subscriptions." introductionVersion:"%%NEXT%%"`
+	LogSize                int           `yaml:"log_size" env:"WEBHOOKS_DELIVERY_LOG_SIZE" desc:"The number of delivery attempts kept in the delivery log of each subscription." introductionVersion:"%%NEXT%%"`
+	AllowInsecureURLs      bool          `yaml:"allow_insecure_urls" env:"WEBHOOKS_DELIVERY_ALLOW_INSECURE_URLS" desc:"Allow subscriptions to endpoints using plain HTTP. Only use this for development." introductionVersion:"%%NEXT%%"`
+	AllowPrivateNetworks   bool          `yaml:"allow_private_networks" env:"WEBHOOKS_DELIVERY_ALLOW_PRIVATE_NETWORKS" desc:"Allow subscriptions to endpoints with private, loopback or link-local addresses. By default only public addresses can be subscribed to and deliveries don't use the HTTP proxy from the environment, so users can't reach internal services. Enabling this also uses the HTTP proxy configured in the environment." introductionVersion:"%%NEXT%%"`
+	TLSInsecure            bool          `yaml:"tls_insecure" env:"WEBHOOKS_DELIVERY_TLS_INSECURE" desc:"Skip the verification of the TLS certificates of the subscribed endpoints. Only use this for development." introductionVersion:"%%NEXT%%"`
+}
+
+// CORS defines the available cors configuration.
+type CORS struct {
+	AllowedOrigins   []string `yaml:"allow_origins" env:"OC_CORS_ALLOW_ORIGINS;WEBHOOKS_CORS_ALLOW_ORIGINS" desc:"A list of allowed CORS origins. See following chapter for more details: *Access-Control-Allow-Origin* at https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Allow-Origin. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AllowedMethods   []string `yaml:"allow_methods" env:"OC_CORS_ALLOW_METHODS;WEBHOOKS_CORS_ALLOW_METHODS" desc:"A list of allowed CORS methods. See following chapter for more details: *Access-Control-Request-Method* at https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Request-Method. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AllowedHeaders   []string `yaml:"allow_headers" env:"OC_CORS_ALLOW_HEADERS;WEBHOOKS_CORS_ALLOW_HEADERS" desc:"A list of allowed CORS headers. See following chapter for more details: *Access-Control-Request-Headers* at https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Request-Headers. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AllowCredentials bool     `yaml:"allow_credentials" env:"OC_CORS_ALLOW_CREDENTIALS;WEBHOOKS_CORS_ALLOW_CREDENTIALS" desc:"Allow credentials for CORS.See following chapter for more details: *Access-Control-Allow-Credentials* at https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Access-Control-Allow-Credentials." introductionVersion:"%%NEXT%%"`
+}
+
+// HTTP defines the available http configuration.
+type HTTP struct {
+	Addr      string                `yaml:"addr" env:"WEBHOOKS_HTTP_ADDR" desc:"The bind address of the HTTP service." introductionVersion:"%%NEXT%%"`
+	Namespace string                `yaml:"-"`
+	Root      string                `yaml:"root" env:"WEBHOOKS_HTTP_ROOT" desc:"Subdirectory that serves as the root for this HTTP service." introductionVersion:"%%NEXT%%"`
+	CORS      CORS                  `yaml:"cors"`
+	TLS       shared.HTTPServiceTLS `yaml:"tls"`
+}
+
+// TokenManager is the config for using the reva token manager
+type TokenManager struct {
+	JWTSecret string `yaml:"jwt_secret" env:"OC_JWT_SECRET;WEBHOOKS_JWT_SECRET" desc:"The secret to mint and validate jwt tokens." introductionVersion:"%%NEXT%%"`
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/google/uuid"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+
+	"github.com/opencloud-eu/opencloud/pkg/version"
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/config"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-OpenCloud-Event"
+	HeaderDelivery  = "X-OpenCloud-Delivery"
+	HeaderTimestamp = "X-OpenCloud-Timestamp"
+	HeaderSignature = "X-OpenCloud-Signature"
+)
+
+// Notification is the body of a delivery
+type Notification struct {
+	ID             string    `json:"id"`
+	SubscriptionID string    `json:"subscriptionId"`
+	EventID        string    `json:"eventId"`
+	EventType      string    `json:"eventType"`
+	DateTime       time.Time `json:"dateTime"`
+	InitiatorID    string    `json:"initiatorId,omitempty"`
+	DriveID        string    `json:"driveId"`
+	// Resource is the resource the event is about as seen by the subscriber. For
+	// resources that are gone it only contains the id if it is known.
+	Resource *Resource `json:"resource,omitempty"`
+	// Event contains the details of the event, it is a FileEvent, ShareEvent, LinkEvent
+	// or SpaceEvent depending on the event type
+	Event any `json:"event,omitempty"`
+}
+
+// Resource describes a file, folder or space root
+type Resource struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parentId,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Type     string `json:"type,omitempty"`
+	MimeType string `json:"mimeType,omitempty"`
+	Size     uint64 `json:"size,omitempty"`
+}
+
+// delivery is a notification that is sent to the endpoint of a subscription
+type delivery struct {
+	id             string
+	subscriptionID string
+	eventID        string
+	eventType      string
+	body           []byte
+	attempt        int
+}
+
+func newDelivery(sub *Subscription, e events.Event, eventType string, t target, info *provider.ResourceInfo) (*delivery, error) {
+	n := Notification{
+		ID:             uuid.New().String(),
+		SubscriptionID: sub.ID,
+		EventID:        e.ID,
+		EventType:      eventType,
+		DateTime:       time.Now().UTC(),
+		InitiatorID:    e.InitiatorID,
+		DriveID:        storagespace.FormatStorageID(t.ref.GetResourceId().GetStorageId(), t.spaceID),
+		Event:          payloadOf(e.Event),
+	}
+
+	switch {
+	case t.gone && t.id != nil:
+		n.Resource = &Resource{ID: storagespace.FormatResourceID(t.id)}
+	case !t.gone && info != nil:
+		n.Resource = &Resource{
+			ID:       storagespace.FormatResourceID(info.GetId()),
+			Name:     info.GetName(),
+			Path:     info.GetPath(),
+			MimeType: info.GetMimeType(),
+			Size:     info.GetSize(),
+			Type:     "file",
+		}
+		if info.GetParentId() != nil {
+			n.Resource.ParentID = storagespace.FormatResourceID(info.GetParentId())
+		}
+		if info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+			n.Resource.Type = "folder"
+		}
+	}
+
+	b, err := json.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery{
+		id:             n.ID,
+		subscriptionID: sub.ID,
+		eventID:        e.ID,
+		eventType:      eventType,
+		body:           b,
+	}, nil
+}
+
+func newHTTPClient(cfg config.Delivery) *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	transport := &http.Transport{
+		DialContext: dialer.DialContext,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: cfg.TLSInsecure, //nolint:gosec
+		},
+	}
+	if cfg.AllowPrivateNetworks {
+		transport.Proxy = http.ProxyFromEnvironment
+	} else {
+		// the endpoints are checked when they are dialed, a proxy would hide them
+		dialer.Control = netx.PublicOnly
+	}
+
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: transport,
+		// redirects are not followed, the signature would be sent to an unverified endpoint
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Sign returns the signature of a delivery. It is the hex encoded HMAC-SHA256 of
+// the timestamp and the body joined by a dot, keyed with the subscription secret.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *Webhooks) work() {
+	for d := range w.queue {
+		w.deliver(d)
+	}
+}
+
+// deliver sends a delivery and schedules a retry if it failed
+func (w *Webhooks) deliver(d *delivery) {
+	sub, err := w.getSubscription(d.subscriptionID)
+	if err != nil || !sub.Enabled {
+		// deleted or disabled in the meantime
+		return
+	}
+
+	d.attempt++
+	start := time.Now()
+	status, err := w.send(sub, d)
+
+	a := DeliveryAttempt{
+		DeliveryID: d.id,
+		EventID:    d.eventID,
+		EventType:  d.eventType,
+		Attempt:    d.attempt,
+		DateTime:   start.UTC(),
+		DurationMs: time.Since(start).Milliseconds(),
+		StatusCode: status,
+		Success:    err == nil,
+	}
+	if err != nil {
+		a.Error = err.Error()
+	}
+	if err := w.logAttempt(sub.ID, a); err != nil {
+		w.log.Error().Err(err).Str("subscription", sub.ID).Msg("could not write delivery log")
+	}
+
+	switch {
+	case err == nil:
+		w.recordSuccess(sub.ID)
+	case d.attempt <= w.cfg.Delivery.MaxRetries:
+		delay := w.cfg.Delivery.RetryDelay << (d.attempt - 1)
+		w.log.Debug().Err(err).Str("subscription", sub.ID).Str("delivery", d.id).Dur("delay", delay).Msg("delivery failed, retrying")
+		time.AfterFunc(delay, func() {
+			w.queue <- d
+		})
+	default:
+		w.log.Info().Err(err).Str("subscription", sub.ID).Str("delivery", d.id).Msg("delivery failed, giving up")
+		w.recordFailure(sub.ID)
+	}
+}
+
+// send posts a delivery to the endpoint and returns the status code of the response
+func (w *Webhooks) send(sub *Subscription, d *delivery) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, sub.URL, bytes.NewReader(d.body))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "OpenCloud-Webhooks/"+version.GetString())
+	req.Header.Set(HeaderEvent, d.eventType)
+	req.Header.Set(HeaderDelivery, d.id)
+	req.Header.Set(HeaderTimestamp, ts)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, ts, d.body))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+func (w *Webhooks) recordSuccess(id string) {
+	_, err := w.updateSubscription(id, func(sub *Subscription) bool {
+		if sub.ConsecutiveFailures == 0 {
+			return false
+		}
+		sub.ConsecutiveFailures = 0
+		return true
+	})
+	if err != nil && !errors.Is(err, ErrSubscriptionNotFound) {
+		w.log.Error().Err(err).Str("subscription", id).Msg("could not update subscription")
+	}
+}
+
+// recordFailure counts a delivery that failed after all retries and disables
+// the subscription if too many deliveries failed in a row
+func (w *Webhooks) recordFailure(id string) {
+	_, err := w.updateSubscription(id, func(sub *Subscription) bool {
+		sub.ConsecutiveFailures++
+		if limit := w.cfg.Delivery.MaxConsecutiveFailures; limit > 0 && sub.ConsecutiveFailures >= limit && sub.Enabled {
+			sub.Enabled = false
+			sub.DisabledReason = fmt.Sprintf("disabled after %d failed deliveries in a row", sub.ConsecutiveFailures)
+			w.log.Warn().Str("subscription", id).Str("url", sub.URL).Msg("disabling failing subscription")
+		}
+		return true
+	})
+	if err != nil && !errors.Is(err, ErrSubscriptionNotFound) {
+		w.log.Error().Err(err).Str("subscription", id).Msg("could not update subscription")
+	}
+}
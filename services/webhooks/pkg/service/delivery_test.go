@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/stretchr/testify/require"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/config"
+)
+
+func newTestService(t *testing.T, srv *httptest.Server, cfg config.Delivery) (*Webhooks, *Subscription) {
+	w := &Webhooks{
+		cfg:    &config.Config{Delivery: cfg},
+		log:    log.NopLogger(),
+		store:  microstore.NewMemoryStore(),
+		client: srv.Client(),
+		queue:  make(chan *delivery, 10),
+	}
+
+	sub := &Subscription{ID: "sub1", Owner: "user1", URL: srv.URL, Secret: "0123456789abcdef", Enabled: true}
+	require.NoError(t, w.saveSubscription(sub))
+	return w, sub
+}
+
+func testDelivery(t *testing.T, sub *Subscription) *delivery {
+	e := events.Event{ID: "ev1", Event: events.ContainerCreated{}}
+	tgt := target{ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "s", SpaceId: "sp", OpaqueId: "sp"}}, spaceID: "sp"}
+	d, err := newDelivery(sub, e, "ContainerCreated", tgt, &provider.ResourceInfo{
+		Id:   &provider.ResourceId{StorageId: "s", SpaceId: "sp", OpaqueId: "item"},
+		Name: "folder",
+		Type: provider.ResourceType_RESOURCE_TYPE_CONTAINER,
+	})
+	require.NoError(t, err)
+	return d
+}
+
+func TestDeliverSignsPayload(t *testing.T) {
+	var received atomic.Bool
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts := r.Header.Get(HeaderTimestamp)
+		if r.Header.Get(HeaderSignature) != Sign("0123456789abcdef", ts, body) || r.Header.Get(HeaderEvent) != "ContainerCreated" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		received.Store(true)
+	}))
+	defer srv.Close()
+
+	w, sub := newTestService(t, srv, config.Delivery{LogSize: 10})
+	w.deliver(testDelivery(t, sub))
+	require.True(t, received.Load())
+
+	log, err := w.deliveryLog(sub.ID)
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+	require.True(t, log[0].Success)
+	require.Equal(t, http.StatusOK, log[0].StatusCode)
+}
+
+func TestDeliverRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	w, sub := newTestService(t, srv, config.Delivery{LogSize: 10, MaxRetries: 2, RetryDelay: time.Millisecond})
+	go w.work()
+	w.queue <- testDelivery(t, sub)
+
+	require.Eventually(t, func() bool {
+		log, _ := w.deliveryLog(sub.ID)
+		return len(log) == 2 && log[0].Success && log[0].Attempt == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	log, err := w.deliveryLog(sub.ID)
+	require.NoError(t, err)
+	require.False(t, log[1].Success)
+	require.Equal(t, "unexpected status code 503", log[1].Error)
+}
+
+func TestDeliverDisablesFailingSubscription(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	w, sub := newTestService(t, srv, config.Delivery{LogSize: 1, MaxConsecutiveFailures: 2})
+	for i := 0; i < 3; i++ {
+		w.deliver(testDelivery(t, sub))
+	}
+
+	// the third delivery is dropped
+	require.Equal(t, int32(2), calls.Load())
+
+	sub, err := w.getSubscription(sub.ID)
+	require.NoError(t, err)
+	require.False(t, sub.Enabled)
+	require.Equal(t, 2, sub.ConsecutiveFailures)
+	require.NotEmpty(t, sub.DisabledReason)
+
+	log, err := w.deliveryLog(sub.ID)
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+}
+
+func TestSubscriptionMatches(t *testing.T) {
+	sub := Subscription{Enabled: true, DriveID: "storage$space", EventTypes: []string{"ShareCreated"}}
+	require.True(t, sub.matches("ShareCreated", "space"))
+	require.False(t, sub.matches("ShareRemoved", "space"))
+	require.False(t, sub.matches("ShareCreated", "other"))
+
+	sub.EventTypes = nil
+	require.True(t, sub.matches("ShareRemoved", "space"))
+
+	sub.Enabled = false
+	require.False(t, sub.matches("ShareRemoved", "space"))
+}
+
+func TestTargetOf(t *testing.T) {
+	root := &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "space"}
+
+	tgt, ok := targetOf(events.ItemTrashed{
+		Ref: &provider.Reference{ResourceId: root, Path: "./folder/file.txt"},
+		ID:  &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"},
+	})
+	require.True(t, ok)
+	require.True(t, tgt.gone)
+	require.Equal(t, "./folder", tgt.ref.GetPath())
+	require.Equal(t, "space", tgt.spaceID)
+
+	tgt, ok = targetOf(events.ItemTrashed{Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}}})
+	require.True(t, ok)
+	require.Equal(t, "space", tgt.ref.GetResourceId().GetOpaqueId())
+
+	tgt, ok = targetOf(events.SpaceDeleted{
+		ID:           &provider.StorageSpaceId{OpaqueId: "storage$space"},
+		FinalMembers: map[string]provider.ResourcePermissions{"user1": {}},
+	})
+	require.True(t, ok)
+	require.Contains(t, tgt.members, "user1")
+	require.Equal(t, "space", tgt.ref.GetResourceId().GetOpaqueId())
+
+	_, ok = targetOf(events.UploadReady{Failed: true, FileRef: &provider.Reference{ResourceId: root}})
+	require.False(t, ok)
+}
+
+func TestDeliveryOmitsSecrets(t *testing.T) {
+	sub := &Subscription{ID: "sub1"}
+	tgt := target{ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "s", SpaceId: "sp", OpaqueId: "item"}}, spaceID: "sp"}
+	tests := []struct {
+		name  string
+		event any
+	}{
+		{name: "link created", event: events.LinkCreated{Token: "public-link-token", DisplayName: "link"}},
+		{name: "link updated", event: events.LinkUpdated{Token: "public-link-token", DisplayName: "link"}},
+		{name: "link removed", event: events.LinkRemoved{ShareToken: "public-link-token"}},
+		{name: "upload ready", event: events.UploadReady{ExecutingUser: &userpb.User{Id: &userpb.UserId{OpaqueId: "user1"}, Mail: "secret@example.com"}}},
+		{name: "space deleted", event: events.SpaceDeleted{ID: &provider.StorageSpaceId{OpaqueId: "s$sp"}, FinalMembers: map[string]provider.ResourcePermissions{"secret-member": {}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := newDelivery(sub, events.Event{ID: "ev1", Event: tt.event}, "event", tgt, nil)
+			require.NoError(t, err)
+			require.NotContains(t, string(d.body), "secret")
+			require.NotContains(t, string(d.body), "public-link-token")
+			require.Contains(t, string(d.body), `"event":{`)
+		})
+	}
+}
+
+func TestDeliveryRefusesPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer srv.Close()
+
+	w, sub := newTestService(t, srv, config.Delivery{LogSize: 10})
+	w.client = newHTTPClient(w.cfg.Delivery)
+	w.deliver(testDelivery(t, sub))
+	require.Equal(t, int32(0), calls.Load())
+
+	w.cfg.Delivery.AllowPrivateNetworks = true
+	w.client = newHTTPClient(w.cfg.Delivery)
+	w.deliver(testDelivery(t, sub))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestApplyRefusesPrivateURLs(t *testing.T) {
+	w := &Webhooks{cfg: &config.Config{Delivery: config.Delivery{AllowInsecureURLs: true}}}
+	for _, u := range []string{"http://127.0.0.1:8080/hook", "https://[::1]/hook", "https://169.254.169.254/latest/meta-data", "https://10.0.0.1/hook", "https://localhost/hook"} {
+		status, err := w.apply(context.Background(), &Subscription{}, subscriptionRequest{URL: &u})
+		require.Error(t, err, u)
+		require.Equal(t, http.StatusBadRequest, status)
+	}
+
+	u := "https://8.8.8.8/hook"
+	_, err := w.apply(context.Background(), &Subscription{}, subscriptionRequest{URL: &u})
+	require.NoError(t, err)
+
+	w.cfg.Delivery.AllowPrivateNetworks = true
+	u = "https://127.0.0.1/hook"
+	_, err = w.apply(context.Background(), &Subscription{}, subscriptionRequest{URL: &u})
+	require.NoError(t, err)
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/x/net/netx"
+)
+
+const (
+	_basePath = "/graph/v1beta1/extensions/org.libregraph/webhooks"
+
+	// users with this permission can manage the subscriptions of all users
+	_adminPermission = "Settings.ReadWrite"
+)
+
+// subscriptionRequest is the body of create and update requests
+type subscriptionRequest struct {
+	URL        *string   `json:"url"`
+	Secret     *string   `json:"secret"`
+	EventTypes *[]string `json:"eventTypes"`
+	DriveID    *string   `json:"driveId"`
+	ItemID     *string   `json:"itemId"`
+	Enabled    *bool     `json:"enabled"`
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (w *Webhooks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w.mux.ServeHTTP(rw, r)
+}
+
+// HandleListSubscriptions lists the subscriptions of the user, admins see all subscriptions
+func (w *Webhooks) HandleListSubscriptions(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+	u := revactx.ContextMustGetUser(ctx)
+
+	subs, err := w.listSubscriptions()
+	if err != nil {
+		w.log.Error().Err(err).Msg("could not list subscriptions")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	admin := w.isAdmin(ctx, gwc)
+	value := make([]Subscription, 0, len(subs))
+	for _, sub := range subs {
+		if admin || sub.Owner == u.GetId().GetOpaqueId() {
+			value = append(value, sub.redacted())
+		}
+	}
+
+	writeJSON(rw, http.StatusOK, map[string]interface{}{"value": value})
+}
+
+// HandleCreateSubscription creates a subscription for the user
+func (w *Webhooks) HandleCreateSubscription(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+	u := revactx.ContextMustGetUser(ctx)
+
+	var req subscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(rw, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.URL == nil {
+		http.Error(rw, "url is required", http.StatusBadRequest)
+		return
+	}
+
+	sub := &Subscription{
+		ID:              uuid.New().String(),
+		Owner:           u.GetId().GetOpaqueId(),
+		Enabled:         true,
+		CreatedDateTime: time.Now().UTC(),
+	}
+	if req.Secret == nil {
+		secret, err := generateSecret()
+		if err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		req.Secret = &secret
+	}
+	if req.Enabled != nil {
+		sub.Enabled = *req.Enabled
+	}
+
+	if status, err := w.apply(r.Context(), sub, req); err != nil {
+		http.Error(rw, err.Error(), status)
+		return
+	}
+
+	if status, err := w.resolveScope(ctx, gwc, sub, req); err != nil {
+		http.Error(rw, err.Error(), status)
+		return
+	}
+	if sub.DriveID == "" && !w.isAdmin(ctx, gwc) {
+		http.Error(rw, "driveId or itemId is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := w.saveSubscription(sub); err != nil {
+		w.log.Error().Err(err).Msg("could not save subscription")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// the secret is only returned once
+	writeJSON(rw, http.StatusCreated, sub)
+}
+
+// HandleGetSubscription returns a subscription
+func (w *Webhooks) HandleGetSubscription(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+
+	sub, ok := w.accessibleSubscription(ctx, gwc, rw, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, sub.redacted())
+}
+
+// HandleUpdateSubscription updates the url, secret, event types or state of a subscription.
+// Enabling a subscription resets its failure counter.
+func (w *Webhooks) HandleUpdateSubscription(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+
+	sub, ok := w.accessibleSubscription(ctx, gwc, rw, r)
+	if !ok {
+		return
+	}
+
+	var req subscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(rw, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.DriveID != nil || req.ItemID != nil {
+		http.Error(rw, "the scope of a subscription can not be changed", http.StatusBadRequest)
+		return
+	}
+
+	// validate before taking the lock, the url is not resolved again
+	checked := &Subscription{}
+	if status, err := w.apply(r.Context(), checked, req); err != nil {
+		http.Error(rw, err.Error(), status)
+		return
+	}
+
+	sub, err := w.updateSubscription(sub.ID, func(sub *Subscription) bool {
+		if req.URL != nil {
+			sub.URL = checked.URL
+		}
+		if req.Secret != nil {
+			sub.Secret = checked.Secret
+		}
+		if req.EventTypes != nil {
+			sub.EventTypes = checked.EventTypes
+		}
+		if req.Enabled != nil {
+			if *req.Enabled && !sub.Enabled {
+				sub.ConsecutiveFailures = 0
+				sub.DisabledReason = ""
+			}
+			sub.Enabled = *req.Enabled
+		}
+		return true
+	})
+	switch {
+	case errors.Is(err, ErrSubscriptionNotFound):
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	case err != nil:
+		w.log.Error().Err(err).Msg("could not update subscription")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, sub.redacted())
+}
+
+// HandleDeleteSubscription deletes a subscription and its delivery log
+func (w *Webhooks) HandleDeleteSubscription(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+
+	sub, ok := w.accessibleSubscription(ctx, gwc, rw, r)
+	if !ok {
+		return
+	}
+
+	if err := w.deleteSubscription(sub.ID); err != nil {
+		w.log.Error().Err(err).Msg("could not delete subscription")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListDeliveries returns the delivery log of a subscription, newest first
+func (w *Webhooks) HandleListDeliveries(rw http.ResponseWriter, r *http.Request) {
+	ctx, gwc, ok := w.requestContext(rw, r)
+	if !ok {
+		return
+	}
+
+	sub, ok := w.accessibleSubscription(ctx, gwc, rw, r)
+	if !ok {
+		return
+	}
+
+	log, err := w.deliveryLog(sub.ID)
+	if err != nil {
+		w.log.Error().Err(err).Msg("could not read delivery log")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, map[string]interface{}{"value": log})
+}
+
+// requestContext returns the context of the requesting user and a gateway client
+func (w *Webhooks) requestContext(rw http.ResponseWriter, r *http.Request) (context.Context, gateway.GatewayAPIClient, bool) {
+	ctx := r.Context()
+	ctx = metadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, r.Header.Get(revactx.TokenHeader))
+
+	if _, ok := revactx.ContextGetUser(ctx); !ok {
+		rw.WriteHeader(http.StatusUnauthorized)
+		return nil, nil, false
+	}
+
+	gwc, err := w.gws.Next()
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	return ctx, gwc, true
+}
+
+// accessibleSubscription returns the subscription of the request if the user owns it or is an admin
+func (w *Webhooks) accessibleSubscription(ctx context.Context, gwc gateway.GatewayAPIClient, rw http.ResponseWriter, r *http.Request) (*Subscription, bool) {
+	sub, err := w.getSubscription(chi.URLParam(r, "subscriptionID"))
+	switch {
+	case errors.Is(err, ErrSubscriptionNotFound):
+		rw.WriteHeader(http.StatusNotFound)
+		return nil, false
+	case err != nil:
+		w.log.Error().Err(err).Msg("could not read subscription")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	u := revactx.ContextMustGetUser(ctx)
+	if sub.Owner != u.GetId().GetOpaqueId() && !w.isAdmin(ctx, gwc) {
+		// don't reveal the existence of other users subscriptions
+		rw.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+	return sub, true
+}
+
+func (w *Webhooks) isAdmin(ctx context.Context, gwc gateway.GatewayAPIClient) bool {
+	ok, err := utils.CheckPermission(ctx, _adminPermission, gwc)
+	if err != nil {
+		w.log.Error().Err(err).Msg("could not check permission")
+	}
+	return ok
+}
+
+// apply validates the url, secret and event types of a request and sets them on the subscription
+func (w *Webhooks) apply(ctx context.Context, sub *Subscription, req subscriptionRequest) (int, error) {
+	if req.URL != nil {
+		u, err := url.Parse(*req.URL)
+		switch {
+		case err != nil || u.Host == "":
+			return http.StatusBadRequest, errors.New("url is invalid")
+		case u.Scheme == "https":
+		case u.Scheme == "http" && w.cfg.Delivery.AllowInsecureURLs:
+		default:
+			return http.StatusBadRequest, errors.New("url must use https")
+		}
+		if !w.cfg.Delivery.AllowPrivateNetworks {
+			if err := netx.CheckPublicHost(ctx, u.Hostname()); err != nil {
+				return http.StatusBadRequest, errors.New("url must point to a public address")
+			}
+		}
+		sub.URL = u.String()
+	}
+
+	if req.Secret != nil {
+		if len(*req.Secret) < 16 {
+			return http.StatusBadRequest, errors.New("secret must be at least 16 characters long")
+		}
+		sub.Secret = *req.Secret
+	}
+
+	if req.EventTypes != nil {
+		for _, t := range *req.EventTypes {
+			if !slices.Contains(w.eventTypes, t) {
+				return http.StatusBadRequest, errors.New("unsupported event type " + t)
+			}
+		}
+		sub.EventTypes = *req.EventTypes
+	}
+	return 0, nil
+}
+
+// resolveScope checks that the user can stat the space or folder of a new subscription
+func (w *Webhooks) resolveScope(ctx context.Context, gwc gateway.GatewayAPIClient, sub *Subscription, req subscriptionRequest) (int, error) {
+	var ref *provider.Reference
+	switch {
+	case req.ItemID != nil && *req.ItemID != "":
+		rid, err := storagespace.ParseID(*req.ItemID)
+		if err != nil || rid.GetOpaqueId() == "" {
+			return http.StatusBadRequest, errors.New("itemId is invalid")
+		}
+		ref = &provider.Reference{ResourceId: &rid}
+	case req.DriveID != nil && *req.DriveID != "":
+		rid, err := storagespace.ParseID(*req.DriveID)
+		if err != nil {
+			return http.StatusBadRequest, errors.New("driveId is invalid")
+		}
+		rid.OpaqueId = rid.GetSpaceId()
+		ref = &provider.Reference{ResourceId: &rid}
+	default:
+		return 0, nil
+	}
+
+	info, err := utils.GetResource(ctx, ref, gwc)
+	if err != nil {
+		return http.StatusNotFound, errors.New("the space or folder does not exist or is not accessible")
+	}
+
+	driveID := storagespace.FormatStorageID(info.GetId().GetStorageId(), info.GetId().GetSpaceId())
+	if req.DriveID != nil && *req.DriveID != "" {
+		if _, sid := storagespace.SplitStorageID(*req.DriveID); sid != info.GetId().GetSpaceId() {
+			return http.StatusBadRequest, errors.New("the item is not part of the drive")
+		}
+	}
+	sub.DriveID = driveID
+
+	if req.ItemID != nil && *req.ItemID != "" && !utils.IsSpaceRoot(info) {
+		if info.GetType() != provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+			return http.StatusBadRequest, errors.New("the item is not a folder")
+		}
+		sub.ItemID = storagespace.FormatResourceID(info.GetId())
+	}
+	return 0, nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func writeJSON(rw http.ResponseWriter, status int, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(v)
+}
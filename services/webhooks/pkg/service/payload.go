@@ -0,0 +1,228 @@
+package service
+
+import (
+	"time"
+
+	group "github.com/cs3org/go-cs3apis/cs3/identity/group/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+)
+
+// The payloads only contain the fields of the events that can be disclosed to anyone
+// who can see the resource. Secrets like public link tokens, full user objects and the
+// members of spaces are never sent.
+
+// FileEvent is the payload of the events about files and folders
+type FileEvent struct {
+	ExecutantID string `json:"executantId,omitempty"`
+	// OldReference is the former location of moved and restored resources
+	OldReference *Reference `json:"oldReference,omitempty"`
+}
+
+// Reference points to a resource by the id of a resource and a path relative to it
+type Reference struct {
+	ResourceID string `json:"resourceId,omitempty"`
+	Path       string `json:"path,omitempty"`
+}
+
+// ShareEvent is the payload of the events about shares
+type ShareEvent struct {
+	ExecutantID    string                        `json:"executantId,omitempty"`
+	ShareID        string                        `json:"shareId,omitempty"`
+	SharerID       string                        `json:"sharerId,omitempty"`
+	GranteeUserID  string                        `json:"granteeUserId,omitempty"`
+	GranteeGroupID string                        `json:"granteeGroupId,omitempty"`
+	ItemID         string                        `json:"itemId,omitempty"`
+	ResourceName   string                        `json:"resourceName,omitempty"`
+	Permissions    *provider.ResourcePermissions `json:"permissions,omitempty"`
+	// UpdateMask contains the fields of updated shares that were changed
+	UpdateMask []string `json:"updateMask,omitempty"`
+}
+
+// LinkEvent is the payload of the events about public links
+type LinkEvent struct {
+	ExecutantID       string                        `json:"executantId,omitempty"`
+	ShareID           string                        `json:"shareId,omitempty"`
+	SharerID          string                        `json:"sharerId,omitempty"`
+	ItemID            string                        `json:"itemId,omitempty"`
+	ResourceName      string                        `json:"resourceName,omitempty"`
+	DisplayName       string                        `json:"displayName,omitempty"`
+	Permissions       *provider.ResourcePermissions `json:"permissions,omitempty"`
+	Expiration        *time.Time                    `json:"expiration,omitempty"`
+	PasswordProtected bool                          `json:"passwordProtected,omitempty"`
+	// FieldUpdated is the field of an updated link that was changed
+	FieldUpdated string `json:"fieldUpdated,omitempty"`
+}
+
+// SpaceEvent is the payload of the events about spaces
+type SpaceEvent struct {
+	ExecutantID    string `json:"executantId,omitempty"`
+	SpaceID        string `json:"spaceId,omitempty"`
+	OwnerID        string `json:"ownerId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Type           string `json:"type,omitempty"`
+	GranteeUserID  string `json:"granteeUserId,omitempty"`
+	GranteeGroupID string `json:"granteeGroupId,omitempty"`
+}
+
+// payloadOf maps an event to its payload, nil means the event has no payload
+func payloadOf(ev interface{}) interface{} {
+	switch ev := ev.(type) {
+	case events.ContainerCreated:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.FileUploaded:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.UploadReady:
+		return FileEvent{ExecutantID: userID(ev.ExecutingUser.GetId())}
+	case events.FileTouched:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.FileVersionRestored:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.ItemMoved:
+		return FileEvent{ExecutantID: userID(ev.Executant), OldReference: reference(ev.OldReference)}
+	case events.ItemTrashed:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.ItemRestored:
+		return FileEvent{ExecutantID: userID(ev.Executant), OldReference: reference(ev.OldReference)}
+	case events.ItemPurged:
+		return FileEvent{ExecutantID: userID(ev.Executant)}
+	case events.ShareCreated:
+		return ShareEvent{
+			ExecutantID:    userID(ev.Executant),
+			ShareID:        ev.ShareID.GetOpaqueId(),
+			SharerID:       userID(ev.Sharer),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+			ItemID:         resourceID(ev.ItemID),
+			ResourceName:   ev.ResourceName,
+			Permissions:    ev.Permissions.GetPermissions(),
+		}
+	case events.ShareUpdated:
+		return ShareEvent{
+			ExecutantID:    userID(ev.Executant),
+			ShareID:        ev.ShareID.GetOpaqueId(),
+			SharerID:       userID(ev.Sharer),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+			ItemID:         resourceID(ev.ItemID),
+			ResourceName:   ev.ResourceName,
+			Permissions:    ev.Permissions.GetPermissions(),
+			UpdateMask:     ev.UpdateMask,
+		}
+	case events.ShareRemoved:
+		return ShareEvent{
+			ExecutantID:    userID(ev.Executant),
+			ShareID:        ev.ShareID.GetOpaqueId(),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+			ItemID:         resourceID(ev.ItemID),
+			ResourceName:   ev.ResourceName,
+		}
+	case events.LinkCreated:
+		return LinkEvent{
+			ExecutantID:       userID(ev.Executant),
+			ShareID:           ev.ShareID.GetOpaqueId(),
+			SharerID:          userID(ev.Sharer),
+			ItemID:            resourceID(ev.ItemID),
+			ResourceName:      ev.ResourceName,
+			DisplayName:       ev.DisplayName,
+			Permissions:       ev.Permissions.GetPermissions(),
+			Expiration:        timestamp(ev.Expiration),
+			PasswordProtected: ev.PasswordProtected,
+		}
+	case events.LinkUpdated:
+		return LinkEvent{
+			ExecutantID:       userID(ev.Executant),
+			ShareID:           ev.ShareID.GetOpaqueId(),
+			SharerID:          userID(ev.Sharer),
+			ItemID:            resourceID(ev.ItemID),
+			ResourceName:      ev.ResourceName,
+			DisplayName:       ev.DisplayName,
+			Permissions:       ev.Permissions.GetPermissions(),
+			Expiration:        timestamp(ev.Expiration),
+			PasswordProtected: ev.PasswordProtected,
+			FieldUpdated:      ev.FieldUpdated,
+		}
+	case events.LinkRemoved:
+		return LinkEvent{
+			ExecutantID:  userID(ev.Executant),
+			ShareID:      ev.ShareID.GetOpaqueId(),
+			ItemID:       resourceID(ev.ItemID),
+			ResourceName: ev.ResourceName,
+		}
+	case events.SpaceCreated:
+		return SpaceEvent{
+			ExecutantID: userID(ev.Executant),
+			SpaceID:     ev.ID.GetOpaqueId(),
+			OwnerID:     userID(ev.Owner),
+			Name:        ev.Name,
+			Type:        ev.Type,
+		}
+	case events.SpaceRenamed:
+		return SpaceEvent{ExecutantID: userID(ev.Executant), SpaceID: ev.ID.GetOpaqueId(), OwnerID: userID(ev.Owner), Name: ev.Name}
+	case events.SpaceUpdated:
+		return SpaceEvent{ExecutantID: userID(ev.Executant), SpaceID: ev.ID.GetOpaqueId(), Name: ev.Space.GetName(), Type: ev.Space.GetSpaceType()}
+	case events.SpaceDisabled:
+		return SpaceEvent{ExecutantID: userID(ev.Executant), SpaceID: ev.ID.GetOpaqueId()}
+	case events.SpaceEnabled:
+		return SpaceEvent{ExecutantID: userID(ev.Executant), SpaceID: ev.ID.GetOpaqueId(), OwnerID: userID(ev.Owner)}
+	case events.SpaceDeleted:
+		return SpaceEvent{ExecutantID: userID(ev.Executant), SpaceID: ev.ID.GetOpaqueId(), Name: ev.SpaceName}
+	case events.SpaceShared:
+		return SpaceEvent{
+			ExecutantID:    userID(ev.Executant),
+			SpaceID:        ev.ID.GetOpaqueId(),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+		}
+	case events.SpaceShareUpdated:
+		return SpaceEvent{
+			ExecutantID:    userID(ev.Executant),
+			SpaceID:        ev.ID.GetOpaqueId(),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+		}
+	case events.SpaceUnshared:
+		return SpaceEvent{
+			ExecutantID:    userID(ev.Executant),
+			SpaceID:        ev.ID.GetOpaqueId(),
+			GranteeUserID:  userID(ev.GranteeUserID),
+			GranteeGroupID: groupID(ev.GranteeGroupID),
+		}
+	}
+	return nil
+}
+
+func userID(id *user.UserId) string {
+	return id.GetOpaqueId()
+}
+
+func groupID(id *group.GroupId) string {
+	return id.GetOpaqueId()
+}
+
+func resourceID(id *provider.ResourceId) string {
+	if id == nil {
+		return ""
+	}
+	return storagespace.FormatResourceID(id)
+}
+
+func reference(ref *provider.Reference) *Reference {
+	if ref == nil {
+		return nil
+	}
+	return &Reference{ResourceID: resourceID(ref.GetResourceId()), Path: ref.GetPath()}
+}
+
+func timestamp(ts *types.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := utils.TSToTime(ts)
+	return &t
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/jellydator/ttlcache/v2"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	microstore "go-micro.dev/v4/store"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/webhooks/pkg/config"
+)
+
+// SupportedEvents are the events subscriptions can be created for
+var SupportedEvents = []events.Unmarshaller{
+	events.ContainerCreated{},
+	events.FileUploaded{},
+	events.UploadReady{},
+	events.FileTouched{},
+	events.FileVersionRestored{},
+	events.ItemMoved{},
+	events.ItemTrashed{},
+	events.ItemRestored{},
+	events.ItemPurged{},
+	events.ShareCreated{},
+	events.ShareUpdated{},
+	events.ShareRemoved{},
+	events.LinkCreated{},
+	events.LinkUpdated{},
+	events.LinkRemoved{},
+	events.SpaceCreated{},
+	events.SpaceRenamed{},
+	events.SpaceUpdated{},
+	events.SpaceDisabled{},
+	events.SpaceEnabled{},
+	events.SpaceDeleted{},
+	events.SpaceShared{},
+	events.SpaceShareUpdated{},
+	events.SpaceUnshared{},
+}
+
+// the maximum number of parents that are walked to check if a resource is inside a folder
+const _maxDepth = 256
+
+// Webhooks delivers events to the subscribed endpoints
+type Webhooks struct {
+	cfg        *config.Config
+	log        log.Logger
+	events     <-chan events.Event
+	gws        pool.Selectable[gateway.GatewayAPIClient]
+	mux        *chi.Mux
+	store      microstore.Store
+	tracer     trace.Tracer
+	client     *http.Client
+	queue      chan *delivery
+	sessions   *ttlcache.Cache
+	eventTypes []string
+	lock       sync.Mutex
+}
+
+// New creates a new Webhooks service
+func New(opts ...Option) (*Webhooks, error) {
+	o := &Options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.Stream == nil {
+		return nil, errors.New("stream is required")
+	}
+	if o.Store == nil {
+		return nil, errors.New("store is required")
+	}
+
+	ch, err := events.Consume(o.Stream, o.Config.Service.Name, o.RegisteredEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := ttlcache.NewCache()
+	if err := sessions.SetTTL(time.Minute); err != nil {
+		return nil, err
+	}
+
+	w := &Webhooks{
+		cfg:      o.Config,
+		log:      o.Logger,
+		events:   ch,
+		gws:      o.GatewaySelector,
+		mux:      o.Mux,
+		store:    o.Store,
+		tracer:   o.TraceProvider.Tracer("github.com/opencloud-eu/opencloud/services/webhooks/pkg/service"),
+		client:   newHTTPClient(o.Config.Delivery),
+		queue:    make(chan *delivery, 1000),
+		sessions: sessions,
+	}
+	for _, e := range o.RegisteredEvents {
+		w.eventTypes = append(w.eventTypes, reflect.TypeOf(e).Name())
+	}
+
+	w.mux.Route(_basePath, func(r chi.Router) {
+		r.Get("/", w.HandleListSubscriptions)
+		r.Post("/", w.HandleCreateSubscription)
+		r.Get("/{subscriptionID}", w.HandleGetSubscription)
+		r.Patch("/{subscriptionID}", w.HandleUpdateSubscription)
+		r.Delete("/{subscriptionID}", w.HandleDeleteSubscription)
+		r.Get("/{subscriptionID}/deliveries", w.HandleListDeliveries)
+	})
+
+	for i := 0; i < w.cfg.Delivery.Workers; i++ {
+		go w.work()
+	}
+	go w.Run()
+
+	return w, nil
+}
+
+// Run consumes the events and delivers them to the matching subscriptions
+func (w *Webhooks) Run() {
+	for e := range w.events {
+		if err := w.processEvent(e); err != nil {
+			w.log.Error().Err(err).Str("eventid", e.ID).Str("type", e.Type).Msg("could not process event")
+		}
+	}
+}
+
+// target describes the resource an event is about
+type target struct {
+	// ref is the resource subscribers need to be able to stat to receive the event
+	ref     *provider.Reference
+	spaceID string
+	// gone is true if the resource does not exist anymore. The ref then points
+	// to the former parent or the space root.
+	gone bool
+	// id of the resource the event is about, only known for some resources that are gone
+	id *provider.ResourceId
+	// members are the ids of the users and groups that were members of a deleted space
+	members map[string]struct{}
+}
+
+// targetOf returns the resource an event is about, false means the event is not delivered
+func targetOf(ev interface{}) (target, bool) {
+	switch ev := ev.(type) {
+	case events.ContainerCreated:
+		return refTarget(ev.Ref)
+	case events.FileUploaded:
+		return refTarget(ev.Ref)
+	case events.UploadReady:
+		if ev.Failed {
+			return target{}, false
+		}
+		return refTarget(ev.FileRef)
+	case events.FileTouched:
+		return refTarget(ev.Ref)
+	case events.FileVersionRestored:
+		return refTarget(ev.Ref)
+	case events.ItemMoved:
+		return refTarget(ev.Ref)
+	case events.ItemRestored:
+		return refTarget(ev.Ref)
+	case events.ItemTrashed:
+		t, ok := refTarget(parentRef(ev.Ref))
+		t.gone, t.id = true, ev.ID
+		return t, ok
+	case events.ItemPurged:
+		t, ok := spaceRootTarget(ev.Ref.GetResourceId())
+		t.gone = true
+		return t, ok
+	case events.ShareCreated:
+		return idTarget(ev.ItemID)
+	case events.ShareUpdated:
+		return idTarget(ev.ItemID)
+	case events.ShareRemoved:
+		return idTarget(ev.ItemID)
+	case events.LinkCreated:
+		return idTarget(ev.ItemID)
+	case events.LinkUpdated:
+		return idTarget(ev.ItemID)
+	case events.LinkRemoved:
+		return idTarget(ev.ItemID)
+	case events.SpaceCreated:
+		return spaceTarget(ev.ID)
+	case events.SpaceRenamed:
+		return spaceTarget(ev.ID)
+	case events.SpaceUpdated:
+		return spaceTarget(ev.ID)
+	case events.SpaceDisabled:
+		return spaceTarget(ev.ID)
+	case events.SpaceEnabled:
+		return spaceTarget(ev.ID)
+	case events.SpaceShared:
+		return spaceTarget(ev.ID)
+	case events.SpaceShareUpdated:
+		return spaceTarget(ev.ID)
+	case events.SpaceUnshared:
+		return spaceTarget(ev.ID)
+	case events.SpaceDeleted:
+		t, ok := spaceTarget(ev.ID)
+		t.gone, t.members = true, make(map[string]struct{}, len(ev.FinalMembers))
+		for id := range ev.FinalMembers {
+			t.members[id] = struct{}{}
+		}
+		return t, ok
+	}
+	return target{}, false
+}
+
+func refTarget(ref *provider.Reference) (target, bool) {
+	if ref.GetResourceId().GetSpaceId() == "" {
+		return target{}, false
+	}
+	return target{ref: ref, spaceID: ref.GetResourceId().GetSpaceId()}, true
+}
+
+func idTarget(id *provider.ResourceId) (target, bool) {
+	return refTarget(&provider.Reference{ResourceId: id})
+}
+
+func spaceRootTarget(id *provider.ResourceId) (target, bool) {
+	return idTarget(&provider.ResourceId{
+		StorageId: id.GetStorageId(),
+		SpaceId:   id.GetSpaceId(),
+		OpaqueId:  id.GetSpaceId(),
+	})
+}
+
+func spaceTarget(id *provider.StorageSpaceId) (target, bool) {
+	rid, err := storagespace.ParseID(id.GetOpaqueId())
+	if err != nil {
+		return target{}, false
+	}
+	return spaceRootTarget(&rid)
+}
+
+// parentRef returns a reference to the parent of a resource that does not exist
+// anymore. If the parent is unknown the space root is returned.
+func parentRef(ref *provider.Reference) *provider.Reference {
+	p := path.Clean(ref.GetPath())
+	if p == "." || p == "/" {
+		t, _ := spaceRootTarget(ref.GetResourceId())
+		return t.ref
+	}
+	return &provider.Reference{ResourceId: ref.GetResourceId(), Path: utils.MakeRelativePath(path.Dir(p))}
+}
+
+func (w *Webhooks) processEvent(e events.Event) error {
+	t, ok := targetOf(e.Event)
+	if !ok {
+		return nil
+	}
+	eventType := reflect.TypeOf(e.Event).Name()
+
+	subs, err := w.listSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	gwc, err := w.gws.Next()
+	if err != nil {
+		return fmt.Errorf("cant get gateway client: %w", err)
+	}
+
+	ctx, span := w.tracer.Start(context.Background(), "processEvent")
+	defer span.End()
+
+	for _, sub := range subs {
+		if !sub.matches(eventType, t.spaceID) {
+			continue
+		}
+
+		info, err := w.authorize(ctx, gwc, sub, t)
+		if err != nil {
+			w.log.Debug().Err(err).Str("subscription", sub.ID).Str("eventid", e.ID).Msg("event is not delivered to subscription")
+			continue
+		}
+
+		d, err := newDelivery(sub, e, eventType, t, info)
+		if err != nil {
+			return err
+		}
+		w.queue <- d
+	}
+	return nil
+}
+
+// authorize checks if the owner of a subscription may see the target of an event and
+// returns the resource info of the target as seen by the owner
+func (w *Webhooks) authorize(ctx context.Context, gwc gateway.GatewayAPIClient, sub *Subscription, t target) (*provider.ResourceInfo, error) {
+	octx, u, err := w.impersonate(ctx, gwc, sub.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.members != nil {
+		// the space is gone, check the members it had
+		if sub.ItemID != "" || !isMember(u, t.members) {
+			return nil, errors.New("subscriber is not a member of the deleted space")
+		}
+		return nil, nil
+	}
+
+	info, err := utils.GetResource(octx, t.ref, gwc)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.ItemID != "" {
+		within, err := isWithin(octx, gwc, info, sub.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if !within {
+			return nil, errors.New("resource is outside of the subscribed folder")
+		}
+	}
+	return info, nil
+}
+
+// session is a cached machine auth session of a subscription owner
+type session struct {
+	token string
+	user  *user.User
+}
+
+// impersonate returns a context authenticated as the given user
+func (w *Webhooks) impersonate(ctx context.Context, gwc gateway.GatewayAPIClient, userID string) (context.Context, *user.User, error) {
+	var s *session
+	if v, err := w.sessions.Get(userID); err == nil {
+		s = v.(*session)
+	} else {
+		res, err := gwc.Authenticate(ctx, &gateway.AuthenticateRequest{
+			Type:         "machine",
+			ClientId:     "userid:" + userID,
+			ClientSecret: w.cfg.MachineAuthAPIKey,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+			return nil, nil, fmt.Errorf("could not impersonate user %s: %s", userID, res.GetStatus().GetMessage())
+		}
+		s = &session{token: res.GetToken(), user: res.GetUser()}
+		_ = w.sessions.Set(userID, s)
+	}
+
+	ctx = revactx.ContextSetUser(ctx, s.user)
+	ctx = metadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, s.token)
+	return ctx, s.user, nil
+}
+
+// isMember returns true if the user or one of its groups is in members
+func isMember(u *user.User, members map[string]struct{}) bool {
+	if _, ok := members[u.GetId().GetOpaqueId()]; ok {
+		return true
+	}
+	return slices.ContainsFunc(u.GetGroups(), func(g string) bool {
+		_, ok := members[g]
+		return ok
+	})
+}
+
+// isWithin returns true if the resource is the folder with the given id or inside of it
+func isWithin(ctx context.Context, gwc gateway.GatewayAPIClient, info *provider.ResourceInfo, folderID string) (bool, error) {
+	fid, err := storagespace.ParseID(folderID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < _maxDepth; i++ {
+		if utils.ResourceIDEqual(info.GetId(), &fid) {
+			return true, nil
+		}
+		if info.GetParentId() == nil || utils.IsSpaceRoot(info) {
+			return false, nil
+		}
+		info, err = utils.GetResourceByID(ctx, info.GetParentId(), gwc)
+		if err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	microstore "go-micro.dev/v4/store"
+)
+
+const (
+	_subscriptionPrefix = "subscriptions/"
+	_deliveryLogPrefix  = "deliveries/"
+)
+
+// ErrSubscriptionNotFound is returned when a subscription does not exist
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// Subscription is a webhook endpoint that is notified about events
+type Subscription struct {
+	ID string `json:"id"`
+	// Owner is the id of the user who created the subscription. Events are only
+	// delivered if the owner is allowed to stat the resource they are about.
+	Owner string `json:"owner"`
+	URL   string `json:"url"`
+	// Secret is used to sign the deliveries, it is only returned when the subscription is created
+	Secret string `json:"secret,omitempty"`
+	// EventTypes filters the delivered events, all supported events are delivered if it is empty
+	EventTypes []string `json:"eventTypes,omitempty"`
+	// DriveID and ItemID limit the subscription to a space or a folder in a space
+	DriveID string `json:"driveId,omitempty"`
+	ItemID  string `json:"itemId,omitempty"`
+
+	Enabled             bool      `json:"enabled"`
+	DisabledReason      string    `json:"disabledReason,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	CreatedDateTime     time.Time `json:"createdDateTime"`
+}
+
+// DeliveryAttempt is an entry of the delivery log of a subscription
+type DeliveryAttempt struct {
+	DeliveryID string    `json:"deliveryId"`
+	EventID    string    `json:"eventId"`
+	EventType  string    `json:"eventType"`
+	Attempt    int       `json:"attempt"`
+	DateTime   time.Time `json:"dateTime"`
+	DurationMs int64     `json:"durationMs"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Success    bool      `json:"success"`
+}
+
+// matches returns true if the subscription wants events of the given type from the given space.
+// Whether the owner is allowed to see the event is not checked here.
+func (s *Subscription) matches(eventType string, spaceID string) bool {
+	if !s.Enabled {
+		return false
+	}
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, eventType) {
+		return false
+	}
+	if s.DriveID == "" {
+		return true
+	}
+	_, sid := storagespace.SplitStorageID(s.DriveID)
+	return sid == spaceID
+}
+
+// redacted returns a copy of the subscription without the secret
+func (s Subscription) redacted() Subscription {
+	s.Secret = ""
+	return s
+}
+
+func (w *Webhooks) getSubscription(id string) (*Subscription, error) {
+	recs, err := w.store.Read(_subscriptionPrefix + id)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return nil, ErrSubscriptionNotFound
+	case err != nil:
+		return nil, err
+	case len(recs) == 0:
+		return nil, ErrSubscriptionNotFound
+	}
+
+	sub := &Subscription{}
+	return sub, json.Unmarshal(recs[0].Value, sub)
+}
+
+func (w *Webhooks) saveSubscription(sub *Subscription) error {
+	b, err := json.Marshal(sub)
+	if err != nil {
+		return err
+	}
+	return w.store.Write(&microstore.Record{Key: _subscriptionPrefix + sub.ID, Value: b})
+}
+
+func (w *Webhooks) deleteSubscription(id string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if err := w.store.Delete(_subscriptionPrefix + id); err != nil && !errors.Is(err, microstore.ErrNotFound) {
+		return err
+	}
+	if err := w.store.Delete(_deliveryLogPrefix + id); err != nil && !errors.Is(err, microstore.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// listSubscriptions returns all subscriptions sorted by creation date
+func (w *Webhooks) listSubscriptions() ([]*Subscription, error) {
+	keys, err := w.store.List(microstore.ListPrefix(_subscriptionPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*Subscription, 0, len(keys))
+	for _, k := range keys {
+		recs, err := w.store.Read(k)
+		if err != nil || len(recs) == 0 {
+			// deleted in the meantime
+			continue
+		}
+		sub := &Subscription{}
+		if err := json.Unmarshal(recs[0].Value, sub); err != nil {
+			w.log.Error().Err(err).Str("key", k).Msg("could not unmarshal subscription")
+			continue
+		}
+		subs = append(subs, sub)
+	}
+
+	slices.SortFunc(subs, func(a, b *Subscription) int {
+		return a.CreatedDateTime.Compare(b.CreatedDateTime)
+	})
+	return subs, nil
+}
+
+// updateSubscription applies f to a subscription while holding the lock
+func (w *Webhooks) updateSubscription(id string, f func(sub *Subscription) bool) (*Subscription, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	sub, err := w.getSubscription(id)
+	if err != nil {
+		return nil, err
+	}
+	if !f(sub) {
+		return sub, nil
+	}
+	return sub, w.saveSubscription(sub)
+}
+
+func (w *Webhooks) deliveryLog(id string) ([]DeliveryAttempt, error) {
+	recs, err := w.store.Read(_deliveryLogPrefix + id)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return []DeliveryAttempt{}, nil
+	case err != nil:
+		return nil, err
+	case len(recs) == 0:
+		return []DeliveryAttempt{}, nil
+	}
+
+	var log []DeliveryAttempt
+	return log, json.Unmarshal(recs[0].Value, &log)
+}
+
+// logAttempt adds an attempt to the delivery log, the newest attempt comes first
+func (w *Webhooks) logAttempt(id string, a DeliveryAttempt) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	log, err := w.deliveryLog(id)
+	if err != nil {
+		return err
+	}
+
+	log = append([]DeliveryAttempt{a}, log...)
+	if size := w.cfg.Delivery.LogSize; len(log) > size {
+		log = log[:size]
+	}
+
+	b, err := json.Marshal(log)
+	if err != nil {
+		return err
+	}
+	return w.store.Write(&microstore.Record{Key: _deliveryLogPrefix + id, Value: b})
+}